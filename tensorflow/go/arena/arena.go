@@ -0,0 +1,305 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package arena shares the constant weights of TensorFlow graphs between
+// models, such as the versions of a model or the members of an ensemble,
+// that have weights in common.
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package arena
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/codec"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+)
+
+// Options configures an Arena.
+type Options struct {
+	// MinBytes is the minimum size of the constants to share. Small
+	// constants contribute little to the memory used by a model.
+	// Defaults to 4096.
+	MinBytes int
+	// Select, if not nil, reports whether the constant name, of at least
+	// MinBytes bytes, is mapped.
+	Select func(name string) bool
+	// MappedDir is the directory from which the ImmutableConst operations
+	// read the files of the arena, for graphs run on other hosts than the
+	// one rewriting them, to which the files are copied. Defaults to the
+	// absolute path of the directory of the arena.
+	MappedDir string
+}
+
+// Stats describes the changes made by Arena.Rewrite.
+type Stats struct {
+	// Mapped lists the names of the constants read from the arena.
+	Mapped []string
+	// Shared lists the names of the mapped constants whose content was
+	// already in the arena, and is therefore shared with other graphs.
+	Shared []string
+	// MappedBytes and SharedBytes are the sizes of the Mapped and Shared
+	// constants.
+	MappedBytes, SharedBytes int64
+	// Files lists the names of the files of the Mapped constants in the
+	// directory of the arena, once each.
+	Files []string
+}
+
+// Arena stores the contents of constants in a directory, in files named by
+// the fingerprint of their content, from which graphs rewritten by the
+// arena memory map them with ImmutableConst operations. As the operating
+// system keeps a single copy of the pages of a file however many times it
+// is mapped, identical weights are shared by all the graphs, sessions and
+// processes using the arena, instead of being copied into each of them.
+//
+// Only constants (Const operations) are shared: graphs should be frozen,
+// their variables converted to constants, to benefit from the arena. The
+// files of an arena must not be removed while graphs using them are loaded.
+//
+// The methods of Arena are safe for concurrent use, and several processes
+// can use the same directory.
+type Arena struct {
+	dir  string
+	opts Options
+
+	mu sync.Mutex
+}
+
+// New returns an Arena storing constants in dir, which is created if
+// needed.
+func New(dir string, opts Options) (*Arena, error) {
+	if opts.MinBytes == 0 {
+		opts.MinBytes = 4096
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	if opts.MappedDir == "" {
+		opts.MappedDir = abs
+	}
+	return &Arena{dir: abs, opts: opts}, nil
+}
+
+// Import imports the graph serialized in graphDef into g, like g.Import,
+// after rewriting it with Rewrite.
+func (a *Arena) Import(g *tf.Graph, graphDef []byte, prefix string) (*Stats, error) {
+	def, stats, err := a.Rewrite(graphDef)
+	if err != nil {
+		return nil, err
+	}
+	return stats, g.Import(def, prefix)
+}
+
+// ImportFrom imports the graph serialized in the GraphDef read from r into
+// g, like g.ImportFrom, rewriting its constants like Rewrite as they are
+// read, so that GraphDefs too large to be held in memory, or larger than
+// the 2GB limit of protocol buffers, can be imported. If opts.RewriteNode
+// is not nil, the arena rewrites the nodes it returns.
+func (a *Arena) ImportFrom(g *tf.Graph, r io.ReadSeeker, opts *tf.ImportOptions) (*Stats, error) {
+	var o tf.ImportOptions
+	if opts != nil {
+		o = *opts
+	}
+	stats := new(Stats)
+	rewrite := o.RewriteNode
+	o.RewriteNode = func(nodeDef []byte) ([]byte, error) {
+		if rewrite != nil {
+			var err error
+			if nodeDef, err = rewrite(nodeDef); err != nil {
+				return nil, err
+			}
+		}
+		var n pb.NodeDef
+		if err := proto.Unmarshal(nodeDef, &n); err != nil {
+			return nil, err
+		}
+		if mapped, err := a.rewriteNode(&n, stats); err != nil || !mapped {
+			return nodeDef, err
+		}
+		return proto.Marshal(&n)
+	}
+	return stats, g.ImportFrom(r, &o)
+}
+
+// Rewrite replaces the constants of a graph, given as a serialized
+// tensorflow.GraphDef protocol buffer
+// (https://www.tensorflow.org/code/tensorflow/core/framework/graph.proto),
+// by ImmutableConst operations reading their content from the arena, and
+// returns the serialized rewritten graph. Every operation keeps its name,
+// so the feeds and fetches of the graph are unchanged.
+//
+// Constants smaller than Options.MinBytes, not selected by Options.Select,
+// of string or other non-numeric types, or placed on devices other than the
+// CPU (on which ImmutableConst is available) are left unchanged.
+func (a *Arena) Rewrite(graphDef []byte) ([]byte, *Stats, error) {
+	var def pb.GraphDef
+	if err := proto.Unmarshal(graphDef, &def); err != nil {
+		return nil, nil, fmt.Errorf("invalid GraphDef: %v", err)
+	}
+	stats := new(Stats)
+	for _, n := range def.Node {
+		if _, err := a.rewriteNode(n, stats); err != nil {
+			return nil, nil, err
+		}
+	}
+	out, err := proto.Marshal(&def)
+	if err != nil {
+		return nil, nil, err
+	}
+	return out, stats, nil
+}
+
+// rewriteNode replaces n by an ImmutableConst operation if it is a
+// constant to map, as described by Rewrite, and adds it to stats.
+func (a *Arena) rewriteNode(n *pb.NodeDef, stats *Stats) (mapped bool, err error) {
+	if n.Op != "Const" || (n.Device != "" && !strings.Contains(strings.ToUpper(n.Device), "CPU")) {
+		return false, nil
+	}
+	if a.opts.Select != nil && !a.opts.Select(n.Name) {
+		return false, nil
+	}
+	t := n.Attr["value"].GetTensor()
+	if t == nil {
+		return false, nil
+	}
+	size, ok := dtypeSizes[t.Dtype]
+	if !ok {
+		return false, nil
+	}
+	elements := 1
+	for _, d := range t.TensorShape.GetDim() {
+		elements *= int(d.Size)
+	}
+	content := t.TensorContent
+	if len(content) == 0 && size*elements >= a.opts.MinBytes {
+		// Constants written by Python may hold their values in the
+		// typed fields of the TensorProto, such as float_val.
+		if content, err = tensorContent(t); err != nil {
+			return false, fmt.Errorf("constant %q: %v", n.Name, err)
+		}
+	}
+	if len(content) < a.opts.MinBytes {
+		return false, nil
+	}
+	if len(content) != size*elements {
+		return false, fmt.Errorf("constant %q: got %d bytes of content for %d elements", n.Name, len(content), elements)
+	}
+	file, shared, err := a.store(content)
+	if err != nil {
+		return false, fmt.Errorf("constant %q: %v", n.Name, err)
+	}
+	n.Op = "ImmutableConst"
+	n.Attr = map[string]*pb.AttrValue{
+		"dtype":              {Value: &pb.AttrValue_Type{Type: t.Dtype}},
+		"shape":              {Value: &pb.AttrValue_Shape{Shape: t.TensorShape}},
+		"memory_region_name": {Value: &pb.AttrValue_S{S: []byte(filepath.Join(a.opts.MappedDir, file))}},
+	}
+	stats.Mapped = append(stats.Mapped, n.Name)
+	stats.MappedBytes += int64(len(content))
+	if shared {
+		stats.Shared = append(stats.Shared, n.Name)
+		stats.SharedBytes += int64(len(content))
+	}
+	for _, f := range stats.Files {
+		if f == file {
+			return true, nil
+		}
+	}
+	stats.Files = append(stats.Files, file)
+	return true, nil
+}
+
+// tensorContent returns the content of t, as found in the tensor_content
+// field of TensorProtos.
+func tensorContent(t *pb.TensorProto) ([]byte, error) {
+	b, err := proto.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	tensor, err := codec.Proto.Decode(b)
+	if err != nil {
+		return nil, err
+	}
+	var content bytes.Buffer
+	if _, err := tensor.WriteContentsTo(&content); err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// store writes content to the arena, unless it is already there, and
+// returns the name of its file.
+func (a *Arena) store(content []byte) (file string, shared bool, err error) {
+	sum := sha256.Sum256(content)
+	file = hex.EncodeToString(sum[:]) + ".weights"
+	path := filepath.Join(a.dir, file)
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if fi, err := os.Stat(path); err == nil && fi.Size() == int64(len(content)) {
+		return file, true, nil
+	}
+	// Write to a temporary file first, so that other processes never map a
+	// partially written file.
+	f, err := ioutil.TempFile(a.dir, ".tmp")
+	if err != nil {
+		return "", false, err
+	}
+	_, err = f.Write(content)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), path)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return "", false, err
+	}
+	return file, false, nil
+}
+
+// dtypeSizes are the sizes of the elements of the types of tensors that
+// can be memory mapped.
+var dtypeSizes = map[pb.DataType]int{
+	pb.DataType_DT_FLOAT:      4,
+	pb.DataType_DT_DOUBLE:     8,
+	pb.DataType_DT_INT32:      4,
+	pb.DataType_DT_UINT8:      1,
+	pb.DataType_DT_INT16:      2,
+	pb.DataType_DT_INT8:       1,
+	pb.DataType_DT_COMPLEX64:  8,
+	pb.DataType_DT_INT64:      8,
+	pb.DataType_DT_BOOL:       1,
+	pb.DataType_DT_BFLOAT16:   2,
+	pb.DataType_DT_UINT16:     2,
+	pb.DataType_DT_COMPLEX128: 16,
+	pb.DataType_DT_HALF:       2,
+}
@@ -0,0 +1,175 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arena
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+// model returns a serialized graph computing "y/MatMul" = "x/Placeholder"
+// times weights.
+func model(t *testing.T, weights [][]float32) []byte {
+	s := op.NewScope()
+	op.MatMul(s.SubScope("y"),
+		op.Placeholder(s.SubScope("x"), tf.Float),
+		op.Const(s.SubScope("w"), weights))
+	graph, err := s.Finalize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if _, err := graph.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func run(t *testing.T, a *Arena, graphDef []byte, x [][]float32) ([][]float32, *Stats) {
+	g := tf.NewGraph()
+	stats, err := a.Import(g, graphDef, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sess, err := tf.NewSession(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sess.Close()
+	in, err := tf.NewTensor(x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := sess.Run(
+		map[tf.Output]*tf.Tensor{g.Operation("x/Placeholder").Output(0): in},
+		[]tf.Output{g.Operation("y/MatMul").Output(0)},
+		nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return out[0].Value().([][]float32), stats
+}
+
+func TestArena(t *testing.T) {
+	dir, err := ioutil.TempDir("", "arena")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	a, err := New(dir, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const n = 64
+	identity, double := make([][]float32, n), make([][]float32, n)
+	for i := range identity {
+		identity[i], double[i] = make([]float32, n), make([]float32, n)
+		identity[i][i], double[i][i] = 1, 2
+	}
+	x := [][]float32{make([]float32, n)}
+	for i := range x[0] {
+		x[0][i] = float32(i)
+	}
+	const size = 4 * n * n
+
+	tests := []struct {
+		weights [][]float32
+		scale   float32
+		stats   Stats
+	}{
+		{identity, 1, Stats{Mapped: []string{"w/Const"}, MappedBytes: size}},
+		{identity, 1, Stats{Mapped: []string{"w/Const"}, Shared: []string{"w/Const"}, MappedBytes: size, SharedBytes: size}},
+		{double, 2, Stats{Mapped: []string{"w/Const"}, MappedBytes: size}},
+	}
+	for i, test := range tests {
+		y, stats := run(t, a, model(t, test.weights), x)
+		if len(stats.Files) != 1 {
+			t.Errorf("%d: got files %v, want one", i, stats.Files)
+		}
+		stats.Files = nil
+		if !reflect.DeepEqual(*stats, test.stats) {
+			t.Errorf("%d: got %+v, want %+v", i, *stats, test.stats)
+		}
+		for j, v := range y[0] {
+			if want := test.scale * x[0][j]; v != want {
+				t.Errorf("%d: got y[%d] = %v, want %v", i, j, v, want)
+				break
+			}
+		}
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 {
+		t.Errorf("Got files %v, want one per distinct constant", files)
+	}
+
+	// Constants that are too small are left unchanged.
+	small, err := New(dir, Options{MinBytes: size + 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, stats := run(t, small, model(t, identity), x); len(stats.Mapped) != 0 {
+		t.Errorf("Got %+v, want no mapped constants", stats)
+	}
+}
+
+func TestArenaImportFrom(t *testing.T) {
+	dir, err := ioutil.TempDir("", "arena")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	a, err := New(dir, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	const n = 32
+	weights := make([][]float32, n)
+	for i := range weights {
+		weights[i] = make([]float32, n)
+		weights[i][i] = 1
+	}
+	def := model(t, weights)
+	for i, want := range []Stats{
+		{Mapped: []string{"w/Const"}, MappedBytes: 4 * n * n},
+		{Mapped: []string{"w/Const"}, Shared: []string{"w/Const"}, MappedBytes: 4 * n * n, SharedBytes: 4 * n * n},
+	} {
+		g := tf.NewGraph()
+		stats, err := a.ImportFrom(g, bytes.NewReader(def), &tf.ImportOptions{ChunkBytes: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(stats.Files) != 1 {
+			t.Errorf("%d: got files %v, want one", i, stats.Files)
+		}
+		stats.Files = nil
+		if !reflect.DeepEqual(*stats, want) {
+			t.Errorf("%d: got %+v, want %+v", i, *stats, want)
+		}
+		if got, want := g.Operation("w/Const").Type(), "ImmutableConst"; got != want {
+			t.Errorf("%d: got a %s operation, want %s", i, got, want)
+		}
+	}
+}
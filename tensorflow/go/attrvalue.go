@@ -0,0 +1,227 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+)
+
+// NameAttrList is the value of attributes of type "func": the name of a
+// function in the library of the graph, and the attributes with which to
+// instantiate it. Attrs has the same form as OpSpec.Attrs.
+type NameAttrList struct {
+	Name  string
+	Attrs map[string]interface{}
+}
+
+// AttrValueProto is a serialized tensorflow.AttrValue protocol buffer
+// (https://www.tensorflow.org/code/tensorflow/core/framework/attr_value.proto),
+// used as is as the value of an attribute. It preserves the attributes
+// whose values have no Go type in this package when copying operations (see
+// Operation.Attrs). Note that the runtime rejects the attributes unknown to
+// the definition of an operation, such as those added by newer versions of
+// TensorFlow, but for those starting with an underscore.
+type AttrValueProto []byte
+
+// AttrPlaceholder returns the value of an attribute of an operation in the
+// body of a function that is set to the value of the attribute name of the
+// function when it is instantiated.
+func AttrPlaceholder(name string) AttrValueProto {
+	return appendBytesField(nil, 9, []byte(name)) // placeholder
+}
+
+// encodeAttrValue returns the serialized AttrValue protocol buffer holding
+// value, for attributes that cannot be set with the specialized functions
+// of the C API. The fields of the protocol buffers used are documented in
+// https://www.tensorflow.org/code/tensorflow/core/framework/attr_value.proto.
+func encodeAttrValue(value interface{}) ([]byte, error) {
+	var b []byte
+	switch v := value.(type) {
+	case string:
+		b = appendBytesField(b, 2, []byte(v)) // s
+	case int64:
+		b = appendVarintField(b, 3, uint64(v)) // i
+	case float32:
+		b = appendFixed32Field(b, 4, math.Float32bits(v)) // f
+	case bool:
+		b = appendVarintField(b, 5, boolToUint64(v)) // b
+	case DataType:
+		b = appendVarintField(b, 6, uint64(v)) // type
+	case Shape:
+		b = appendBytesField(b, 7, encodeShape(v)) // shape
+	case *Tensor:
+		t, err := encodeTensorProto(v)
+		if err != nil {
+			return nil, err
+		}
+		b = appendBytesField(b, 8, t) // tensor
+	case AttrValueProto:
+		b = append(b, v...)
+	case NameAttrList:
+		f, err := encodeNameAttrList(v)
+		if err != nil {
+			return nil, err
+		}
+		b = appendBytesField(b, 10, f) // func
+	default:
+		list, err := encodeListValue(value)
+		if err != nil {
+			return nil, err
+		}
+		b = appendBytesField(b, 1, list) // list
+	}
+	return b, nil
+}
+
+// encodeListValue encodes the AttrValue.ListValue message for the list
+// attribute value.
+func encodeListValue(value interface{}) ([]byte, error) {
+	var b []byte
+	switch v := value.(type) {
+	case []string:
+		for _, s := range v {
+			b = appendBytesField(b, 2, []byte(s))
+		}
+	case []int64:
+		var packed []byte
+		for _, i := range v {
+			packed = appendVarint(packed, uint64(i))
+		}
+		b = appendBytesField(b, 3, packed)
+	case []float32:
+		packed := make([]byte, 4*len(v))
+		for i, f := range v {
+			binary.LittleEndian.PutUint32(packed[4*i:], math.Float32bits(f))
+		}
+		b = appendBytesField(b, 4, packed)
+	case []bool:
+		var packed []byte
+		for _, x := range v {
+			packed = appendVarint(packed, boolToUint64(x))
+		}
+		b = appendBytesField(b, 5, packed)
+	case []DataType:
+		var packed []byte
+		for _, dt := range v {
+			packed = appendVarint(packed, uint64(dt))
+		}
+		b = appendBytesField(b, 6, packed)
+	case []Shape:
+		for _, s := range v {
+			b = appendBytesField(b, 7, encodeShape(s))
+		}
+	case []*Tensor:
+		for _, t := range v {
+			tp, err := encodeTensorProto(t)
+			if err != nil {
+				return nil, err
+			}
+			b = appendBytesField(b, 8, tp)
+		}
+	case []NameAttrList:
+		for _, f := range v {
+			fp, err := encodeNameAttrList(f)
+			if err != nil {
+				return nil, err
+			}
+			b = appendBytesField(b, 9, fp)
+		}
+	default:
+		return nil, fmt.Errorf("type %T is not valid for operation attributes", value)
+	}
+	return b, nil
+}
+
+func encodeNameAttrList(f NameAttrList) ([]byte, error) {
+	b := appendBytesField(nil, 1, []byte(f.Name)) // name
+	names := make([]string, 0, len(f.Attrs))
+	for name := range f.Attrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		v, err := encodeAttrValue(f.Attrs[name])
+		if err != nil {
+			return nil, fmt.Errorf("attribute %q of function %q: %v", name, f.Name, err)
+		}
+		// attr is a map<string, AttrValue>, encoded as repeated entries
+		// with the key in field 1 and the value in field 2.
+		entry := appendBytesField(nil, 1, []byte(name))
+		entry = appendBytesField(entry, 2, v)
+		b = appendBytesField(b, 2, entry)
+	}
+	return b, nil
+}
+
+// encodeShape encodes the TensorShapeProto message for s.
+func encodeShape(s Shape) []byte {
+	if s.NumDimensions() < 0 {
+		return appendVarintField(nil, 3, 1) // unknown_rank
+	}
+	var b []byte
+	for _, size := range s.dims {
+		b = appendBytesField(b, 2, appendVarintField(nil, 1, uint64(size))) // dim.size
+	}
+	return b
+}
+
+// encodeTensorProto encodes the TensorProto message for t.
+func encodeTensorProto(t *Tensor) ([]byte, error) {
+	if t == nil {
+		return nil, fmt.Errorf("nil Tensor")
+	}
+	if t.c == nil {
+		return nil, fmt.Errorf("released Tensor")
+	}
+	b := appendVarintField(nil, 1, uint64(t.DataType())) // dtype
+	b = appendBytesField(b, 2, encodeShape(MakeShape(t.Shape()...)))
+	if t.DataType() != String {
+		return appendBytesField(b, 4, tensorData(t.c)), nil // tensor_content
+	}
+	val, err := t.DecodeValue()
+	if err != nil {
+		return nil, err
+	}
+	var appendStrings func(v reflect.Value)
+	appendStrings = func(v reflect.Value) {
+		if v.Kind() == reflect.String {
+			b = appendBytesField(b, 8, []byte(v.String())) // string_val
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			appendStrings(v.Index(i))
+		}
+	}
+	appendStrings(reflect.ValueOf(val))
+	return b, nil
+}
+
+func appendFixed32Field(b []byte, field int, v uint32) []byte {
+	b = appendVarint(b, uint64(field)<<3|5)
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], v)
+	return append(b, buf[:]...)
+}
+
+func boolToUint64(b bool) uint64 {
+	if b {
+		return 1
+	}
+	return 0
+}
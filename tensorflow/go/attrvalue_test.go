@@ -0,0 +1,81 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEncodeAttrValue(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		want  []byte
+	}{
+		{"a", []byte{0x12, 0x01, 'a'}},
+		{int64(-1), []byte{0x18, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}},
+		{float32(1), []byte{0x25, 0x00, 0x00, 0x80, 0x3f}},
+		{true, []byte{0x28, 0x01}},
+		{Float, []byte{0x30, 0x01}},
+		{MakeShape(2, -1), []byte{0x3a, 0x11, 0x12, 0x02, 0x08, 0x02, 0x12, 0x0b, 0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}},
+		{Shape{}, []byte{0x3a, 0x02, 0x18, 0x01}},
+		{[]int64{1, 2}, []byte{0x0a, 0x04, 0x1a, 0x02, 0x01, 0x02}},
+		{[]DataType{Int32}, []byte{0x0a, 0x03, 0x32, 0x01, 0x03}},
+		{
+			NameAttrList{Name: "f", Attrs: map[string]interface{}{"T": Float}},
+			[]byte{0x52, 0x0c, 0x0a, 0x01, 'f', 0x12, 0x07, 0x0a, 0x01, 'T', 0x12, 0x02, 0x30, 0x01},
+		},
+		{
+			[]NameAttrList{{Name: "f"}, {Name: "g"}},
+			[]byte{0x0a, 0x0a, 0x4a, 0x03, 0x0a, 0x01, 'f', 0x4a, 0x03, 0x0a, 0x01, 'g'},
+		},
+		{AttrValueProto{0x28, 0x01}, []byte{0x28, 0x01}},
+		{AttrPlaceholder("T"), []byte{0x4a, 0x01, 'T'}},
+	}
+	for _, test := range tests {
+		got, err := encodeAttrValue(test.value)
+		if err != nil {
+			t.Errorf("%v: %v", test.value, err)
+			continue
+		}
+		if !bytes.Equal(got, test.want) {
+			t.Errorf("%v: got %x, want %x", test.value, got, test.want)
+		}
+	}
+}
+
+func TestEncodeAttrValueTensor(t *testing.T) {
+	tensor, err := NewTensor([]int32{1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := encodeAttrValue(tensor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{
+		0x42, 0x12, // tensor
+		0x08, 0x03, // dtype: DT_INT32
+		0x12, 0x04, 0x12, 0x02, 0x08, 0x02, // tensor_shape: [2]
+		0x22, 0x08, 0x01, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, // tensor_content
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("Got %x, want %x", got, want)
+	}
+
+	if _, err := encodeAttrValue(NameAttrList{Name: "f", Attrs: map[string]interface{}{"x": 1}}); err == nil {
+		t.Error("expected an error for an attribute of type int")
+	}
+}
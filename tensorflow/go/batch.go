@@ -0,0 +1,98 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+)
+
+// AddBatchDim returns a Tensor with the contents of t and a leading
+// dimension of size 1, turning a single example into a batch of one.
+func AddBatchDim(t *Tensor) (*Tensor, error) {
+	return reshape(t, append([]int64{1}, t.Shape()...))
+}
+
+// SqueezeBatch returns a Tensor with the contents of t without its leading
+// dimension, which must be of size 1. It is the inverse of AddBatchDim,
+// extracting the single result from a batch of one.
+func SqueezeBatch(t *Tensor) (*Tensor, error) {
+	shape := t.Shape()
+	if len(shape) == 0 || shape[0] != 1 {
+		return nil, fmt.Errorf("cannot squeeze the batch dimension of a Tensor of shape %v", shape)
+	}
+	return reshape(t, append([]int64(nil), shape[1:]...))
+}
+
+// reshape returns a copy of t with the given shape, which must have the
+// same number of elements.
+func reshape(t *Tensor, shape []int64) (*Tensor, error) {
+	if t.c == nil {
+		return nil, fmt.Errorf("Tensor has been released")
+	}
+	if t.DataType() != String {
+		return ReadTensor(t.DataType(), shape, bytes.NewReader(tensorData(t.c)))
+	}
+	// String tensors are not serializable with ReadTensor: convert through
+	// their Go value instead, which for copies, AddBatchDim and SqueezeBatch
+	// only requires adding or removing the outermost slice.
+	val, err := t.DecodeValue()
+	if err != nil {
+		return nil, err
+	}
+	v := reflect.ValueOf(val)
+	switch len(shape) - len(t.Shape()) {
+	case 0:
+	case 1:
+		s := reflect.MakeSlice(reflect.SliceOf(v.Type()), 1, 1)
+		s.Index(0).Set(v)
+		v = s
+	case -1:
+		v = v.Index(0)
+	default:
+		return nil, bug("cannot reshape a String Tensor of shape %v to %v", t.Shape(), shape)
+	}
+	return NewTensor(v.Interface())
+}
+
+// PredictSingle runs the model on a single example, for models whose inputs
+// and outputs are batches of examples. Each feed is converted to a batch of
+// one with AddBatchDim, and each fetched result is extracted from its batch
+// with SqueezeBatch.
+func (m *SavedModel) PredictSingle(feeds map[Output]*Tensor, fetches []Output) ([]*Tensor, error) {
+	batched := make(map[Output]*Tensor, len(feeds))
+	for o, t := range feeds {
+		b, err := AddBatchDim(t)
+		if err != nil {
+			return nil, err
+		}
+		defer b.Release()
+		batched[o] = b
+	}
+	results, err := m.Session.Run(batched, fetches, nil)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Tensor, len(results))
+	for i, r := range results {
+		ret[i], err = SqueezeBatch(r)
+		r.Release()
+		if err != nil {
+			return nil, fmt.Errorf("output %d: %v", i, err)
+		}
+	}
+	return ret, nil
+}
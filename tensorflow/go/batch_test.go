@@ -0,0 +1,90 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBatchDim(t *testing.T) {
+	for _, value := range []interface{}{
+		float32(1),
+		[]int64{1, 2, 3},
+		[][]float64{{1, 2}, {3, 4}},
+		"hello",
+		[]string{"a", "bc"},
+	} {
+		single, err := NewTensor(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		batch, err := AddBatchDim(single)
+		if err != nil {
+			t.Fatalf("AddBatchDim(%v): %v", value, err)
+		}
+		if got, want := batch.Shape(), append([]int64{1}, single.Shape()...); !reflect.DeepEqual(got, want) {
+			t.Errorf("AddBatchDim(%v): got shape %v, want %v", value, got, want)
+		}
+		if got := reflect.ValueOf(batch.Value()).Index(0).Interface(); !reflect.DeepEqual(got, value) {
+			t.Errorf("AddBatchDim(%v): got %v", value, batch.Value())
+		}
+		squeezed, err := SqueezeBatch(batch)
+		if err != nil {
+			t.Fatalf("SqueezeBatch(%v): %v", batch.Value(), err)
+		}
+		if got := squeezed.Value(); !reflect.DeepEqual(got, value) {
+			t.Errorf("SqueezeBatch(AddBatchDim(%v)) = %v", value, got)
+		}
+	}
+	for _, value := range []interface{}{int32(1), []int32{1, 2}} {
+		tensor, err := NewTensor(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := SqueezeBatch(tensor); err == nil {
+			t.Errorf("SqueezeBatch(%v) succeeded", value)
+		}
+	}
+}
+
+func TestPredictSingle(t *testing.T) {
+	g := NewGraph()
+	x, err := Placeholder(g, "x", Float)
+	if err != nil {
+		t.Fatal(err)
+	}
+	y, err := Neg(g, "y", x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewSession(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &SavedModel{Session: s, Graph: g}
+	defer m.Close()
+	in, err := NewTensor([]float32{1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := m.PredictSingle(map[Output]*Tensor{x: in}, []Output{y})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := out[0].Value(), []float32{-1, -2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Capability identifies a feature of the TensorFlow runtime that is not
+// available in all releases of the TensorFlow C library.
+type Capability int
+
+// Capabilities that can be probed using HasCapability.
+const (
+	// CapabilityPartialRun is required by Session.NewPartialRun.
+	CapabilityPartialRun Capability = iota
+	// CapabilitySavedModel is required by LoadSavedModel.
+	CapabilitySavedModel
+	// CapabilityLoadLibrary is required by LoadLibrary.
+	CapabilityLoadLibrary
+	// CapabilityDeletePRunHandle indicates that the runtime can free the
+	// handles of partial runs itself. See the libtensorflow_1_1 build tag.
+	CapabilityDeletePRunHandle
+)
+
+// capabilitySymbols lists the C API functions each capability depends on.
+var capabilitySymbols = map[Capability][]string{
+	CapabilityPartialRun:       {"TF_SessionPRunSetup", "TF_SessionPRun"},
+	CapabilitySavedModel:       {"TF_LoadSessionFromSavedModel"},
+	CapabilityLoadLibrary:      {"TF_LoadLibrary", "TF_GetOpList", "TF_DeleteLibraryHandle"},
+	CapabilityDeletePRunHandle: {"TF_DeletePRunHandle"},
+}
+
+func (c Capability) String() string {
+	switch c {
+	case CapabilityPartialRun:
+		return "PartialRun"
+	case CapabilitySavedModel:
+		return "SavedModel"
+	case CapabilityLoadLibrary:
+		return "LoadLibrary"
+	case CapabilityDeletePRunHandle:
+		return "DeletePRunHandle"
+	}
+	return fmt.Sprintf("Capability(%d)", int(c))
+}
+
+var capabilities struct {
+	sync.Mutex
+	probed map[Capability]bool
+}
+
+// HasCapability returns true if the TensorFlow runtime the program is running
+// against provides c.
+//
+// The TensorFlow C library is loaded dynamically, so the library found at run
+// time may be older than the one the program was built against. Functions in
+// this package that depend on a capability return an error instead of
+// crashing the process when it is missing.
+func HasCapability(c Capability) bool {
+	capabilities.Lock()
+	defer capabilities.Unlock()
+	if ok, probed := capabilities.probed[c]; probed {
+		return ok
+	}
+	syms, known := capabilitySymbols[c]
+	ok := known
+	for _, sym := range syms {
+		if !hasSymbol(sym) {
+			ok = false
+			break
+		}
+	}
+	if capabilities.probed == nil {
+		capabilities.probed = make(map[Capability]bool)
+	}
+	capabilities.probed[c] = ok
+	return ok
+}
+
+// requireCapability returns an error if the runtime does not provide c.
+func requireCapability(c Capability) error {
+	if HasCapability(c) {
+		return nil
+	}
+	return fmt.Errorf("%v is not supported by the TensorFlow runtime (version %s)", c, Version())
+}
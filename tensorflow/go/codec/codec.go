@@ -0,0 +1,406 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package codec converts Tensors to and from the wire formats used by RPC
+// services built around TensorFlow models, so that the formats of feeds
+// and fetches can be negotiated by content type:
+//
+//	c, err := codec.ForContentType(req.Header.Get("Content-Type"))
+//	if err != nil {
+//		...
+//	}
+//	t, err := c.Decode(body)
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package codec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"reflect"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+)
+
+// TensorCodec encodes Tensors in a wire format. Implementations must be
+// safe for concurrent use.
+type TensorCodec interface {
+	// ContentType is the MIME type of the encoded Tensors, e.g.
+	// "application/json".
+	ContentType() string
+	// Encode returns the encoding of t.
+	Encode(t *tf.Tensor) ([]byte, error)
+	// Decode returns the Tensor encoded in b.
+	Decode(b []byte) (*tf.Tensor, error)
+}
+
+var (
+	// Proto encodes Tensors as serialized tensorflow.TensorProto protocol
+	// buffers
+	// (https://www.tensorflow.org/code/tensorflow/core/framework/tensor.proto),
+	// the format of TensorFlow Serving. Decode accepts both the
+	// tensor_content and the typed value fields (e.g., float_val).
+	Proto TensorCodec = protoCodec{}
+	// JSON encodes Tensors as JSON objects of the form
+	//
+	//	{"dtype": "float32", "shape": [2, 2], "values": [1, 2, 3, 4]}
+	//
+	// listing the values in row-major order. String values must be valid
+	// UTF-8, and complex values are not supported.
+	JSON TensorCodec = jsonCodec{}
+	// Raw encodes Tensors in a compact binary format: the DataType, rank
+	// and dimensions as little-endian 32, 32 and 64 bit integers, followed
+	// by the contents of the Tensor as written by Tensor.WriteContentsTo,
+	// in the byte order of the host, or for strings each value prefixed by
+	// its length as a uvarint.
+	Raw TensorCodec = rawCodec{}
+)
+
+// Codecs lists the codecs of this package, in order of preference.
+var Codecs = []TensorCodec{Raw, Proto, JSON}
+
+// ForContentType returns the codec of Codecs for the MIME type
+// contentType, ignoring its parameters (e.g., "; charset=utf-8").
+func ForContentType(contentType string) (TensorCodec, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, fmt.Errorf("invalid content type %q: %v", contentType, err)
+	}
+	for _, c := range Codecs {
+		if c.ContentType() == mediaType {
+			return c, nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported content type %q", mediaType)
+}
+
+type protoCodec struct{}
+
+func (protoCodec) ContentType() string { return "application/x-protobuf" }
+
+func (protoCodec) Encode(t *tf.Tensor) ([]byte, error) {
+	p := &pb.TensorProto{
+		Dtype:       pb.DataType(t.DataType()),
+		TensorShape: &pb.TensorShapeProto{},
+	}
+	for _, d := range t.Shape() {
+		p.TensorShape.Dim = append(p.TensorShape.Dim, &pb.TensorShapeProto_Dim{Size: d})
+	}
+	if t.DataType() == tf.String {
+		values, err := flatValues(t)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range values.([]string) {
+			p.StringVal = append(p.StringVal, []byte(s))
+		}
+	} else {
+		var buf bytes.Buffer
+		if _, err := t.WriteContentsTo(&buf); err != nil {
+			return nil, err
+		}
+		p.TensorContent = buf.Bytes()
+	}
+	return proto.Marshal(p)
+}
+
+func (protoCodec) Decode(b []byte) (*tf.Tensor, error) {
+	var p pb.TensorProto
+	if err := proto.Unmarshal(b, &p); err != nil {
+		return nil, fmt.Errorf("invalid TensorProto: %v", err)
+	}
+	dt := tf.DataType(p.Dtype)
+	if p.TensorShape.GetUnknownRank() {
+		return nil, fmt.Errorf("TensorProto has an unknown shape")
+	}
+	shape := make([]int64, len(p.TensorShape.GetDim()))
+	for i, d := range p.TensorShape.GetDim() {
+		if d.Size < 0 {
+			return nil, fmt.Errorf("TensorProto has an unknown shape")
+		}
+		shape[i] = d.Size
+	}
+	if len(p.TensorContent) > 0 {
+		return tf.ReadTensor(dt, shape, bytes.NewReader(p.TensorContent))
+	}
+	var src interface{}
+	switch dt {
+	case tf.Float:
+		src = p.FloatVal
+	case tf.Double:
+		src = p.DoubleVal
+	case tf.Int32, tf.Int16, tf.Int8, tf.Uint8, tf.Uint16:
+		src = p.IntVal
+	case tf.Int64:
+		src = p.Int64Val
+	case tf.Bool:
+		src = p.BoolVal
+	case tf.String:
+		s := make([]string, len(p.StringVal))
+		for i, v := range p.StringVal {
+			s[i] = string(v)
+		}
+		src = s
+	case tf.Complex64:
+		c := make([]complex64, len(p.ScomplexVal)/2)
+		for i := range c {
+			c[i] = complex(p.ScomplexVal[2*i], p.ScomplexVal[2*i+1])
+		}
+		src = c
+	case tf.Complex128:
+		c := make([]complex128, len(p.DcomplexVal)/2)
+		for i := range c {
+			c[i] = complex(p.DcomplexVal[2*i], p.DcomplexVal[2*i+1])
+		}
+		src = c
+	default:
+		return nil, fmt.Errorf("TensorProto of type %v has no tensor_content", dt)
+	}
+	elem, _ := dt.GoType()
+	n := numElements(shape)
+	values := reflect.MakeSlice(reflect.SliceOf(elem), n, n)
+	s := reflect.ValueOf(src)
+	if s.Len() > n {
+		return nil, fmt.Errorf("TensorProto has %d values for shape %v", s.Len(), shape)
+	}
+	// As in TensorFlow, the last value is repeated to fill the tensor.
+	for i := 0; i < n && s.Len() > 0; i++ {
+		j := i
+		if j >= s.Len() {
+			j = s.Len() - 1
+		}
+		values.Index(i).Set(s.Index(j).Convert(elem))
+	}
+	return fromFlatValues(dt, shape, values.Interface())
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) ContentType() string { return "application/json" }
+
+// jsonTensor is the JSON encoding of a Tensor.
+type jsonTensor struct {
+	DataType string          `json:"dtype"`
+	Shape    []int64         `json:"shape"`
+	Values   json.RawMessage `json:"values"`
+}
+
+func (jsonCodec) Encode(t *tf.Tensor) ([]byte, error) {
+	if t.DataType() == tf.Complex64 || t.DataType() == tf.Complex128 {
+		return nil, fmt.Errorf("Tensors of type %v cannot be encoded in JSON", t.DataType())
+	}
+	values, err := flatValues(t)
+	if err != nil {
+		return nil, err
+	}
+	v, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+	shape := t.Shape()
+	if shape == nil {
+		shape = []int64{}
+	}
+	return json.Marshal(jsonTensor{DataType: t.DataType().String(), Shape: shape, Values: v})
+}
+
+func (jsonCodec) Decode(b []byte) (*tf.Tensor, error) {
+	var j jsonTensor
+	if err := json.Unmarshal(b, &j); err != nil {
+		return nil, err
+	}
+	dt, err := tf.ParseDataType(j.DataType)
+	if err != nil {
+		return nil, err
+	}
+	elem, err := dt.GoType()
+	if err != nil {
+		return nil, err
+	}
+	values := reflect.New(reflect.SliceOf(elem))
+	if err := json.Unmarshal(j.Values, values.Interface()); err != nil {
+		return nil, fmt.Errorf("invalid values: %v", err)
+	}
+	return fromFlatValues(dt, j.Shape, values.Elem().Interface())
+}
+
+type rawCodec struct{}
+
+func (rawCodec) ContentType() string { return "application/octet-stream" }
+
+func (rawCodec) Encode(t *tf.Tensor) ([]byte, error) {
+	shape := t.Shape()
+	b := make([]byte, 8+8*len(shape))
+	binary.LittleEndian.PutUint32(b, uint32(t.DataType()))
+	binary.LittleEndian.PutUint32(b[4:], uint32(len(shape)))
+	for i, d := range shape {
+		binary.LittleEndian.PutUint64(b[8+8*i:], uint64(d))
+	}
+	buf := bytes.NewBuffer(b)
+	if t.DataType() != tf.String {
+		if _, err := t.WriteContentsTo(buf); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+	values, err := flatValues(t)
+	if err != nil {
+		return nil, err
+	}
+	var n [binary.MaxVarintLen64]byte
+	for _, s := range values.([]string) {
+		buf.Write(n[:binary.PutUvarint(n[:], uint64(len(s)))])
+		buf.WriteString(s)
+	}
+	return buf.Bytes(), nil
+}
+
+func (rawCodec) Decode(b []byte) (*tf.Tensor, error) {
+	if len(b) < 8 {
+		return nil, fmt.Errorf("truncated header")
+	}
+	dt := tf.DataType(binary.LittleEndian.Uint32(b))
+	rank := binary.LittleEndian.Uint32(b[4:])
+	if uint64(len(b)-8) < 8*uint64(rank) {
+		return nil, fmt.Errorf("truncated header")
+	}
+	shape := make([]int64, rank)
+	for i := range shape {
+		shape[i] = int64(binary.LittleEndian.Uint64(b[8+8*i:]))
+	}
+	r := bytes.NewReader(b[8+8*len(shape):])
+	if dt != tf.String {
+		return tf.ReadTensor(dt, shape, r)
+	}
+	for _, d := range shape {
+		if d < 0 {
+			return nil, fmt.Errorf("invalid shape %v", shape)
+		}
+	}
+	n := numElements(shape)
+	if n < 0 || n > r.Len() {
+		// Each value takes at least a byte for its length.
+		return nil, fmt.Errorf("truncated contents for shape %v", shape)
+	}
+	values := make([]string, n)
+	for i := range values {
+		n, err := binary.ReadUvarint(r)
+		if err == nil && n > uint64(r.Len()) {
+			err = io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return nil, fmt.Errorf("string %d: %v", i, err)
+		}
+		s := make([]byte, n)
+		r.Read(s)
+		values[i] = string(s)
+	}
+	return fromFlatValues(dt, shape, values)
+}
+
+// flatValues returns the values of t as a slice, in row-major order.
+func flatValues(t *tf.Tensor) (interface{}, error) {
+	v, err := t.DecodeValue()
+	if err != nil {
+		return nil, err
+	}
+	val := reflect.ValueOf(v)
+	elem := val.Type()
+	for elem.Kind() == reflect.Slice {
+		elem = elem.Elem()
+	}
+	flat := reflect.MakeSlice(reflect.SliceOf(elem), 0, numElements(t.Shape()))
+	var appendValues func(v reflect.Value)
+	appendValues = func(v reflect.Value) {
+		if v.Kind() != reflect.Slice {
+			flat = reflect.Append(flat, v)
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			appendValues(v.Index(i))
+		}
+	}
+	appendValues(val)
+	return flat.Interface(), nil
+}
+
+// fromFlatValues returns a Tensor of type dt and the given shape with the
+// values of the slice values, in row-major order.
+func fromFlatValues(dt tf.DataType, shape []int64, values interface{}) (*tf.Tensor, error) {
+	v := reflect.ValueOf(values)
+	for _, d := range shape {
+		if d < 0 {
+			return nil, fmt.Errorf("invalid shape %v", shape)
+		}
+	}
+	if n := numElements(shape); v.Len() != n {
+		return nil, fmt.Errorf("got %d values for shape %v, want %d", v.Len(), shape, n)
+	}
+	if dt != tf.String {
+		flat, err := tf.NewTensor(values)
+		if err != nil {
+			return nil, err
+		}
+		var buf bytes.Buffer
+		if _, err := flat.WriteContentsTo(&buf); err != nil {
+			return nil, err
+		}
+		return tf.ReadTensor(dt, shape, &buf)
+	}
+	t, err := tf.NewTensor(nest(v, shape).Interface())
+	if err != nil {
+		return nil, err
+	}
+	if !reflect.DeepEqual(t.Shape(), shape) && numElements(shape) == 0 {
+		// The inner dimensions of nested empty slices are lost.
+		return nil, fmt.Errorf("cannot create an empty String Tensor of shape %v", shape)
+	}
+	return t, nil
+}
+
+// nest returns the slice flat as nested slices of the given shape.
+func nest(flat reflect.Value, shape []int64) reflect.Value {
+	if len(shape) == 0 {
+		return flat.Index(0)
+	}
+	typ := flat.Type()
+	for range shape[1:] {
+		typ = reflect.SliceOf(typ)
+	}
+	n := int(shape[0])
+	nested := reflect.MakeSlice(typ, n, n)
+	if n == 0 {
+		return nested
+	}
+	size := flat.Len() / n
+	for i := 0; i < n; i++ {
+		nested.Index(i).Set(nest(flat.Slice(i*size, (i+1)*size), shape[1:]))
+	}
+	return nested
+}
+
+func numElements(shape []int64) int {
+	n := 1
+	for _, d := range shape {
+		n *= int(d)
+	}
+	return n
+}
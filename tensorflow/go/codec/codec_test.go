@@ -0,0 +1,159 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+)
+
+func TestRoundTrip(t *testing.T) {
+	values := []interface{}{
+		float32(1.5),
+		[]int64{1, -2, 3},
+		[][]float32{{1, 2, 3}, {4, 5, 6}},
+		[][]int32{{}, {}},
+		[]uint8{},
+		[]bool{true, false},
+		"scalar",
+		[][]string{{"a", ""}, {"b\x00c", "d"}},
+		[]complex64{1 + 2i},
+	}
+	for _, c := range Codecs {
+		for _, v := range values {
+			in, err := tf.NewTensor(v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := c.Encode(in)
+			if c == JSON && in.DataType() == tf.Complex64 {
+				if err == nil {
+					t.Errorf("%s: Encode(%v) succeeded, want an error", c.ContentType(), v)
+				}
+				continue
+			}
+			if err != nil {
+				t.Errorf("%s: Encode(%v): %v", c.ContentType(), v, err)
+				continue
+			}
+			out, err := c.Decode(b)
+			if err != nil {
+				t.Errorf("%s: Decode(Encode(%v)): %v", c.ContentType(), v, err)
+				continue
+			}
+			if out.DataType() != in.DataType() || !reflect.DeepEqual(out.Shape(), in.Shape()) || !reflect.DeepEqual(out.Value(), in.Value()) {
+				t.Errorf("%s: Decode(Encode(%v)): got a %v tensor of shape %v with value %v", c.ContentType(), v, out.DataType(), out.Shape(), out.Value())
+			}
+		}
+	}
+}
+
+func TestJSON(t *testing.T) {
+	in, err := tf.NewTensor([][]int32{{1, 2}, {3, 4}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := JSON.Encode(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(b), `{"dtype":"int32","shape":[2,2],"values":[1,2,3,4]}`; got != want {
+		t.Errorf("Got %s, want %s", got, want)
+	}
+	for _, s := range []string{
+		`{"dtype":"int8","shape":[1],"values":[300]}`,
+		`{"dtype":"float32","shape":[3],"values":[1,2]}`,
+		`{"dtype":"float32","shape":[-1],"values":[]}`,
+		`{"dtype":"unknown","shape":[],"values":[1]}`,
+	} {
+		if _, err := JSON.Decode([]byte(s)); err == nil {
+			t.Errorf("Decode(%s) succeeded, want an error", s)
+		}
+	}
+}
+
+func TestProtoTypedValues(t *testing.T) {
+	shape := &pb.TensorShapeProto{Dim: []*pb.TensorShapeProto_Dim{{Size: 2}, {Size: 2}}}
+	tests := []struct {
+		proto *pb.TensorProto
+		want  interface{}
+	}{
+		{
+			proto: &pb.TensorProto{Dtype: pb.DataType_DT_FLOAT, TensorShape: shape, FloatVal: []float32{1, 2}},
+			want:  [][]float32{{1, 2}, {2, 2}},
+		},
+		{
+			proto: &pb.TensorProto{Dtype: pb.DataType_DT_INT16, TensorShape: shape, IntVal: []int32{-1, 2, 3, 4}},
+			want:  [][]int16{{-1, 2}, {3, 4}},
+		},
+		{
+			proto: &pb.TensorProto{Dtype: pb.DataType_DT_STRING, TensorShape: shape, StringVal: [][]byte{[]byte("x")}},
+			want:  [][]string{{"x", "x"}, {"x", "x"}},
+		},
+		{
+			proto: &pb.TensorProto{Dtype: pb.DataType_DT_COMPLEX64, TensorShape: &pb.TensorShapeProto{}, ScomplexVal: []float32{1, 2}},
+			want:  complex64(1 + 2i),
+		},
+		{
+			proto: &pb.TensorProto{Dtype: pb.DataType_DT_INT64, TensorShape: shape},
+			want:  [][]int64{{0, 0}, {0, 0}},
+		},
+	}
+	for _, test := range tests {
+		b, err := proto.Marshal(test.proto)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := Proto.Decode(b)
+		if err != nil {
+			t.Errorf("Decode(%v): %v", test.proto, err)
+			continue
+		}
+		if !reflect.DeepEqual(got.Value(), test.want) {
+			t.Errorf("Decode(%v): got %v, want %v", test.proto, got.Value(), test.want)
+		}
+	}
+}
+
+func TestRawErrors(t *testing.T) {
+	in, err := tf.NewTensor([]string{"abc", "de"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := Raw.Encode(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for n := 0; n < len(b); n++ {
+		if _, err := Raw.Decode(b[:n]); err == nil {
+			t.Errorf("Decode of %d of %d bytes succeeded, want an error", n, len(b))
+		}
+	}
+}
+
+func TestForContentType(t *testing.T) {
+	for _, c := range Codecs {
+		if got, err := ForContentType(c.ContentType() + "; charset=utf-8"); err != nil || got != c {
+			t.Errorf("ForContentType(%q): got (%v, %v)", c.ContentType(), got, err)
+		}
+	}
+	if _, err := ForContentType("text/plain"); err == nil {
+		t.Error("ForContentType(text/plain) succeeded, want an error")
+	}
+}
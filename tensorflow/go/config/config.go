@@ -0,0 +1,261 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package config builds the options of sessions and models from declarative
+// JSON files, so that deployments are configured without code changes:
+//
+//	{
+//	  "models": [{
+//	    "name": "ranker",
+//	    "version": 3,
+//	    "path": "/models/ranker/3",
+//	    "session": {"intra_op_threads": 4, "gpu": {"allow_growth": true}},
+//	    "max_concurrent_runs": 8
+//	  }]
+//	}
+//
+//	c, err := config.Load("serving.json")
+//	if err != nil {
+//		...
+//	}
+//	server, err := c.NewServer()
+//
+// Unknown fields are rejected, to catch misspelled settings.
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	configpb "github.com/tensorflow/tensorflow/tensorflow/go/core/protobuf"
+	"github.com/tensorflow/tensorflow/tensorflow/go/modelserver"
+	"github.com/tensorflow/tensorflow/tensorflow/go/replica"
+	"github.com/tensorflow/tensorflow/tensorflow/go/signature"
+)
+
+// Config is the root of a configuration file.
+type Config struct {
+	Models []Model `json:"models"`
+}
+
+// Model configures the loading and serving of a SavedModel.
+type Model struct {
+	// Name and Version identify the model in a modelserver.Server.
+	Name    string `json:"name"`
+	Version int64  `json:"version"`
+	// Path is the export directory of the SavedModel.
+	Path string `json:"path"`
+	// Tags identify the graph to load. Defaults to ["serve"].
+	Tags []string `json:"tags"`
+	// Signature is the key of the signature used. Defaults to
+	// signature.DefaultKey.
+	Signature string `json:"signature"`
+	// Devices, if not empty, are the devices onto which LoadReplicated
+	// replicates the model, e.g. "/device:GPU:0".
+	Devices []string `json:"devices"`
+	Session Session  `json:"session"`
+	// MaxConcurrentRuns and MaxFeedBytes are the quotas of the model in a
+	// modelserver.Server. Zero means no limit.
+	MaxConcurrentRuns int   `json:"max_concurrent_runs"`
+	MaxFeedBytes      int64 `json:"max_feed_bytes"`
+}
+
+// Session configures a tf.Session.
+type Session struct {
+	// Target is the TensorFlow runtime to connect to. Empty means the
+	// local runtime.
+	Target string `json:"target"`
+	// IntraOpThreads and InterOpThreads size the thread pools of the
+	// runtime. Zero lets the runtime choose.
+	IntraOpThreads int32 `json:"intra_op_threads"`
+	InterOpThreads int32 `json:"inter_op_threads"`
+	// DeviceCount limits the number of devices of each type, e.g.
+	// {"GPU": 0} to run on CPU only.
+	DeviceCount map[string]int32 `json:"device_count"`
+	// AllowSoftPlacement runs operations on the CPU when they have no
+	// kernel for the device they are placed on.
+	AllowSoftPlacement bool `json:"allow_soft_placement"`
+	// LogDevicePlacement logs the device of each operation.
+	LogDevicePlacement bool `json:"log_device_placement"`
+	GPU                GPU  `json:"gpu"`
+}
+
+// GPU configures the use of GPUs by a Session.
+type GPU struct {
+	// AllowGrowth allocates memory as needed instead of reserving it
+	// upfront.
+	AllowGrowth bool `json:"allow_growth"`
+	// MemoryFraction is the fraction of the memory of each GPU reserved.
+	// Zero lets the runtime choose.
+	MemoryFraction float64 `json:"memory_fraction"`
+	// VisibleDevices is a comma-separated list of the GPUs visible to the
+	// process, e.g. "0,2". Empty means all of them.
+	VisibleDevices string `json:"visible_devices"`
+}
+
+// Load reads the configuration file at path.
+func Load(path string) (*Config, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c, err := Parse(b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return c, nil
+}
+
+// Parse parses and validates a configuration.
+func Parse(b []byte) (*Config, error) {
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.DisallowUnknownFields()
+	var c Config
+	if err := d.Decode(&c); err != nil {
+		return nil, err
+	}
+	for i, m := range c.Models {
+		if err := m.validate(); err != nil {
+			return nil, fmt.Errorf("model %d: %v", i, err)
+		}
+	}
+	return &c, nil
+}
+
+func (m Model) validate() error {
+	if m.Path == "" {
+		return fmt.Errorf("path is required")
+	}
+	if m.Version < 0 || m.MaxConcurrentRuns < 0 || m.MaxFeedBytes < 0 {
+		return fmt.Errorf("version and quotas must not be negative")
+	}
+	return m.Session.validate()
+}
+
+func (s Session) validate() error {
+	if s.IntraOpThreads < 0 || s.InterOpThreads < 0 {
+		return fmt.Errorf("thread counts must not be negative")
+	}
+	if s.GPU.MemoryFraction < 0 || s.GPU.MemoryFraction > 1 {
+		return fmt.Errorf("GPU memory fraction %v is not in [0, 1]", s.GPU.MemoryFraction)
+	}
+	return nil
+}
+
+// Options returns the tf.SessionOptions configured by s.
+func (s Session) Options() (*tf.SessionOptions, error) {
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+	config := &configpb.ConfigProto{
+		DeviceCount:               s.DeviceCount,
+		IntraOpParallelismThreads: s.IntraOpThreads,
+		InterOpParallelismThreads: s.InterOpThreads,
+		AllowSoftPlacement:        s.AllowSoftPlacement,
+		LogDevicePlacement:        s.LogDevicePlacement,
+	}
+	if s.GPU != (GPU{}) {
+		config.GpuOptions = &configpb.GPUOptions{
+			AllowGrowth:                 s.GPU.AllowGrowth,
+			PerProcessGpuMemoryFraction: s.GPU.MemoryFraction,
+			VisibleDeviceList:           s.GPU.VisibleDevices,
+		}
+	}
+	b, err := proto.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	return &tf.SessionOptions{Target: s.Target, Config: b}, nil
+}
+
+func (m Model) tags() []string {
+	if len(m.Tags) == 0 {
+		return []string{"serve"}
+	}
+	return m.Tags
+}
+
+// Load loads the model and returns it with its signature.
+func (m Model) Load() (*tf.SavedModel, signature.Signature, error) {
+	opts, err := m.Session.Options()
+	if err != nil {
+		return nil, signature.Signature{}, err
+	}
+	model, err := tf.LoadSavedModel(m.Path, m.tags(), opts)
+	if err != nil {
+		return nil, signature.Signature{}, err
+	}
+	sigs, err := signature.FromSavedModel(model)
+	if err != nil {
+		model.Close()
+		return nil, signature.Signature{}, err
+	}
+	key := m.Signature
+	if key == "" {
+		key = signature.DefaultKey
+	}
+	sig, ok := sigs[key]
+	if !ok {
+		model.Close()
+		return nil, signature.Signature{}, fmt.Errorf("signature %q not found in %s", key, m.Path)
+	}
+	return model, sig, nil
+}
+
+// LoadReplicated loads the model replicated onto its Devices, or onto all
+// the visible GPUs if there are none.
+func (m Model) LoadReplicated() (*replica.Pool, error) {
+	opts, err := m.Session.Options()
+	if err != nil {
+		return nil, err
+	}
+	return replica.Load(m.Path, replica.Options{Tags: m.tags(), Devices: m.Devices, SessionOptions: opts})
+}
+
+// ModelOptions returns the options of the model in a modelserver.Server.
+func (m Model) ModelOptions() (modelserver.ModelOptions, error) {
+	opts, err := m.Session.Options()
+	if err != nil {
+		return modelserver.ModelOptions{}, err
+	}
+	return modelserver.ModelOptions{
+		Model:             tf.ResilientModelOptions{Tags: m.tags(), SessionOptions: opts},
+		MaxConcurrentRuns: m.MaxConcurrentRuns,
+		MaxFeedBytes:      m.MaxFeedBytes,
+	}, nil
+}
+
+// NewServer returns a modelserver.Server serving the models of c, which
+// must all have a name and a positive version.
+func (c *Config) NewServer() (*modelserver.Server, error) {
+	s := modelserver.New()
+	for _, m := range c.Models {
+		opts, err := m.ModelOptions()
+		if err == nil {
+			err = s.Load(m.Name, m.Version, m.Path, opts)
+		}
+		if err != nil {
+			s.Close()
+			return nil, fmt.Errorf("model %q version %d: %v", m.Name, m.Version, err)
+		}
+	}
+	return s, nil
+}
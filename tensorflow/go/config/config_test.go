@@ -0,0 +1,118 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	configpb "github.com/tensorflow/tensorflow/tensorflow/go/core/protobuf"
+)
+
+const halfPlusTwo = "../../cc/saved_model/testdata/half_plus_two/00000123"
+
+func TestLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "serving.json")
+	contents := `{
+		"models": [{
+			"name": "half_plus_two",
+			"version": 123,
+			"path": "` + halfPlusTwo + `",
+			"signature": "regress_x_to_y",
+			"session": {
+				"intra_op_threads": 2,
+				"device_count": {"GPU": 0},
+				"gpu": {"allow_growth": true, "visible_devices": "0"}
+			},
+			"max_concurrent_runs": 4
+		}]
+	}`
+	if err := ioutil.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := c.Models[0]
+
+	opts, err := m.Session.Options()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var config configpb.ConfigProto
+	if err := proto.Unmarshal(opts.Config, &config); err != nil {
+		t.Fatal(err)
+	}
+	want := &configpb.ConfigProto{
+		DeviceCount:               map[string]int32{"GPU": 0},
+		IntraOpParallelismThreads: 2,
+		GpuOptions:                &configpb.GPUOptions{AllowGrowth: true, VisibleDeviceList: "0"},
+	}
+	if !proto.Equal(&config, want) {
+		t.Errorf("Got %v, want %v", &config, want)
+	}
+
+	model, sig, err := m.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer model.Close()
+	if sig.MethodName != "tensorflow/serving/regress" {
+		t.Errorf("Got signature %+v, want regress_x_to_y", sig)
+	}
+
+	s, err := c.NewServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	x, err := tf.NewTensor([]float32{2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := s.Run("half_plus_two", 123, map[string]*tf.Tensor{"x:0": x}, []string{"y:0"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := out[0].Value().([]float32)[0], float32(3); got != want {
+		t.Errorf("Got %v, want %v", got, want)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, test := range []struct {
+		config, want string
+	}{
+		{`{"models": [{"path": "m", "sesion": {}}]}`, "unknown field"},
+		{`{"models": [{"name": "m"}]}`, "path is required"},
+		{`{"models": [{"path": "m", "max_feed_bytes": -1}]}`, "must not be negative"},
+		{`{"models": [{"path": "m", "session": {"gpu": {"memory_fraction": 1.5}}}]}`, "not in [0, 1]"},
+	} {
+		if _, err := Parse([]byte(test.config)); err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("Parse(%s) = %v, want an error containing %q", test.config, err, test.want)
+		}
+	}
+}
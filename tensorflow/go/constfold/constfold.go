@@ -0,0 +1,317 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package constfold evaluates the parts of TensorFlow graphs that do not
+// depend on their inputs ahead of time, replacing them with constants.
+//
+// This is the constant folding that Grappler performs when a session is
+// created, available for runtimes linked without Grappler and to shrink
+// graphs before they are deployed.
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package constfold
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+)
+
+// Options configures Fold.
+type Options struct {
+	// Keep lists the names of operations that must remain in the folded
+	// graph, such as operations that are fetched. Operations consumed by
+	// operations that are not folded always remain.
+	Keep []string
+}
+
+// Stats describes the changes made by Fold.
+type Stats struct {
+	// Folded lists the names of the operations replaced by constants.
+	Folded []string
+	// Removed lists the names of the operations that were only needed to
+	// compute the folded operations.
+	Removed []string
+}
+
+// unfoldable lists the types of operations that are never folded, in
+// addition to the stateful ones: the inputs of the graph, and control flow.
+var unfoldable = map[string]bool{
+	"Placeholder":            true,
+	"PlaceholderV2":          true,
+	"PlaceholderWithDefault": true,
+	"ImmutableConst":         true,
+	"Enter":                  true,
+	"Exit":                   true,
+	"LoopCond":               true,
+	"Merge":                  true,
+	"NextIteration":          true,
+	"Switch":                 true,
+}
+
+// Fold replaces the operations of a graph, given as a serialized
+// tensorflow.GraphDef protocol buffer
+// (https://www.tensorflow.org/code/tensorflow/core/framework/graph.proto),
+// that do not depend on any placeholder by constants, and returns the
+// serialized folded graph.
+//
+// An operation is folded if it is registered with the runtime, is not
+// stateful, has a single output that is not a resource,
+// and all its inputs, including its control inputs, are constants or folded
+// operations. It is then evaluated by running the graph in a new Session
+// and, if it is used by an operation that is not folded or listed in
+// Options.Keep, replaced by a Const operation of the same name; otherwise
+// it is removed, with the constants only it used.
+func Fold(graphDef []byte, opts Options) ([]byte, *Stats, error) {
+	var def pb.GraphDef
+	if err := proto.Unmarshal(graphDef, &def); err != nil {
+		return nil, nil, fmt.Errorf("invalid GraphDef: %v", err)
+	}
+	ops, err := tf.RegisteredOps()
+	if err != nil {
+		return nil, nil, err
+	}
+	f := &folder{
+		nodes:     make(map[string]*pb.NodeDef, len(def.Node)),
+		consumers: make(map[string][]*pb.NodeDef),
+		opInfo:    make(map[string]tf.OpInfo, len(ops)),
+		keep:      make(map[string]bool, len(opts.Keep)),
+		blocked:   make(map[string]bool),
+	}
+	for _, op := range ops {
+		f.opInfo[op.Name] = op
+	}
+	for _, name := range opts.Keep {
+		f.keep[name] = true
+	}
+	for _, n := range def.Node {
+		f.nodes[n.Name] = n
+	}
+	for _, n := range def.Node {
+		for _, in := range n.Input {
+			if !strings.HasPrefix(in, "^") {
+				f.consumers[nodeName(in)] = append(f.consumers[nodeName(in)], n)
+			}
+		}
+	}
+
+	graph := tf.NewGraph()
+	defer graph.Close()
+	if err := graph.Import(graphDef, ""); err != nil {
+		return nil, nil, err
+	}
+	// Operations whose values cannot be constants are only known once
+	// the graph is imported: block them until all the folded operations
+	// can be evaluated.
+	var frontier []*pb.NodeDef
+	for {
+		f.computeFoldable(def.Node)
+		frontier = f.frontier(def.Node)
+		blocked := false
+		for _, n := range frontier {
+			if dt := graph.Operation(n.Name).Output(0).DataType(); dt == tf.Resource {
+				f.blocked[n.Name] = true
+				blocked = true
+			}
+		}
+		if !blocked {
+			break
+		}
+	}
+	stats := new(Stats)
+	if len(frontier) == 0 {
+		return graphDef, stats, nil
+	}
+	consts, err := evaluate(graph, frontier)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, n := range frontier {
+		*n = pb.NodeDef{Name: n.Name, Op: "Const", Device: n.Device, Attr: consts[i].Attr}
+		stats.Folded = append(stats.Folded, n.Name)
+	}
+
+	// Remove the folded operations and constants that are no longer used.
+	live := make(map[string]bool)
+	var mark func(n *pb.NodeDef)
+	mark = func(n *pb.NodeDef) {
+		if live[n.Name] {
+			return
+		}
+		live[n.Name] = true
+		for _, in := range n.Input {
+			if in, ok := f.nodes[nodeName(in)]; ok {
+				mark(in)
+			}
+		}
+	}
+	for _, n := range def.Node {
+		if !f.foldable[n.Name] || f.keep[n.Name] || (n.Op == "Const" && len(f.consumers[n.Name]) == 0) {
+			mark(n)
+		}
+	}
+	for _, n := range frontier {
+		mark(n)
+	}
+	nodes := def.Node[:0]
+	for _, n := range def.Node {
+		if live[n.Name] {
+			nodes = append(nodes, n)
+		} else {
+			stats.Removed = append(stats.Removed, n.Name)
+		}
+	}
+	def.Node = nodes
+	sort.Strings(stats.Folded)
+	sort.Strings(stats.Removed)
+	out, err := proto.Marshal(&def)
+	if err != nil {
+		return nil, nil, err
+	}
+	return out, stats, nil
+}
+
+type folder struct {
+	nodes     map[string]*pb.NodeDef
+	consumers map[string][]*pb.NodeDef // Using the outputs of a node.
+	opInfo    map[string]tf.OpInfo
+	keep      map[string]bool
+	blocked   map[string]bool
+	foldable  map[string]bool
+}
+
+func (f *folder) computeFoldable(nodes []*pb.NodeDef) {
+	f.foldable = make(map[string]bool, len(nodes))
+	visiting := make(map[string]bool)
+	var visit func(n *pb.NodeDef) bool
+	visit = func(n *pb.NodeDef) bool {
+		if foldable, ok := f.foldable[n.Name]; ok {
+			return foldable
+		}
+		if visiting[n.Name] {
+			return false // A cycle.
+		}
+		visiting[n.Name] = true
+		foldable := n.Op == "Const" || f.canFold(n)
+		if foldable && n.Op != "Const" {
+			for _, in := range n.Input {
+				in, ok := f.nodes[nodeName(in)]
+				if !ok || !visit(in) {
+					foldable = false
+					break
+				}
+			}
+		}
+		f.foldable[n.Name] = foldable
+		return foldable
+	}
+	for _, n := range nodes {
+		visit(n)
+	}
+}
+
+// canFold reports whether n can be folded if its inputs are.
+func (f *folder) canFold(n *pb.NodeDef) bool {
+	op, ok := f.opInfo[n.Op]
+	if !ok || op.IsStateful || unfoldable[n.Op] || f.blocked[n.Name] {
+		return false
+	}
+	return len(op.Outputs) == 1 && op.Outputs[0].NumberAttr == "" && op.Outputs[0].TypeListAttr == ""
+}
+
+// frontier returns the folded operations that must be replaced by
+// constants: those used by operations that are not folded, or listed in
+// Options.Keep, or that are not used at all.
+func (f *folder) frontier(nodes []*pb.NodeDef) []*pb.NodeDef {
+	var frontier []*pb.NodeDef
+	for _, n := range nodes {
+		if !f.foldable[n.Name] || n.Op == "Const" {
+			continue
+		}
+		replace := f.keep[n.Name] || len(f.consumers[n.Name]) == 0
+		for _, c := range f.consumers[n.Name] {
+			if !f.foldable[c.Name] {
+				replace = true
+			}
+		}
+		if replace {
+			frontier = append(frontier, n)
+		}
+	}
+	return frontier
+}
+
+// evaluate returns Const operations with the values of nodes in graph.
+func evaluate(graph *tf.Graph, nodes []*pb.NodeDef) ([]*pb.NodeDef, error) {
+	fetches := make([]tf.Output, len(nodes))
+	for i, n := range nodes {
+		fetches[i] = graph.Operation(n.Name).Output(0)
+	}
+	sess, err := tf.NewSession(graph, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer sess.Close()
+	values, err := sess.Run(nil, fetches, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate the folded operations: %v", err)
+	}
+	// Let the runtime serialize the values, as Const operations of a new
+	// graph.
+	consts := tf.NewGraph()
+	defer consts.Close()
+	for i, v := range values {
+		if _, err := consts.AddOperation(tf.OpSpec{
+			Type:  "Const",
+			Name:  fmt.Sprintf("c%d", i),
+			Attrs: map[string]interface{}{"dtype": v.DataType(), "value": v},
+		}); err != nil {
+			return nil, fmt.Errorf("operation %q: %v", nodes[i].Name, err)
+		}
+	}
+	var buf bytes.Buffer
+	if _, err := consts.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+	var def pb.GraphDef
+	if err := proto.Unmarshal(buf.Bytes(), &def); err != nil {
+		return nil, err
+	}
+	ret := make([]*pb.NodeDef, len(nodes))
+	for _, n := range def.Node {
+		var i int
+		if _, err := fmt.Sscanf(n.Name, "c%d", &i); err != nil || i < 0 || i >= len(ret) {
+			return nil, fmt.Errorf("unexpected operation %q", n.Name)
+		}
+		ret[i] = n
+	}
+	return ret, nil
+}
+
+// nodeName returns the name of the operation of an input, in the form
+// "operation:index" or "^operation" for control inputs, or input itself if
+// it is invalid, so that it names no node.
+func nodeName(input string) string {
+	op, _, err := tf.ParseTensorName(input)
+	if err != nil {
+		return input
+	}
+	return op
+}
@@ -0,0 +1,87 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package constfold
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+func TestFold(t *testing.T) {
+	s := op.NewScope()
+	a := op.Const(s.SubScope("a"), float32(2))
+	m := op.Mul(s.SubScope("m"), a, op.Const(s.SubScope("b"), float32(3)))
+	c := op.Add(s.SubScope("c"), m, a)
+	op.Add(s.SubScope("y"), op.Placeholder(s.SubScope("x"), tf.Float), c)
+	op.RandomUniform(s.SubScope("r"), op.Const(s.SubScope("shape"), []int32{2}), tf.Float)
+	graph, err := s.Finalize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if _, err := graph.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		opts Options
+		want Stats
+	}{
+		{Options{}, Stats{Folded: []string{"c/Add"}, Removed: []string{"a/Const", "b/Const", "m/Mul"}}},
+		{Options{Keep: []string{"m/Mul"}}, Stats{Folded: []string{"c/Add", "m/Mul"}, Removed: []string{"a/Const", "b/Const"}}},
+	}
+	for _, test := range tests {
+		folded, stats, err := Fold(buf.Bytes(), test.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(*stats, test.want) {
+			t.Errorf("%+v: got %+v, want %+v", test.opts, *stats, test.want)
+		}
+		g := tf.NewGraph()
+		if err := g.Import(folded, ""); err != nil {
+			t.Fatal(err)
+		}
+		if op := g.Operation("c/Add"); op == nil || op.Type() != "Const" {
+			t.Errorf("%+v: c/Add is not a constant", test.opts)
+		}
+		if op := g.Operation("r/RandomUniform"); op == nil || op.Type() != "RandomUniform" {
+			t.Errorf("%+v: the stateful r/RandomUniform was folded", test.opts)
+		}
+		sess, err := tf.NewSession(g, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		x, err := tf.NewTensor(float32(1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, err := sess.Run(
+			map[tf.Output]*tf.Tensor{g.Operation("x/Placeholder").Output(0): x},
+			[]tf.Output{g.Operation("y/Add").Output(0)},
+			nil)
+		sess.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := out[0].Value().(float32); got != 9 {
+			t.Errorf("%+v: got %v, want 9", test.opts, got)
+		}
+	}
+}
@@ -0,0 +1,393 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: tensorflow/core/example/feature.proto
+
+package tensorflow
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type BytesList struct {
+	Value                [][]byte `protobuf:"bytes,1,rep,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BytesList) Reset()         { *m = BytesList{} }
+func (m *BytesList) String() string { return proto.CompactTextString(m) }
+func (*BytesList) ProtoMessage()    {}
+func (*BytesList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a52991187ca0172, []int{0}
+}
+
+func (m *BytesList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BytesList.Unmarshal(m, b)
+}
+func (m *BytesList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BytesList.Marshal(b, m, deterministic)
+}
+func (m *BytesList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BytesList.Merge(m, src)
+}
+func (m *BytesList) XXX_Size() int {
+	return xxx_messageInfo_BytesList.Size(m)
+}
+func (m *BytesList) XXX_DiscardUnknown() {
+	xxx_messageInfo_BytesList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BytesList proto.InternalMessageInfo
+
+func (m *BytesList) GetValue() [][]byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type FloatList struct {
+	Value                []float32 `protobuf:"fixed32,1,rep,packed,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *FloatList) Reset()         { *m = FloatList{} }
+func (m *FloatList) String() string { return proto.CompactTextString(m) }
+func (*FloatList) ProtoMessage()    {}
+func (*FloatList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a52991187ca0172, []int{1}
+}
+
+func (m *FloatList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FloatList.Unmarshal(m, b)
+}
+func (m *FloatList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FloatList.Marshal(b, m, deterministic)
+}
+func (m *FloatList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FloatList.Merge(m, src)
+}
+func (m *FloatList) XXX_Size() int {
+	return xxx_messageInfo_FloatList.Size(m)
+}
+func (m *FloatList) XXX_DiscardUnknown() {
+	xxx_messageInfo_FloatList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FloatList proto.InternalMessageInfo
+
+func (m *FloatList) GetValue() []float32 {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type Int64List struct {
+	Value                []int64  `protobuf:"varint,1,rep,packed,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Int64List) Reset()         { *m = Int64List{} }
+func (m *Int64List) String() string { return proto.CompactTextString(m) }
+func (*Int64List) ProtoMessage()    {}
+func (*Int64List) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a52991187ca0172, []int{2}
+}
+
+func (m *Int64List) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Int64List.Unmarshal(m, b)
+}
+func (m *Int64List) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Int64List.Marshal(b, m, deterministic)
+}
+func (m *Int64List) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Int64List.Merge(m, src)
+}
+func (m *Int64List) XXX_Size() int {
+	return xxx_messageInfo_Int64List.Size(m)
+}
+func (m *Int64List) XXX_DiscardUnknown() {
+	xxx_messageInfo_Int64List.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Int64List proto.InternalMessageInfo
+
+func (m *Int64List) GetValue() []int64 {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type Feature struct {
+	// Types that are valid to be assigned to Kind:
+	//	*Feature_BytesList
+	//	*Feature_FloatList
+	//	*Feature_Int64List
+	Kind                 isFeature_Kind `protobuf_oneof:"kind"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Feature) Reset()         { *m = Feature{} }
+func (m *Feature) String() string { return proto.CompactTextString(m) }
+func (*Feature) ProtoMessage()    {}
+func (*Feature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a52991187ca0172, []int{3}
+}
+
+func (m *Feature) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Feature.Unmarshal(m, b)
+}
+func (m *Feature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Feature.Marshal(b, m, deterministic)
+}
+func (m *Feature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Feature.Merge(m, src)
+}
+func (m *Feature) XXX_Size() int {
+	return xxx_messageInfo_Feature.Size(m)
+}
+func (m *Feature) XXX_DiscardUnknown() {
+	xxx_messageInfo_Feature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Feature proto.InternalMessageInfo
+
+type isFeature_Kind interface {
+	isFeature_Kind()
+}
+
+type Feature_BytesList struct {
+	BytesList *BytesList `protobuf:"bytes,1,opt,name=bytes_list,json=bytesList,proto3,oneof"`
+}
+
+type Feature_FloatList struct {
+	FloatList *FloatList `protobuf:"bytes,2,opt,name=float_list,json=floatList,proto3,oneof"`
+}
+
+type Feature_Int64List struct {
+	Int64List *Int64List `protobuf:"bytes,3,opt,name=int64_list,json=int64List,proto3,oneof"`
+}
+
+func (*Feature_BytesList) isFeature_Kind() {}
+
+func (*Feature_FloatList) isFeature_Kind() {}
+
+func (*Feature_Int64List) isFeature_Kind() {}
+
+func (m *Feature) GetKind() isFeature_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (m *Feature) GetBytesList() *BytesList {
+	if x, ok := m.GetKind().(*Feature_BytesList); ok {
+		return x.BytesList
+	}
+	return nil
+}
+
+func (m *Feature) GetFloatList() *FloatList {
+	if x, ok := m.GetKind().(*Feature_FloatList); ok {
+		return x.FloatList
+	}
+	return nil
+}
+
+func (m *Feature) GetInt64List() *Int64List {
+	if x, ok := m.GetKind().(*Feature_Int64List); ok {
+		return x.Int64List
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Feature) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Feature_BytesList)(nil),
+		(*Feature_FloatList)(nil),
+		(*Feature_Int64List)(nil),
+	}
+}
+
+type Features struct {
+	Feature              map[string]*Feature `protobuf:"bytes,1,rep,name=feature,proto3" json:"feature,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *Features) Reset()         { *m = Features{} }
+func (m *Features) String() string { return proto.CompactTextString(m) }
+func (*Features) ProtoMessage()    {}
+func (*Features) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a52991187ca0172, []int{4}
+}
+
+func (m *Features) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Features.Unmarshal(m, b)
+}
+func (m *Features) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Features.Marshal(b, m, deterministic)
+}
+func (m *Features) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Features.Merge(m, src)
+}
+func (m *Features) XXX_Size() int {
+	return xxx_messageInfo_Features.Size(m)
+}
+func (m *Features) XXX_DiscardUnknown() {
+	xxx_messageInfo_Features.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Features proto.InternalMessageInfo
+
+func (m *Features) GetFeature() map[string]*Feature {
+	if m != nil {
+		return m.Feature
+	}
+	return nil
+}
+
+type FeatureList struct {
+	Feature              []*Feature `protobuf:"bytes,1,rep,name=feature,proto3" json:"feature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *FeatureList) Reset()         { *m = FeatureList{} }
+func (m *FeatureList) String() string { return proto.CompactTextString(m) }
+func (*FeatureList) ProtoMessage()    {}
+func (*FeatureList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a52991187ca0172, []int{5}
+}
+
+func (m *FeatureList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeatureList.Unmarshal(m, b)
+}
+func (m *FeatureList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeatureList.Marshal(b, m, deterministic)
+}
+func (m *FeatureList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeatureList.Merge(m, src)
+}
+func (m *FeatureList) XXX_Size() int {
+	return xxx_messageInfo_FeatureList.Size(m)
+}
+func (m *FeatureList) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeatureList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeatureList proto.InternalMessageInfo
+
+func (m *FeatureList) GetFeature() []*Feature {
+	if m != nil {
+		return m.Feature
+	}
+	return nil
+}
+
+type FeatureLists struct {
+	FeatureList          map[string]*FeatureList `protobuf:"bytes,1,rep,name=feature_list,json=featureList,proto3" json:"feature_list,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *FeatureLists) Reset()         { *m = FeatureLists{} }
+func (m *FeatureLists) String() string { return proto.CompactTextString(m) }
+func (*FeatureLists) ProtoMessage()    {}
+func (*FeatureLists) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a52991187ca0172, []int{6}
+}
+
+func (m *FeatureLists) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeatureLists.Unmarshal(m, b)
+}
+func (m *FeatureLists) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeatureLists.Marshal(b, m, deterministic)
+}
+func (m *FeatureLists) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeatureLists.Merge(m, src)
+}
+func (m *FeatureLists) XXX_Size() int {
+	return xxx_messageInfo_FeatureLists.Size(m)
+}
+func (m *FeatureLists) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeatureLists.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeatureLists proto.InternalMessageInfo
+
+func (m *FeatureLists) GetFeatureList() map[string]*FeatureList {
+	if m != nil {
+		return m.FeatureList
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*BytesList)(nil), "tensorflow.BytesList")
+	proto.RegisterType((*FloatList)(nil), "tensorflow.FloatList")
+	proto.RegisterType((*Int64List)(nil), "tensorflow.Int64List")
+	proto.RegisterType((*Feature)(nil), "tensorflow.Feature")
+	proto.RegisterType((*Features)(nil), "tensorflow.Features")
+	proto.RegisterMapType((map[string]*Feature)(nil), "tensorflow.Features.FeatureEntry")
+	proto.RegisterType((*FeatureList)(nil), "tensorflow.FeatureList")
+	proto.RegisterType((*FeatureLists)(nil), "tensorflow.FeatureLists")
+	proto.RegisterMapType((map[string]*FeatureList)(nil), "tensorflow.FeatureLists.FeatureListEntry")
+}
+
+func init() {
+	proto.RegisterFile("tensorflow/core/example/feature.proto", fileDescriptor_0a52991187ca0172)
+}
+
+var fileDescriptor_0a52991187ca0172 = []byte{
+	// 368 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xdf, 0x4a, 0xc3, 0x30,
+	0x14, 0xc6, 0x4d, 0xab, 0x9b, 0x3d, 0xad, 0x30, 0xe2, 0xbf, 0xb1, 0xab, 0xad, 0x30, 0xd8, 0xc0,
+	0x6d, 0x30, 0xa5, 0x88, 0x7a, 0x55, 0x70, 0x28, 0x0c, 0x1c, 0xbd, 0xf1, 0x52, 0x3a, 0x4d, 0xa5,
+	0xac, 0x36, 0xa3, 0xc9, 0xd4, 0xbd, 0x89, 0x2f, 0xe2, 0x85, 0x6f, 0xe6, 0xa5, 0xa4, 0x4d, 0xbb,
+	0x6c, 0xab, 0x77, 0x3d, 0xc9, 0xf7, 0x9d, 0xfc, 0xbe, 0xd3, 0x03, 0x6d, 0x4e, 0x62, 0x46, 0x93,
+	0x20, 0xa2, 0x1f, 0x83, 0x67, 0x9a, 0x90, 0x01, 0xf9, 0xf4, 0xdf, 0xe6, 0x11, 0x19, 0x04, 0xc4,
+	0xe7, 0x8b, 0x84, 0xf4, 0xe7, 0x09, 0xe5, 0x14, 0xc3, 0x4a, 0x66, 0xb7, 0xc0, 0x70, 0x97, 0x9c,
+	0xb0, 0x71, 0xc8, 0x38, 0x3e, 0x82, 0xbd, 0x77, 0x3f, 0x5a, 0x90, 0x3a, 0x6a, 0xea, 0x1d, 0xcb,
+	0xcb, 0x0a, 0xbb, 0x0d, 0xc6, 0x28, 0xa2, 0x3e, 0x4f, 0x25, 0x75, 0x55, 0xa2, 0xb9, 0x5a, 0x0d,
+	0x29, 0xb2, 0xfb, 0x98, 0x3b, 0x17, 0xdb, 0x32, 0x5d, 0x95, 0xfd, 0x20, 0xa8, 0x8e, 0x32, 0x1c,
+	0xec, 0x00, 0x4c, 0xc5, 0xe3, 0x4f, 0x51, 0xc8, 0x78, 0x1d, 0x35, 0x51, 0xc7, 0x1c, 0x1e, 0xf7,
+	0x57, 0x74, 0xfd, 0x02, 0xed, 0x6e, 0xc7, 0x33, 0xa6, 0x05, 0xa7, 0x03, 0x10, 0x08, 0xa2, 0xcc,
+	0xa7, 0x6d, 0xfb, 0x0a, 0x5e, 0xe1, 0x0b, 0x0a, 0x78, 0x07, 0x20, 0x14, 0x88, 0x99, 0x4f, 0xdf,
+	0xf6, 0x15, 0x01, 0x84, 0x2f, 0xcc, 0x0b, 0xb7, 0x02, 0xbb, 0xb3, 0x30, 0x7e, 0xb1, 0xbf, 0x10,
+	0xec, 0x4b, 0x76, 0x86, 0xaf, 0xa1, 0x2a, 0xc7, 0x9a, 0x86, 0x34, 0x87, 0xad, 0x35, 0x02, 0x29,
+	0xcb, 0x3f, 0x6e, 0x63, 0x9e, 0x2c, 0xbd, 0xdc, 0xd1, 0x78, 0x00, 0x4b, 0xbd, 0xc0, 0x35, 0xd0,
+	0x67, 0x64, 0x99, 0x8e, 0xc0, 0xf0, 0xc4, 0x27, 0xee, 0xe6, 0x13, 0xcc, 0xe2, 0x1d, 0x96, 0x34,
+	0x97, 0x23, 0xbd, 0xd2, 0x2e, 0x91, 0x7d, 0x03, 0xa6, 0x3c, 0x4d, 0x93, 0xf6, 0x36, 0xe1, 0x4a,
+	0xfd, 0xb9, 0xc6, 0xfe, 0x46, 0x60, 0x29, 0x76, 0x86, 0xc7, 0x60, 0xc9, 0xbb, 0xfc, 0xdf, 0x88,
+	0x26, 0xdd, 0x92, 0x26, 0xa9, 0x5e, 0x2d, 0xb2, 0xa4, 0x66, 0xb0, 0x3a, 0x69, 0x3c, 0x42, 0x6d,
+	0x53, 0x50, 0x92, 0xb8, 0xb7, 0x9e, 0xf8, 0xf4, 0x9f, 0xc7, 0x94, 0xd4, 0xee, 0x19, 0x9c, 0xd0,
+	0xe4, 0x55, 0x15, 0xca, 0x8d, 0x77, 0x0f, 0xa4, 0x63, 0x22, 0x36, 0x9e, 0x4d, 0xd0, 0x2f, 0x42,
+	0xd3, 0x4a, 0xba, 0xfe, 0xe7, 0x7f, 0x03, 0x00, 0xd3, 0x38, 0x1c, 0x54, 0x27, 0x03, 0x00, 0x00,
+}
@@ -0,0 +1,373 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: tensorflow/core/framework/cost_graph.proto
+
+package tensorflow
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type CostGraphDef struct {
+	Node                 []*CostGraphDef_Node `protobuf:"bytes,1,rep,name=node,proto3" json:"node,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CostGraphDef) Reset()         { *m = CostGraphDef{} }
+func (m *CostGraphDef) String() string { return proto.CompactTextString(m) }
+func (*CostGraphDef) ProtoMessage()    {}
+func (*CostGraphDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f8948141565ace8, []int{0}
+}
+
+func (m *CostGraphDef) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CostGraphDef.Unmarshal(m, b)
+}
+func (m *CostGraphDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CostGraphDef.Marshal(b, m, deterministic)
+}
+func (m *CostGraphDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CostGraphDef.Merge(m, src)
+}
+func (m *CostGraphDef) XXX_Size() int {
+	return xxx_messageInfo_CostGraphDef.Size(m)
+}
+func (m *CostGraphDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_CostGraphDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CostGraphDef proto.InternalMessageInfo
+
+func (m *CostGraphDef) GetNode() []*CostGraphDef_Node {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+type CostGraphDef_Node struct {
+	Name                       string                          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Device                     string                          `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	Id                         int32                           `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	InputInfo                  []*CostGraphDef_Node_InputInfo  `protobuf:"bytes,4,rep,name=input_info,json=inputInfo,proto3" json:"input_info,omitempty"`
+	OutputInfo                 []*CostGraphDef_Node_OutputInfo `protobuf:"bytes,5,rep,name=output_info,json=outputInfo,proto3" json:"output_info,omitempty"`
+	TemporaryMemorySize        int64                           `protobuf:"varint,6,opt,name=temporary_memory_size,json=temporaryMemorySize,proto3" json:"temporary_memory_size,omitempty"`
+	HostTempMemorySize         int64                           `protobuf:"varint,10,opt,name=host_temp_memory_size,json=hostTempMemorySize,proto3" json:"host_temp_memory_size,omitempty"`
+	DeviceTempMemorySize       int64                           `protobuf:"varint,11,opt,name=device_temp_memory_size,json=deviceTempMemorySize,proto3" json:"device_temp_memory_size,omitempty"`
+	HostPersistentMemorySize   int64                           `protobuf:"varint,12,opt,name=host_persistent_memory_size,json=hostPersistentMemorySize,proto3" json:"host_persistent_memory_size,omitempty"`
+	DevicePersistentMemorySize int64                           `protobuf:"varint,16,opt,name=device_persistent_memory_size,json=devicePersistentMemorySize,proto3" json:"device_persistent_memory_size,omitempty"`
+	ComputeCost                int64                           `protobuf:"varint,9,opt,name=compute_cost,json=computeCost,proto3" json:"compute_cost,omitempty"`
+	ComputeTime                int64                           `protobuf:"varint,14,opt,name=compute_time,json=computeTime,proto3" json:"compute_time,omitempty"`
+	MemoryTime                 int64                           `protobuf:"varint,15,opt,name=memory_time,json=memoryTime,proto3" json:"memory_time,omitempty"`
+	IsFinal                    bool                            `protobuf:"varint,7,opt,name=is_final,json=isFinal,proto3" json:"is_final,omitempty"`
+	ControlInput               []int32                         `protobuf:"varint,8,rep,packed,name=control_input,json=controlInput,proto3" json:"control_input,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{}                        `json:"-"`
+	XXX_unrecognized           []byte                          `json:"-"`
+	XXX_sizecache              int32                           `json:"-"`
+}
+
+func (m *CostGraphDef_Node) Reset()         { *m = CostGraphDef_Node{} }
+func (m *CostGraphDef_Node) String() string { return proto.CompactTextString(m) }
+func (*CostGraphDef_Node) ProtoMessage()    {}
+func (*CostGraphDef_Node) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f8948141565ace8, []int{0, 0}
+}
+
+func (m *CostGraphDef_Node) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CostGraphDef_Node.Unmarshal(m, b)
+}
+func (m *CostGraphDef_Node) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CostGraphDef_Node.Marshal(b, m, deterministic)
+}
+func (m *CostGraphDef_Node) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CostGraphDef_Node.Merge(m, src)
+}
+func (m *CostGraphDef_Node) XXX_Size() int {
+	return xxx_messageInfo_CostGraphDef_Node.Size(m)
+}
+func (m *CostGraphDef_Node) XXX_DiscardUnknown() {
+	xxx_messageInfo_CostGraphDef_Node.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CostGraphDef_Node proto.InternalMessageInfo
+
+func (m *CostGraphDef_Node) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CostGraphDef_Node) GetDevice() string {
+	if m != nil {
+		return m.Device
+	}
+	return ""
+}
+
+func (m *CostGraphDef_Node) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *CostGraphDef_Node) GetInputInfo() []*CostGraphDef_Node_InputInfo {
+	if m != nil {
+		return m.InputInfo
+	}
+	return nil
+}
+
+func (m *CostGraphDef_Node) GetOutputInfo() []*CostGraphDef_Node_OutputInfo {
+	if m != nil {
+		return m.OutputInfo
+	}
+	return nil
+}
+
+func (m *CostGraphDef_Node) GetTemporaryMemorySize() int64 {
+	if m != nil {
+		return m.TemporaryMemorySize
+	}
+	return 0
+}
+
+func (m *CostGraphDef_Node) GetHostTempMemorySize() int64 {
+	if m != nil {
+		return m.HostTempMemorySize
+	}
+	return 0
+}
+
+func (m *CostGraphDef_Node) GetDeviceTempMemorySize() int64 {
+	if m != nil {
+		return m.DeviceTempMemorySize
+	}
+	return 0
+}
+
+func (m *CostGraphDef_Node) GetHostPersistentMemorySize() int64 {
+	if m != nil {
+		return m.HostPersistentMemorySize
+	}
+	return 0
+}
+
+func (m *CostGraphDef_Node) GetDevicePersistentMemorySize() int64 {
+	if m != nil {
+		return m.DevicePersistentMemorySize
+	}
+	return 0
+}
+
+func (m *CostGraphDef_Node) GetComputeCost() int64 {
+	if m != nil {
+		return m.ComputeCost
+	}
+	return 0
+}
+
+func (m *CostGraphDef_Node) GetComputeTime() int64 {
+	if m != nil {
+		return m.ComputeTime
+	}
+	return 0
+}
+
+func (m *CostGraphDef_Node) GetMemoryTime() int64 {
+	if m != nil {
+		return m.MemoryTime
+	}
+	return 0
+}
+
+func (m *CostGraphDef_Node) GetIsFinal() bool {
+	if m != nil {
+		return m.IsFinal
+	}
+	return false
+}
+
+func (m *CostGraphDef_Node) GetControlInput() []int32 {
+	if m != nil {
+		return m.ControlInput
+	}
+	return nil
+}
+
+type CostGraphDef_Node_InputInfo struct {
+	PrecedingNode        int32    `protobuf:"varint,1,opt,name=preceding_node,json=precedingNode,proto3" json:"preceding_node,omitempty"`
+	PrecedingPort        int32    `protobuf:"varint,2,opt,name=preceding_port,json=precedingPort,proto3" json:"preceding_port,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CostGraphDef_Node_InputInfo) Reset()         { *m = CostGraphDef_Node_InputInfo{} }
+func (m *CostGraphDef_Node_InputInfo) String() string { return proto.CompactTextString(m) }
+func (*CostGraphDef_Node_InputInfo) ProtoMessage()    {}
+func (*CostGraphDef_Node_InputInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f8948141565ace8, []int{0, 0, 0}
+}
+
+func (m *CostGraphDef_Node_InputInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CostGraphDef_Node_InputInfo.Unmarshal(m, b)
+}
+func (m *CostGraphDef_Node_InputInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CostGraphDef_Node_InputInfo.Marshal(b, m, deterministic)
+}
+func (m *CostGraphDef_Node_InputInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CostGraphDef_Node_InputInfo.Merge(m, src)
+}
+func (m *CostGraphDef_Node_InputInfo) XXX_Size() int {
+	return xxx_messageInfo_CostGraphDef_Node_InputInfo.Size(m)
+}
+func (m *CostGraphDef_Node_InputInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CostGraphDef_Node_InputInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CostGraphDef_Node_InputInfo proto.InternalMessageInfo
+
+func (m *CostGraphDef_Node_InputInfo) GetPrecedingNode() int32 {
+	if m != nil {
+		return m.PrecedingNode
+	}
+	return 0
+}
+
+func (m *CostGraphDef_Node_InputInfo) GetPrecedingPort() int32 {
+	if m != nil {
+		return m.PrecedingPort
+	}
+	return 0
+}
+
+type CostGraphDef_Node_OutputInfo struct {
+	Size                 int64             `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	AliasInputPort       int64             `protobuf:"varint,2,opt,name=alias_input_port,json=aliasInputPort,proto3" json:"alias_input_port,omitempty"`
+	Shape                *TensorShapeProto `protobuf:"bytes,3,opt,name=shape,proto3" json:"shape,omitempty"`
+	Dtype                DataType          `protobuf:"varint,4,opt,name=dtype,proto3,enum=tensorflow.DataType" json:"dtype,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CostGraphDef_Node_OutputInfo) Reset()         { *m = CostGraphDef_Node_OutputInfo{} }
+func (m *CostGraphDef_Node_OutputInfo) String() string { return proto.CompactTextString(m) }
+func (*CostGraphDef_Node_OutputInfo) ProtoMessage()    {}
+func (*CostGraphDef_Node_OutputInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f8948141565ace8, []int{0, 0, 1}
+}
+
+func (m *CostGraphDef_Node_OutputInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CostGraphDef_Node_OutputInfo.Unmarshal(m, b)
+}
+func (m *CostGraphDef_Node_OutputInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CostGraphDef_Node_OutputInfo.Marshal(b, m, deterministic)
+}
+func (m *CostGraphDef_Node_OutputInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CostGraphDef_Node_OutputInfo.Merge(m, src)
+}
+func (m *CostGraphDef_Node_OutputInfo) XXX_Size() int {
+	return xxx_messageInfo_CostGraphDef_Node_OutputInfo.Size(m)
+}
+func (m *CostGraphDef_Node_OutputInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CostGraphDef_Node_OutputInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CostGraphDef_Node_OutputInfo proto.InternalMessageInfo
+
+func (m *CostGraphDef_Node_OutputInfo) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *CostGraphDef_Node_OutputInfo) GetAliasInputPort() int64 {
+	if m != nil {
+		return m.AliasInputPort
+	}
+	return 0
+}
+
+func (m *CostGraphDef_Node_OutputInfo) GetShape() *TensorShapeProto {
+	if m != nil {
+		return m.Shape
+	}
+	return nil
+}
+
+func (m *CostGraphDef_Node_OutputInfo) GetDtype() DataType {
+	if m != nil {
+		return m.Dtype
+	}
+	return DataType_DT_INVALID
+}
+
+func init() {
+	proto.RegisterType((*CostGraphDef)(nil), "tensorflow.CostGraphDef")
+	proto.RegisterType((*CostGraphDef_Node)(nil), "tensorflow.CostGraphDef.Node")
+	proto.RegisterType((*CostGraphDef_Node_InputInfo)(nil), "tensorflow.CostGraphDef.Node.InputInfo")
+	proto.RegisterType((*CostGraphDef_Node_OutputInfo)(nil), "tensorflow.CostGraphDef.Node.OutputInfo")
+}
+
+func init() {
+	proto.RegisterFile("tensorflow/core/framework/cost_graph.proto", fileDescriptor_5f8948141565ace8)
+}
+
+var fileDescriptor_5f8948141565ace8 = []byte{
+	// 584 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xe5, 0xfc, 0x69, 0x9a, 0x71, 0x9a, 0x56, 0x4b, 0x0b, 0x8b, 0xa1, 0xc2, 0x80, 0x2a,
+	0xac, 0x0a, 0xa5, 0x34, 0x88, 0x23, 0x07, 0x4a, 0x55, 0xd4, 0x03, 0x10, 0xb9, 0xb9, 0x70, 0xb2,
+	0x8c, 0xbd, 0x6e, 0x57, 0xc4, 0xde, 0xd5, 0xee, 0x86, 0x2a, 0x7d, 0x1e, 0x4e, 0x3c, 0x19, 0x8f,
+	0xc0, 0x11, 0xed, 0xd8, 0x38, 0x4e, 0xa1, 0xb9, 0xad, 0x67, 0x7e, 0xdf, 0x37, 0xce, 0xe6, 0x1b,
+	0xc3, 0xa1, 0x61, 0x85, 0x16, 0x2a, 0x9b, 0x89, 0xeb, 0xa3, 0x44, 0x28, 0x76, 0x94, 0xa9, 0x38,
+	0x67, 0xd7, 0x42, 0x7d, 0x3b, 0x4a, 0x84, 0x36, 0xd1, 0xa5, 0x8a, 0xe5, 0xd5, 0x48, 0x2a, 0x61,
+	0x04, 0x81, 0x25, 0xeb, 0xbd, 0xbc, 0x5b, 0x57, 0x76, 0x22, 0x7d, 0x15, 0x4b, 0x56, 0x2a, 0xbd,
+	0x83, 0x35, 0xf4, 0x42, 0x32, 0x5d, 0x62, 0xcf, 0x7e, 0xf5, 0x60, 0xf0, 0x5e, 0x68, 0xf3, 0xc1,
+	0x0e, 0x3d, 0x65, 0x19, 0x39, 0x86, 0x4e, 0x21, 0x52, 0x46, 0x1d, 0xbf, 0x1d, 0xb8, 0xe3, 0xfd,
+	0xd1, 0xd2, 0x66, 0xd4, 0xe4, 0x46, 0x9f, 0x44, 0xca, 0x42, 0x44, 0xbd, 0x1f, 0x3d, 0xe8, 0xd8,
+	0x47, 0x42, 0xa0, 0x53, 0xc4, 0xb9, 0xd5, 0x3a, 0x41, 0x3f, 0xc4, 0x33, 0xb9, 0x0f, 0x1b, 0x29,
+	0xfb, 0xce, 0x13, 0x46, 0x5b, 0x58, 0xad, 0x9e, 0xc8, 0x10, 0x5a, 0x3c, 0xa5, 0x6d, 0xdf, 0x09,
+	0xba, 0x61, 0x8b, 0xa7, 0xe4, 0x0c, 0x80, 0x17, 0x72, 0x6e, 0x22, 0x5e, 0x64, 0x82, 0x76, 0x70,
+	0xfa, 0x8b, 0xb5, 0xd3, 0x47, 0xe7, 0x96, 0x3f, 0x2f, 0x32, 0x11, 0xf6, 0xf9, 0xdf, 0x23, 0x39,
+	0x07, 0x57, 0xcc, 0x4d, 0x6d, 0xd4, 0x45, 0xa3, 0x60, 0xbd, 0xd1, 0xe7, 0xb9, 0xa9, 0xe4, 0x21,
+	0x88, 0xfa, 0x4c, 0xc6, 0xb0, 0x67, 0x58, 0x2e, 0x85, 0x8a, 0xd5, 0x22, 0xca, 0x59, 0x2e, 0xd4,
+	0x22, 0xd2, 0xfc, 0x86, 0xd1, 0x0d, 0xdf, 0x09, 0xda, 0xe1, 0xbd, 0xba, 0xf9, 0x11, 0x7b, 0x17,
+	0xfc, 0x86, 0x91, 0x63, 0xd8, 0xbb, 0xb2, 0x7f, 0xa2, 0xed, 0xad, 0x68, 0x00, 0x35, 0xc4, 0x36,
+	0xa7, 0x2c, 0x97, 0x0d, 0xc9, 0x1b, 0x78, 0x50, 0xde, 0xc9, 0xbf, 0x22, 0x17, 0x45, 0xbb, 0x65,
+	0xfb, 0x96, 0xec, 0x2d, 0x3c, 0xc2, 0x49, 0x92, 0x29, 0xcd, 0xb5, 0x61, 0x85, 0x59, 0x91, 0x0e,
+	0x50, 0x4a, 0x2d, 0x32, 0xa9, 0x89, 0x86, 0xfc, 0x1d, 0xec, 0x57, 0x53, 0xef, 0x30, 0xd8, 0x41,
+	0x03, 0xaf, 0x84, 0xfe, 0x6b, 0xf1, 0x14, 0x06, 0x89, 0xc8, 0xe5, 0xdc, 0xb0, 0xc8, 0x06, 0x97,
+	0xf6, 0x51, 0xe1, 0x56, 0x35, 0x7b, 0xcd, 0x4d, 0xc4, 0xf0, 0x9c, 0xd1, 0xe1, 0x0a, 0x32, 0xe5,
+	0x39, 0x23, 0x4f, 0xc0, 0xad, 0xc6, 0x22, 0xb1, 0x8d, 0x04, 0x94, 0x25, 0x04, 0x1e, 0xc2, 0x26,
+	0xd7, 0x51, 0xc6, 0x8b, 0x78, 0x46, 0x7b, 0xbe, 0x13, 0x6c, 0x86, 0x3d, 0xae, 0xcf, 0xec, 0x23,
+	0x79, 0x0e, 0x5b, 0x89, 0x28, 0x8c, 0x12, 0xb3, 0x08, 0x13, 0x40, 0x37, 0xfd, 0x76, 0xd0, 0x0d,
+	0x07, 0x55, 0x11, 0x03, 0xe2, 0x7d, 0x81, 0x7e, 0x9d, 0x14, 0x72, 0x00, 0x43, 0xa9, 0x58, 0xc2,
+	0x52, 0x5e, 0x5c, 0x46, 0x55, 0xd0, 0x6d, 0x04, 0xb7, 0xea, 0x2a, 0x26, 0x79, 0x05, 0x93, 0x42,
+	0x19, 0xda, 0xba, 0x85, 0x4d, 0x84, 0x32, 0xde, 0x4f, 0x07, 0x60, 0x19, 0x1e, 0x9b, 0x7f, 0xbc,
+	0x3a, 0x07, 0x7f, 0x03, 0x9e, 0x49, 0x00, 0x3b, 0xf1, 0x8c, 0xc7, 0xba, 0x7c, 0xc1, 0xa5, 0x57,
+	0x3b, 0x1c, 0x62, 0x1d, 0x5f, 0xcd, 0x9a, 0x91, 0x31, 0x74, 0x71, 0x81, 0x71, 0x29, 0xdc, 0xf1,
+	0xe3, 0x66, 0x66, 0xa7, 0x78, 0xbc, 0xb0, 0xed, 0x89, 0xdd, 0xdb, 0xb0, 0x44, 0xc9, 0x21, 0x74,
+	0x53, 0xbb, 0xce, 0xb4, 0xe3, 0x3b, 0xc1, 0x70, 0xbc, 0xdb, 0xd4, 0x9c, 0xc6, 0x26, 0x9e, 0x2e,
+	0x24, 0x0b, 0x4b, 0xe4, 0xe4, 0x15, 0x50, 0xa1, 0x2e, 0x9b, 0x44, 0xfd, 0x49, 0x38, 0xd9, 0xae,
+	0x97, 0x02, 0xed, 0xf5, 0xc4, 0xf9, 0xed, 0x38, 0x5f, 0x37, 0xf0, 0x1b, 0xf1, 0xfa, 0xcf, 0x00,
+	0x4e, 0xa8, 0x1a, 0x17, 0xb2, 0x04, 0x00, 0x00,
+}
@@ -0,0 +1,602 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: tensorflow/core/framework/graph_transfer_info.proto
+
+package tensorflow
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type GraphTransferInfo_Destination int32
+
+const (
+	GraphTransferInfo_NOP     GraphTransferInfo_Destination = 0
+	GraphTransferInfo_HEXAGON GraphTransferInfo_Destination = 1
+)
+
+var GraphTransferInfo_Destination_name = map[int32]string{
+	0: "NOP",
+	1: "HEXAGON",
+}
+
+var GraphTransferInfo_Destination_value = map[string]int32{
+	"NOP":     0,
+	"HEXAGON": 1,
+}
+
+func (x GraphTransferInfo_Destination) String() string {
+	return proto.EnumName(GraphTransferInfo_Destination_name, int32(x))
+}
+
+func (GraphTransferInfo_Destination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c3a1e773f26c9475, []int{0, 0}
+}
+
+type GraphTransferInfo struct {
+	NodeInfo             []*GraphTransferInfo_NodeInfo            `protobuf:"bytes,1,rep,name=node_info,json=nodeInfo,proto3" json:"node_info,omitempty"`
+	ConstNodeInfo        []*GraphTransferInfo_ConstNodeInfo       `protobuf:"bytes,2,rep,name=const_node_info,json=constNodeInfo,proto3" json:"const_node_info,omitempty"`
+	NodeInputInfo        []*GraphTransferInfo_NodeInputInfo       `protobuf:"bytes,3,rep,name=node_input_info,json=nodeInputInfo,proto3" json:"node_input_info,omitempty"`
+	NodeOutputInfo       []*GraphTransferInfo_NodeOutputInfo      `protobuf:"bytes,4,rep,name=node_output_info,json=nodeOutputInfo,proto3" json:"node_output_info,omitempty"`
+	GraphInputNodeInfo   []*GraphTransferInfo_GraphInputNodeInfo  `protobuf:"bytes,5,rep,name=graph_input_node_info,json=graphInputNodeInfo,proto3" json:"graph_input_node_info,omitempty"`
+	GraphOutputNodeInfo  []*GraphTransferInfo_GraphOutputNodeInfo `protobuf:"bytes,6,rep,name=graph_output_node_info,json=graphOutputNodeInfo,proto3" json:"graph_output_node_info,omitempty"`
+	Destination          GraphTransferInfo_Destination            `protobuf:"varint,7,opt,name=destination,proto3,enum=tensorflow.GraphTransferInfo_Destination" json:"destination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
+}
+
+func (m *GraphTransferInfo) Reset()         { *m = GraphTransferInfo{} }
+func (m *GraphTransferInfo) String() string { return proto.CompactTextString(m) }
+func (*GraphTransferInfo) ProtoMessage()    {}
+func (*GraphTransferInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a1e773f26c9475, []int{0}
+}
+
+func (m *GraphTransferInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GraphTransferInfo.Unmarshal(m, b)
+}
+func (m *GraphTransferInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GraphTransferInfo.Marshal(b, m, deterministic)
+}
+func (m *GraphTransferInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraphTransferInfo.Merge(m, src)
+}
+func (m *GraphTransferInfo) XXX_Size() int {
+	return xxx_messageInfo_GraphTransferInfo.Size(m)
+}
+func (m *GraphTransferInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraphTransferInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraphTransferInfo proto.InternalMessageInfo
+
+func (m *GraphTransferInfo) GetNodeInfo() []*GraphTransferInfo_NodeInfo {
+	if m != nil {
+		return m.NodeInfo
+	}
+	return nil
+}
+
+func (m *GraphTransferInfo) GetConstNodeInfo() []*GraphTransferInfo_ConstNodeInfo {
+	if m != nil {
+		return m.ConstNodeInfo
+	}
+	return nil
+}
+
+func (m *GraphTransferInfo) GetNodeInputInfo() []*GraphTransferInfo_NodeInputInfo {
+	if m != nil {
+		return m.NodeInputInfo
+	}
+	return nil
+}
+
+func (m *GraphTransferInfo) GetNodeOutputInfo() []*GraphTransferInfo_NodeOutputInfo {
+	if m != nil {
+		return m.NodeOutputInfo
+	}
+	return nil
+}
+
+func (m *GraphTransferInfo) GetGraphInputNodeInfo() []*GraphTransferInfo_GraphInputNodeInfo {
+	if m != nil {
+		return m.GraphInputNodeInfo
+	}
+	return nil
+}
+
+func (m *GraphTransferInfo) GetGraphOutputNodeInfo() []*GraphTransferInfo_GraphOutputNodeInfo {
+	if m != nil {
+		return m.GraphOutputNodeInfo
+	}
+	return nil
+}
+
+func (m *GraphTransferInfo) GetDestination() GraphTransferInfo_Destination {
+	if m != nil {
+		return m.Destination
+	}
+	return GraphTransferInfo_NOP
+}
+
+type GraphTransferInfo_NodeInput struct {
+	NodeId               int32    `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	OutputPort           int32    `protobuf:"varint,2,opt,name=output_port,json=outputPort,proto3" json:"output_port,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GraphTransferInfo_NodeInput) Reset()         { *m = GraphTransferInfo_NodeInput{} }
+func (m *GraphTransferInfo_NodeInput) String() string { return proto.CompactTextString(m) }
+func (*GraphTransferInfo_NodeInput) ProtoMessage()    {}
+func (*GraphTransferInfo_NodeInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a1e773f26c9475, []int{0, 0}
+}
+
+func (m *GraphTransferInfo_NodeInput) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GraphTransferInfo_NodeInput.Unmarshal(m, b)
+}
+func (m *GraphTransferInfo_NodeInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GraphTransferInfo_NodeInput.Marshal(b, m, deterministic)
+}
+func (m *GraphTransferInfo_NodeInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraphTransferInfo_NodeInput.Merge(m, src)
+}
+func (m *GraphTransferInfo_NodeInput) XXX_Size() int {
+	return xxx_messageInfo_GraphTransferInfo_NodeInput.Size(m)
+}
+func (m *GraphTransferInfo_NodeInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraphTransferInfo_NodeInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraphTransferInfo_NodeInput proto.InternalMessageInfo
+
+func (m *GraphTransferInfo_NodeInput) GetNodeId() int32 {
+	if m != nil {
+		return m.NodeId
+	}
+	return 0
+}
+
+func (m *GraphTransferInfo_NodeInput) GetOutputPort() int32 {
+	if m != nil {
+		return m.OutputPort
+	}
+	return 0
+}
+
+type GraphTransferInfo_NodeInfo struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NodeId               int32    `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	TypeName             string   `protobuf:"bytes,3,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	SocOpId              int32    `protobuf:"varint,4,opt,name=soc_op_id,json=socOpId,proto3" json:"soc_op_id,omitempty"`
+	PaddingId            int32    `protobuf:"varint,5,opt,name=padding_id,json=paddingId,proto3" json:"padding_id,omitempty"`
+	InputCount           int32    `protobuf:"varint,6,opt,name=input_count,json=inputCount,proto3" json:"input_count,omitempty"`
+	OutputCount          int32    `protobuf:"varint,7,opt,name=output_count,json=outputCount,proto3" json:"output_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GraphTransferInfo_NodeInfo) Reset()         { *m = GraphTransferInfo_NodeInfo{} }
+func (m *GraphTransferInfo_NodeInfo) String() string { return proto.CompactTextString(m) }
+func (*GraphTransferInfo_NodeInfo) ProtoMessage()    {}
+func (*GraphTransferInfo_NodeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a1e773f26c9475, []int{0, 1}
+}
+
+func (m *GraphTransferInfo_NodeInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GraphTransferInfo_NodeInfo.Unmarshal(m, b)
+}
+func (m *GraphTransferInfo_NodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GraphTransferInfo_NodeInfo.Marshal(b, m, deterministic)
+}
+func (m *GraphTransferInfo_NodeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraphTransferInfo_NodeInfo.Merge(m, src)
+}
+func (m *GraphTransferInfo_NodeInfo) XXX_Size() int {
+	return xxx_messageInfo_GraphTransferInfo_NodeInfo.Size(m)
+}
+func (m *GraphTransferInfo_NodeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraphTransferInfo_NodeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraphTransferInfo_NodeInfo proto.InternalMessageInfo
+
+func (m *GraphTransferInfo_NodeInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GraphTransferInfo_NodeInfo) GetNodeId() int32 {
+	if m != nil {
+		return m.NodeId
+	}
+	return 0
+}
+
+func (m *GraphTransferInfo_NodeInfo) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *GraphTransferInfo_NodeInfo) GetSocOpId() int32 {
+	if m != nil {
+		return m.SocOpId
+	}
+	return 0
+}
+
+func (m *GraphTransferInfo_NodeInfo) GetPaddingId() int32 {
+	if m != nil {
+		return m.PaddingId
+	}
+	return 0
+}
+
+func (m *GraphTransferInfo_NodeInfo) GetInputCount() int32 {
+	if m != nil {
+		return m.InputCount
+	}
+	return 0
+}
+
+func (m *GraphTransferInfo_NodeInfo) GetOutputCount() int32 {
+	if m != nil {
+		return m.OutputCount
+	}
+	return 0
+}
+
+type GraphTransferInfo_ConstNodeInfo struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NodeId               int32    `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Shape                []int64  `protobuf:"varint,3,rep,packed,name=shape,proto3" json:"shape,omitempty"`
+	Data                 []byte   `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Dtype                DataType `protobuf:"varint,5,opt,name=dtype,proto3,enum=tensorflow.DataType" json:"dtype,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GraphTransferInfo_ConstNodeInfo) Reset()         { *m = GraphTransferInfo_ConstNodeInfo{} }
+func (m *GraphTransferInfo_ConstNodeInfo) String() string { return proto.CompactTextString(m) }
+func (*GraphTransferInfo_ConstNodeInfo) ProtoMessage()    {}
+func (*GraphTransferInfo_ConstNodeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a1e773f26c9475, []int{0, 2}
+}
+
+func (m *GraphTransferInfo_ConstNodeInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GraphTransferInfo_ConstNodeInfo.Unmarshal(m, b)
+}
+func (m *GraphTransferInfo_ConstNodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GraphTransferInfo_ConstNodeInfo.Marshal(b, m, deterministic)
+}
+func (m *GraphTransferInfo_ConstNodeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraphTransferInfo_ConstNodeInfo.Merge(m, src)
+}
+func (m *GraphTransferInfo_ConstNodeInfo) XXX_Size() int {
+	return xxx_messageInfo_GraphTransferInfo_ConstNodeInfo.Size(m)
+}
+func (m *GraphTransferInfo_ConstNodeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraphTransferInfo_ConstNodeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraphTransferInfo_ConstNodeInfo proto.InternalMessageInfo
+
+func (m *GraphTransferInfo_ConstNodeInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GraphTransferInfo_ConstNodeInfo) GetNodeId() int32 {
+	if m != nil {
+		return m.NodeId
+	}
+	return 0
+}
+
+func (m *GraphTransferInfo_ConstNodeInfo) GetShape() []int64 {
+	if m != nil {
+		return m.Shape
+	}
+	return nil
+}
+
+func (m *GraphTransferInfo_ConstNodeInfo) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *GraphTransferInfo_ConstNodeInfo) GetDtype() DataType {
+	if m != nil {
+		return m.Dtype
+	}
+	return DataType_DT_INVALID
+}
+
+type GraphTransferInfo_NodeInputInfo struct {
+	NodeId               int32                          `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeInput            []*GraphTransferInfo_NodeInput `protobuf:"bytes,2,rep,name=node_input,json=nodeInput,proto3" json:"node_input,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *GraphTransferInfo_NodeInputInfo) Reset()         { *m = GraphTransferInfo_NodeInputInfo{} }
+func (m *GraphTransferInfo_NodeInputInfo) String() string { return proto.CompactTextString(m) }
+func (*GraphTransferInfo_NodeInputInfo) ProtoMessage()    {}
+func (*GraphTransferInfo_NodeInputInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a1e773f26c9475, []int{0, 3}
+}
+
+func (m *GraphTransferInfo_NodeInputInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GraphTransferInfo_NodeInputInfo.Unmarshal(m, b)
+}
+func (m *GraphTransferInfo_NodeInputInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GraphTransferInfo_NodeInputInfo.Marshal(b, m, deterministic)
+}
+func (m *GraphTransferInfo_NodeInputInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraphTransferInfo_NodeInputInfo.Merge(m, src)
+}
+func (m *GraphTransferInfo_NodeInputInfo) XXX_Size() int {
+	return xxx_messageInfo_GraphTransferInfo_NodeInputInfo.Size(m)
+}
+func (m *GraphTransferInfo_NodeInputInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraphTransferInfo_NodeInputInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraphTransferInfo_NodeInputInfo proto.InternalMessageInfo
+
+func (m *GraphTransferInfo_NodeInputInfo) GetNodeId() int32 {
+	if m != nil {
+		return m.NodeId
+	}
+	return 0
+}
+
+func (m *GraphTransferInfo_NodeInputInfo) GetNodeInput() []*GraphTransferInfo_NodeInput {
+	if m != nil {
+		return m.NodeInput
+	}
+	return nil
+}
+
+type GraphTransferInfo_NodeOutputInfo struct {
+	NodeId               int32    `protobuf:"varint,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	MaxByteSize          []int32  `protobuf:"varint,2,rep,packed,name=max_byte_size,json=maxByteSize,proto3" json:"max_byte_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GraphTransferInfo_NodeOutputInfo) Reset()         { *m = GraphTransferInfo_NodeOutputInfo{} }
+func (m *GraphTransferInfo_NodeOutputInfo) String() string { return proto.CompactTextString(m) }
+func (*GraphTransferInfo_NodeOutputInfo) ProtoMessage()    {}
+func (*GraphTransferInfo_NodeOutputInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a1e773f26c9475, []int{0, 4}
+}
+
+func (m *GraphTransferInfo_NodeOutputInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GraphTransferInfo_NodeOutputInfo.Unmarshal(m, b)
+}
+func (m *GraphTransferInfo_NodeOutputInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GraphTransferInfo_NodeOutputInfo.Marshal(b, m, deterministic)
+}
+func (m *GraphTransferInfo_NodeOutputInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraphTransferInfo_NodeOutputInfo.Merge(m, src)
+}
+func (m *GraphTransferInfo_NodeOutputInfo) XXX_Size() int {
+	return xxx_messageInfo_GraphTransferInfo_NodeOutputInfo.Size(m)
+}
+func (m *GraphTransferInfo_NodeOutputInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraphTransferInfo_NodeOutputInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraphTransferInfo_NodeOutputInfo proto.InternalMessageInfo
+
+func (m *GraphTransferInfo_NodeOutputInfo) GetNodeId() int32 {
+	if m != nil {
+		return m.NodeId
+	}
+	return 0
+}
+
+func (m *GraphTransferInfo_NodeOutputInfo) GetMaxByteSize() []int32 {
+	if m != nil {
+		return m.MaxByteSize
+	}
+	return nil
+}
+
+type GraphTransferInfo_GraphInputNodeInfo struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Shape                []int64  `protobuf:"varint,2,rep,packed,name=shape,proto3" json:"shape,omitempty"`
+	Dtype                DataType `protobuf:"varint,3,opt,name=dtype,proto3,enum=tensorflow.DataType" json:"dtype,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GraphTransferInfo_GraphInputNodeInfo) Reset()         { *m = GraphTransferInfo_GraphInputNodeInfo{} }
+func (m *GraphTransferInfo_GraphInputNodeInfo) String() string { return proto.CompactTextString(m) }
+func (*GraphTransferInfo_GraphInputNodeInfo) ProtoMessage()    {}
+func (*GraphTransferInfo_GraphInputNodeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a1e773f26c9475, []int{0, 5}
+}
+
+func (m *GraphTransferInfo_GraphInputNodeInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GraphTransferInfo_GraphInputNodeInfo.Unmarshal(m, b)
+}
+func (m *GraphTransferInfo_GraphInputNodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GraphTransferInfo_GraphInputNodeInfo.Marshal(b, m, deterministic)
+}
+func (m *GraphTransferInfo_GraphInputNodeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraphTransferInfo_GraphInputNodeInfo.Merge(m, src)
+}
+func (m *GraphTransferInfo_GraphInputNodeInfo) XXX_Size() int {
+	return xxx_messageInfo_GraphTransferInfo_GraphInputNodeInfo.Size(m)
+}
+func (m *GraphTransferInfo_GraphInputNodeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraphTransferInfo_GraphInputNodeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraphTransferInfo_GraphInputNodeInfo proto.InternalMessageInfo
+
+func (m *GraphTransferInfo_GraphInputNodeInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GraphTransferInfo_GraphInputNodeInfo) GetShape() []int64 {
+	if m != nil {
+		return m.Shape
+	}
+	return nil
+}
+
+func (m *GraphTransferInfo_GraphInputNodeInfo) GetDtype() DataType {
+	if m != nil {
+		return m.Dtype
+	}
+	return DataType_DT_INVALID
+}
+
+type GraphTransferInfo_GraphOutputNodeInfo struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Shape                []int64  `protobuf:"varint,2,rep,packed,name=shape,proto3" json:"shape,omitempty"`
+	Dtype                DataType `protobuf:"varint,3,opt,name=dtype,proto3,enum=tensorflow.DataType" json:"dtype,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GraphTransferInfo_GraphOutputNodeInfo) Reset()         { *m = GraphTransferInfo_GraphOutputNodeInfo{} }
+func (m *GraphTransferInfo_GraphOutputNodeInfo) String() string { return proto.CompactTextString(m) }
+func (*GraphTransferInfo_GraphOutputNodeInfo) ProtoMessage()    {}
+func (*GraphTransferInfo_GraphOutputNodeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a1e773f26c9475, []int{0, 6}
+}
+
+func (m *GraphTransferInfo_GraphOutputNodeInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GraphTransferInfo_GraphOutputNodeInfo.Unmarshal(m, b)
+}
+func (m *GraphTransferInfo_GraphOutputNodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GraphTransferInfo_GraphOutputNodeInfo.Marshal(b, m, deterministic)
+}
+func (m *GraphTransferInfo_GraphOutputNodeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraphTransferInfo_GraphOutputNodeInfo.Merge(m, src)
+}
+func (m *GraphTransferInfo_GraphOutputNodeInfo) XXX_Size() int {
+	return xxx_messageInfo_GraphTransferInfo_GraphOutputNodeInfo.Size(m)
+}
+func (m *GraphTransferInfo_GraphOutputNodeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraphTransferInfo_GraphOutputNodeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraphTransferInfo_GraphOutputNodeInfo proto.InternalMessageInfo
+
+func (m *GraphTransferInfo_GraphOutputNodeInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GraphTransferInfo_GraphOutputNodeInfo) GetShape() []int64 {
+	if m != nil {
+		return m.Shape
+	}
+	return nil
+}
+
+func (m *GraphTransferInfo_GraphOutputNodeInfo) GetDtype() DataType {
+	if m != nil {
+		return m.Dtype
+	}
+	return DataType_DT_INVALID
+}
+
+func init() {
+	proto.RegisterEnum("tensorflow.GraphTransferInfo_Destination", GraphTransferInfo_Destination_name, GraphTransferInfo_Destination_value)
+	proto.RegisterType((*GraphTransferInfo)(nil), "tensorflow.GraphTransferInfo")
+	proto.RegisterType((*GraphTransferInfo_NodeInput)(nil), "tensorflow.GraphTransferInfo.NodeInput")
+	proto.RegisterType((*GraphTransferInfo_NodeInfo)(nil), "tensorflow.GraphTransferInfo.NodeInfo")
+	proto.RegisterType((*GraphTransferInfo_ConstNodeInfo)(nil), "tensorflow.GraphTransferInfo.ConstNodeInfo")
+	proto.RegisterType((*GraphTransferInfo_NodeInputInfo)(nil), "tensorflow.GraphTransferInfo.NodeInputInfo")
+	proto.RegisterType((*GraphTransferInfo_NodeOutputInfo)(nil), "tensorflow.GraphTransferInfo.NodeOutputInfo")
+	proto.RegisterType((*GraphTransferInfo_GraphInputNodeInfo)(nil), "tensorflow.GraphTransferInfo.GraphInputNodeInfo")
+	proto.RegisterType((*GraphTransferInfo_GraphOutputNodeInfo)(nil), "tensorflow.GraphTransferInfo.GraphOutputNodeInfo")
+}
+
+func init() {
+	proto.RegisterFile("tensorflow/core/framework/graph_transfer_info.proto", fileDescriptor_c3a1e773f26c9475)
+}
+
+var fileDescriptor_c3a1e773f26c9475 = []byte{
+	// 629 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xdb, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x49, 0xbb, 0xb6, 0xcb, 0x97, 0xb5, 0x0c, 0x6f, 0x8c, 0x2a, 0x08, 0x31, 0x86, 0x80,
+	0x71, 0x50, 0x07, 0xdb, 0x05, 0xd7, 0xec, 0xc0, 0x98, 0x10, 0x6d, 0x95, 0x4d, 0x88, 0xbb, 0xc8,
+	0x4b, 0xdc, 0x2e, 0x8c, 0xda, 0x96, 0xe3, 0x6a, 0xeb, 0x1e, 0x83, 0x57, 0xe3, 0x19, 0x78, 0x07,
+	0x2e, 0x91, 0x3f, 0x47, 0x49, 0xaa, 0xee, 0xc4, 0x05, 0x77, 0xf6, 0x17, 0xff, 0x7f, 0xdf, 0x49,
+	0x7f, 0x05, 0xb6, 0x34, 0xe3, 0xa9, 0x50, 0x83, 0x1f, 0xe2, 0x6c, 0x23, 0x12, 0x8a, 0x6d, 0x0c,
+	0x14, 0x1d, 0xb1, 0x33, 0xa1, 0x4e, 0x37, 0x86, 0x8a, 0xca, 0x93, 0x50, 0x2b, 0xca, 0xd3, 0x01,
+	0x53, 0x61, 0xc2, 0x07, 0xa2, 0x23, 0x95, 0xd0, 0x82, 0x40, 0x21, 0xf2, 0x9f, 0x5d, 0x0d, 0xd0,
+	0x13, 0xc9, 0x52, 0x2b, 0x59, 0xfb, 0xed, 0xc1, 0xbd, 0x7d, 0x03, 0x3c, 0xca, 0x78, 0x07, 0x7c,
+	0x20, 0xc8, 0x0e, 0xb8, 0x5c, 0xc4, 0x0c, 0xd9, 0x6d, 0x67, 0xb5, 0xba, 0xee, 0x6d, 0x3e, 0xef,
+	0x14, 0xc0, 0xce, 0x8c, 0xa2, 0xd3, 0x15, 0x31, 0x33, 0x87, 0x60, 0x9e, 0x67, 0x27, 0x72, 0x08,
+	0x77, 0x23, 0xc1, 0x53, 0x1d, 0x16, 0xa8, 0x0a, 0xa2, 0x5e, 0x5f, 0x8f, 0xda, 0x31, 0xa2, 0x9c,
+	0xd7, 0x8c, 0xca, 0x57, 0x03, 0xcd, 0x70, 0x72, 0xac, 0x2d, 0xb4, 0x7a, 0x1b, 0xa8, 0x05, 0xc8,
+	0xb1, 0xb6, 0x50, 0x5e, 0xbe, 0x92, 0xaf, 0xb0, 0x88, 0x50, 0x31, 0xd6, 0x39, 0x75, 0x0e, 0xa9,
+	0x6f, 0x6e, 0xa6, 0xf6, 0x50, 0x84, 0xd8, 0x16, 0x9f, 0xba, 0x93, 0x08, 0xee, 0xdb, 0x65, 0xd9,
+	0x6a, 0x8b, 0x39, 0xd4, 0x10, 0xfe, 0xf6, 0x7a, 0x38, 0x46, 0xb0, 0xc8, 0x7c, 0x18, 0x64, 0x38,
+	0x13, 0x23, 0x03, 0x58, 0xb1, 0x49, 0xb2, 0xea, 0x8b, 0x2c, 0x75, 0xcc, 0xf2, 0xee, 0x16, 0x59,
+	0x6c, 0xcd, 0x79, 0x9a, 0xa5, 0xe1, 0x6c, 0x90, 0x7c, 0x06, 0x2f, 0x66, 0xa9, 0x4e, 0x38, 0xd5,
+	0x89, 0xe0, 0xed, 0xc6, 0xaa, 0xb3, 0xde, 0xda, 0x7c, 0x79, 0x3d, 0x7c, 0xb7, 0x10, 0x04, 0x65,
+	0xb5, 0xbf, 0x07, 0x6e, 0xbe, 0x11, 0xf2, 0x00, 0x1a, 0xb6, 0xe8, 0xb8, 0xed, 0xac, 0x3a, 0xeb,
+	0xb5, 0xa0, 0x6e, 0xae, 0x07, 0x31, 0x79, 0x0c, 0x5e, 0xd6, 0x94, 0x14, 0x4a, 0xb7, 0x2b, 0xf8,
+	0x11, 0x6c, 0xa8, 0x2f, 0x94, 0xf6, 0x7f, 0x39, 0x30, 0x9f, 0x17, 0x48, 0x60, 0x8e, 0xd3, 0x11,
+	0x43, 0x86, 0x1b, 0xe0, 0xb9, 0x8c, 0xae, 0x4c, 0xa1, 0x1f, 0x82, 0x6b, 0x6c, 0x10, 0xa2, 0xa2,
+	0x8a, 0x8a, 0x79, 0x13, 0xe8, 0x1a, 0x95, 0x0f, 0x6e, 0x2a, 0xa2, 0x50, 0x48, 0xa3, 0x9b, 0x43,
+	0x5d, 0x23, 0x15, 0x51, 0x4f, 0x1e, 0xc4, 0xe4, 0x11, 0x80, 0xa4, 0x71, 0x9c, 0xf0, 0xa1, 0xf9,
+	0x58, 0xc3, 0x8f, 0x6e, 0x16, 0xb1, 0x25, 0xdb, 0x65, 0x47, 0x62, 0xcc, 0x75, 0xbb, 0x6e, 0x4b,
+	0xc6, 0xd0, 0x8e, 0x89, 0x90, 0x27, 0xb0, 0x90, 0xf5, 0x64, 0x5f, 0x34, 0xf0, 0x45, 0xd6, 0x27,
+	0x3e, 0xf1, 0x7f, 0x3a, 0xd0, 0x9c, 0x32, 0xc1, 0xbf, 0xb5, 0xb6, 0x0c, 0xb5, 0xf4, 0x84, 0x4a,
+	0x86, 0xc6, 0xa8, 0x06, 0xf6, 0x62, 0x10, 0x31, 0xd5, 0x14, 0xdb, 0x59, 0x08, 0xf0, 0x4c, 0x5e,
+	0x41, 0x2d, 0x36, 0x4d, 0x63, 0x1b, 0xad, 0xcd, 0xe5, 0xf2, 0x32, 0x77, 0xa9, 0xa6, 0x47, 0x13,
+	0xc9, 0x02, 0xfb, 0xc4, 0x97, 0xd0, 0x9c, 0xf2, 0xd0, 0xd5, 0x5b, 0xfb, 0x08, 0x50, 0x58, 0x34,
+	0xb3, 0xfc, 0x8b, 0x5b, 0xba, 0x33, 0x70, 0x73, 0x67, 0xfa, 0x5f, 0xa0, 0x35, 0xed, 0xaf, 0xab,
+	0x53, 0xae, 0x41, 0x73, 0x44, 0xcf, 0xc3, 0xe3, 0x89, 0x66, 0x61, 0x9a, 0x5c, 0x30, 0xcc, 0x5a,
+	0x0b, 0xbc, 0x11, 0x3d, 0xdf, 0x9e, 0x68, 0x76, 0x98, 0x5c, 0x30, 0xff, 0x3b, 0x90, 0x59, 0x47,
+	0x5d, 0x3a, 0xd9, 0x7c, 0x80, 0x95, 0xf2, 0x00, 0xf3, 0x61, 0x55, 0x6f, 0x1e, 0xd6, 0x29, 0x2c,
+	0x5d, 0xe2, 0xab, 0xff, 0x93, 0x6c, 0xed, 0x29, 0x78, 0x25, 0x9f, 0x91, 0x06, 0x54, 0xbb, 0xbd,
+	0xfe, 0xe2, 0x1d, 0xe2, 0x41, 0xe3, 0xd3, 0xde, 0xb7, 0x0f, 0xfb, 0xbd, 0xee, 0xa2, 0xb3, 0xfd,
+	0x1e, 0xda, 0x42, 0x0d, 0xcb, 0x98, 0xfc, 0x7f, 0xb0, 0xbd, 0x32, 0xb3, 0x90, 0xbe, 0x12, 0x5a,
+	0xf4, 0x9d, 0x3f, 0x8e, 0x73, 0x5c, 0xc7, 0xff, 0xc4, 0xd6, 0xdf, 0x01, 0x00, 0xb4, 0x1f, 0x2b,
+	0xd3, 0x91, 0x06, 0x00, 0x00,
+}
@@ -0,0 +1,455 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: tensorflow/core/framework/op_def.proto
+
+package tensorflow
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type OpDef struct {
+	Name                     string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	InputArg                 []*OpDef_ArgDef  `protobuf:"bytes,2,rep,name=input_arg,json=inputArg,proto3" json:"input_arg,omitempty"`
+	OutputArg                []*OpDef_ArgDef  `protobuf:"bytes,3,rep,name=output_arg,json=outputArg,proto3" json:"output_arg,omitempty"`
+	Attr                     []*OpDef_AttrDef `protobuf:"bytes,4,rep,name=attr,proto3" json:"attr,omitempty"`
+	Deprecation              *OpDeprecation   `protobuf:"bytes,8,opt,name=deprecation,proto3" json:"deprecation,omitempty"`
+	Summary                  string           `protobuf:"bytes,5,opt,name=summary,proto3" json:"summary,omitempty"`
+	Description              string           `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	IsCommutative            bool             `protobuf:"varint,18,opt,name=is_commutative,json=isCommutative,proto3" json:"is_commutative,omitempty"`
+	IsAggregate              bool             `protobuf:"varint,16,opt,name=is_aggregate,json=isAggregate,proto3" json:"is_aggregate,omitempty"`
+	IsStateful               bool             `protobuf:"varint,17,opt,name=is_stateful,json=isStateful,proto3" json:"is_stateful,omitempty"`
+	AllowsUninitializedInput bool             `protobuf:"varint,19,opt,name=allows_uninitialized_input,json=allowsUninitializedInput,proto3" json:"allows_uninitialized_input,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}         `json:"-"`
+	XXX_unrecognized         []byte           `json:"-"`
+	XXX_sizecache            int32            `json:"-"`
+}
+
+func (m *OpDef) Reset()         { *m = OpDef{} }
+func (m *OpDef) String() string { return proto.CompactTextString(m) }
+func (*OpDef) ProtoMessage()    {}
+func (*OpDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a0e27face061c12, []int{0}
+}
+
+func (m *OpDef) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OpDef.Unmarshal(m, b)
+}
+func (m *OpDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OpDef.Marshal(b, m, deterministic)
+}
+func (m *OpDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpDef.Merge(m, src)
+}
+func (m *OpDef) XXX_Size() int {
+	return xxx_messageInfo_OpDef.Size(m)
+}
+func (m *OpDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpDef proto.InternalMessageInfo
+
+func (m *OpDef) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *OpDef) GetInputArg() []*OpDef_ArgDef {
+	if m != nil {
+		return m.InputArg
+	}
+	return nil
+}
+
+func (m *OpDef) GetOutputArg() []*OpDef_ArgDef {
+	if m != nil {
+		return m.OutputArg
+	}
+	return nil
+}
+
+func (m *OpDef) GetAttr() []*OpDef_AttrDef {
+	if m != nil {
+		return m.Attr
+	}
+	return nil
+}
+
+func (m *OpDef) GetDeprecation() *OpDeprecation {
+	if m != nil {
+		return m.Deprecation
+	}
+	return nil
+}
+
+func (m *OpDef) GetSummary() string {
+	if m != nil {
+		return m.Summary
+	}
+	return ""
+}
+
+func (m *OpDef) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *OpDef) GetIsCommutative() bool {
+	if m != nil {
+		return m.IsCommutative
+	}
+	return false
+}
+
+func (m *OpDef) GetIsAggregate() bool {
+	if m != nil {
+		return m.IsAggregate
+	}
+	return false
+}
+
+func (m *OpDef) GetIsStateful() bool {
+	if m != nil {
+		return m.IsStateful
+	}
+	return false
+}
+
+func (m *OpDef) GetAllowsUninitializedInput() bool {
+	if m != nil {
+		return m.AllowsUninitializedInput
+	}
+	return false
+}
+
+type OpDef_ArgDef struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Type                 DataType `protobuf:"varint,3,opt,name=type,proto3,enum=tensorflow.DataType" json:"type,omitempty"`
+	TypeAttr             string   `protobuf:"bytes,4,opt,name=type_attr,json=typeAttr,proto3" json:"type_attr,omitempty"`
+	NumberAttr           string   `protobuf:"bytes,5,opt,name=number_attr,json=numberAttr,proto3" json:"number_attr,omitempty"`
+	TypeListAttr         string   `protobuf:"bytes,6,opt,name=type_list_attr,json=typeListAttr,proto3" json:"type_list_attr,omitempty"`
+	IsRef                bool     `protobuf:"varint,16,opt,name=is_ref,json=isRef,proto3" json:"is_ref,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OpDef_ArgDef) Reset()         { *m = OpDef_ArgDef{} }
+func (m *OpDef_ArgDef) String() string { return proto.CompactTextString(m) }
+func (*OpDef_ArgDef) ProtoMessage()    {}
+func (*OpDef_ArgDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a0e27face061c12, []int{0, 0}
+}
+
+func (m *OpDef_ArgDef) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OpDef_ArgDef.Unmarshal(m, b)
+}
+func (m *OpDef_ArgDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OpDef_ArgDef.Marshal(b, m, deterministic)
+}
+func (m *OpDef_ArgDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpDef_ArgDef.Merge(m, src)
+}
+func (m *OpDef_ArgDef) XXX_Size() int {
+	return xxx_messageInfo_OpDef_ArgDef.Size(m)
+}
+func (m *OpDef_ArgDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpDef_ArgDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpDef_ArgDef proto.InternalMessageInfo
+
+func (m *OpDef_ArgDef) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *OpDef_ArgDef) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *OpDef_ArgDef) GetType() DataType {
+	if m != nil {
+		return m.Type
+	}
+	return DataType_DT_INVALID
+}
+
+func (m *OpDef_ArgDef) GetTypeAttr() string {
+	if m != nil {
+		return m.TypeAttr
+	}
+	return ""
+}
+
+func (m *OpDef_ArgDef) GetNumberAttr() string {
+	if m != nil {
+		return m.NumberAttr
+	}
+	return ""
+}
+
+func (m *OpDef_ArgDef) GetTypeListAttr() string {
+	if m != nil {
+		return m.TypeListAttr
+	}
+	return ""
+}
+
+func (m *OpDef_ArgDef) GetIsRef() bool {
+	if m != nil {
+		return m.IsRef
+	}
+	return false
+}
+
+type OpDef_AttrDef struct {
+	Name                 string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 string     `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	DefaultValue         *AttrValue `protobuf:"bytes,3,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Description          string     `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	HasMinimum           bool       `protobuf:"varint,5,opt,name=has_minimum,json=hasMinimum,proto3" json:"has_minimum,omitempty"`
+	Minimum              int64      `protobuf:"varint,6,opt,name=minimum,proto3" json:"minimum,omitempty"`
+	AllowedValues        *AttrValue `protobuf:"bytes,7,opt,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *OpDef_AttrDef) Reset()         { *m = OpDef_AttrDef{} }
+func (m *OpDef_AttrDef) String() string { return proto.CompactTextString(m) }
+func (*OpDef_AttrDef) ProtoMessage()    {}
+func (*OpDef_AttrDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a0e27face061c12, []int{0, 1}
+}
+
+func (m *OpDef_AttrDef) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OpDef_AttrDef.Unmarshal(m, b)
+}
+func (m *OpDef_AttrDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OpDef_AttrDef.Marshal(b, m, deterministic)
+}
+func (m *OpDef_AttrDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpDef_AttrDef.Merge(m, src)
+}
+func (m *OpDef_AttrDef) XXX_Size() int {
+	return xxx_messageInfo_OpDef_AttrDef.Size(m)
+}
+func (m *OpDef_AttrDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpDef_AttrDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpDef_AttrDef proto.InternalMessageInfo
+
+func (m *OpDef_AttrDef) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *OpDef_AttrDef) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *OpDef_AttrDef) GetDefaultValue() *AttrValue {
+	if m != nil {
+		return m.DefaultValue
+	}
+	return nil
+}
+
+func (m *OpDef_AttrDef) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *OpDef_AttrDef) GetHasMinimum() bool {
+	if m != nil {
+		return m.HasMinimum
+	}
+	return false
+}
+
+func (m *OpDef_AttrDef) GetMinimum() int64 {
+	if m != nil {
+		return m.Minimum
+	}
+	return 0
+}
+
+func (m *OpDef_AttrDef) GetAllowedValues() *AttrValue {
+	if m != nil {
+		return m.AllowedValues
+	}
+	return nil
+}
+
+type OpDeprecation struct {
+	Version              int32    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Explanation          string   `protobuf:"bytes,2,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OpDeprecation) Reset()         { *m = OpDeprecation{} }
+func (m *OpDeprecation) String() string { return proto.CompactTextString(m) }
+func (*OpDeprecation) ProtoMessage()    {}
+func (*OpDeprecation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a0e27face061c12, []int{1}
+}
+
+func (m *OpDeprecation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OpDeprecation.Unmarshal(m, b)
+}
+func (m *OpDeprecation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OpDeprecation.Marshal(b, m, deterministic)
+}
+func (m *OpDeprecation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpDeprecation.Merge(m, src)
+}
+func (m *OpDeprecation) XXX_Size() int {
+	return xxx_messageInfo_OpDeprecation.Size(m)
+}
+func (m *OpDeprecation) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpDeprecation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpDeprecation proto.InternalMessageInfo
+
+func (m *OpDeprecation) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *OpDeprecation) GetExplanation() string {
+	if m != nil {
+		return m.Explanation
+	}
+	return ""
+}
+
+type OpList struct {
+	Op                   []*OpDef `protobuf:"bytes,1,rep,name=op,proto3" json:"op,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OpList) Reset()         { *m = OpList{} }
+func (m *OpList) String() string { return proto.CompactTextString(m) }
+func (*OpList) ProtoMessage()    {}
+func (*OpList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a0e27face061c12, []int{2}
+}
+
+func (m *OpList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OpList.Unmarshal(m, b)
+}
+func (m *OpList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OpList.Marshal(b, m, deterministic)
+}
+func (m *OpList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpList.Merge(m, src)
+}
+func (m *OpList) XXX_Size() int {
+	return xxx_messageInfo_OpList.Size(m)
+}
+func (m *OpList) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpList proto.InternalMessageInfo
+
+func (m *OpList) GetOp() []*OpDef {
+	if m != nil {
+		return m.Op
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*OpDef)(nil), "tensorflow.OpDef")
+	proto.RegisterType((*OpDef_ArgDef)(nil), "tensorflow.OpDef.ArgDef")
+	proto.RegisterType((*OpDef_AttrDef)(nil), "tensorflow.OpDef.AttrDef")
+	proto.RegisterType((*OpDeprecation)(nil), "tensorflow.OpDeprecation")
+	proto.RegisterType((*OpList)(nil), "tensorflow.OpList")
+}
+
+func init() {
+	proto.RegisterFile("tensorflow/core/framework/op_def.proto", fileDescriptor_0a0e27face061c12)
+}
+
+var fileDescriptor_0a0e27face061c12 = []byte{
+	// 622 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xdd, 0x6e, 0xd3, 0x30,
+	0x14, 0xc7, 0x95, 0x7e, 0xf7, 0x64, 0xad, 0x98, 0x61, 0x92, 0x29, 0x17, 0xeb, 0x26, 0x86, 0x2a,
+	0x3e, 0x3a, 0x69, 0x08, 0x21, 0xc1, 0x6e, 0x36, 0x76, 0x83, 0x00, 0x6d, 0x0a, 0x1f, 0xb7, 0x91,
+	0xd7, 0x3a, 0x99, 0x45, 0x12, 0x5b, 0xb6, 0xb3, 0x31, 0x9e, 0x80, 0x97, 0xe3, 0x29, 0x78, 0x09,
+	0x2e, 0x91, 0x8f, 0xd3, 0x2d, 0xac, 0x1d, 0x5c, 0x6d, 0x3e, 0xe7, 0xf7, 0x77, 0xfd, 0xff, 0x1f,
+	0x3b, 0xf0, 0xc8, 0xf2, 0xc2, 0x48, 0x9d, 0x64, 0xf2, 0x62, 0x77, 0x26, 0x35, 0xdf, 0x4d, 0x34,
+	0xcb, 0xf9, 0x85, 0xd4, 0x5f, 0x77, 0xa5, 0x8a, 0xe7, 0x3c, 0x99, 0x2a, 0x2d, 0xad, 0x24, 0x70,
+	0xcd, 0x8d, 0x1e, 0xdf, 0xae, 0x61, 0xd6, 0xea, 0xf8, 0x9c, 0x65, 0x25, 0xf7, 0xba, 0xd1, 0xce,
+	0xed, 0xac, 0xbd, 0x54, 0xdc, 0x78, 0x6c, 0xfb, 0x67, 0x17, 0xda, 0xc7, 0xea, 0x88, 0x27, 0x84,
+	0x40, 0xab, 0x60, 0x39, 0xa7, 0xc1, 0x38, 0x98, 0xf4, 0x23, 0xfc, 0x9f, 0xbc, 0x80, 0xbe, 0x28,
+	0x54, 0x69, 0x63, 0xa6, 0x53, 0xda, 0x18, 0x37, 0x27, 0xe1, 0x1e, 0x9d, 0x5e, 0x6f, 0x3c, 0x45,
+	0xe5, 0xf4, 0x40, 0xa7, 0x47, 0x3c, 0x89, 0x7a, 0x88, 0x1e, 0xe8, 0x94, 0xbc, 0x04, 0x90, 0xa5,
+	0x5d, 0xe8, 0x9a, 0xff, 0xd1, 0xf5, 0x3d, 0xeb, 0x84, 0xcf, 0xa0, 0xe5, 0x8c, 0xd0, 0x16, 0x4a,
+	0xee, 0xaf, 0x90, 0x58, 0xab, 0x9d, 0x06, 0x31, 0xf2, 0x1a, 0xc2, 0x39, 0x57, 0x9a, 0xcf, 0x98,
+	0x15, 0xb2, 0xa0, 0xbd, 0x71, 0xb0, 0x4a, 0x75, 0x05, 0x44, 0x75, 0x9a, 0x50, 0xe8, 0x9a, 0x32,
+	0xcf, 0x99, 0xbe, 0xa4, 0x6d, 0xb4, 0xbc, 0x58, 0x92, 0xb1, 0xdb, 0xd6, 0xcc, 0xb4, 0x50, 0xb8,
+	0x6d, 0x07, 0xbb, 0xf5, 0x12, 0xd9, 0x81, 0xa1, 0x30, 0xf1, 0x4c, 0xe6, 0x79, 0x69, 0x99, 0x15,
+	0xe7, 0x9c, 0x92, 0x71, 0x30, 0xe9, 0x45, 0x03, 0x61, 0xde, 0x5c, 0x17, 0xc9, 0x16, 0xac, 0x09,
+	0x13, 0xb3, 0x34, 0xd5, 0x3c, 0x65, 0x96, 0xd3, 0x3b, 0x08, 0x85, 0xc2, 0x1c, 0x2c, 0x4a, 0x64,
+	0x13, 0x42, 0x61, 0x62, 0x63, 0x99, 0xe5, 0x49, 0x99, 0xd1, 0x75, 0x24, 0x40, 0x98, 0x8f, 0x55,
+	0x85, 0xec, 0xc3, 0x88, 0x65, 0x99, 0xbc, 0x30, 0x71, 0x59, 0x88, 0x42, 0x58, 0xc1, 0x32, 0xf1,
+	0x9d, 0xcf, 0x63, 0x0c, 0x9b, 0xde, 0x45, 0x9e, 0x7a, 0xe2, 0x73, 0x1d, 0x78, 0xeb, 0xfa, 0xa3,
+	0x5f, 0x01, 0x74, 0x7c, 0xcc, 0x2b, 0xe7, 0x7b, 0xc3, 0x69, 0x63, 0xd9, 0xe9, 0x04, 0x5a, 0xee,
+	0xba, 0xd0, 0xe6, 0x38, 0x98, 0x0c, 0xf7, 0xee, 0xd5, 0xb3, 0x3d, 0x62, 0x96, 0x7d, 0xba, 0x54,
+	0x3c, 0x42, 0x82, 0x3c, 0x80, 0xbe, 0xfb, 0x1b, 0x57, 0x03, 0x74, 0x3b, 0xf5, 0x5c, 0xc1, 0x8d,
+	0xcc, 0xd9, 0x2c, 0xca, 0xfc, 0x94, 0x6b, 0xdf, 0xf6, 0x81, 0x83, 0x2f, 0x21, 0xf0, 0x10, 0x86,
+	0xa8, 0xce, 0x84, 0xb1, 0x9e, 0xf1, 0xb1, 0xaf, 0xb9, 0xea, 0x7b, 0x61, 0x2c, 0x52, 0x1b, 0xd0,
+	0x11, 0x26, 0xd6, 0x3c, 0xa9, 0xa2, 0x6c, 0x0b, 0x13, 0xf1, 0x64, 0xf4, 0xa3, 0x01, 0xdd, 0xea,
+	0x66, 0xac, 0xb4, 0x49, 0x2a, 0x13, 0xde, 0x9f, 0x3f, 0xee, 0x2b, 0x18, 0xcc, 0x79, 0xc2, 0xca,
+	0xcc, 0xfa, 0x67, 0x83, 0x0e, 0xc3, 0xbd, 0x8d, 0xba, 0x43, 0xb7, 0xe7, 0x17, 0xd7, 0x8c, 0xd6,
+	0x2a, 0x16, 0x57, 0x37, 0x63, 0x6b, 0x2d, 0xc7, 0xb6, 0x09, 0xe1, 0x19, 0x33, 0x71, 0x2e, 0x0a,
+	0x91, 0x97, 0x39, 0xfa, 0xed, 0x45, 0x70, 0xc6, 0xcc, 0x07, 0x5f, 0x71, 0xb7, 0x6f, 0xd1, 0x74,
+	0x46, 0x9b, 0xd1, 0x62, 0x49, 0xf6, 0x61, 0x88, 0xe3, 0xe4, 0x73, 0x7f, 0x30, 0x43, 0xbb, 0xff,
+	0x3a, 0xd9, 0xa0, 0x82, 0x71, 0x65, 0xb6, 0xdf, 0xc1, 0xe0, 0xaf, 0x3b, 0xef, 0x7e, 0xe8, 0x9c,
+	0x6b, 0xe3, 0xce, 0xe9, 0x22, 0x69, 0x47, 0x8b, 0xa5, 0x73, 0xc1, 0xbf, 0xa9, 0x8c, 0x15, 0xac,
+	0x3e, 0xfc, 0x5a, 0x69, 0xfb, 0x09, 0x74, 0x8e, 0x95, 0x0b, 0x9f, 0x6c, 0x41, 0x43, 0x2a, 0x1a,
+	0xe0, 0xb3, 0x5c, 0x5f, 0x7a, 0x96, 0x51, 0x43, 0xaa, 0xc3, 0xa7, 0x40, 0xa5, 0x4e, 0xeb, 0xbd,
+	0xab, 0x2f, 0xce, 0x61, 0x88, 0xd8, 0x89, 0x96, 0x56, 0x9a, 0x93, 0xe0, 0x77, 0x10, 0x9c, 0x76,
+	0xf0, 0xf3, 0xf3, 0xfc, 0xcf, 0x00, 0x73, 0x62, 0xad, 0xd8, 0x07, 0x05, 0x00, 0x00,
+}
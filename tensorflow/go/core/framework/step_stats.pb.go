@@ -0,0 +1,486 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: tensorflow/core/framework/step_stats.proto
+
+package tensorflow
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type AllocatorMemoryUsed struct {
+	AllocatorName        string   `protobuf:"bytes,1,opt,name=allocator_name,json=allocatorName,proto3" json:"allocator_name,omitempty"`
+	TotalBytes           int64    `protobuf:"varint,2,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	PeakBytes            int64    `protobuf:"varint,3,opt,name=peak_bytes,json=peakBytes,proto3" json:"peak_bytes,omitempty"`
+	LiveBytes            int64    `protobuf:"varint,4,opt,name=live_bytes,json=liveBytes,proto3" json:"live_bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AllocatorMemoryUsed) Reset()         { *m = AllocatorMemoryUsed{} }
+func (m *AllocatorMemoryUsed) String() string { return proto.CompactTextString(m) }
+func (*AllocatorMemoryUsed) ProtoMessage()    {}
+func (*AllocatorMemoryUsed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e915309f7ed52e5, []int{0}
+}
+
+func (m *AllocatorMemoryUsed) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AllocatorMemoryUsed.Unmarshal(m, b)
+}
+func (m *AllocatorMemoryUsed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AllocatorMemoryUsed.Marshal(b, m, deterministic)
+}
+func (m *AllocatorMemoryUsed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllocatorMemoryUsed.Merge(m, src)
+}
+func (m *AllocatorMemoryUsed) XXX_Size() int {
+	return xxx_messageInfo_AllocatorMemoryUsed.Size(m)
+}
+func (m *AllocatorMemoryUsed) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllocatorMemoryUsed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllocatorMemoryUsed proto.InternalMessageInfo
+
+func (m *AllocatorMemoryUsed) GetAllocatorName() string {
+	if m != nil {
+		return m.AllocatorName
+	}
+	return ""
+}
+
+func (m *AllocatorMemoryUsed) GetTotalBytes() int64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
+}
+
+func (m *AllocatorMemoryUsed) GetPeakBytes() int64 {
+	if m != nil {
+		return m.PeakBytes
+	}
+	return 0
+}
+
+func (m *AllocatorMemoryUsed) GetLiveBytes() int64 {
+	if m != nil {
+		return m.LiveBytes
+	}
+	return 0
+}
+
+type NodeOutput struct {
+	Slot                 int32              `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	TensorDescription    *TensorDescription `protobuf:"bytes,3,opt,name=tensor_description,json=tensorDescription,proto3" json:"tensor_description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *NodeOutput) Reset()         { *m = NodeOutput{} }
+func (m *NodeOutput) String() string { return proto.CompactTextString(m) }
+func (*NodeOutput) ProtoMessage()    {}
+func (*NodeOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e915309f7ed52e5, []int{1}
+}
+
+func (m *NodeOutput) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeOutput.Unmarshal(m, b)
+}
+func (m *NodeOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeOutput.Marshal(b, m, deterministic)
+}
+func (m *NodeOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeOutput.Merge(m, src)
+}
+func (m *NodeOutput) XXX_Size() int {
+	return xxx_messageInfo_NodeOutput.Size(m)
+}
+func (m *NodeOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeOutput proto.InternalMessageInfo
+
+func (m *NodeOutput) GetSlot() int32 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *NodeOutput) GetTensorDescription() *TensorDescription {
+	if m != nil {
+		return m.TensorDescription
+	}
+	return nil
+}
+
+type MemoryStats struct {
+	HostTempMemorySize             int64    `protobuf:"varint,1,opt,name=host_temp_memory_size,json=hostTempMemorySize,proto3" json:"host_temp_memory_size,omitempty"`
+	DeviceTempMemorySize           int64    `protobuf:"varint,2,opt,name=device_temp_memory_size,json=deviceTempMemorySize,proto3" json:"device_temp_memory_size,omitempty"`
+	HostPersistentMemorySize       int64    `protobuf:"varint,3,opt,name=host_persistent_memory_size,json=hostPersistentMemorySize,proto3" json:"host_persistent_memory_size,omitempty"`
+	DevicePersistentMemorySize     int64    `protobuf:"varint,4,opt,name=device_persistent_memory_size,json=devicePersistentMemorySize,proto3" json:"device_persistent_memory_size,omitempty"`
+	HostPersistentTensorAllocIds   []int64  `protobuf:"varint,5,rep,packed,name=host_persistent_tensor_alloc_ids,json=hostPersistentTensorAllocIds,proto3" json:"host_persistent_tensor_alloc_ids,omitempty"`
+	DevicePersistentTensorAllocIds []int64  `protobuf:"varint,6,rep,packed,name=device_persistent_tensor_alloc_ids,json=devicePersistentTensorAllocIds,proto3" json:"device_persistent_tensor_alloc_ids,omitempty"`
+	XXX_NoUnkeyedLiteral           struct{} `json:"-"`
+	XXX_unrecognized               []byte   `json:"-"`
+	XXX_sizecache                  int32    `json:"-"`
+}
+
+func (m *MemoryStats) Reset()         { *m = MemoryStats{} }
+func (m *MemoryStats) String() string { return proto.CompactTextString(m) }
+func (*MemoryStats) ProtoMessage()    {}
+func (*MemoryStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e915309f7ed52e5, []int{2}
+}
+
+func (m *MemoryStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MemoryStats.Unmarshal(m, b)
+}
+func (m *MemoryStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MemoryStats.Marshal(b, m, deterministic)
+}
+func (m *MemoryStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemoryStats.Merge(m, src)
+}
+func (m *MemoryStats) XXX_Size() int {
+	return xxx_messageInfo_MemoryStats.Size(m)
+}
+func (m *MemoryStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemoryStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemoryStats proto.InternalMessageInfo
+
+func (m *MemoryStats) GetHostTempMemorySize() int64 {
+	if m != nil {
+		return m.HostTempMemorySize
+	}
+	return 0
+}
+
+func (m *MemoryStats) GetDeviceTempMemorySize() int64 {
+	if m != nil {
+		return m.DeviceTempMemorySize
+	}
+	return 0
+}
+
+func (m *MemoryStats) GetHostPersistentMemorySize() int64 {
+	if m != nil {
+		return m.HostPersistentMemorySize
+	}
+	return 0
+}
+
+func (m *MemoryStats) GetDevicePersistentMemorySize() int64 {
+	if m != nil {
+		return m.DevicePersistentMemorySize
+	}
+	return 0
+}
+
+func (m *MemoryStats) GetHostPersistentTensorAllocIds() []int64 {
+	if m != nil {
+		return m.HostPersistentTensorAllocIds
+	}
+	return nil
+}
+
+func (m *MemoryStats) GetDevicePersistentTensorAllocIds() []int64 {
+	if m != nil {
+		return m.DevicePersistentTensorAllocIds
+	}
+	return nil
+}
+
+type NodeExecStats struct {
+	NodeName             string                   `protobuf:"bytes,1,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	AllStartMicros       int64                    `protobuf:"varint,2,opt,name=all_start_micros,json=allStartMicros,proto3" json:"all_start_micros,omitempty"`
+	OpStartRelMicros     int64                    `protobuf:"varint,3,opt,name=op_start_rel_micros,json=opStartRelMicros,proto3" json:"op_start_rel_micros,omitempty"`
+	OpEndRelMicros       int64                    `protobuf:"varint,4,opt,name=op_end_rel_micros,json=opEndRelMicros,proto3" json:"op_end_rel_micros,omitempty"`
+	AllEndRelMicros      int64                    `protobuf:"varint,5,opt,name=all_end_rel_micros,json=allEndRelMicros,proto3" json:"all_end_rel_micros,omitempty"`
+	Memory               []*AllocatorMemoryUsed   `protobuf:"bytes,6,rep,name=memory,proto3" json:"memory,omitempty"`
+	Output               []*NodeOutput            `protobuf:"bytes,7,rep,name=output,proto3" json:"output,omitempty"`
+	TimelineLabel        string                   `protobuf:"bytes,8,opt,name=timeline_label,json=timelineLabel,proto3" json:"timeline_label,omitempty"`
+	ScheduledMicros      int64                    `protobuf:"varint,9,opt,name=scheduled_micros,json=scheduledMicros,proto3" json:"scheduled_micros,omitempty"`
+	ThreadId             uint32                   `protobuf:"varint,10,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	ReferencedTensor     []*AllocationDescription `protobuf:"bytes,11,rep,name=referenced_tensor,json=referencedTensor,proto3" json:"referenced_tensor,omitempty"`
+	MemoryStats          *MemoryStats             `protobuf:"bytes,12,opt,name=memory_stats,json=memoryStats,proto3" json:"memory_stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *NodeExecStats) Reset()         { *m = NodeExecStats{} }
+func (m *NodeExecStats) String() string { return proto.CompactTextString(m) }
+func (*NodeExecStats) ProtoMessage()    {}
+func (*NodeExecStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e915309f7ed52e5, []int{3}
+}
+
+func (m *NodeExecStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeExecStats.Unmarshal(m, b)
+}
+func (m *NodeExecStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeExecStats.Marshal(b, m, deterministic)
+}
+func (m *NodeExecStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeExecStats.Merge(m, src)
+}
+func (m *NodeExecStats) XXX_Size() int {
+	return xxx_messageInfo_NodeExecStats.Size(m)
+}
+func (m *NodeExecStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeExecStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeExecStats proto.InternalMessageInfo
+
+func (m *NodeExecStats) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
+	}
+	return ""
+}
+
+func (m *NodeExecStats) GetAllStartMicros() int64 {
+	if m != nil {
+		return m.AllStartMicros
+	}
+	return 0
+}
+
+func (m *NodeExecStats) GetOpStartRelMicros() int64 {
+	if m != nil {
+		return m.OpStartRelMicros
+	}
+	return 0
+}
+
+func (m *NodeExecStats) GetOpEndRelMicros() int64 {
+	if m != nil {
+		return m.OpEndRelMicros
+	}
+	return 0
+}
+
+func (m *NodeExecStats) GetAllEndRelMicros() int64 {
+	if m != nil {
+		return m.AllEndRelMicros
+	}
+	return 0
+}
+
+func (m *NodeExecStats) GetMemory() []*AllocatorMemoryUsed {
+	if m != nil {
+		return m.Memory
+	}
+	return nil
+}
+
+func (m *NodeExecStats) GetOutput() []*NodeOutput {
+	if m != nil {
+		return m.Output
+	}
+	return nil
+}
+
+func (m *NodeExecStats) GetTimelineLabel() string {
+	if m != nil {
+		return m.TimelineLabel
+	}
+	return ""
+}
+
+func (m *NodeExecStats) GetScheduledMicros() int64 {
+	if m != nil {
+		return m.ScheduledMicros
+	}
+	return 0
+}
+
+func (m *NodeExecStats) GetThreadId() uint32 {
+	if m != nil {
+		return m.ThreadId
+	}
+	return 0
+}
+
+func (m *NodeExecStats) GetReferencedTensor() []*AllocationDescription {
+	if m != nil {
+		return m.ReferencedTensor
+	}
+	return nil
+}
+
+func (m *NodeExecStats) GetMemoryStats() *MemoryStats {
+	if m != nil {
+		return m.MemoryStats
+	}
+	return nil
+}
+
+type DeviceStepStats struct {
+	Device               string           `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	NodeStats            []*NodeExecStats `protobuf:"bytes,2,rep,name=node_stats,json=nodeStats,proto3" json:"node_stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *DeviceStepStats) Reset()         { *m = DeviceStepStats{} }
+func (m *DeviceStepStats) String() string { return proto.CompactTextString(m) }
+func (*DeviceStepStats) ProtoMessage()    {}
+func (*DeviceStepStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e915309f7ed52e5, []int{4}
+}
+
+func (m *DeviceStepStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceStepStats.Unmarshal(m, b)
+}
+func (m *DeviceStepStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceStepStats.Marshal(b, m, deterministic)
+}
+func (m *DeviceStepStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceStepStats.Merge(m, src)
+}
+func (m *DeviceStepStats) XXX_Size() int {
+	return xxx_messageInfo_DeviceStepStats.Size(m)
+}
+func (m *DeviceStepStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceStepStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceStepStats proto.InternalMessageInfo
+
+func (m *DeviceStepStats) GetDevice() string {
+	if m != nil {
+		return m.Device
+	}
+	return ""
+}
+
+func (m *DeviceStepStats) GetNodeStats() []*NodeExecStats {
+	if m != nil {
+		return m.NodeStats
+	}
+	return nil
+}
+
+type StepStats struct {
+	DevStats             []*DeviceStepStats `protobuf:"bytes,1,rep,name=dev_stats,json=devStats,proto3" json:"dev_stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *StepStats) Reset()         { *m = StepStats{} }
+func (m *StepStats) String() string { return proto.CompactTextString(m) }
+func (*StepStats) ProtoMessage()    {}
+func (*StepStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e915309f7ed52e5, []int{5}
+}
+
+func (m *StepStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StepStats.Unmarshal(m, b)
+}
+func (m *StepStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StepStats.Marshal(b, m, deterministic)
+}
+func (m *StepStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StepStats.Merge(m, src)
+}
+func (m *StepStats) XXX_Size() int {
+	return xxx_messageInfo_StepStats.Size(m)
+}
+func (m *StepStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_StepStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StepStats proto.InternalMessageInfo
+
+func (m *StepStats) GetDevStats() []*DeviceStepStats {
+	if m != nil {
+		return m.DevStats
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AllocatorMemoryUsed)(nil), "tensorflow.AllocatorMemoryUsed")
+	proto.RegisterType((*NodeOutput)(nil), "tensorflow.NodeOutput")
+	proto.RegisterType((*MemoryStats)(nil), "tensorflow.MemoryStats")
+	proto.RegisterType((*NodeExecStats)(nil), "tensorflow.NodeExecStats")
+	proto.RegisterType((*DeviceStepStats)(nil), "tensorflow.DeviceStepStats")
+	proto.RegisterType((*StepStats)(nil), "tensorflow.StepStats")
+}
+
+func init() {
+	proto.RegisterFile("tensorflow/core/framework/step_stats.proto", fileDescriptor_1e915309f7ed52e5)
+}
+
+var fileDescriptor_1e915309f7ed52e5 = []byte{
+	// 726 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xed, 0x4e, 0x13, 0x41,
+	0x14, 0xcd, 0x52, 0xa8, 0xed, 0x2d, 0x1f, 0x65, 0x50, 0x58, 0xa9, 0x48, 0x6d, 0x62, 0x52, 0x34,
+	0x16, 0xc5, 0xa8, 0xc4, 0xc4, 0x1f, 0x10, 0x30, 0xc1, 0x00, 0x92, 0x05, 0x7f, 0x6f, 0x96, 0x9d,
+	0x8b, 0x6c, 0x98, 0xdd, 0xd9, 0xcc, 0x0c, 0x45, 0x78, 0x07, 0x9f, 0xc0, 0xa7, 0xf1, 0xad, 0xfc,
+	0x69, 0xe6, 0xa3, 0xdd, 0x6d, 0x0b, 0xff, 0xa6, 0xe7, 0x9e, 0x7b, 0xe7, 0xf4, 0xcc, 0xb9, 0x0b,
+	0xaf, 0x14, 0x66, 0x92, 0x8b, 0x0b, 0xc6, 0x6f, 0x36, 0x63, 0x2e, 0x70, 0xf3, 0x42, 0x44, 0x29,
+	0xde, 0x70, 0x71, 0xb5, 0x29, 0x15, 0xe6, 0xa1, 0x54, 0x91, 0x92, 0xbd, 0x5c, 0x70, 0xc5, 0x09,
+	0x14, 0xdc, 0xd5, 0x8f, 0x0f, 0xf7, 0x45, 0x8c, 0xf1, 0x38, 0x52, 0x09, 0xcf, 0x42, 0x8a, 0x32,
+	0x16, 0x49, 0xae, 0xcf, 0x76, 0xc6, 0xea, 0xd6, 0xc3, 0x7d, 0xb6, 0x32, 0xd9, 0xd3, 0xf9, 0xe3,
+	0xc1, 0xd2, 0x8e, 0x1d, 0xca, 0xc5, 0x11, 0xa6, 0x5c, 0xdc, 0xfe, 0x90, 0x48, 0xc9, 0x4b, 0x98,
+	0x8f, 0x06, 0x70, 0x98, 0x45, 0x29, 0xfa, 0x5e, 0xdb, 0xeb, 0xd6, 0x83, 0xb9, 0x21, 0x7a, 0x1c,
+	0xa5, 0x48, 0xd6, 0xa1, 0xa1, 0xb8, 0x8a, 0x58, 0x78, 0x7e, 0xab, 0x50, 0xfa, 0x53, 0x6d, 0xaf,
+	0x5b, 0x09, 0xc0, 0x40, 0xbb, 0x1a, 0x21, 0x6b, 0x00, 0x39, 0x46, 0x57, 0xae, 0x5e, 0x31, 0xf5,
+	0xba, 0x46, 0x86, 0x65, 0x96, 0xf4, 0xd1, 0x95, 0xa7, 0x6d, 0x59, 0x23, 0xa6, 0xdc, 0xc9, 0x00,
+	0x8e, 0x39, 0xc5, 0xef, 0xd7, 0x2a, 0xbf, 0x56, 0x84, 0xc0, 0xb4, 0x64, 0x5c, 0x19, 0x25, 0x33,
+	0x81, 0x39, 0x93, 0x43, 0x20, 0x93, 0xff, 0xcd, 0xdc, 0xd3, 0xd8, 0x5a, 0xeb, 0x15, 0x86, 0xf4,
+	0xce, 0xcc, 0x71, 0xaf, 0x20, 0x05, 0x8b, 0x6a, 0x1c, 0xea, 0xfc, 0xae, 0x40, 0xc3, 0x9a, 0x70,
+	0xaa, 0xdf, 0x86, 0xbc, 0x83, 0x27, 0x97, 0x5c, 0xaa, 0x50, 0x61, 0x9a, 0x87, 0xa9, 0x29, 0x84,
+	0x32, 0xb9, 0xb3, 0x66, 0x54, 0x02, 0xa2, 0x8b, 0x67, 0x98, 0xe6, 0xae, 0x27, 0xb9, 0x43, 0xf2,
+	0x01, 0x56, 0x28, 0xf6, 0x93, 0x18, 0x27, 0x9b, 0xac, 0x3b, 0x8f, 0x6d, 0x79, 0xac, 0xed, 0x0b,
+	0xb4, 0xcc, 0x4d, 0x39, 0x0a, 0x99, 0x48, 0x85, 0x99, 0x1a, 0x69, 0xb5, 0xc6, 0xf9, 0x9a, 0x72,
+	0x32, 0x64, 0x94, 0xda, 0x77, 0x60, 0xcd, 0xdd, 0xfa, 0xc0, 0x00, 0x6b, 0xed, 0xaa, 0x25, 0xdd,
+	0x3b, 0xe2, 0x2b, 0xb4, 0xc7, 0x15, 0x38, 0x67, 0xcd, 0x93, 0x87, 0x09, 0x95, 0xfe, 0x4c, 0xbb,
+	0xd2, 0xad, 0x04, 0xcf, 0x46, 0x65, 0x58, 0x67, 0x4d, 0x88, 0x0e, 0xa8, 0x24, 0xdf, 0xa0, 0x33,
+	0x29, 0x65, 0x62, 0x52, 0xd5, 0x4c, 0x7a, 0x3e, 0xae, 0x67, 0x74, 0x56, 0xe7, 0xef, 0x34, 0xcc,
+	0xe9, 0x00, 0xec, 0xff, 0xc2, 0xd8, 0xbe, 0x48, 0x0b, 0xea, 0x19, 0xa7, 0x58, 0x8e, 0x64, 0x4d,
+	0x03, 0x26, 0x8d, 0x5d, 0x68, 0x46, 0x8c, 0xe9, 0xbd, 0x12, 0x2a, 0x4c, 0x93, 0x58, 0xf0, 0x41,
+	0x24, 0x75, 0x98, 0x4f, 0x35, 0x7c, 0x64, 0x50, 0xf2, 0x06, 0x96, 0x78, 0xee, 0x88, 0x02, 0xd9,
+	0x80, 0x6c, 0x6d, 0x6e, 0xf2, 0xdc, 0x70, 0x03, 0x64, 0x8e, 0xbe, 0x01, 0x8b, 0x3c, 0x0f, 0x31,
+	0xa3, 0x65, 0xb2, 0xb5, 0x74, 0x9e, 0xe7, 0xfb, 0x19, 0x2d, 0xa8, 0xaf, 0x81, 0x68, 0x0d, 0x63,
+	0xdc, 0x19, 0xc3, 0x5d, 0x88, 0x18, 0x1b, 0x21, 0x7f, 0x82, 0xaa, 0x7d, 0x24, 0xe3, 0x47, 0x63,
+	0x6b, 0xbd, 0x9c, 0xd8, 0x7b, 0xd6, 0x32, 0x70, 0x74, 0xd2, 0x83, 0x2a, 0x37, 0x4b, 0xe1, 0x3f,
+	0x32, 0x8d, 0xcb, 0xe5, 0xc6, 0x62, 0x65, 0x02, 0xc7, 0xd2, 0xeb, 0xac, 0x92, 0x14, 0x59, 0x92,
+	0x61, 0xc8, 0xa2, 0x73, 0x64, 0x7e, 0xcd, 0xae, 0xf3, 0x00, 0x3d, 0xd4, 0x20, 0xd9, 0x80, 0xa6,
+	0x8c, 0x2f, 0x91, 0x5e, 0x33, 0xa4, 0x03, 0xe9, 0x75, 0x2b, 0x7d, 0x88, 0x3b, 0xe9, 0x2d, 0xa8,
+	0xab, 0x4b, 0x81, 0x11, 0x0d, 0x13, 0xea, 0x43, 0xdb, 0xeb, 0xce, 0x05, 0x35, 0x0b, 0x1c, 0x50,
+	0x72, 0x0c, 0x8b, 0x02, 0x2f, 0x50, 0x60, 0x16, 0x23, 0x75, 0x8f, 0xef, 0x37, 0x8c, 0xd2, 0x17,
+	0xf7, 0xfc, 0xc5, 0x84, 0x67, 0xe5, 0xc5, 0x6c, 0x16, 0xbd, 0x36, 0x0f, 0xe4, 0x33, 0xcc, 0x0e,
+	0xc2, 0xac, 0x53, 0xe0, 0xcf, 0x9a, 0xfd, 0x5e, 0x29, 0x8f, 0x2a, 0xad, 0x6d, 0xd0, 0x48, 0x8b,
+	0x1f, 0x9d, 0x18, 0x16, 0xf6, 0x4c, 0xca, 0x4e, 0x15, 0xe6, 0x36, 0x44, 0xcb, 0x50, 0xb5, 0xc1,
+	0x73, 0x09, 0x72, 0xbf, 0xc8, 0x36, 0x80, 0x09, 0x97, 0xbd, 0x64, 0xca, 0xe8, 0x7d, 0x3a, 0xee,
+	0xec, 0x30, 0x8b, 0x81, 0x49, 0xa2, 0xbd, 0x64, 0x1f, 0xea, 0xc5, 0xf8, 0x6d, 0xa8, 0x53, 0xec,
+	0xbb, 0x29, 0x9e, 0x99, 0xd2, 0x2a, 0x4f, 0x19, 0x93, 0x13, 0xd4, 0x28, 0xf6, 0xcd, 0x69, 0xf7,
+	0x2d, 0xf8, 0x5c, 0xfc, 0x2c, 0x73, 0x87, 0x9f, 0xf0, 0xdd, 0x85, 0x61, 0xc3, 0x89, 0xe0, 0x8a,
+	0xcb, 0x13, 0xef, 0x9f, 0xe7, 0x9d, 0x57, 0xcd, 0x67, 0xfc, 0xfd, 0xff, 0x01, 0x00, 0xb6, 0x5f,
+	0x63, 0xe0, 0x6c, 0x06, 0x00, 0x00,
+}
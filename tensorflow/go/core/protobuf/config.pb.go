@@ -0,0 +1,816 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: tensorflow/core/protobuf/config.proto
+
+package tensorflow
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	framework "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type OptimizerOptions_Level int32
+
+const (
+	OptimizerOptions_L1 OptimizerOptions_Level = 0
+	OptimizerOptions_L0 OptimizerOptions_Level = -1
+)
+
+var OptimizerOptions_Level_name = map[int32]string{
+	0:  "L1",
+	-1: "L0",
+}
+
+var OptimizerOptions_Level_value = map[string]int32{
+	"L1": 0,
+	"L0": -1,
+}
+
+func (x OptimizerOptions_Level) String() string {
+	return proto.EnumName(OptimizerOptions_Level_name, int32(x))
+}
+
+func (OptimizerOptions_Level) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e2349c44c118036b, []int{1, 0}
+}
+
+type OptimizerOptions_GlobalJitLevel int32
+
+const (
+	OptimizerOptions_DEFAULT OptimizerOptions_GlobalJitLevel = 0
+	OptimizerOptions_OFF     OptimizerOptions_GlobalJitLevel = -1
+	OptimizerOptions_ON_1    OptimizerOptions_GlobalJitLevel = 1
+	OptimizerOptions_ON_2    OptimizerOptions_GlobalJitLevel = 2
+)
+
+var OptimizerOptions_GlobalJitLevel_name = map[int32]string{
+	0:  "DEFAULT",
+	-1: "OFF",
+	1:  "ON_1",
+	2:  "ON_2",
+}
+
+var OptimizerOptions_GlobalJitLevel_value = map[string]int32{
+	"DEFAULT": 0,
+	"OFF":     -1,
+	"ON_1":    1,
+	"ON_2":    2,
+}
+
+func (x OptimizerOptions_GlobalJitLevel) String() string {
+	return proto.EnumName(OptimizerOptions_GlobalJitLevel_name, int32(x))
+}
+
+func (OptimizerOptions_GlobalJitLevel) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e2349c44c118036b, []int{1, 1}
+}
+
+type RunOptions_TraceLevel int32
+
+const (
+	RunOptions_NO_TRACE       RunOptions_TraceLevel = 0
+	RunOptions_SOFTWARE_TRACE RunOptions_TraceLevel = 1
+	RunOptions_HARDWARE_TRACE RunOptions_TraceLevel = 2
+	RunOptions_FULL_TRACE     RunOptions_TraceLevel = 3
+)
+
+var RunOptions_TraceLevel_name = map[int32]string{
+	0: "NO_TRACE",
+	1: "SOFTWARE_TRACE",
+	2: "HARDWARE_TRACE",
+	3: "FULL_TRACE",
+}
+
+var RunOptions_TraceLevel_value = map[string]int32{
+	"NO_TRACE":       0,
+	"SOFTWARE_TRACE": 1,
+	"HARDWARE_TRACE": 2,
+	"FULL_TRACE":     3,
+}
+
+func (x RunOptions_TraceLevel) String() string {
+	return proto.EnumName(RunOptions_TraceLevel_name, int32(x))
+}
+
+func (RunOptions_TraceLevel) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e2349c44c118036b, []int{6, 0}
+}
+
+type GPUOptions struct {
+	PerProcessGpuMemoryFraction float64  `protobuf:"fixed64,1,opt,name=per_process_gpu_memory_fraction,json=perProcessGpuMemoryFraction,proto3" json:"per_process_gpu_memory_fraction,omitempty"`
+	AllocatorType               string   `protobuf:"bytes,2,opt,name=allocator_type,json=allocatorType,proto3" json:"allocator_type,omitempty"`
+	DeferredDeletionBytes       int64    `protobuf:"varint,3,opt,name=deferred_deletion_bytes,json=deferredDeletionBytes,proto3" json:"deferred_deletion_bytes,omitempty"`
+	AllowGrowth                 bool     `protobuf:"varint,4,opt,name=allow_growth,json=allowGrowth,proto3" json:"allow_growth,omitempty"`
+	VisibleDeviceList           string   `protobuf:"bytes,5,opt,name=visible_device_list,json=visibleDeviceList,proto3" json:"visible_device_list,omitempty"`
+	PollingActiveDelayUsecs     int32    `protobuf:"varint,6,opt,name=polling_active_delay_usecs,json=pollingActiveDelayUsecs,proto3" json:"polling_active_delay_usecs,omitempty"`
+	PollingInactiveDelayMsecs   int32    `protobuf:"varint,7,opt,name=polling_inactive_delay_msecs,json=pollingInactiveDelayMsecs,proto3" json:"polling_inactive_delay_msecs,omitempty"`
+	XXX_NoUnkeyedLiteral        struct{} `json:"-"`
+	XXX_unrecognized            []byte   `json:"-"`
+	XXX_sizecache               int32    `json:"-"`
+}
+
+func (m *GPUOptions) Reset()         { *m = GPUOptions{} }
+func (m *GPUOptions) String() string { return proto.CompactTextString(m) }
+func (*GPUOptions) ProtoMessage()    {}
+func (*GPUOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2349c44c118036b, []int{0}
+}
+
+func (m *GPUOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GPUOptions.Unmarshal(m, b)
+}
+func (m *GPUOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GPUOptions.Marshal(b, m, deterministic)
+}
+func (m *GPUOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GPUOptions.Merge(m, src)
+}
+func (m *GPUOptions) XXX_Size() int {
+	return xxx_messageInfo_GPUOptions.Size(m)
+}
+func (m *GPUOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_GPUOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GPUOptions proto.InternalMessageInfo
+
+func (m *GPUOptions) GetPerProcessGpuMemoryFraction() float64 {
+	if m != nil {
+		return m.PerProcessGpuMemoryFraction
+	}
+	return 0
+}
+
+func (m *GPUOptions) GetAllocatorType() string {
+	if m != nil {
+		return m.AllocatorType
+	}
+	return ""
+}
+
+func (m *GPUOptions) GetDeferredDeletionBytes() int64 {
+	if m != nil {
+		return m.DeferredDeletionBytes
+	}
+	return 0
+}
+
+func (m *GPUOptions) GetAllowGrowth() bool {
+	if m != nil {
+		return m.AllowGrowth
+	}
+	return false
+}
+
+func (m *GPUOptions) GetVisibleDeviceList() string {
+	if m != nil {
+		return m.VisibleDeviceList
+	}
+	return ""
+}
+
+func (m *GPUOptions) GetPollingActiveDelayUsecs() int32 {
+	if m != nil {
+		return m.PollingActiveDelayUsecs
+	}
+	return 0
+}
+
+func (m *GPUOptions) GetPollingInactiveDelayMsecs() int32 {
+	if m != nil {
+		return m.PollingInactiveDelayMsecs
+	}
+	return 0
+}
+
+type OptimizerOptions struct {
+	DoCommonSubexpressionElimination bool                            `protobuf:"varint,1,opt,name=do_common_subexpression_elimination,json=doCommonSubexpressionElimination,proto3" json:"do_common_subexpression_elimination,omitempty"`
+	DoConstantFolding                bool                            `protobuf:"varint,2,opt,name=do_constant_folding,json=doConstantFolding,proto3" json:"do_constant_folding,omitempty"`
+	DoFunctionInlining               bool                            `protobuf:"varint,4,opt,name=do_function_inlining,json=doFunctionInlining,proto3" json:"do_function_inlining,omitempty"`
+	OptLevel                         OptimizerOptions_Level          `protobuf:"varint,3,opt,name=opt_level,json=optLevel,proto3,enum=tensorflow.OptimizerOptions_Level" json:"opt_level,omitempty"`
+	GlobalJitLevel                   OptimizerOptions_GlobalJitLevel `protobuf:"varint,5,opt,name=global_jit_level,json=globalJitLevel,proto3,enum=tensorflow.OptimizerOptions_GlobalJitLevel" json:"global_jit_level,omitempty"`
+	XXX_NoUnkeyedLiteral             struct{}                        `json:"-"`
+	XXX_unrecognized                 []byte                          `json:"-"`
+	XXX_sizecache                    int32                           `json:"-"`
+}
+
+func (m *OptimizerOptions) Reset()         { *m = OptimizerOptions{} }
+func (m *OptimizerOptions) String() string { return proto.CompactTextString(m) }
+func (*OptimizerOptions) ProtoMessage()    {}
+func (*OptimizerOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2349c44c118036b, []int{1}
+}
+
+func (m *OptimizerOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OptimizerOptions.Unmarshal(m, b)
+}
+func (m *OptimizerOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OptimizerOptions.Marshal(b, m, deterministic)
+}
+func (m *OptimizerOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptimizerOptions.Merge(m, src)
+}
+func (m *OptimizerOptions) XXX_Size() int {
+	return xxx_messageInfo_OptimizerOptions.Size(m)
+}
+func (m *OptimizerOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptimizerOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OptimizerOptions proto.InternalMessageInfo
+
+func (m *OptimizerOptions) GetDoCommonSubexpressionElimination() bool {
+	if m != nil {
+		return m.DoCommonSubexpressionElimination
+	}
+	return false
+}
+
+func (m *OptimizerOptions) GetDoConstantFolding() bool {
+	if m != nil {
+		return m.DoConstantFolding
+	}
+	return false
+}
+
+func (m *OptimizerOptions) GetDoFunctionInlining() bool {
+	if m != nil {
+		return m.DoFunctionInlining
+	}
+	return false
+}
+
+func (m *OptimizerOptions) GetOptLevel() OptimizerOptions_Level {
+	if m != nil {
+		return m.OptLevel
+	}
+	return OptimizerOptions_L1
+}
+
+func (m *OptimizerOptions) GetGlobalJitLevel() OptimizerOptions_GlobalJitLevel {
+	if m != nil {
+		return m.GlobalJitLevel
+	}
+	return OptimizerOptions_DEFAULT
+}
+
+type GraphOptions struct {
+	EnableRecvScheduling   bool              `protobuf:"varint,2,opt,name=enable_recv_scheduling,json=enableRecvScheduling,proto3" json:"enable_recv_scheduling,omitempty"`
+	OptimizerOptions       *OptimizerOptions `protobuf:"bytes,3,opt,name=optimizer_options,json=optimizerOptions,proto3" json:"optimizer_options,omitempty"`
+	BuildCostModel         int64             `protobuf:"varint,4,opt,name=build_cost_model,json=buildCostModel,proto3" json:"build_cost_model,omitempty"`
+	BuildCostModelAfter    int64             `protobuf:"varint,9,opt,name=build_cost_model_after,json=buildCostModelAfter,proto3" json:"build_cost_model_after,omitempty"`
+	InferShapes            bool              `protobuf:"varint,5,opt,name=infer_shapes,json=inferShapes,proto3" json:"infer_shapes,omitempty"`
+	PlacePrunedGraph       bool              `protobuf:"varint,6,opt,name=place_pruned_graph,json=placePrunedGraph,proto3" json:"place_pruned_graph,omitempty"`
+	EnableBfloat16Sendrecv bool              `protobuf:"varint,7,opt,name=enable_bfloat16_sendrecv,json=enableBfloat16Sendrecv,proto3" json:"enable_bfloat16_sendrecv,omitempty"`
+	TimelineStep           int32             `protobuf:"varint,8,opt,name=timeline_step,json=timelineStep,proto3" json:"timeline_step,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}          `json:"-"`
+	XXX_unrecognized       []byte            `json:"-"`
+	XXX_sizecache          int32             `json:"-"`
+}
+
+func (m *GraphOptions) Reset()         { *m = GraphOptions{} }
+func (m *GraphOptions) String() string { return proto.CompactTextString(m) }
+func (*GraphOptions) ProtoMessage()    {}
+func (*GraphOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2349c44c118036b, []int{2}
+}
+
+func (m *GraphOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GraphOptions.Unmarshal(m, b)
+}
+func (m *GraphOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GraphOptions.Marshal(b, m, deterministic)
+}
+func (m *GraphOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraphOptions.Merge(m, src)
+}
+func (m *GraphOptions) XXX_Size() int {
+	return xxx_messageInfo_GraphOptions.Size(m)
+}
+func (m *GraphOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraphOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraphOptions proto.InternalMessageInfo
+
+func (m *GraphOptions) GetEnableRecvScheduling() bool {
+	if m != nil {
+		return m.EnableRecvScheduling
+	}
+	return false
+}
+
+func (m *GraphOptions) GetOptimizerOptions() *OptimizerOptions {
+	if m != nil {
+		return m.OptimizerOptions
+	}
+	return nil
+}
+
+func (m *GraphOptions) GetBuildCostModel() int64 {
+	if m != nil {
+		return m.BuildCostModel
+	}
+	return 0
+}
+
+func (m *GraphOptions) GetBuildCostModelAfter() int64 {
+	if m != nil {
+		return m.BuildCostModelAfter
+	}
+	return 0
+}
+
+func (m *GraphOptions) GetInferShapes() bool {
+	if m != nil {
+		return m.InferShapes
+	}
+	return false
+}
+
+func (m *GraphOptions) GetPlacePrunedGraph() bool {
+	if m != nil {
+		return m.PlacePrunedGraph
+	}
+	return false
+}
+
+func (m *GraphOptions) GetEnableBfloat16Sendrecv() bool {
+	if m != nil {
+		return m.EnableBfloat16Sendrecv
+	}
+	return false
+}
+
+func (m *GraphOptions) GetTimelineStep() int32 {
+	if m != nil {
+		return m.TimelineStep
+	}
+	return 0
+}
+
+type ThreadPoolOptionProto struct {
+	NumThreads           int32    `protobuf:"varint,1,opt,name=num_threads,json=numThreads,proto3" json:"num_threads,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThreadPoolOptionProto) Reset()         { *m = ThreadPoolOptionProto{} }
+func (m *ThreadPoolOptionProto) String() string { return proto.CompactTextString(m) }
+func (*ThreadPoolOptionProto) ProtoMessage()    {}
+func (*ThreadPoolOptionProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2349c44c118036b, []int{3}
+}
+
+func (m *ThreadPoolOptionProto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadPoolOptionProto.Unmarshal(m, b)
+}
+func (m *ThreadPoolOptionProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadPoolOptionProto.Marshal(b, m, deterministic)
+}
+func (m *ThreadPoolOptionProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadPoolOptionProto.Merge(m, src)
+}
+func (m *ThreadPoolOptionProto) XXX_Size() int {
+	return xxx_messageInfo_ThreadPoolOptionProto.Size(m)
+}
+func (m *ThreadPoolOptionProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadPoolOptionProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadPoolOptionProto proto.InternalMessageInfo
+
+func (m *ThreadPoolOptionProto) GetNumThreads() int32 {
+	if m != nil {
+		return m.NumThreads
+	}
+	return 0
+}
+
+type RPCOptions struct {
+	UseRpcForInprocessMaster bool     `protobuf:"varint,1,opt,name=use_rpc_for_inprocess_master,json=useRpcForInprocessMaster,proto3" json:"use_rpc_for_inprocess_master,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
+}
+
+func (m *RPCOptions) Reset()         { *m = RPCOptions{} }
+func (m *RPCOptions) String() string { return proto.CompactTextString(m) }
+func (*RPCOptions) ProtoMessage()    {}
+func (*RPCOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2349c44c118036b, []int{4}
+}
+
+func (m *RPCOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RPCOptions.Unmarshal(m, b)
+}
+func (m *RPCOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RPCOptions.Marshal(b, m, deterministic)
+}
+func (m *RPCOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RPCOptions.Merge(m, src)
+}
+func (m *RPCOptions) XXX_Size() int {
+	return xxx_messageInfo_RPCOptions.Size(m)
+}
+func (m *RPCOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_RPCOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RPCOptions proto.InternalMessageInfo
+
+func (m *RPCOptions) GetUseRpcForInprocessMaster() bool {
+	if m != nil {
+		return m.UseRpcForInprocessMaster
+	}
+	return false
+}
+
+type ConfigProto struct {
+	DeviceCount               map[string]int32         `protobuf:"bytes,1,rep,name=device_count,json=deviceCount,proto3" json:"device_count,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	IntraOpParallelismThreads int32                    `protobuf:"varint,2,opt,name=intra_op_parallelism_threads,json=intraOpParallelismThreads,proto3" json:"intra_op_parallelism_threads,omitempty"`
+	InterOpParallelismThreads int32                    `protobuf:"varint,5,opt,name=inter_op_parallelism_threads,json=interOpParallelismThreads,proto3" json:"inter_op_parallelism_threads,omitempty"`
+	UsePerSessionThreads      bool                     `protobuf:"varint,9,opt,name=use_per_session_threads,json=usePerSessionThreads,proto3" json:"use_per_session_threads,omitempty"`
+	SessionInterOpThreadPool  []*ThreadPoolOptionProto `protobuf:"bytes,12,rep,name=session_inter_op_thread_pool,json=sessionInterOpThreadPool,proto3" json:"session_inter_op_thread_pool,omitempty"`
+	PlacementPeriod           int32                    `protobuf:"varint,3,opt,name=placement_period,json=placementPeriod,proto3" json:"placement_period,omitempty"`
+	DeviceFilters             []string                 `protobuf:"bytes,4,rep,name=device_filters,json=deviceFilters,proto3" json:"device_filters,omitempty"`
+	GpuOptions                *GPUOptions              `protobuf:"bytes,6,opt,name=gpu_options,json=gpuOptions,proto3" json:"gpu_options,omitempty"`
+	AllowSoftPlacement        bool                     `protobuf:"varint,7,opt,name=allow_soft_placement,json=allowSoftPlacement,proto3" json:"allow_soft_placement,omitempty"`
+	LogDevicePlacement        bool                     `protobuf:"varint,8,opt,name=log_device_placement,json=logDevicePlacement,proto3" json:"log_device_placement,omitempty"`
+	GraphOptions              *GraphOptions            `protobuf:"bytes,10,opt,name=graph_options,json=graphOptions,proto3" json:"graph_options,omitempty"`
+	OperationTimeoutInMs      int64                    `protobuf:"varint,11,opt,name=operation_timeout_in_ms,json=operationTimeoutInMs,proto3" json:"operation_timeout_in_ms,omitempty"`
+	RpcOptions                *RPCOptions              `protobuf:"bytes,13,opt,name=rpc_options,json=rpcOptions,proto3" json:"rpc_options,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{}                 `json:"-"`
+	XXX_unrecognized          []byte                   `json:"-"`
+	XXX_sizecache             int32                    `json:"-"`
+}
+
+func (m *ConfigProto) Reset()         { *m = ConfigProto{} }
+func (m *ConfigProto) String() string { return proto.CompactTextString(m) }
+func (*ConfigProto) ProtoMessage()    {}
+func (*ConfigProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2349c44c118036b, []int{5}
+}
+
+func (m *ConfigProto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigProto.Unmarshal(m, b)
+}
+func (m *ConfigProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigProto.Marshal(b, m, deterministic)
+}
+func (m *ConfigProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigProto.Merge(m, src)
+}
+func (m *ConfigProto) XXX_Size() int {
+	return xxx_messageInfo_ConfigProto.Size(m)
+}
+func (m *ConfigProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigProto proto.InternalMessageInfo
+
+func (m *ConfigProto) GetDeviceCount() map[string]int32 {
+	if m != nil {
+		return m.DeviceCount
+	}
+	return nil
+}
+
+func (m *ConfigProto) GetIntraOpParallelismThreads() int32 {
+	if m != nil {
+		return m.IntraOpParallelismThreads
+	}
+	return 0
+}
+
+func (m *ConfigProto) GetInterOpParallelismThreads() int32 {
+	if m != nil {
+		return m.InterOpParallelismThreads
+	}
+	return 0
+}
+
+func (m *ConfigProto) GetUsePerSessionThreads() bool {
+	if m != nil {
+		return m.UsePerSessionThreads
+	}
+	return false
+}
+
+func (m *ConfigProto) GetSessionInterOpThreadPool() []*ThreadPoolOptionProto {
+	if m != nil {
+		return m.SessionInterOpThreadPool
+	}
+	return nil
+}
+
+func (m *ConfigProto) GetPlacementPeriod() int32 {
+	if m != nil {
+		return m.PlacementPeriod
+	}
+	return 0
+}
+
+func (m *ConfigProto) GetDeviceFilters() []string {
+	if m != nil {
+		return m.DeviceFilters
+	}
+	return nil
+}
+
+func (m *ConfigProto) GetGpuOptions() *GPUOptions {
+	if m != nil {
+		return m.GpuOptions
+	}
+	return nil
+}
+
+func (m *ConfigProto) GetAllowSoftPlacement() bool {
+	if m != nil {
+		return m.AllowSoftPlacement
+	}
+	return false
+}
+
+func (m *ConfigProto) GetLogDevicePlacement() bool {
+	if m != nil {
+		return m.LogDevicePlacement
+	}
+	return false
+}
+
+func (m *ConfigProto) GetGraphOptions() *GraphOptions {
+	if m != nil {
+		return m.GraphOptions
+	}
+	return nil
+}
+
+func (m *ConfigProto) GetOperationTimeoutInMs() int64 {
+	if m != nil {
+		return m.OperationTimeoutInMs
+	}
+	return 0
+}
+
+func (m *ConfigProto) GetRpcOptions() *RPCOptions {
+	if m != nil {
+		return m.RpcOptions
+	}
+	return nil
+}
+
+type RunOptions struct {
+	TraceLevel            RunOptions_TraceLevel `protobuf:"varint,1,opt,name=trace_level,json=traceLevel,proto3,enum=tensorflow.RunOptions_TraceLevel" json:"trace_level,omitempty"`
+	TimeoutInMs           int64                 `protobuf:"varint,2,opt,name=timeout_in_ms,json=timeoutInMs,proto3" json:"timeout_in_ms,omitempty"`
+	InterOpThreadPool     int32                 `protobuf:"varint,3,opt,name=inter_op_thread_pool,json=interOpThreadPool,proto3" json:"inter_op_thread_pool,omitempty"`
+	OutputPartitionGraphs bool                  `protobuf:"varint,5,opt,name=output_partition_graphs,json=outputPartitionGraphs,proto3" json:"output_partition_graphs,omitempty"`
+	DebugOptions          *DebugOptions         `protobuf:"bytes,6,opt,name=debug_options,json=debugOptions,proto3" json:"debug_options,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}              `json:"-"`
+	XXX_unrecognized      []byte                `json:"-"`
+	XXX_sizecache         int32                 `json:"-"`
+}
+
+func (m *RunOptions) Reset()         { *m = RunOptions{} }
+func (m *RunOptions) String() string { return proto.CompactTextString(m) }
+func (*RunOptions) ProtoMessage()    {}
+func (*RunOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2349c44c118036b, []int{6}
+}
+
+func (m *RunOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RunOptions.Unmarshal(m, b)
+}
+func (m *RunOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RunOptions.Marshal(b, m, deterministic)
+}
+func (m *RunOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunOptions.Merge(m, src)
+}
+func (m *RunOptions) XXX_Size() int {
+	return xxx_messageInfo_RunOptions.Size(m)
+}
+func (m *RunOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RunOptions proto.InternalMessageInfo
+
+func (m *RunOptions) GetTraceLevel() RunOptions_TraceLevel {
+	if m != nil {
+		return m.TraceLevel
+	}
+	return RunOptions_NO_TRACE
+}
+
+func (m *RunOptions) GetTimeoutInMs() int64 {
+	if m != nil {
+		return m.TimeoutInMs
+	}
+	return 0
+}
+
+func (m *RunOptions) GetInterOpThreadPool() int32 {
+	if m != nil {
+		return m.InterOpThreadPool
+	}
+	return 0
+}
+
+func (m *RunOptions) GetOutputPartitionGraphs() bool {
+	if m != nil {
+		return m.OutputPartitionGraphs
+	}
+	return false
+}
+
+func (m *RunOptions) GetDebugOptions() *DebugOptions {
+	if m != nil {
+		return m.DebugOptions
+	}
+	return nil
+}
+
+type RunMetadata struct {
+	StepStats            *framework.StepStats    `protobuf:"bytes,1,opt,name=step_stats,json=stepStats,proto3" json:"step_stats,omitempty"`
+	CostGraph            *framework.CostGraphDef `protobuf:"bytes,2,opt,name=cost_graph,json=costGraph,proto3" json:"cost_graph,omitempty"`
+	PartitionGraphs      []*framework.GraphDef   `protobuf:"bytes,3,rep,name=partition_graphs,json=partitionGraphs,proto3" json:"partition_graphs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *RunMetadata) Reset()         { *m = RunMetadata{} }
+func (m *RunMetadata) String() string { return proto.CompactTextString(m) }
+func (*RunMetadata) ProtoMessage()    {}
+func (*RunMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2349c44c118036b, []int{7}
+}
+
+func (m *RunMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RunMetadata.Unmarshal(m, b)
+}
+func (m *RunMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RunMetadata.Marshal(b, m, deterministic)
+}
+func (m *RunMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunMetadata.Merge(m, src)
+}
+func (m *RunMetadata) XXX_Size() int {
+	return xxx_messageInfo_RunMetadata.Size(m)
+}
+func (m *RunMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RunMetadata proto.InternalMessageInfo
+
+func (m *RunMetadata) GetStepStats() *framework.StepStats {
+	if m != nil {
+		return m.StepStats
+	}
+	return nil
+}
+
+func (m *RunMetadata) GetCostGraph() *framework.CostGraphDef {
+	if m != nil {
+		return m.CostGraph
+	}
+	return nil
+}
+
+func (m *RunMetadata) GetPartitionGraphs() []*framework.GraphDef {
+	if m != nil {
+		return m.PartitionGraphs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("tensorflow.OptimizerOptions_Level", OptimizerOptions_Level_name, OptimizerOptions_Level_value)
+	proto.RegisterEnum("tensorflow.OptimizerOptions_GlobalJitLevel", OptimizerOptions_GlobalJitLevel_name, OptimizerOptions_GlobalJitLevel_value)
+	proto.RegisterEnum("tensorflow.RunOptions_TraceLevel", RunOptions_TraceLevel_name, RunOptions_TraceLevel_value)
+	proto.RegisterType((*GPUOptions)(nil), "tensorflow.GPUOptions")
+	proto.RegisterType((*OptimizerOptions)(nil), "tensorflow.OptimizerOptions")
+	proto.RegisterType((*GraphOptions)(nil), "tensorflow.GraphOptions")
+	proto.RegisterType((*ThreadPoolOptionProto)(nil), "tensorflow.ThreadPoolOptionProto")
+	proto.RegisterType((*RPCOptions)(nil), "tensorflow.RPCOptions")
+	proto.RegisterType((*ConfigProto)(nil), "tensorflow.ConfigProto")
+	proto.RegisterMapType((map[string]int32)(nil), "tensorflow.ConfigProto.DeviceCountEntry")
+	proto.RegisterType((*RunOptions)(nil), "tensorflow.RunOptions")
+	proto.RegisterType((*RunMetadata)(nil), "tensorflow.RunMetadata")
+}
+
+func init() {
+	proto.RegisterFile("tensorflow/core/protobuf/config.proto", fileDescriptor_e2349c44c118036b)
+}
+
+var fileDescriptor_e2349c44c118036b = []byte{
+	// 1459 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x56, 0xdf, 0x53, 0x1b, 0xb7,
+	0x16, 0xce, 0xda, 0x98, 0x98, 0x63, 0x43, 0x16, 0x05, 0xc2, 0x5e, 0x2e, 0x33, 0x71, 0x9c, 0xcb,
+	0x8c, 0xef, 0x2f, 0x48, 0x48, 0x6e, 0x92, 0xb9, 0x77, 0x6e, 0x32, 0x80, 0x31, 0x25, 0x35, 0xc1,
+	0x23, 0xc3, 0xf4, 0x51, 0xb3, 0xde, 0xd5, 0x9a, 0x6d, 0xd6, 0x2b, 0x8d, 0xa4, 0x85, 0xd2, 0xc7,
+	0xfe, 0x5f, 0x7d, 0xe9, 0x6b, 0x5f, 0xfb, 0xb7, 0x74, 0xfa, 0xd6, 0x8e, 0xa4, 0x5d, 0xaf, 0x0d,
+	0x49, 0xea, 0x27, 0xe9, 0x7c, 0xdf, 0x39, 0x96, 0xbe, 0xf3, 0x43, 0x0b, 0xdb, 0x8a, 0xa6, 0x92,
+	0x89, 0x28, 0x61, 0xd7, 0xbb, 0x01, 0x13, 0x74, 0x97, 0x0b, 0xa6, 0xd8, 0x28, 0x8b, 0x76, 0x03,
+	0x96, 0x46, 0xf1, 0x78, 0xc7, 0xec, 0x11, 0x94, 0xb4, 0xcd, 0x7f, 0xdc, 0x76, 0x89, 0x84, 0x3f,
+	0xa1, 0xd7, 0x4c, 0x7c, 0xdc, 0x0d, 0x98, 0x54, 0x64, 0x2c, 0x7c, 0x7e, 0x69, 0xfd, 0x36, 0xb7,
+	0x3f, 0xcf, 0x9d, 0xa5, 0x7d, 0x21, 0xa4, 0x54, 0x94, 0x13, 0xa9, 0x7c, 0x25, 0x73, 0xee, 0xdf,
+	0x3e, 0x7b, 0xe2, 0x90, 0x8e, 0xb2, 0xfc, 0xc0, 0xed, 0x1f, 0xaa, 0x00, 0xc7, 0x83, 0x8b, 0x33,
+	0xae, 0x62, 0x96, 0x4a, 0xd4, 0x85, 0xc7, 0x9c, 0x0a, 0xc2, 0x05, 0x0b, 0xa8, 0x94, 0x64, 0xcc,
+	0x33, 0x32, 0xa1, 0x13, 0x26, 0x6e, 0x48, 0x24, 0xfc, 0x40, 0x73, 0x3c, 0xa7, 0xe5, 0x74, 0x1c,
+	0xfc, 0x57, 0x4e, 0xc5, 0xc0, 0xb2, 0x8e, 0x79, 0x76, 0x6a, 0x38, 0xbd, 0x9c, 0x82, 0xb6, 0x61,
+	0xc5, 0x4f, 0x12, 0x16, 0xf8, 0x8a, 0x09, 0xa2, 0x6e, 0x38, 0xf5, 0x2a, 0x2d, 0xa7, 0xb3, 0x84,
+	0x97, 0xa7, 0xd6, 0xf3, 0x1b, 0x4e, 0xd1, 0x2b, 0xd8, 0x08, 0x69, 0x44, 0x85, 0xa0, 0x21, 0x09,
+	0x69, 0x42, 0xb5, 0x2f, 0x19, 0xdd, 0x28, 0x2a, 0xbd, 0x6a, 0xcb, 0xe9, 0x54, 0xf1, 0x7a, 0x01,
+	0x77, 0x73, 0xf4, 0x40, 0x83, 0xe8, 0x09, 0x34, 0x75, 0xa0, 0x6b, 0x32, 0x16, 0xec, 0x5a, 0x5d,
+	0x7a, 0x0b, 0x2d, 0xa7, 0x53, 0xc7, 0x0d, 0x63, 0x3b, 0x36, 0x26, 0xb4, 0x03, 0x0f, 0xaf, 0x62,
+	0x19, 0x8f, 0x12, 0x4a, 0x42, 0x7a, 0x15, 0x07, 0x94, 0x24, 0xb1, 0x54, 0x5e, 0xcd, 0x1c, 0x63,
+	0x35, 0x87, 0xba, 0x06, 0xe9, 0xc7, 0x52, 0xa1, 0xff, 0xc1, 0x26, 0x67, 0x49, 0x12, 0xa7, 0x63,
+	0xa2, 0xef, 0x70, 0xa5, 0xdd, 0x12, 0xff, 0x86, 0x64, 0x92, 0x06, 0xd2, 0x5b, 0x6c, 0x39, 0x9d,
+	0x1a, 0xde, 0xc8, 0x19, 0xfb, 0x86, 0xd0, 0xd5, 0xf8, 0x85, 0x86, 0xd1, 0x3b, 0xd8, 0x2a, 0x9c,
+	0xe3, 0x74, 0xce, 0x7d, 0x62, 0xdc, 0xef, 0x1b, 0xf7, 0xbf, 0xe4, 0x9c, 0x93, 0xd4, 0x2f, 0x03,
+	0x9c, 0x6a, 0x42, 0xfb, 0xa7, 0x2a, 0xb8, 0x3a, 0x03, 0x93, 0xf8, 0x7b, 0x2a, 0x8a, 0x54, 0x9c,
+	0xc2, 0xd3, 0x90, 0x91, 0x80, 0x4d, 0x26, 0x2c, 0x25, 0x32, 0x1b, 0xd1, 0xef, 0xb8, 0xa0, 0x52,
+	0x6a, 0x8d, 0x68, 0x12, 0x4f, 0xe2, 0xd4, 0x9f, 0xa6, 0xa3, 0x8e, 0x5b, 0x21, 0x3b, 0x34, 0xcc,
+	0xe1, 0x2c, 0xf1, 0xa8, 0xe4, 0x69, 0x45, 0x4c, 0xb8, 0x54, 0x2a, 0x3f, 0x55, 0x24, 0x62, 0x49,
+	0x18, 0xa7, 0x63, 0x93, 0x98, 0x3a, 0x5e, 0xd5, 0xee, 0x16, 0xe9, 0x59, 0x00, 0x3d, 0x83, 0xb5,
+	0x90, 0x91, 0x28, 0x4b, 0x4d, 0x4a, 0x49, 0x9c, 0x26, 0x71, 0xaa, 0x1d, 0xac, 0xd8, 0x28, 0x64,
+	0xbd, 0x1c, 0x3a, 0xc9, 0x11, 0xf4, 0x0e, 0x96, 0x18, 0x57, 0x24, 0xa1, 0x57, 0x34, 0x31, 0x09,
+	0x5c, 0xd9, 0x6b, 0xef, 0x94, 0x45, 0xb8, 0x73, 0xfb, 0x86, 0x3b, 0x7d, 0xcd, 0xc4, 0x75, 0xc6,
+	0x95, 0x59, 0xa1, 0x0b, 0x70, 0xc7, 0x09, 0x1b, 0xf9, 0x09, 0xf9, 0x36, 0x2e, 0xe2, 0xd4, 0x4c,
+	0x9c, 0x7f, 0x7e, 0x31, 0xce, 0xb1, 0x71, 0x7a, 0x1f, 0xdb, 0x30, 0x78, 0x65, 0x3c, 0xb7, 0x6f,
+	0xb7, 0xa0, 0x66, 0xe3, 0x2f, 0x42, 0xa5, 0xff, 0xdc, 0xbd, 0x87, 0x1e, 0x40, 0xa5, 0xff, 0xcc,
+	0xfd, 0xbd, 0xf8, 0x39, 0xed, 0x43, 0x58, 0x99, 0x8f, 0x81, 0x1a, 0x70, 0xbf, 0x7b, 0xd4, 0xdb,
+	0xbf, 0xe8, 0x9f, 0xbb, 0xf7, 0x90, 0x0b, 0xd5, 0xb3, 0x5e, 0x6f, 0xc6, 0x01, 0xd5, 0x61, 0xe1,
+	0xec, 0x03, 0x79, 0xee, 0x16, 0xab, 0x3d, 0xb7, 0xd2, 0xfe, 0xb9, 0x0a, 0xcd, 0x63, 0xdd, 0xab,
+	0x45, 0x02, 0x5f, 0xc2, 0x23, 0x9a, 0xfa, 0xba, 0x04, 0x05, 0x0d, 0xae, 0x88, 0x0c, 0x2e, 0x69,
+	0x98, 0x25, 0xa5, 0xe8, 0x6b, 0x16, 0xc5, 0x34, 0xb8, 0x1a, 0x4e, 0x31, 0x74, 0x02, 0xab, 0xac,
+	0xb8, 0x20, 0x61, 0x36, 0x94, 0x51, 0xb3, 0xb1, 0xb7, 0xf5, 0x25, 0x15, 0xb0, 0xcb, 0x6e, 0x57,
+	0x50, 0x07, 0xdc, 0x51, 0x16, 0x27, 0x21, 0x31, 0xe3, 0x66, 0xc2, 0x42, 0x9a, 0x98, 0xf4, 0x55,
+	0xf1, 0x8a, 0xb1, 0x1f, 0x32, 0xa9, 0x4e, 0xb5, 0x15, 0xbd, 0x80, 0x47, 0xb7, 0x99, 0xc4, 0x8f,
+	0x14, 0x15, 0xde, 0x92, 0xe1, 0x3f, 0x9c, 0xe7, 0xef, 0x6b, 0x48, 0xb7, 0x61, 0x9c, 0x46, 0x54,
+	0x10, 0x79, 0xe9, 0x73, 0x2a, 0x4d, 0xaa, 0xea, 0xb8, 0x61, 0x6c, 0x43, 0x63, 0x42, 0xff, 0x02,
+	0xc4, 0x13, 0x3f, 0xa0, 0x84, 0x8b, 0x2c, 0xa5, 0xa1, 0x1d, 0x79, 0xa6, 0x9d, 0xea, 0xd8, 0x35,
+	0xc8, 0xc0, 0x00, 0x46, 0x37, 0xf4, 0x06, 0xbc, 0x5c, 0xb0, 0x51, 0x94, 0x30, 0x5f, 0x3d, 0x7f,
+	0x45, 0x24, 0x4d, 0x43, 0xad, 0x9e, 0xe9, 0xa1, 0x3a, 0xce, 0x05, 0x3d, 0xc8, 0xe1, 0x61, 0x8e,
+	0xa2, 0xa7, 0xb0, 0xac, 0xe2, 0x09, 0x4d, 0xe2, 0x94, 0x12, 0x3d, 0x08, 0xbd, 0xba, 0x69, 0xb9,
+	0x66, 0x61, 0x1c, 0x2a, 0xca, 0xdf, 0x2f, 0xd4, 0x1d, 0xb7, 0x82, 0xb7, 0xe5, 0xc7, 0x98, 0xff,
+	0x69, 0x5b, 0xb5, 0xdf, 0xc0, 0xfa, 0xf9, 0xa5, 0xa0, 0x7e, 0x38, 0x60, 0x2c, 0xb1, 0x82, 0x0e,
+	0xcc, 0x84, 0x7f, 0x0c, 0x8d, 0x34, 0x9b, 0x10, 0x65, 0x40, 0x69, 0xda, 0xaf, 0x86, 0x21, 0xcd,
+	0x26, 0x96, 0x2e, 0xdb, 0x7d, 0x00, 0x3c, 0x38, 0x2c, 0x72, 0xf0, 0x16, 0xb6, 0x32, 0x49, 0x89,
+	0xe0, 0x01, 0x89, 0x98, 0x20, 0x71, 0x5a, 0x8c, 0xd6, 0x89, 0x2f, 0xb5, 0xbe, 0xb6, 0x7d, 0xbd,
+	0x4c, 0x52, 0xcc, 0x83, 0x1e, 0x13, 0x27, 0x05, 0xe1, 0xd4, 0xe0, 0xed, 0x5f, 0x16, 0xa1, 0x71,
+	0x68, 0x5e, 0x18, 0xfb, 0xf7, 0x5f, 0x43, 0x33, 0x1f, 0x68, 0x01, 0xcb, 0x52, 0xe5, 0x39, 0xad,
+	0x6a, 0xa7, 0xb1, 0xd7, 0x99, 0xad, 0x8c, 0x19, 0xfa, 0x8e, 0x1d, 0x71, 0x87, 0x9a, 0x7a, 0x94,
+	0x2a, 0x71, 0x83, 0x1b, 0x61, 0x69, 0xd1, 0x83, 0x2b, 0x4e, 0x95, 0xf0, 0x09, 0xe3, 0x84, 0xfb,
+	0xc2, 0x4f, 0x12, 0x9a, 0xc4, 0xb2, 0xbc, 0x5c, 0xc5, 0x0e, 0x2e, 0xc3, 0x39, 0xe3, 0x83, 0x92,
+	0x91, 0xdf, 0x35, 0x0f, 0x40, 0xc5, 0xe7, 0x02, 0xd4, 0xa6, 0x01, 0xa8, 0xf8, 0x64, 0x80, 0xff,
+	0xc0, 0x86, 0x96, 0x47, 0xbf, 0x39, 0x32, 0xcf, 0x42, 0xe1, 0xbb, 0x64, 0x9b, 0x24, 0x93, 0x74,
+	0x40, 0xc5, 0xd0, 0x82, 0x85, 0x9b, 0x0f, 0x5b, 0x05, 0x7d, 0xfa, 0xff, 0xd6, 0x8f, 0x70, 0xc6,
+	0x12, 0xaf, 0x69, 0x54, 0x79, 0x32, 0xab, 0xca, 0x27, 0xb3, 0x89, 0xbd, 0x3c, 0xcc, 0x89, 0x3d,
+	0x61, 0x49, 0x42, 0x7f, 0x07, 0x5b, 0xa0, 0x13, 0x9a, 0x2a, 0x7d, 0xbe, 0x98, 0x85, 0xa6, 0x0d,
+	0x6b, 0xf8, 0xc1, 0xd4, 0x3e, 0x30, 0x66, 0xfd, 0xdc, 0xe5, 0x39, 0x89, 0xe2, 0x44, 0x51, 0x21,
+	0xbd, 0x85, 0x56, 0x55, 0x3f, 0x77, 0xd6, 0xda, 0xb3, 0x46, 0xf4, 0x1a, 0x1a, 0xfa, 0x3d, 0x2d,
+	0x7a, 0x7a, 0xd1, 0xf4, 0xf4, 0xa3, 0xd9, 0x33, 0x96, 0x0f, 0x31, 0x86, 0x31, 0xcf, 0xf2, 0xb5,
+	0x1e, 0xc5, 0xf6, 0xbd, 0x93, 0x2c, 0x52, 0x64, 0xfa, 0xef, 0x79, 0x4f, 0x20, 0x83, 0x0d, 0x59,
+	0xa4, 0x06, 0x05, 0xa2, 0x3d, 0x12, 0x36, 0x2e, 0x9e, 0xbe, 0xd2, 0xa3, 0x6e, 0x3d, 0x12, 0x36,
+	0xb6, 0x85, 0x51, 0x7a, 0xfc, 0x1f, 0x96, 0x4d, 0x73, 0x4e, 0x8f, 0x07, 0xe6, 0x78, 0xde, 0xdc,
+	0xf1, 0x66, 0xa6, 0x1b, 0x6e, 0x8e, 0x67, 0x76, 0x3a, 0x8f, 0x8c, 0x53, 0x61, 0x7a, 0x87, 0xe8,
+	0xae, 0x63, 0x99, 0x22, 0x71, 0x4a, 0x26, 0xd2, 0x6b, 0x98, 0x09, 0xb2, 0x36, 0x85, 0xcf, 0x2d,
+	0x7a, 0x92, 0x9e, 0x1a, 0x49, 0x74, 0x67, 0x14, 0xff, 0xb9, 0x7c, 0x57, 0x92, 0xb2, 0x95, 0x30,
+	0x08, 0x1e, 0xe4, 0xeb, 0xcd, 0xb7, 0xe0, 0xde, 0x2e, 0x6d, 0x3d, 0xa6, 0x3f, 0xd2, 0x1b, 0xd3,
+	0x51, 0x4b, 0x58, 0x2f, 0xd1, 0x1a, 0xd4, 0xae, 0xfc, 0x24, 0xa3, 0x79, 0x21, 0xdb, 0xcd, 0x7f,
+	0x2b, 0x6f, 0x9c, 0xf6, 0xaf, 0x15, 0x00, 0x9c, 0xa5, 0xc5, 0xf1, 0x0f, 0xa0, 0xa1, 0x84, 0x9e,
+	0x53, 0xf6, 0xd1, 0x71, 0xcc, 0xa3, 0x33, 0x57, 0x3e, 0x25, 0x79, 0xe7, 0x5c, 0x33, 0xed, 0x53,
+	0x03, 0x6a, 0xba, 0x46, 0x6d, 0x58, 0x9e, 0xbf, 0x78, 0xc5, 0x5c, 0xbc, 0xa1, 0x66, 0xee, 0xbb,
+	0x0b, 0x6b, 0x9f, 0xac, 0x57, 0x5b, 0x58, 0xab, 0xf1, 0x9d, 0x2a, 0x7c, 0x05, 0x1b, 0x2c, 0x53,
+	0x3c, 0x53, 0xba, 0xbd, 0x54, 0x6c, 0xe4, 0x35, 0xc2, 0x17, 0xe3, 0x76, 0xdd, 0xc2, 0x83, 0x02,
+	0x35, 0x39, 0x92, 0x3a, 0x9d, 0xe6, 0x2b, 0xef, 0x56, 0xb5, 0xcd, 0xa5, 0xb3, 0xab, 0x09, 0xd3,
+	0x74, 0x86, 0x33, 0xbb, 0x36, 0x06, 0x28, 0x6f, 0x89, 0x9a, 0x50, 0xff, 0x70, 0x46, 0xce, 0xf1,
+	0xfe, 0xe1, 0x91, 0x7b, 0x0f, 0x21, 0x58, 0x19, 0x9e, 0xf5, 0xce, 0xbf, 0xd9, 0xc7, 0x47, 0xb9,
+	0xcd, 0xd1, 0xb6, 0xaf, 0xf6, 0x71, 0x77, 0xc6, 0x56, 0x41, 0x2b, 0x00, 0xbd, 0x8b, 0x7e, 0x3f,
+	0xdf, 0x57, 0xdf, 0x2f, 0xd4, 0x17, 0xdc, 0x5a, 0xfb, 0x47, 0x07, 0x1a, 0x38, 0x4b, 0x4f, 0xa9,
+	0xf2, 0x43, 0x5f, 0xf9, 0xe8, 0x25, 0x40, 0xf9, 0xe5, 0x6a, 0x84, 0x6f, 0xec, 0xad, 0xcf, 0x9e,
+	0x52, 0x8f, 0xee, 0xa1, 0x06, 0xf1, 0x92, 0x2c, 0x96, 0xe8, 0x35, 0x40, 0xf9, 0x09, 0xed, 0x55,
+	0xee, 0xde, 0x4d, 0x3f, 0x55, 0x46, 0x8a, 0x2e, 0x8d, 0xf0, 0x52, 0x50, 0xec, 0xd0, 0x3b, 0x70,
+	0xef, 0x08, 0x59, 0x35, 0xc3, 0x62, 0xed, 0x4e, 0xa5, 0x6b, 0xd7, 0x07, 0x7c, 0x5e, 0xd8, 0x83,
+	0x7f, 0x83, 0xc7, 0xc4, 0x78, 0x96, 0x3b, 0xfd, 0x04, 0x3f, 0x68, 0xce, 0x4c, 0x5e, 0x39, 0x70,
+	0x7e, 0x73, 0x9c, 0xd1, 0xa2, 0xf9, 0xca, 0x7e, 0xf1, 0xc7, 0x00, 0x86, 0x6e, 0x53, 0xe4, 0x3f,
+	0x0c, 0x00, 0x00,
+}
@@ -0,0 +1,741 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: tensorflow/core/protobuf/meta_graph.proto
+
+package tensorflow
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	any "github.com/golang/protobuf/ptypes/any"
+	framework "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type MetaGraphDef struct {
+	MetaInfoDef          *MetaGraphDef_MetaInfoDef `protobuf:"bytes,1,opt,name=meta_info_def,json=metaInfoDef,proto3" json:"meta_info_def,omitempty"`
+	GraphDef             *framework.GraphDef       `protobuf:"bytes,2,opt,name=graph_def,json=graphDef,proto3" json:"graph_def,omitempty"`
+	SaverDef             *SaverDef                 `protobuf:"bytes,3,opt,name=saver_def,json=saverDef,proto3" json:"saver_def,omitempty"`
+	CollectionDef        map[string]*CollectionDef `protobuf:"bytes,4,rep,name=collection_def,json=collectionDef,proto3" json:"collection_def,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SignatureDef         map[string]*SignatureDef  `protobuf:"bytes,5,rep,name=signature_def,json=signatureDef,proto3" json:"signature_def,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	AssetFileDef         []*AssetFileDef           `protobuf:"bytes,6,rep,name=asset_file_def,json=assetFileDef,proto3" json:"asset_file_def,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *MetaGraphDef) Reset()         { *m = MetaGraphDef{} }
+func (m *MetaGraphDef) String() string { return proto.CompactTextString(m) }
+func (*MetaGraphDef) ProtoMessage()    {}
+func (*MetaGraphDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e94adf32e895c059, []int{0}
+}
+
+func (m *MetaGraphDef) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MetaGraphDef.Unmarshal(m, b)
+}
+func (m *MetaGraphDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MetaGraphDef.Marshal(b, m, deterministic)
+}
+func (m *MetaGraphDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetaGraphDef.Merge(m, src)
+}
+func (m *MetaGraphDef) XXX_Size() int {
+	return xxx_messageInfo_MetaGraphDef.Size(m)
+}
+func (m *MetaGraphDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetaGraphDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetaGraphDef proto.InternalMessageInfo
+
+func (m *MetaGraphDef) GetMetaInfoDef() *MetaGraphDef_MetaInfoDef {
+	if m != nil {
+		return m.MetaInfoDef
+	}
+	return nil
+}
+
+func (m *MetaGraphDef) GetGraphDef() *framework.GraphDef {
+	if m != nil {
+		return m.GraphDef
+	}
+	return nil
+}
+
+func (m *MetaGraphDef) GetSaverDef() *SaverDef {
+	if m != nil {
+		return m.SaverDef
+	}
+	return nil
+}
+
+func (m *MetaGraphDef) GetCollectionDef() map[string]*CollectionDef {
+	if m != nil {
+		return m.CollectionDef
+	}
+	return nil
+}
+
+func (m *MetaGraphDef) GetSignatureDef() map[string]*SignatureDef {
+	if m != nil {
+		return m.SignatureDef
+	}
+	return nil
+}
+
+func (m *MetaGraphDef) GetAssetFileDef() []*AssetFileDef {
+	if m != nil {
+		return m.AssetFileDef
+	}
+	return nil
+}
+
+type MetaGraphDef_MetaInfoDef struct {
+	MetaGraphVersion     string            `protobuf:"bytes,1,opt,name=meta_graph_version,json=metaGraphVersion,proto3" json:"meta_graph_version,omitempty"`
+	StrippedOpList       *framework.OpList `protobuf:"bytes,2,opt,name=stripped_op_list,json=strippedOpList,proto3" json:"stripped_op_list,omitempty"`
+	AnyInfo              *any.Any          `protobuf:"bytes,3,opt,name=any_info,json=anyInfo,proto3" json:"any_info,omitempty"`
+	Tags                 []string          `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	TensorflowVersion    string            `protobuf:"bytes,5,opt,name=tensorflow_version,json=tensorflowVersion,proto3" json:"tensorflow_version,omitempty"`
+	TensorflowGitVersion string            `protobuf:"bytes,6,opt,name=tensorflow_git_version,json=tensorflowGitVersion,proto3" json:"tensorflow_git_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *MetaGraphDef_MetaInfoDef) Reset()         { *m = MetaGraphDef_MetaInfoDef{} }
+func (m *MetaGraphDef_MetaInfoDef) String() string { return proto.CompactTextString(m) }
+func (*MetaGraphDef_MetaInfoDef) ProtoMessage()    {}
+func (*MetaGraphDef_MetaInfoDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e94adf32e895c059, []int{0, 0}
+}
+
+func (m *MetaGraphDef_MetaInfoDef) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MetaGraphDef_MetaInfoDef.Unmarshal(m, b)
+}
+func (m *MetaGraphDef_MetaInfoDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MetaGraphDef_MetaInfoDef.Marshal(b, m, deterministic)
+}
+func (m *MetaGraphDef_MetaInfoDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetaGraphDef_MetaInfoDef.Merge(m, src)
+}
+func (m *MetaGraphDef_MetaInfoDef) XXX_Size() int {
+	return xxx_messageInfo_MetaGraphDef_MetaInfoDef.Size(m)
+}
+func (m *MetaGraphDef_MetaInfoDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetaGraphDef_MetaInfoDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetaGraphDef_MetaInfoDef proto.InternalMessageInfo
+
+func (m *MetaGraphDef_MetaInfoDef) GetMetaGraphVersion() string {
+	if m != nil {
+		return m.MetaGraphVersion
+	}
+	return ""
+}
+
+func (m *MetaGraphDef_MetaInfoDef) GetStrippedOpList() *framework.OpList {
+	if m != nil {
+		return m.StrippedOpList
+	}
+	return nil
+}
+
+func (m *MetaGraphDef_MetaInfoDef) GetAnyInfo() *any.Any {
+	if m != nil {
+		return m.AnyInfo
+	}
+	return nil
+}
+
+func (m *MetaGraphDef_MetaInfoDef) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *MetaGraphDef_MetaInfoDef) GetTensorflowVersion() string {
+	if m != nil {
+		return m.TensorflowVersion
+	}
+	return ""
+}
+
+func (m *MetaGraphDef_MetaInfoDef) GetTensorflowGitVersion() string {
+	if m != nil {
+		return m.TensorflowGitVersion
+	}
+	return ""
+}
+
+type CollectionDef struct {
+	// Types that are valid to be assigned to Kind:
+	//	*CollectionDef_NodeList_
+	//	*CollectionDef_BytesList_
+	//	*CollectionDef_Int64List_
+	//	*CollectionDef_FloatList_
+	//	*CollectionDef_AnyList_
+	Kind                 isCollectionDef_Kind `protobuf_oneof:"kind"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CollectionDef) Reset()         { *m = CollectionDef{} }
+func (m *CollectionDef) String() string { return proto.CompactTextString(m) }
+func (*CollectionDef) ProtoMessage()    {}
+func (*CollectionDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e94adf32e895c059, []int{1}
+}
+
+func (m *CollectionDef) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectionDef.Unmarshal(m, b)
+}
+func (m *CollectionDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectionDef.Marshal(b, m, deterministic)
+}
+func (m *CollectionDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionDef.Merge(m, src)
+}
+func (m *CollectionDef) XXX_Size() int {
+	return xxx_messageInfo_CollectionDef.Size(m)
+}
+func (m *CollectionDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionDef proto.InternalMessageInfo
+
+type isCollectionDef_Kind interface {
+	isCollectionDef_Kind()
+}
+
+type CollectionDef_NodeList_ struct {
+	NodeList *CollectionDef_NodeList `protobuf:"bytes,1,opt,name=node_list,json=nodeList,proto3,oneof"`
+}
+
+type CollectionDef_BytesList_ struct {
+	BytesList *CollectionDef_BytesList `protobuf:"bytes,2,opt,name=bytes_list,json=bytesList,proto3,oneof"`
+}
+
+type CollectionDef_Int64List_ struct {
+	Int64List *CollectionDef_Int64List `protobuf:"bytes,3,opt,name=int64_list,json=int64List,proto3,oneof"`
+}
+
+type CollectionDef_FloatList_ struct {
+	FloatList *CollectionDef_FloatList `protobuf:"bytes,4,opt,name=float_list,json=floatList,proto3,oneof"`
+}
+
+type CollectionDef_AnyList_ struct {
+	AnyList *CollectionDef_AnyList `protobuf:"bytes,5,opt,name=any_list,json=anyList,proto3,oneof"`
+}
+
+func (*CollectionDef_NodeList_) isCollectionDef_Kind() {}
+
+func (*CollectionDef_BytesList_) isCollectionDef_Kind() {}
+
+func (*CollectionDef_Int64List_) isCollectionDef_Kind() {}
+
+func (*CollectionDef_FloatList_) isCollectionDef_Kind() {}
+
+func (*CollectionDef_AnyList_) isCollectionDef_Kind() {}
+
+func (m *CollectionDef) GetKind() isCollectionDef_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (m *CollectionDef) GetNodeList() *CollectionDef_NodeList {
+	if x, ok := m.GetKind().(*CollectionDef_NodeList_); ok {
+		return x.NodeList
+	}
+	return nil
+}
+
+func (m *CollectionDef) GetBytesList() *CollectionDef_BytesList {
+	if x, ok := m.GetKind().(*CollectionDef_BytesList_); ok {
+		return x.BytesList
+	}
+	return nil
+}
+
+func (m *CollectionDef) GetInt64List() *CollectionDef_Int64List {
+	if x, ok := m.GetKind().(*CollectionDef_Int64List_); ok {
+		return x.Int64List
+	}
+	return nil
+}
+
+func (m *CollectionDef) GetFloatList() *CollectionDef_FloatList {
+	if x, ok := m.GetKind().(*CollectionDef_FloatList_); ok {
+		return x.FloatList
+	}
+	return nil
+}
+
+func (m *CollectionDef) GetAnyList() *CollectionDef_AnyList {
+	if x, ok := m.GetKind().(*CollectionDef_AnyList_); ok {
+		return x.AnyList
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*CollectionDef) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*CollectionDef_NodeList_)(nil),
+		(*CollectionDef_BytesList_)(nil),
+		(*CollectionDef_Int64List_)(nil),
+		(*CollectionDef_FloatList_)(nil),
+		(*CollectionDef_AnyList_)(nil),
+	}
+}
+
+type CollectionDef_NodeList struct {
+	Value                []string `protobuf:"bytes,1,rep,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CollectionDef_NodeList) Reset()         { *m = CollectionDef_NodeList{} }
+func (m *CollectionDef_NodeList) String() string { return proto.CompactTextString(m) }
+func (*CollectionDef_NodeList) ProtoMessage()    {}
+func (*CollectionDef_NodeList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e94adf32e895c059, []int{1, 0}
+}
+
+func (m *CollectionDef_NodeList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectionDef_NodeList.Unmarshal(m, b)
+}
+func (m *CollectionDef_NodeList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectionDef_NodeList.Marshal(b, m, deterministic)
+}
+func (m *CollectionDef_NodeList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionDef_NodeList.Merge(m, src)
+}
+func (m *CollectionDef_NodeList) XXX_Size() int {
+	return xxx_messageInfo_CollectionDef_NodeList.Size(m)
+}
+func (m *CollectionDef_NodeList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionDef_NodeList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionDef_NodeList proto.InternalMessageInfo
+
+func (m *CollectionDef_NodeList) GetValue() []string {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type CollectionDef_BytesList struct {
+	Value                [][]byte `protobuf:"bytes,1,rep,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CollectionDef_BytesList) Reset()         { *m = CollectionDef_BytesList{} }
+func (m *CollectionDef_BytesList) String() string { return proto.CompactTextString(m) }
+func (*CollectionDef_BytesList) ProtoMessage()    {}
+func (*CollectionDef_BytesList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e94adf32e895c059, []int{1, 1}
+}
+
+func (m *CollectionDef_BytesList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectionDef_BytesList.Unmarshal(m, b)
+}
+func (m *CollectionDef_BytesList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectionDef_BytesList.Marshal(b, m, deterministic)
+}
+func (m *CollectionDef_BytesList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionDef_BytesList.Merge(m, src)
+}
+func (m *CollectionDef_BytesList) XXX_Size() int {
+	return xxx_messageInfo_CollectionDef_BytesList.Size(m)
+}
+func (m *CollectionDef_BytesList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionDef_BytesList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionDef_BytesList proto.InternalMessageInfo
+
+func (m *CollectionDef_BytesList) GetValue() [][]byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type CollectionDef_Int64List struct {
+	Value                []int64  `protobuf:"varint,1,rep,packed,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CollectionDef_Int64List) Reset()         { *m = CollectionDef_Int64List{} }
+func (m *CollectionDef_Int64List) String() string { return proto.CompactTextString(m) }
+func (*CollectionDef_Int64List) ProtoMessage()    {}
+func (*CollectionDef_Int64List) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e94adf32e895c059, []int{1, 2}
+}
+
+func (m *CollectionDef_Int64List) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectionDef_Int64List.Unmarshal(m, b)
+}
+func (m *CollectionDef_Int64List) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectionDef_Int64List.Marshal(b, m, deterministic)
+}
+func (m *CollectionDef_Int64List) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionDef_Int64List.Merge(m, src)
+}
+func (m *CollectionDef_Int64List) XXX_Size() int {
+	return xxx_messageInfo_CollectionDef_Int64List.Size(m)
+}
+func (m *CollectionDef_Int64List) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionDef_Int64List.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionDef_Int64List proto.InternalMessageInfo
+
+func (m *CollectionDef_Int64List) GetValue() []int64 {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type CollectionDef_FloatList struct {
+	Value                []float32 `protobuf:"fixed32,1,rep,packed,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *CollectionDef_FloatList) Reset()         { *m = CollectionDef_FloatList{} }
+func (m *CollectionDef_FloatList) String() string { return proto.CompactTextString(m) }
+func (*CollectionDef_FloatList) ProtoMessage()    {}
+func (*CollectionDef_FloatList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e94adf32e895c059, []int{1, 3}
+}
+
+func (m *CollectionDef_FloatList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectionDef_FloatList.Unmarshal(m, b)
+}
+func (m *CollectionDef_FloatList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectionDef_FloatList.Marshal(b, m, deterministic)
+}
+func (m *CollectionDef_FloatList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionDef_FloatList.Merge(m, src)
+}
+func (m *CollectionDef_FloatList) XXX_Size() int {
+	return xxx_messageInfo_CollectionDef_FloatList.Size(m)
+}
+func (m *CollectionDef_FloatList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionDef_FloatList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionDef_FloatList proto.InternalMessageInfo
+
+func (m *CollectionDef_FloatList) GetValue() []float32 {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type CollectionDef_AnyList struct {
+	Value                []*any.Any `protobuf:"bytes,1,rep,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *CollectionDef_AnyList) Reset()         { *m = CollectionDef_AnyList{} }
+func (m *CollectionDef_AnyList) String() string { return proto.CompactTextString(m) }
+func (*CollectionDef_AnyList) ProtoMessage()    {}
+func (*CollectionDef_AnyList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e94adf32e895c059, []int{1, 4}
+}
+
+func (m *CollectionDef_AnyList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectionDef_AnyList.Unmarshal(m, b)
+}
+func (m *CollectionDef_AnyList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectionDef_AnyList.Marshal(b, m, deterministic)
+}
+func (m *CollectionDef_AnyList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionDef_AnyList.Merge(m, src)
+}
+func (m *CollectionDef_AnyList) XXX_Size() int {
+	return xxx_messageInfo_CollectionDef_AnyList.Size(m)
+}
+func (m *CollectionDef_AnyList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionDef_AnyList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionDef_AnyList proto.InternalMessageInfo
+
+func (m *CollectionDef_AnyList) GetValue() []*any.Any {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type TensorInfo struct {
+	Name                 string                      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Dtype                framework.DataType          `protobuf:"varint,2,opt,name=dtype,proto3,enum=tensorflow.DataType" json:"dtype,omitempty"`
+	TensorShape          *framework.TensorShapeProto `protobuf:"bytes,3,opt,name=tensor_shape,json=tensorShape,proto3" json:"tensor_shape,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *TensorInfo) Reset()         { *m = TensorInfo{} }
+func (m *TensorInfo) String() string { return proto.CompactTextString(m) }
+func (*TensorInfo) ProtoMessage()    {}
+func (*TensorInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e94adf32e895c059, []int{2}
+}
+
+func (m *TensorInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TensorInfo.Unmarshal(m, b)
+}
+func (m *TensorInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TensorInfo.Marshal(b, m, deterministic)
+}
+func (m *TensorInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TensorInfo.Merge(m, src)
+}
+func (m *TensorInfo) XXX_Size() int {
+	return xxx_messageInfo_TensorInfo.Size(m)
+}
+func (m *TensorInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TensorInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TensorInfo proto.InternalMessageInfo
+
+func (m *TensorInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TensorInfo) GetDtype() framework.DataType {
+	if m != nil {
+		return m.Dtype
+	}
+	return framework.DataType_DT_INVALID
+}
+
+func (m *TensorInfo) GetTensorShape() *framework.TensorShapeProto {
+	if m != nil {
+		return m.TensorShape
+	}
+	return nil
+}
+
+type SignatureDef struct {
+	Inputs               map[string]*TensorInfo `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Outputs              map[string]*TensorInfo `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MethodName           string                 `protobuf:"bytes,3,opt,name=method_name,json=methodName,proto3" json:"method_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *SignatureDef) Reset()         { *m = SignatureDef{} }
+func (m *SignatureDef) String() string { return proto.CompactTextString(m) }
+func (*SignatureDef) ProtoMessage()    {}
+func (*SignatureDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e94adf32e895c059, []int{3}
+}
+
+func (m *SignatureDef) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignatureDef.Unmarshal(m, b)
+}
+func (m *SignatureDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignatureDef.Marshal(b, m, deterministic)
+}
+func (m *SignatureDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignatureDef.Merge(m, src)
+}
+func (m *SignatureDef) XXX_Size() int {
+	return xxx_messageInfo_SignatureDef.Size(m)
+}
+func (m *SignatureDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignatureDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignatureDef proto.InternalMessageInfo
+
+func (m *SignatureDef) GetInputs() map[string]*TensorInfo {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+func (m *SignatureDef) GetOutputs() map[string]*TensorInfo {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+func (m *SignatureDef) GetMethodName() string {
+	if m != nil {
+		return m.MethodName
+	}
+	return ""
+}
+
+type AssetFileDef struct {
+	TensorInfo           *TensorInfo `protobuf:"bytes,1,opt,name=tensor_info,json=tensorInfo,proto3" json:"tensor_info,omitempty"`
+	Filename             string      `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *AssetFileDef) Reset()         { *m = AssetFileDef{} }
+func (m *AssetFileDef) String() string { return proto.CompactTextString(m) }
+func (*AssetFileDef) ProtoMessage()    {}
+func (*AssetFileDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e94adf32e895c059, []int{4}
+}
+
+func (m *AssetFileDef) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AssetFileDef.Unmarshal(m, b)
+}
+func (m *AssetFileDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AssetFileDef.Marshal(b, m, deterministic)
+}
+func (m *AssetFileDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetFileDef.Merge(m, src)
+}
+func (m *AssetFileDef) XXX_Size() int {
+	return xxx_messageInfo_AssetFileDef.Size(m)
+}
+func (m *AssetFileDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetFileDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetFileDef proto.InternalMessageInfo
+
+func (m *AssetFileDef) GetTensorInfo() *TensorInfo {
+	if m != nil {
+		return m.TensorInfo
+	}
+	return nil
+}
+
+func (m *AssetFileDef) GetFilename() string {
+	if m != nil {
+		return m.Filename
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*MetaGraphDef)(nil), "tensorflow.MetaGraphDef")
+	proto.RegisterMapType((map[string]*CollectionDef)(nil), "tensorflow.MetaGraphDef.CollectionDefEntry")
+	proto.RegisterMapType((map[string]*SignatureDef)(nil), "tensorflow.MetaGraphDef.SignatureDefEntry")
+	proto.RegisterType((*MetaGraphDef_MetaInfoDef)(nil), "tensorflow.MetaGraphDef.MetaInfoDef")
+	proto.RegisterType((*CollectionDef)(nil), "tensorflow.CollectionDef")
+	proto.RegisterType((*CollectionDef_NodeList)(nil), "tensorflow.CollectionDef.NodeList")
+	proto.RegisterType((*CollectionDef_BytesList)(nil), "tensorflow.CollectionDef.BytesList")
+	proto.RegisterType((*CollectionDef_Int64List)(nil), "tensorflow.CollectionDef.Int64List")
+	proto.RegisterType((*CollectionDef_FloatList)(nil), "tensorflow.CollectionDef.FloatList")
+	proto.RegisterType((*CollectionDef_AnyList)(nil), "tensorflow.CollectionDef.AnyList")
+	proto.RegisterType((*TensorInfo)(nil), "tensorflow.TensorInfo")
+	proto.RegisterType((*SignatureDef)(nil), "tensorflow.SignatureDef")
+	proto.RegisterMapType((map[string]*TensorInfo)(nil), "tensorflow.SignatureDef.InputsEntry")
+	proto.RegisterMapType((map[string]*TensorInfo)(nil), "tensorflow.SignatureDef.OutputsEntry")
+	proto.RegisterType((*AssetFileDef)(nil), "tensorflow.AssetFileDef")
+}
+
+func init() {
+	proto.RegisterFile("tensorflow/core/protobuf/meta_graph.proto", fileDescriptor_e94adf32e895c059)
+}
+
+var fileDescriptor_e94adf32e895c059 = []byte{
+	// 897 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xdd, 0x6e, 0xdb, 0x36,
+	0x14, 0xc7, 0x27, 0xdb, 0xf1, 0xc7, 0xb1, 0x93, 0xa5, 0x44, 0x50, 0xa4, 0xc2, 0x80, 0xa5, 0x5e,
+	0x32, 0x74, 0x5d, 0x66, 0xaf, 0x5d, 0xf7, 0x81, 0xa1, 0x68, 0x11, 0xcf, 0x6b, 0x1b, 0x60, 0x6b,
+	0x3a, 0xa5, 0x18, 0x30, 0xec, 0xc2, 0x60, 0x6c, 0xca, 0x11, 0x22, 0x93, 0x82, 0x48, 0xa7, 0xd0,
+	0xe5, 0x1e, 0x60, 0x4f, 0xb1, 0x77, 0xd8, 0x9b, 0xec, 0x5d, 0x76, 0x39, 0xf0, 0x50, 0x94, 0xa8,
+	0x39, 0x5a, 0x6e, 0x76, 0xc7, 0x43, 0xfe, 0xcf, 0x8f, 0x47, 0xe7, 0x1c, 0x92, 0x82, 0x4f, 0x14,
+	0xe3, 0x52, 0xa4, 0x61, 0x2c, 0xde, 0x8d, 0xe7, 0x22, 0x65, 0xe3, 0x24, 0x15, 0x4a, 0x5c, 0xac,
+	0xc3, 0xf1, 0x8a, 0x29, 0x3a, 0x5b, 0xa6, 0x34, 0xb9, 0x1c, 0xe1, 0x1c, 0x81, 0x52, 0xea, 0xdf,
+	0x5b, 0x0a, 0xb1, 0x8c, 0x1d, 0x35, 0xe5, 0x99, 0x91, 0xf9, 0x47, 0xff, 0x26, 0x86, 0x29, 0x5d,
+	0xb1, 0x77, 0x22, 0xbd, 0x1a, 0x3b, 0x34, 0xff, 0xe3, 0x7a, 0x99, 0x48, 0x66, 0x0b, 0x16, 0xe6,
+	0xba, 0xe3, 0x7a, 0x9d, 0x59, 0x99, 0xc9, 0x4b, 0x9a, 0xb0, 0xdb, 0x37, 0x57, 0x59, 0xc2, 0x64,
+	0x2e, 0x3b, 0xac, 0xfd, 0x6a, 0x49, 0xaf, 0x59, 0x6a, 0x54, 0xc3, 0xdf, 0x3a, 0x30, 0xf8, 0x91,
+	0x29, 0xfa, 0x52, 0x87, 0x3d, 0x65, 0x21, 0x79, 0x05, 0xdb, 0x98, 0x95, 0x88, 0x87, 0x42, 0x87,
+	0xb8, 0xef, 0x1d, 0x78, 0x0f, 0xfa, 0x8f, 0x0f, 0x47, 0x25, 0x6e, 0xe4, 0x3a, 0xa0, 0x71, 0xca,
+	0x43, 0x31, 0x65, 0x61, 0xd0, 0x5f, 0x95, 0x06, 0x79, 0x04, 0x3d, 0x4c, 0x06, 0x52, 0x1a, 0x48,
+	0xd9, 0x73, 0x29, 0x96, 0x10, 0x74, 0x97, 0x76, 0xf3, 0x47, 0xd0, 0xc3, 0xe0, 0xd0, 0xa5, 0xb9,
+	0xe9, 0x72, 0xae, 0x17, 0xd1, 0x45, 0xe6, 0x23, 0x12, 0xc0, 0xce, 0x5c, 0xc4, 0x31, 0x9b, 0xab,
+	0x48, 0x70, 0xf4, 0x6b, 0x1d, 0x34, 0x1f, 0xf4, 0x1f, 0x7f, 0x5a, 0x1b, 0xf0, 0x77, 0x85, 0x7c,
+	0xca, 0xc2, 0xef, 0xb9, 0x4a, 0xb3, 0x60, 0x7b, 0xee, 0xce, 0x91, 0x33, 0xd8, 0x96, 0xd1, 0x92,
+	0x53, 0xb5, 0x4e, 0x19, 0x22, 0xb7, 0x10, 0xf9, 0xb0, 0x16, 0x79, 0x6e, 0xd5, 0x05, 0x71, 0x20,
+	0x9d, 0x29, 0xf2, 0x0c, 0x76, 0xa8, 0x94, 0x4c, 0xcd, 0xc2, 0x28, 0x36, 0xc4, 0x36, 0x12, 0xf7,
+	0x5d, 0xe2, 0x89, 0x56, 0xbc, 0x88, 0x62, 0xed, 0x11, 0x0c, 0xa8, 0x63, 0xf9, 0x7f, 0x34, 0xa0,
+	0xef, 0xe4, 0x99, 0x1c, 0x03, 0x29, 0x5b, 0x77, 0x76, 0xcd, 0x52, 0x19, 0x09, 0x8e, 0x95, 0xea,
+	0x05, 0xbb, 0x2b, 0x1b, 0xd9, 0xcf, 0x66, 0x9e, 0x3c, 0x85, 0x5d, 0xa9, 0xd2, 0x28, 0x49, 0xd8,
+	0x62, 0x26, 0x92, 0x59, 0x1c, 0x49, 0x95, 0xd7, 0x83, 0xb8, 0xfb, 0x9f, 0x25, 0x3f, 0x44, 0x52,
+	0x05, 0x3b, 0x56, 0x6b, 0x6c, 0x32, 0x86, 0x2e, 0xe5, 0x19, 0xf6, 0x43, 0x51, 0x12, 0x73, 0x32,
+	0x46, 0xb6, 0xa3, 0x46, 0x27, 0x3c, 0x0b, 0x3a, 0x94, 0x67, 0x3a, 0x3e, 0x42, 0xa0, 0xa5, 0xe8,
+	0x52, 0x62, 0x1d, 0x7a, 0x01, 0x8e, 0xc9, 0x67, 0x40, 0xca, 0x9d, 0x8a, 0x80, 0xb7, 0x30, 0xe0,
+	0x3b, 0xe5, 0x8a, 0x8d, 0xf8, 0x09, 0xdc, 0x75, 0xe4, 0xcb, 0x48, 0x15, 0x2e, 0x6d, 0x74, 0xd9,
+	0x2b, 0x57, 0x5f, 0x46, 0x2a, 0xf7, 0xf2, 0x7f, 0x05, 0xb2, 0x59, 0x5b, 0xb2, 0x0b, 0xcd, 0x2b,
+	0x96, 0xe5, 0xc9, 0xd1, 0x43, 0x32, 0x86, 0xad, 0x6b, 0x1a, 0xaf, 0x59, 0x9e, 0x84, 0x7b, 0x6e,
+	0x12, 0x2a, 0x80, 0xc0, 0xe8, 0xbe, 0x6d, 0x7c, 0xe3, 0xf9, 0xbf, 0xc0, 0x9d, 0x8d, 0x2a, 0xdf,
+	0xc0, 0x1e, 0x55, 0xd9, 0x95, 0x02, 0xbb, 0xfe, 0x0e, 0x7a, 0xf8, 0x67, 0x0b, 0xb6, 0x2b, 0xfb,
+	0x92, 0x13, 0xe8, 0x71, 0xb1, 0x60, 0xa6, 0x54, 0xe6, 0x00, 0x0e, 0x6b, 0xa3, 0x1c, 0xbd, 0x16,
+	0x0b, 0xa6, 0x4b, 0xf5, 0xea, 0xbd, 0xa0, 0xcb, 0xf3, 0x31, 0x99, 0x02, 0x5c, 0x64, 0x8a, 0x49,
+	0xb7, 0xdc, 0x1f, 0xd5, 0x33, 0x26, 0x5a, 0x9b, 0x43, 0x7a, 0x17, 0xd6, 0xd0, 0x94, 0x88, 0xab,
+	0xaf, 0x9e, 0x18, 0x4a, 0xf3, 0x36, 0xca, 0xa9, 0xd6, 0x5a, 0x4a, 0x64, 0x0d, 0x4d, 0x09, 0x63,
+	0x41, 0x95, 0xa1, 0xb4, 0x6e, 0xa3, 0xbc, 0xd0, 0x5a, 0x4b, 0x09, 0xad, 0x41, 0x9e, 0x99, 0x46,
+	0x44, 0xc6, 0x16, 0x32, 0xee, 0xd7, 0x33, 0x4e, 0x78, 0x96, 0x13, 0x3a, 0xd4, 0x0c, 0xfd, 0x03,
+	0xe8, 0xda, 0x4c, 0x91, 0x3d, 0x5b, 0x26, 0x0f, 0x9b, 0xd4, 0x18, 0xfe, 0x7d, 0xe8, 0x15, 0x79,
+	0xa8, 0x4a, 0x06, 0x56, 0x72, 0x04, 0xbd, 0xe2, 0x23, 0xc9, 0xbe, 0x2b, 0x69, 0x4e, 0x1a, 0xbb,
+	0x9e, 0x23, 0x2b, 0xbe, 0xa2, 0x2a, 0x6b, 0xb8, 0xb2, 0x2f, 0xa1, 0x93, 0x07, 0x4a, 0x1e, 0xba,
+	0xa2, 0xba, 0x33, 0x66, 0x24, 0x93, 0x36, 0xb4, 0xae, 0x22, 0xbe, 0x18, 0xfe, 0xee, 0x01, 0xbc,
+	0xc5, 0x0c, 0xd8, 0x83, 0xc7, 0xe9, 0x8a, 0xe5, 0xed, 0x88, 0x63, 0x8d, 0x5d, 0xe8, 0x57, 0x01,
+	0x3b, 0x60, 0xa7, 0x7a, 0x9b, 0x4e, 0xa9, 0xa2, 0x6f, 0xb3, 0x84, 0x05, 0x46, 0x42, 0x9e, 0xc3,
+	0xc0, 0x7d, 0x6e, 0xf2, 0x72, 0x7f, 0xe0, 0xba, 0x98, 0xdd, 0xce, 0xf5, 0xf2, 0x1b, 0x1d, 0x58,
+	0xd0, 0x57, 0xe5, 0xcc, 0xf0, 0xaf, 0x06, 0x0c, 0xdc, 0x26, 0x27, 0x4f, 0xa1, 0x1d, 0xf1, 0x64,
+	0xad, 0x64, 0xfe, 0x55, 0x87, 0x75, 0xc7, 0x61, 0x74, 0x8a, 0x32, 0x73, 0x77, 0xe6, 0x3e, 0xe4,
+	0x39, 0x74, 0xc4, 0x5a, 0xa1, 0x7b, 0x03, 0xdd, 0x8f, 0x6a, 0xdd, 0xcf, 0xd6, 0xaa, 0xf4, 0xb7,
+	0x5e, 0xe4, 0x43, 0xd0, 0x0f, 0xd2, 0xa5, 0x58, 0xcc, 0x30, 0x2f, 0x4d, 0xcc, 0x0b, 0x98, 0xa9,
+	0xd7, 0x74, 0xc5, 0xfc, 0x9f, 0xa0, 0xef, 0x6c, 0x7c, 0xc3, 0x71, 0x3e, 0xae, 0x1e, 0xe7, 0xbb,
+	0x9b, 0xb9, 0xd0, 0x99, 0x77, 0xef, 0x89, 0x00, 0x06, 0x6e, 0x30, 0xff, 0x07, 0x73, 0x38, 0x87,
+	0x81, 0xfb, 0x38, 0x90, 0xaf, 0x21, 0x4f, 0xbb, 0xb9, 0x95, 0xbd, 0xff, 0xe4, 0x80, 0x2a, 0xc6,
+	0xc4, 0x87, 0xae, 0x7e, 0x81, 0x30, 0x1b, 0x0d, 0x8c, 0xa8, 0xb0, 0x27, 0x9f, 0xc3, 0xbe, 0x48,
+	0x97, 0x2e, 0xa4, 0xf8, 0xaf, 0x98, 0xbc, 0x5f, 0xbc, 0x76, 0x58, 0x75, 0xf9, 0xc6, 0xfb, 0xdb,
+	0xf3, 0x2e, 0xda, 0xd8, 0x9b, 0x5f, 0xfc, 0x33, 0x00, 0x13, 0xcd, 0x7a, 0x08, 0x60, 0x09, 0x00,
+	0x00,
+}
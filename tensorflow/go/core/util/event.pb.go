@@ -0,0 +1,465 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: tensorflow/core/util/event.proto
+
+package tensorflow
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	framework "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type LogMessage_Level int32
+
+const (
+	LogMessage_UNKNOWN   LogMessage_Level = 0
+	LogMessage_DEBUGGING LogMessage_Level = 10
+	LogMessage_INFO      LogMessage_Level = 20
+	LogMessage_WARN      LogMessage_Level = 30
+	LogMessage_ERROR     LogMessage_Level = 40
+	LogMessage_FATAL     LogMessage_Level = 50
+)
+
+var LogMessage_Level_name = map[int32]string{
+	0:  "UNKNOWN",
+	10: "DEBUGGING",
+	20: "INFO",
+	30: "WARN",
+	40: "ERROR",
+	50: "FATAL",
+}
+
+var LogMessage_Level_value = map[string]int32{
+	"UNKNOWN":   0,
+	"DEBUGGING": 10,
+	"INFO":      20,
+	"WARN":      30,
+	"ERROR":     40,
+	"FATAL":     50,
+}
+
+func (x LogMessage_Level) String() string {
+	return proto.EnumName(LogMessage_Level_name, int32(x))
+}
+
+func (LogMessage_Level) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c32e35f2f2971c10, []int{1, 0}
+}
+
+type SessionLog_SessionStatus int32
+
+const (
+	SessionLog_STATUS_UNSPECIFIED SessionLog_SessionStatus = 0
+	SessionLog_START              SessionLog_SessionStatus = 1
+	SessionLog_STOP               SessionLog_SessionStatus = 2
+	SessionLog_CHECKPOINT         SessionLog_SessionStatus = 3
+)
+
+var SessionLog_SessionStatus_name = map[int32]string{
+	0: "STATUS_UNSPECIFIED",
+	1: "START",
+	2: "STOP",
+	3: "CHECKPOINT",
+}
+
+var SessionLog_SessionStatus_value = map[string]int32{
+	"STATUS_UNSPECIFIED": 0,
+	"START":              1,
+	"STOP":               2,
+	"CHECKPOINT":         3,
+}
+
+func (x SessionLog_SessionStatus) String() string {
+	return proto.EnumName(SessionLog_SessionStatus_name, int32(x))
+}
+
+func (SessionLog_SessionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c32e35f2f2971c10, []int{2, 0}
+}
+
+type Event struct {
+	WallTime float64 `protobuf:"fixed64,1,opt,name=wall_time,json=wallTime,proto3" json:"wall_time,omitempty"`
+	Step     int64   `protobuf:"varint,2,opt,name=step,proto3" json:"step,omitempty"`
+	// Types that are valid to be assigned to What:
+	//	*Event_FileVersion
+	//	*Event_GraphDef
+	//	*Event_Summary
+	//	*Event_LogMessage
+	//	*Event_SessionLog
+	//	*Event_TaggedRunMetadata
+	//	*Event_MetaGraphDef
+	What                 isEvent_What `protobuf_oneof:"what"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c32e35f2f2971c10, []int{0}
+}
+
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Event.Unmarshal(m, b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return xxx_messageInfo_Event.Size(m)
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetWallTime() float64 {
+	if m != nil {
+		return m.WallTime
+	}
+	return 0
+}
+
+func (m *Event) GetStep() int64 {
+	if m != nil {
+		return m.Step
+	}
+	return 0
+}
+
+type isEvent_What interface {
+	isEvent_What()
+}
+
+type Event_FileVersion struct {
+	FileVersion string `protobuf:"bytes,3,opt,name=file_version,json=fileVersion,proto3,oneof"`
+}
+
+type Event_GraphDef struct {
+	GraphDef []byte `protobuf:"bytes,4,opt,name=graph_def,json=graphDef,proto3,oneof"`
+}
+
+type Event_Summary struct {
+	Summary *framework.Summary `protobuf:"bytes,5,opt,name=summary,proto3,oneof"`
+}
+
+type Event_LogMessage struct {
+	LogMessage *LogMessage `protobuf:"bytes,6,opt,name=log_message,json=logMessage,proto3,oneof"`
+}
+
+type Event_SessionLog struct {
+	SessionLog *SessionLog `protobuf:"bytes,7,opt,name=session_log,json=sessionLog,proto3,oneof"`
+}
+
+type Event_TaggedRunMetadata struct {
+	TaggedRunMetadata *TaggedRunMetadata `protobuf:"bytes,8,opt,name=tagged_run_metadata,json=taggedRunMetadata,proto3,oneof"`
+}
+
+type Event_MetaGraphDef struct {
+	MetaGraphDef []byte `protobuf:"bytes,9,opt,name=meta_graph_def,json=metaGraphDef,proto3,oneof"`
+}
+
+func (*Event_FileVersion) isEvent_What() {}
+
+func (*Event_GraphDef) isEvent_What() {}
+
+func (*Event_Summary) isEvent_What() {}
+
+func (*Event_LogMessage) isEvent_What() {}
+
+func (*Event_SessionLog) isEvent_What() {}
+
+func (*Event_TaggedRunMetadata) isEvent_What() {}
+
+func (*Event_MetaGraphDef) isEvent_What() {}
+
+func (m *Event) GetWhat() isEvent_What {
+	if m != nil {
+		return m.What
+	}
+	return nil
+}
+
+func (m *Event) GetFileVersion() string {
+	if x, ok := m.GetWhat().(*Event_FileVersion); ok {
+		return x.FileVersion
+	}
+	return ""
+}
+
+func (m *Event) GetGraphDef() []byte {
+	if x, ok := m.GetWhat().(*Event_GraphDef); ok {
+		return x.GraphDef
+	}
+	return nil
+}
+
+func (m *Event) GetSummary() *framework.Summary {
+	if x, ok := m.GetWhat().(*Event_Summary); ok {
+		return x.Summary
+	}
+	return nil
+}
+
+func (m *Event) GetLogMessage() *LogMessage {
+	if x, ok := m.GetWhat().(*Event_LogMessage); ok {
+		return x.LogMessage
+	}
+	return nil
+}
+
+func (m *Event) GetSessionLog() *SessionLog {
+	if x, ok := m.GetWhat().(*Event_SessionLog); ok {
+		return x.SessionLog
+	}
+	return nil
+}
+
+func (m *Event) GetTaggedRunMetadata() *TaggedRunMetadata {
+	if x, ok := m.GetWhat().(*Event_TaggedRunMetadata); ok {
+		return x.TaggedRunMetadata
+	}
+	return nil
+}
+
+func (m *Event) GetMetaGraphDef() []byte {
+	if x, ok := m.GetWhat().(*Event_MetaGraphDef); ok {
+		return x.MetaGraphDef
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Event) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Event_FileVersion)(nil),
+		(*Event_GraphDef)(nil),
+		(*Event_Summary)(nil),
+		(*Event_LogMessage)(nil),
+		(*Event_SessionLog)(nil),
+		(*Event_TaggedRunMetadata)(nil),
+		(*Event_MetaGraphDef)(nil),
+	}
+}
+
+type LogMessage struct {
+	Level                LogMessage_Level `protobuf:"varint,1,opt,name=level,proto3,enum=tensorflow.LogMessage_Level" json:"level,omitempty"`
+	Message              string           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *LogMessage) Reset()         { *m = LogMessage{} }
+func (m *LogMessage) String() string { return proto.CompactTextString(m) }
+func (*LogMessage) ProtoMessage()    {}
+func (*LogMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c32e35f2f2971c10, []int{1}
+}
+
+func (m *LogMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogMessage.Unmarshal(m, b)
+}
+func (m *LogMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogMessage.Marshal(b, m, deterministic)
+}
+func (m *LogMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogMessage.Merge(m, src)
+}
+func (m *LogMessage) XXX_Size() int {
+	return xxx_messageInfo_LogMessage.Size(m)
+}
+func (m *LogMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogMessage proto.InternalMessageInfo
+
+func (m *LogMessage) GetLevel() LogMessage_Level {
+	if m != nil {
+		return m.Level
+	}
+	return LogMessage_UNKNOWN
+}
+
+func (m *LogMessage) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type SessionLog struct {
+	Status               SessionLog_SessionStatus `protobuf:"varint,1,opt,name=status,proto3,enum=tensorflow.SessionLog_SessionStatus" json:"status,omitempty"`
+	CheckpointPath       string                   `protobuf:"bytes,2,opt,name=checkpoint_path,json=checkpointPath,proto3" json:"checkpoint_path,omitempty"`
+	Msg                  string                   `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *SessionLog) Reset()         { *m = SessionLog{} }
+func (m *SessionLog) String() string { return proto.CompactTextString(m) }
+func (*SessionLog) ProtoMessage()    {}
+func (*SessionLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c32e35f2f2971c10, []int{2}
+}
+
+func (m *SessionLog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SessionLog.Unmarshal(m, b)
+}
+func (m *SessionLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SessionLog.Marshal(b, m, deterministic)
+}
+func (m *SessionLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionLog.Merge(m, src)
+}
+func (m *SessionLog) XXX_Size() int {
+	return xxx_messageInfo_SessionLog.Size(m)
+}
+func (m *SessionLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionLog proto.InternalMessageInfo
+
+func (m *SessionLog) GetStatus() SessionLog_SessionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SessionLog_STATUS_UNSPECIFIED
+}
+
+func (m *SessionLog) GetCheckpointPath() string {
+	if m != nil {
+		return m.CheckpointPath
+	}
+	return ""
+}
+
+func (m *SessionLog) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+type TaggedRunMetadata struct {
+	Tag                  string   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	RunMetadata          []byte   `protobuf:"bytes,2,opt,name=run_metadata,json=runMetadata,proto3" json:"run_metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TaggedRunMetadata) Reset()         { *m = TaggedRunMetadata{} }
+func (m *TaggedRunMetadata) String() string { return proto.CompactTextString(m) }
+func (*TaggedRunMetadata) ProtoMessage()    {}
+func (*TaggedRunMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c32e35f2f2971c10, []int{3}
+}
+
+func (m *TaggedRunMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TaggedRunMetadata.Unmarshal(m, b)
+}
+func (m *TaggedRunMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TaggedRunMetadata.Marshal(b, m, deterministic)
+}
+func (m *TaggedRunMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaggedRunMetadata.Merge(m, src)
+}
+func (m *TaggedRunMetadata) XXX_Size() int {
+	return xxx_messageInfo_TaggedRunMetadata.Size(m)
+}
+func (m *TaggedRunMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaggedRunMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaggedRunMetadata proto.InternalMessageInfo
+
+func (m *TaggedRunMetadata) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *TaggedRunMetadata) GetRunMetadata() []byte {
+	if m != nil {
+		return m.RunMetadata
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("tensorflow.LogMessage_Level", LogMessage_Level_name, LogMessage_Level_value)
+	proto.RegisterEnum("tensorflow.SessionLog_SessionStatus", SessionLog_SessionStatus_name, SessionLog_SessionStatus_value)
+	proto.RegisterType((*Event)(nil), "tensorflow.Event")
+	proto.RegisterType((*LogMessage)(nil), "tensorflow.LogMessage")
+	proto.RegisterType((*SessionLog)(nil), "tensorflow.SessionLog")
+	proto.RegisterType((*TaggedRunMetadata)(nil), "tensorflow.TaggedRunMetadata")
+}
+
+func init() {
+	proto.RegisterFile("tensorflow/core/util/event.proto", fileDescriptor_c32e35f2f2971c10)
+}
+
+var fileDescriptor_c32e35f2f2971c10 = []byte{
+	// 603 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xcd, 0x6e, 0xda, 0x4c,
+	0x14, 0xf5, 0x84, 0x5f, 0x5f, 0x08, 0x9f, 0x33, 0xf9, 0x14, 0x59, 0x6d, 0x53, 0x51, 0x5a, 0x35,
+	0xac, 0x40, 0xa2, 0xab, 0x4a, 0xdd, 0x40, 0x42, 0x30, 0x0a, 0x31, 0x68, 0x6c, 0x9a, 0xa5, 0x35,
+	0x4d, 0x06, 0x83, 0x62, 0x7b, 0x90, 0x67, 0x08, 0xea, 0xfb, 0x74, 0xd3, 0xe7, 0xe9, 0xcb, 0x74,
+	0x59, 0x8d, 0x6d, 0x62, 0xf2, 0xb3, 0xbb, 0x73, 0xe6, 0x9c, 0xeb, 0x73, 0xcf, 0xf8, 0x42, 0x53,
+	0xb2, 0x48, 0xf0, 0x78, 0x11, 0xf0, 0x6d, 0xf7, 0x96, 0xc7, 0xac, 0xbb, 0x91, 0xab, 0xa0, 0xcb,
+	0x1e, 0x58, 0x24, 0x3b, 0xeb, 0x98, 0x4b, 0x8e, 0x21, 0x67, 0xbc, 0x39, 0x7b, 0xce, 0x5e, 0xc4,
+	0x34, 0x64, 0x5b, 0x1e, 0xdf, 0x77, 0xc5, 0x26, 0x0c, 0x69, 0xfc, 0x33, 0x15, 0xb5, 0x7e, 0x15,
+	0xa0, 0x34, 0x54, 0x4d, 0xf0, 0x5b, 0xd0, 0xb7, 0x34, 0x08, 0x3c, 0xb9, 0x0a, 0x99, 0x89, 0x9a,
+	0xa8, 0x8d, 0x48, 0x55, 0x01, 0xee, 0x2a, 0x64, 0x18, 0x43, 0x51, 0x48, 0xb6, 0x36, 0x0f, 0x9a,
+	0xa8, 0x5d, 0x20, 0x49, 0x8d, 0x3f, 0x42, 0x7d, 0xb1, 0x0a, 0x98, 0xf7, 0xc0, 0x62, 0xb1, 0xe2,
+	0x91, 0x59, 0x68, 0xa2, 0xb6, 0x6e, 0x69, 0xa4, 0xa6, 0xd0, 0xef, 0x29, 0x88, 0x4f, 0x41, 0xf7,
+	0x63, 0xba, 0x5e, 0x7a, 0x77, 0x6c, 0x61, 0x16, 0x9b, 0xa8, 0x5d, 0xb7, 0x34, 0x52, 0x4d, 0xa0,
+	0x0b, 0xb6, 0xc0, 0x5d, 0xa8, 0x64, 0x7e, 0xcc, 0x52, 0x13, 0xb5, 0x6b, 0xbd, 0xe3, 0x4e, 0xee,
+	0xbc, 0xe3, 0xa4, 0x57, 0x96, 0x46, 0x76, 0x2c, 0xfc, 0x15, 0x6a, 0x01, 0xf7, 0xbd, 0x90, 0x09,
+	0x41, 0x7d, 0x66, 0x96, 0x13, 0xd1, 0xc9, 0xbe, 0x68, 0xc2, 0xfd, 0xeb, 0xf4, 0xd6, 0xd2, 0x08,
+	0x04, 0x8f, 0x27, 0x25, 0x15, 0x4c, 0x28, 0x57, 0x5e, 0xc0, 0x7d, 0xb3, 0xf2, 0x52, 0xea, 0xa4,
+	0xd7, 0x13, 0xee, 0x2b, 0xa9, 0x78, 0x3c, 0xe1, 0x29, 0x1c, 0x4b, 0xea, 0xfb, 0xec, 0xce, 0x8b,
+	0x37, 0x91, 0x17, 0x32, 0x49, 0xef, 0xa8, 0xa4, 0x66, 0x35, 0x69, 0x71, 0xba, 0xdf, 0xc2, 0x4d,
+	0x68, 0x64, 0x13, 0x5d, 0x67, 0x24, 0x4b, 0x23, 0x47, 0xf2, 0x39, 0x88, 0x3f, 0x43, 0x43, 0x75,
+	0xf1, 0xf2, 0x6c, 0xf4, 0x2c, 0x9b, 0xba, 0xc2, 0x47, 0x59, 0x3e, 0x83, 0x32, 0x14, 0xb7, 0x4b,
+	0x2a, 0x5b, 0xbf, 0x11, 0x40, 0x3e, 0x18, 0xee, 0x41, 0x29, 0x60, 0x0f, 0x2c, 0x48, 0xde, 0xa9,
+	0xd1, 0x7b, 0xf7, 0xfa, 0xfc, 0x9d, 0x89, 0xe2, 0x90, 0x94, 0x8a, 0x4d, 0xa8, 0xec, 0x52, 0x53,
+	0xaf, 0xa8, 0x93, 0xdd, 0xb1, 0x75, 0x0d, 0xa5, 0x84, 0x89, 0x6b, 0x50, 0x99, 0xdb, 0x57, 0xf6,
+	0xf4, 0xc6, 0x36, 0x34, 0x7c, 0x08, 0xfa, 0xc5, 0x70, 0x30, 0x1f, 0x8d, 0xc6, 0xf6, 0xc8, 0x00,
+	0x5c, 0x85, 0xe2, 0xd8, 0xbe, 0x9c, 0x1a, 0xff, 0xab, 0xea, 0xa6, 0x4f, 0x6c, 0xe3, 0x3d, 0xd6,
+	0xa1, 0x34, 0x24, 0x64, 0x4a, 0x8c, 0xb6, 0x2a, 0x2f, 0xfb, 0x6e, 0x7f, 0x62, 0xf4, 0x5a, 0x7f,
+	0x10, 0x40, 0x9e, 0x24, 0xfe, 0x06, 0x65, 0x21, 0xa9, 0xdc, 0x88, 0xcc, 0xec, 0xa7, 0xd7, 0x13,
+	0xdf, 0x95, 0x4e, 0xc2, 0x25, 0x99, 0x06, 0x9f, 0xc1, 0x7f, 0xb7, 0x4b, 0x76, 0x7b, 0xbf, 0xe6,
+	0xab, 0x48, 0x7a, 0x6b, 0x2a, 0x97, 0x99, 0xfb, 0x46, 0x0e, 0xcf, 0xa8, 0x5c, 0x62, 0x03, 0x0a,
+	0xa1, 0xf0, 0xd3, 0x9f, 0x90, 0xa8, 0xb2, 0x35, 0x81, 0xc3, 0x27, 0x3d, 0xf1, 0x09, 0x60, 0xc7,
+	0xed, 0xbb, 0x73, 0xc7, 0x9b, 0xdb, 0xce, 0x6c, 0x78, 0x3e, 0xbe, 0x1c, 0x0f, 0x2f, 0x0c, 0x4d,
+	0x79, 0x77, 0xdc, 0x3e, 0x71, 0x0d, 0xa4, 0x66, 0x73, 0xdc, 0xe9, 0xcc, 0x38, 0xc0, 0x0d, 0x80,
+	0x73, 0x6b, 0x78, 0x7e, 0x35, 0x9b, 0x8e, 0x6d, 0xd7, 0x28, 0xb4, 0x2c, 0x38, 0x7a, 0xf1, 0xb6,
+	0xea, 0xa3, 0x92, 0xfa, 0xc9, 0x60, 0x3a, 0x51, 0x25, 0xfe, 0x00, 0xf5, 0x27, 0xbf, 0x88, 0x32,
+	0x5b, 0x27, 0xb5, 0x38, 0x17, 0x0d, 0xce, 0xe0, 0x98, 0xc7, 0xfe, 0x7e, 0x0a, 0x6a, 0x95, 0x07,
+	0xb5, 0x64, 0x0d, 0x67, 0x6a, 0x2b, 0xc5, 0x0c, 0xfd, 0x45, 0xe8, 0x47, 0x39, 0x59, 0xd1, 0x2f,
+	0xff, 0x06, 0x00, 0xca, 0xda, 0x73, 0xa8, 0xfb, 0x03, 0x00, 0x00,
+}
@@ -0,0 +1,278 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import "time"
+
+// Cost is an analytical estimate of the cost of running operations.
+type Cost struct {
+	// FLOPs is the number of arithmetic operations.
+	FLOPs int64
+	// Bytes is the size of the tensors read and written.
+	Bytes int64
+	// ComputeTime is the time needed for FLOPs, and MemoryTime the time
+	// needed to access Bytes, with the throughputs of the CostOptions.
+	ComputeTime, MemoryTime time.Duration
+}
+
+// Time returns the estimated time of running the operations, which
+// neither overlap their computations with their memory accesses nor run
+// concurrently.
+func (c Cost) Time() time.Duration {
+	return c.ComputeTime + c.MemoryTime
+}
+
+func (c *Cost) add(o Cost) {
+	c.FLOPs += o.FLOPs
+	c.Bytes += o.Bytes
+	c.ComputeTime += o.ComputeTime
+	c.MemoryTime += o.MemoryTime
+}
+
+// OpCost is the estimated cost of an operation.
+type OpCost struct {
+	Name, Type string
+	Cost
+	// Estimated is false if the number of FLOPs of the operation could not
+	// be estimated, because its type is not known to EstimateCost or the
+	// shapes of its tensors are not fully known, in which case FLOPs is 0.
+	Estimated bool
+}
+
+// CostEstimate is the estimated cost of a run of a Session.
+type CostEstimate struct {
+	// Cost is the total cost of the run.
+	Cost
+	// Ops are the costs of the operations of the run, each after its
+	// inputs.
+	Ops []OpCost
+	// Fetches are the costs of computing each of the fetches alone.
+	Fetches []Cost
+	// NotEstimated is the number of operations whose FLOPs could not be
+	// estimated.
+	NotEstimated int
+}
+
+// CostOptions describes the device for which costs are estimated.
+type CostOptions struct {
+	// FLOPS is the number of arithmetic operations per second of the
+	// device. Zero means 1e10.
+	FLOPS float64
+	// Bandwidth is the memory bandwidth of the device in bytes per
+	// second. Zero means 1e10.
+	Bandwidth float64
+}
+
+// EstimateCost returns an analytical estimate of the cost of running
+// Run(feeds, fetches, nil), without executing any operation, so that
+// autoscaling or admission control decisions can be based on the cost of
+// requests. As with DryRun, the shapes of the tensors of the run are
+// inferred from those of the fed tensors.
+//
+// The FLOPs of matrix multiplications and convolutions are counted as two
+// operations per multiply-add, and those of element-wise operations,
+// reductions and pooling as one operation per element of their largest
+// tensor. Operations that only forward or reshape their inputs, such as
+// Identity and Reshape, cost nothing.
+func (s *Session) EstimateCost(feeds map[Output]*Tensor, fetches []Output, opts CostOptions) (*CostEstimate, error) {
+	if opts.FLOPS <= 0 {
+		opts.FLOPS = 1e10
+	}
+	if opts.Bandwidth <= 0 {
+		opts.Bandwidth = 1e10
+	}
+	d, err := s.newDryRun(feeds, fetches)
+	if err != nil {
+		return nil, err
+	}
+	defer d.graph.unref()
+	order, err := d.order(fetches)
+	if err != nil {
+		return nil, err
+	}
+	est := &CostEstimate{Ops: make([]OpCost, len(order))}
+	costs := make(map[string]Cost, len(order))
+	for i, n := range order {
+		c := d.opCost(d.graph.Operation(n.name), opts)
+		if !c.Estimated {
+			est.NotEstimated++
+		}
+		est.Ops[i] = c
+		est.add(c.Cost)
+		costs[n.name] = c.Cost
+	}
+	est.Fetches = make([]Cost, len(fetches))
+	for i, o := range fetches {
+		ops, err := d.order([]Output{o})
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range ops {
+			est.Fetches[i].add(costs[n.name])
+		}
+	}
+	return est, nil
+}
+
+// flopsFunc returns the FLOPs of an operation given the shapes of its
+// inputs and outputs, if they can be estimated.
+type flopsFunc func(in, out []Shape) (int64, bool)
+
+// freeOps are the operations without any computation or memory access
+// of their own.
+var freeOps = map[string]bool{
+	"Const":                  true,
+	"ExpandDims":             true,
+	"Identity":               true,
+	"NoOp":                   true,
+	"Placeholder":            true,
+	"PlaceholderWithDefault": true,
+	"Rank":                   true,
+	"Reshape":                true,
+	"Shape":                  true,
+	"Size":                   true,
+	"Squeeze":                true,
+	"StopGradient":           true,
+}
+
+var opFLOPs = map[string]flopsFunc{
+	"BatchMatMul":           matMulFLOPs,
+	"Conv2D":                conv2DFLOPs,
+	"DepthwiseConv2dNative": depthwiseConv2DFLOPs,
+	"MatMul":                matMulFLOPs,
+}
+
+func init() {
+	for _, t := range []string{
+		// Element-wise operations.
+		"Abs", "Add", "AddN", "BiasAdd", "Cast", "Ceil", "Cos", "Div",
+		"Elu", "Equal", "Exp", "Floor", "Greater", "GreaterEqual", "Less",
+		"LessEqual", "Log", "LogicalAnd", "LogicalNot", "LogicalOr",
+		"Maximum", "Minimum", "Mul", "Neg", "NotEqual", "Pow", "RealDiv",
+		"Reciprocal", "Relu", "Relu6", "Rsqrt", "Select", "Sigmoid", "Sin",
+		"Softplus", "Sqrt", "Square", "SquaredDifference", "Sub", "Tanh",
+		// Reductions and pooling.
+		"ArgMax", "ArgMin", "AvgPool", "LogSoftmax", "Max", "MaxPool",
+		"Mean", "Min", "Prod", "Softmax", "Sum",
+	} {
+		opFLOPs[t] = elementwiseFLOPs
+	}
+}
+
+// opCost returns the cost of op, an operation of d.graph.
+func (d *dryRun) opCost(op *Operation, opts CostOptions) OpCost {
+	c := OpCost{Name: op.Name(), Type: op.Type()}
+	if freeOps[c.Type] {
+		c.Estimated = true
+		return c
+	}
+	in := make([]Shape, op.NumInputs())
+	for i := range in {
+		o := op.Input(i)
+		in[i] = o.Shape()
+		if size, ok := d.size(o); ok {
+			c.Bytes += size
+		}
+	}
+	out := make([]Shape, op.NumOutputs())
+	for i := range out {
+		o := op.Output(i)
+		out[i] = o.Shape()
+		if size, ok := d.size(o); ok {
+			c.Bytes += size
+		}
+	}
+	if f, ok := opFLOPs[c.Type]; ok {
+		c.FLOPs, c.Estimated = f(in, out)
+	}
+	c.ComputeTime = time.Duration(float64(c.FLOPs) * float64(time.Second) / opts.FLOPS)
+	c.MemoryTime = time.Duration(float64(c.Bytes) * float64(time.Second) / opts.Bandwidth)
+	return c
+}
+
+// shapeElements returns the number of elements of s, if it is fully known.
+func shapeElements(s Shape) (int64, bool) {
+	if !s.IsFullySpecified() {
+		return 0, false
+	}
+	n := int64(1)
+	for i := 0; i < s.NumDimensions(); i++ {
+		n *= s.Size(i)
+	}
+	return n, true
+}
+
+func elementwiseFLOPs(in, out []Shape) (int64, bool) {
+	var flops int64
+	for _, shapes := range [][]Shape{in, out} {
+		for _, s := range shapes {
+			n, ok := shapeElements(s)
+			if !ok {
+				return 0, false
+			}
+			if n > flops {
+				flops = n
+			}
+		}
+	}
+	return flops, true
+}
+
+// matMulFLOPs counts the multiply-adds of the output [..., m, n] of the
+// product of a [..., m, k] and b [..., k, n], inferring k from the number
+// of elements of a, so that transposed inputs need not be considered.
+func matMulFLOPs(in, out []Shape) (int64, bool) {
+	if len(in) != 2 || len(out) != 1 || out[0].NumDimensions() < 2 {
+		return 0, false
+	}
+	a, ok := shapeElements(in[0])
+	if !ok {
+		return 0, false
+	}
+	c, ok := shapeElements(out[0])
+	if !ok || c == 0 {
+		return 0, ok
+	}
+	n := out[0].Size(out[0].NumDimensions() - 1)
+	k := a * n / c
+	return 2 * c * k, true
+}
+
+// conv2DFLOPs counts the multiply-adds of each output element with a
+// filter of shape [height, width, in_channels, out_channels].
+func conv2DFLOPs(in, out []Shape) (int64, bool) {
+	if len(in) != 2 || len(out) != 1 || in[1].NumDimensions() != 4 || !in[1].IsFullySpecified() {
+		return 0, false
+	}
+	c, ok := shapeElements(out[0])
+	if !ok {
+		return 0, false
+	}
+	return 2 * c * in[1].Size(0) * in[1].Size(1) * in[1].Size(2), true
+}
+
+// depthwiseConv2DFLOPs counts the multiply-adds of each output element
+// with a filter of shape [height, width, in_channels, multiplier], each
+// output channel depending on a single input channel.
+func depthwiseConv2DFLOPs(in, out []Shape) (int64, bool) {
+	if len(in) != 2 || len(out) != 1 || in[1].NumDimensions() != 4 || !in[1].IsFullySpecified() {
+		return 0, false
+	}
+	c, ok := shapeElements(out[0])
+	if !ok {
+		return 0, false
+	}
+	return 2 * c * in[1].Size(0) * in[1].Size(1), true
+}
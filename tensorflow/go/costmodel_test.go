@@ -0,0 +1,119 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEstimateCost(t *testing.T) {
+	g := NewGraph()
+	x, err := Placeholder(g, "x", Float)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := Const(g, "w", [][]float32{{1, 2}, {3, 4}, {5, 6}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	matmul, err := g.AddOperation(OpSpec{Type: "MatMul", Name: "y", Input: []Input{x, w}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	y := matmul.Output(0)
+	z, err := Neg(g, "z", y)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewSession(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	in, err := NewTensor([][]float32{{1, 2, 3}, {1, 2, 3}, {1, 2, 3}, {1, 2, 3}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := CostOptions{FLOPS: 1e9, Bandwidth: 1e9}
+	est, err := s.EstimateCost(map[Output]*Tensor{x: in}, []Output{z, y}, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// y = x·w computes 4x2 elements of 3 multiply-adds, reading 4x3 and
+	// 3x2 floats and writing 4x2 floats. z = -y reads and writes 4x2
+	// floats.
+	want := map[string]Cost{
+		"x": {},
+		"w": {},
+		"y": {FLOPs: 48, Bytes: 104, ComputeTime: 48 * time.Nanosecond, MemoryTime: 104 * time.Nanosecond},
+		"z": {FLOPs: 8, Bytes: 64, ComputeTime: 8 * time.Nanosecond, MemoryTime: 64 * time.Nanosecond},
+	}
+	if len(est.Ops) != len(want) {
+		t.Fatalf("Got %d operations, want %d: %v", len(est.Ops), len(want), est.Ops)
+	}
+	for _, op := range est.Ops {
+		if !op.Estimated {
+			t.Errorf("Cost of %s not estimated", op.Name)
+		}
+		if op.Cost != want[op.Name] {
+			t.Errorf("Got cost %+v for %s, want %+v", op.Cost, op.Name, want[op.Name])
+		}
+	}
+	if got, want := est.FLOPs, int64(56); got != want {
+		t.Errorf("Got %d FLOPs, want %d", got, want)
+	}
+	if got, want := est.Time(), 224*time.Nanosecond; got != want {
+		t.Errorf("Got time %v, want %v", got, want)
+	}
+	if got, want := est.Fetches[0].FLOPs, int64(56); got != want {
+		t.Errorf("Got %d FLOPs for z, want %d", got, want)
+	}
+	if got, want := est.Fetches[1].FLOPs, int64(48); got != want {
+		t.Errorf("Got %d FLOPs for y, want %d", got, want)
+	}
+
+	// Without feeds, the shape of x is unknown.
+	est, err = s.EstimateCost(nil, []Output{z}, CostOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := est.NotEstimated, 2; got != want {
+		t.Errorf("Got %d operations not estimated, want %d", got, want)
+	}
+}
+
+func TestMatMulFLOPs(t *testing.T) {
+	tests := []struct {
+		a, b, out Shape
+		want      int64
+	}{
+		{MakeShape(2, 3), MakeShape(3, 4), MakeShape(2, 4), 48},
+		// Transposed a.
+		{MakeShape(3, 2), MakeShape(3, 4), MakeShape(2, 4), 48},
+		// Batched.
+		{MakeShape(5, 2, 3), MakeShape(5, 3, 4), MakeShape(5, 2, 4), 240},
+	}
+	for _, test := range tests {
+		got, ok := matMulFLOPs([]Shape{test.a, test.b}, []Shape{test.out})
+		if !ok || got != test.want {
+			t.Errorf("matMulFLOPs(%v, %v): got %d, %v, want %d", test.a, test.b, got, ok, test.want)
+		}
+	}
+	if _, ok := matMulFLOPs([]Shape{MakeShape(-1, 3), MakeShape(3, 4)}, []Shape{MakeShape(-1, 4)}); ok {
+		t.Error("matMulFLOPs estimated the FLOPs of a partially known shape")
+	}
+}
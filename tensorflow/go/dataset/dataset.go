@@ -0,0 +1,125 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dataset serves in-memory datasets from the variables of a
+// Session, so that large static tensors are fed once rather than with
+// every request.
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package dataset
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+// Iterator iterates over the elements of a dataset held in the variables
+// of a Session. The dataset is a tuple of tensors, its components, sharing
+// their leading dimension: the i-th element of the dataset is the tuple of
+// the i-th slices of the components.
+//
+// The dataset is fed once, by Initialize, after which every call to Next,
+// and every run fetching or using the outputs of Element, consumes the next
+// element. Elements are consumed atomically, so concurrent runs get distinct
+// elements, but Initialize must not run concurrently with them.
+type Iterator struct {
+	placeholders []tf.Output
+	init         []*tf.Operation
+	element      []tf.Output
+}
+
+// NewIterator adds to the graph of scope the operations of an Iterator
+// over datasets whose components are tensors of the given types.
+func NewIterator(scope *op.Scope, types ...tf.DataType) *Iterator {
+	it := new(Iterator)
+	if scope.Err() != nil {
+		return it
+	}
+	if len(types) == 0 {
+		scope.UpdateErr("NewIterator", errors.New("at least one component type must be provided"))
+		return it
+	}
+	s := scope.SubScope("position")
+	position := op.Raw(s, "VariableV2", nil, map[string]interface{}{"dtype": tf.Int64, "shape": tf.ScalarShape()})
+	if s.Err() != nil {
+		return it
+	}
+	it.init = append(it.init, op.Raw(s, "Assign", []tf.Input{position.Output(0), op.Const(s, int64(0))}, nil))
+	// CountUpTo returns the position before the increment atomically.
+	index := op.Raw(s, "CountUpTo", []tf.Input{position.Output(0)}, map[string]interface{}{"limit": int64(math.MaxInt64)})
+	if s.Err() != nil {
+		return it
+	}
+	for _, dt := range types {
+		s := scope.SubScope("component")
+		v := op.Raw(s, "VariableV2", nil, map[string]interface{}{"dtype": dt, "shape": tf.Shape{}})
+		if s.Err() != nil {
+			return it
+		}
+		p := op.Placeholder(s, dt)
+		it.placeholders = append(it.placeholders, p)
+		it.init = append(it.init, op.Raw(s, "Assign", []tf.Input{v.Output(0), p}, map[string]interface{}{"validate_shape": false}))
+		it.element = append(it.element, op.Gather(s, v.Output(0), index.Output(0)))
+	}
+	return it
+}
+
+// Initialize feeds the components of a dataset to session, and resets the
+// position of the iterator to the first element.
+func (it *Iterator) Initialize(session *tf.Session, components ...*tf.Tensor) error {
+	if len(components) != len(it.placeholders) {
+		return fmt.Errorf("got %d components, want %d", len(components), len(it.placeholders))
+	}
+	feeds := make(map[tf.Output]*tf.Tensor, len(components))
+	for i, c := range components {
+		if c.DataType() != it.placeholders[i].DataType() {
+			return fmt.Errorf("component %d: got a %v Tensor, want %v", i, c.DataType(), it.placeholders[i].DataType())
+		}
+		if len(c.Shape()) == 0 || c.Shape()[0] != components[0].Shape()[0] {
+			return fmt.Errorf("component %d: shape %v does not have the leading dimension of the dataset %v", i, c.Shape(), components[0].Shape())
+		}
+		feeds[it.placeholders[i]] = c
+	}
+	_, err := session.Run(feeds, nil, it.init)
+	return err
+}
+
+// Element returns the outputs producing the components of the next element
+// of the dataset. Runs fetching or using them, for example to feed a model
+// with the element, consume it; such runs fail with an InvalidArgument
+// error once the dataset is exhausted.
+func (it *Iterator) Element() []tf.Output {
+	return it.element
+}
+
+// Next returns the components of the next element of the dataset, or
+// io.EOF once the dataset is exhausted.
+func (it *Iterator) Next(session *tf.Session) ([]*tf.Tensor, error) {
+	out, err := session.Run(nil, it.element, nil)
+	var serr *tf.StatusError
+	if errors.As(err, &serr) && serr.Code == tf.InvalidArgument {
+		for _, o := range it.element {
+			if serr.Op == o.Op.Name() {
+				return nil, io.EOF
+			}
+		}
+	}
+	return out, err
+}
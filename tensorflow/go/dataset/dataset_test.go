@@ -0,0 +1,88 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataset
+
+import (
+	"io"
+	"reflect"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+func TestIterator(t *testing.T) {
+	s := op.NewScope()
+	it := NewIterator(s.SubScope("dataset"), tf.Float, tf.String)
+	// A model using the elements of the dataset.
+	doubled := op.Mul(s, it.Element()[0], op.Const(s, float32(2)))
+	graph, err := s.Finalize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sess, err := tf.NewSession(graph, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sess.Close()
+	features, err := tf.NewTensor([][]float32{{1, 2}, {3, 4}, {5, 6}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	labels, err := tf.NewTensor([]string{"a", "b", "c"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for epoch := 0; epoch < 2; epoch++ {
+		if err := it.Initialize(sess, features, labels); err != nil {
+			t.Fatal(err)
+		}
+		element, err := it.Next(sess)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := element[0].Value(), []float32{1, 2}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Got %v, want %v", got, want)
+		}
+		if got, want := element[1].Value(), "a"; got != want {
+			t.Errorf("Got %v, want %v", got, want)
+		}
+		out, err := sess.Run(nil, []tf.Output{doubled}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := out[0].Value(), []float32{6, 8}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Got %v, want %v", got, want)
+		}
+		if element, err = it.Next(sess); err != nil || element[1].Value() != "c" {
+			t.Errorf("Got %v, %v; want the last element", element, err)
+		}
+		if _, err := it.Next(sess); err != io.EOF {
+			t.Errorf("Got %v, want io.EOF", err)
+		}
+	}
+
+	short, err := tf.NewTensor([]string{"a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := it.Initialize(sess, features, short); err == nil {
+		t.Error("Initialize accepted components of different lengths")
+	}
+	if err := it.Initialize(sess, features); err == nil {
+		t.Error("Initialize accepted a missing component")
+	}
+}
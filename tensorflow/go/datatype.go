@@ -0,0 +1,327 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+// #include "tensorflow/c/c_api.h"
+import "C"
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// dataTypeNames lists the names of each DataType, as used by the Python API
+// (e.g., tf.float32) and by the DataType enum of the protocol buffers
+// (https://www.tensorflow.org/code/tensorflow/core/framework/types.proto).
+var dataTypeNames = []struct {
+	dt    DataType
+	name  string
+	proto string
+}{
+	{Float, "float32", "DT_FLOAT"},
+	{Double, "float64", "DT_DOUBLE"},
+	{Int32, "int32", "DT_INT32"},
+	{Uint8, "uint8", "DT_UINT8"},
+	{Int16, "int16", "DT_INT16"},
+	{Int8, "int8", "DT_INT8"},
+	{String, "string", "DT_STRING"},
+	{Complex64, "complex64", "DT_COMPLEX64"},
+	{Int64, "int64", "DT_INT64"},
+	{Bool, "bool", "DT_BOOL"},
+	{Qint8, "qint8", "DT_QINT8"},
+	{Quint8, "quint8", "DT_QUINT8"},
+	{Qint32, "qint32", "DT_QINT32"},
+	{Bfloat16, "bfloat16", "DT_BFLOAT16"},
+	{Qint16, "qint16", "DT_QINT16"},
+	{Quint16, "quint16", "DT_QUINT16"},
+	{Uint16, "uint16", "DT_UINT16"},
+	{Complex128, "complex128", "DT_COMPLEX128"},
+	{Half, "float16", "DT_HALF"},
+	{Resource, "resource", "DT_RESOURCE"},
+}
+
+// dataTypeAliases are names accepted by ParseDataType in addition to those
+// in dataTypeNames.
+var dataTypeAliases = map[string]DataType{
+	"float":  Float,
+	"double": Double,
+	"half":   Half,
+}
+
+// DataTypeInfo describes a DataType unknown to this package, such as an
+// experimental type of a research build of the TensorFlow runtime.
+type DataTypeInfo struct {
+	// Name and Proto are the names of the DataType, as used by the
+	// Python API and by the protocol buffers, e.g. "posit16" and
+	// "DT_POSIT16".
+	Name, Proto string
+	// Size is the size in bytes of a single element.
+	Size int
+}
+
+// registeredTypes holds the DataTypes registered with RegisterDataType.
+var registeredTypes struct {
+	sync.RWMutex
+	m map[DataType]DataTypeInfo
+}
+
+// RegisterDataType makes a DataType unknown to this package usable, for
+// runtimes built with types that this package does not support. Tensors
+// of the DataType can then be created with ReadTensor from their raw
+// contents, in the layout expected by the kernels of the runtime, and
+// serialized with WriteContentsTo, but not converted from or to Go values.
+// The DataType is also accepted by ParseDataType and as the value of
+// attributes such as the dtype of Placeholder operations.
+//
+// RegisterDataType is meant to be called during initialization. It returns
+// an error if the DataType or its names are already known, or if Size is
+// not that of the DataType in the runtime.
+func RegisterDataType(dt DataType, info DataTypeInfo) error {
+	if info.Name == "" || info.Proto == "" || info.Size <= 0 {
+		return fmt.Errorf("DataTypeInfo %+v must have names and a positive size", info)
+	}
+	for _, n := range dataTypeNames {
+		if n.dt == dt {
+			return fmt.Errorf("DataType %v is already known", dt)
+		}
+	}
+	for _, name := range []string{info.Name, info.Proto} {
+		if _, err := ParseDataType(name); err == nil {
+			return fmt.Errorf("DataType %q is already known", name)
+		}
+	}
+	if size := int(C.TF_DataTypeSize(C.TF_DataType(dt))); size != 0 && size != info.Size {
+		return fmt.Errorf("DataType(%d) has elements of %d bytes in the runtime, not %d", int(dt), size, info.Size)
+	}
+	registeredTypes.Lock()
+	defer registeredTypes.Unlock()
+	if _, ok := registeredTypes.m[dt]; ok {
+		return fmt.Errorf("DataType(%d) is already registered", int(dt))
+	}
+	if registeredTypes.m == nil {
+		registeredTypes.m = make(map[DataType]DataTypeInfo)
+	}
+	registeredTypes.m[dt] = info
+	return nil
+}
+
+// registeredType returns the DataTypeInfo of a DataType registered with
+// RegisterDataType.
+func registeredType(dt DataType) (DataTypeInfo, bool) {
+	registeredTypes.RLock()
+	defer registeredTypes.RUnlock()
+	info, ok := registeredTypes.m[dt]
+	return info, ok
+}
+
+// String returns the name of the DataType used by the Python API, for
+// example "float32".
+func (dt DataType) String() string {
+	for _, n := range dataTypeNames {
+		if n.dt == dt {
+			return n.name
+		}
+	}
+	if info, ok := registeredType(dt); ok {
+		return info.Name
+	}
+	return fmt.Sprintf("DataType(%d)", int(dt))
+}
+
+// ParseDataType returns the DataType with the given name, which may be
+// either the name used by the Python API (e.g., "float32") or by the
+// protocol buffers (e.g., "DT_FLOAT").
+func ParseDataType(name string) (DataType, error) {
+	for _, n := range dataTypeNames {
+		if n.name == name || n.proto == name {
+			return n.dt, nil
+		}
+	}
+	if dt, ok := dataTypeAliases[name]; ok {
+		return dt, nil
+	}
+	registeredTypes.RLock()
+	defer registeredTypes.RUnlock()
+	for dt, info := range registeredTypes.m {
+		if info.Name == name || info.Proto == name {
+			return dt, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown DataType %q", name)
+}
+
+// Size returns the size in bytes of a single element of the DataType, or 0
+// if elements do not have a fixed size (as is the case for String).
+func (dt DataType) Size() int {
+	if size := int(C.TF_DataTypeSize(C.TF_DataType(dt))); size != 0 {
+		return size
+	}
+	info, _ := registeredType(dt)
+	return info.Size
+}
+
+// IsFloating returns true for floating point DataTypes.
+func (dt DataType) IsFloating() bool {
+	switch dt {
+	case Float, Double, Half, Bfloat16:
+		return true
+	}
+	return false
+}
+
+// IsInteger returns true for (non-quantized) integer DataTypes.
+func (dt DataType) IsInteger() bool {
+	switch dt {
+	case Int8, Int16, Int32, Int64, Uint8, Uint16:
+		return true
+	}
+	return false
+}
+
+// IsQuantized returns true for quantized integer DataTypes.
+func (dt DataType) IsQuantized() bool {
+	switch dt {
+	case Qint8, Quint8, Qint16, Quint16, Qint32:
+		return true
+	}
+	return false
+}
+
+// DataTypeOf returns the DataType of the elements of Tensors created by
+// NewTensor from values of type typ. typ may be a scalar type, or an
+// arbitrarily nested array or slice of one.
+func DataTypeOf(typ reflect.Type) (DataType, error) {
+	for typ.Kind() == reflect.Array || typ.Kind() == reflect.Slice {
+		typ = typ.Elem()
+	}
+	for _, t := range types {
+		if typ.Kind() == t.typ.Kind() {
+			return DataType(t.dataType), nil
+		}
+	}
+	return 0, fmt.Errorf("unsupported type %v", typ)
+}
+
+// GoType returns the Go type of the scalar values of the DataType, as
+// returned by Tensor.Value, or an error if the DataType has no Go
+// representation.
+func (dt DataType) GoType() (reflect.Type, error) {
+	return typeOf(dt, nil)
+}
+
+// PromoteTypes returns the DataType to which values of types a and b are
+// converted when they are combined, for example to cast the operands of an
+// arithmetic operation to a common type. The rules are those of NumPy, which
+// TensorFlow follows: the result is the smallest type that can represent all
+// the values of both types, with Bool converting to any type, and integers
+// converting to floating point types whose mantissa can represent them
+// exactly (e.g., Int16 and Half promote to Float, Int32 and Float to Double).
+//
+// An error is returned for quantized, String and Resource types, which are
+// only compatible with themselves.
+func PromoteTypes(a, b DataType) (DataType, error) {
+	if a == b {
+		return a, nil
+	}
+	ka, kb := promotionKind(a), promotionKind(b)
+	if ka < 0 || kb < 0 {
+		return 0, fmt.Errorf("%v and %v cannot be promoted to a common type", a, b)
+	}
+	if ka > kb {
+		a, b, ka, kb = b, a, kb, ka
+	}
+	switch {
+	case ka == kindBool:
+		return b, nil
+	case kb == kindComplex:
+		ca, cb := a, complexComponent(b)
+		if ka == kindComplex {
+			ca = complexComponent(a)
+		}
+		if c, _ := PromoteTypes(ca, cb); c == Double {
+			return Complex128, nil
+		}
+		return Complex64, nil
+	case kb == kindFloat && ka == kindFloat:
+		if a.Size() != b.Size() {
+			return largest(a, b), nil
+		}
+		return Float, nil // Half and Bfloat16.
+	case kb == kindFloat:
+		// The smallest type whose mantissa represents the integers of a.
+		f := Double
+		switch a.Size() {
+		case 1:
+			f = Half
+		case 2:
+			f = Float
+		}
+		return PromoteTypes(f, b)
+	case ka == kb:
+		return largest(a, b), nil
+	default:
+		// Unsigned a and signed b.
+		if b.Size() > a.Size() {
+			return b, nil
+		}
+		switch a.Size() {
+		case 1:
+			return Int16, nil
+		case 2:
+			return Int32, nil
+		}
+		return Int64, nil
+	}
+}
+
+// Kinds of DataTypes, ordered for PromoteTypes.
+const (
+	kindBool = iota
+	kindUnsigned
+	kindSigned
+	kindFloat
+	kindComplex
+)
+
+func promotionKind(dt DataType) int {
+	switch dt {
+	case Bool:
+		return kindBool
+	case Uint8, Uint16:
+		return kindUnsigned
+	case Int8, Int16, Int32, Int64:
+		return kindSigned
+	case Half, Bfloat16, Float, Double:
+		return kindFloat
+	case Complex64, Complex128:
+		return kindComplex
+	}
+	return -1
+}
+
+func complexComponent(dt DataType) DataType {
+	if dt == Complex128 {
+		return Double
+	}
+	return Float
+}
+
+func largest(a, b DataType) DataType {
+	if a.Size() > b.Size() {
+		return a
+	}
+	return b
+}
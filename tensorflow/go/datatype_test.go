@@ -0,0 +1,211 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestParseDataType(t *testing.T) {
+	for _, n := range dataTypeNames {
+		for _, name := range []string{n.name, n.proto} {
+			if dt, err := ParseDataType(name); err != nil || dt != n.dt {
+				t.Errorf("ParseDataType(%q) = (%v, %v), want %v", name, dt, err, n.dt)
+			}
+		}
+		if got := n.dt.String(); got != n.name {
+			t.Errorf("%d.String() = %q, want %q", int(n.dt), got, n.name)
+		}
+	}
+	if dt, err := ParseDataType("double"); err != nil || dt != Double {
+		t.Errorf("ParseDataType(\"double\") = (%v, %v), want %v", dt, err, Double)
+	}
+	for _, name := range []string{"", "Float32", "DT_FLOAT_REF", "int"} {
+		if dt, err := ParseDataType(name); err == nil {
+			t.Errorf("ParseDataType(%q) = %v, want error", name, dt)
+		}
+	}
+	if got, want := DataType(100).String(), "DataType(100)"; got != want {
+		t.Errorf("Got %q, want %q", got, want)
+	}
+}
+
+func TestDataTypeSize(t *testing.T) {
+	tests := map[DataType]int{
+		Float:      4,
+		Double:     8,
+		Int8:       1,
+		Half:       2,
+		Bool:       1,
+		Complex128: 16,
+		String:     0,
+	}
+	for dt, want := range tests {
+		if got := dt.Size(); got != want {
+			t.Errorf("%v.Size() = %d, want %d", dt, got, want)
+		}
+	}
+}
+
+func TestDataTypePredicates(t *testing.T) {
+	for _, n := range dataTypeNames {
+		var kinds int
+		for _, is := range []bool{n.dt.IsFloating(), n.dt.IsInteger(), n.dt.IsQuantized()} {
+			if is {
+				kinds++
+			}
+		}
+		if kinds > 1 {
+			t.Errorf("%v has more than one kind", n.dt)
+		}
+	}
+	if !Half.IsFloating() || Int32.IsFloating() {
+		t.Error("IsFloating")
+	}
+	if !Uint16.IsInteger() || Qint8.IsInteger() || Bool.IsInteger() {
+		t.Error("IsInteger")
+	}
+	if !Quint8.IsQuantized() || Uint8.IsQuantized() {
+		t.Error("IsQuantized")
+	}
+}
+
+func TestDataTypeOf(t *testing.T) {
+	type myFloat float32
+	tests := []struct {
+		value interface{}
+		want  DataType
+	}{
+		{int64(1), Int64},
+		{"", String},
+		{[]float64{}, Double},
+		{[2][]complex64{}, Complex64},
+		{myFloat(1), Float},
+		{[]byte{}, Uint8},
+	}
+	for _, test := range tests {
+		typ := reflect.TypeOf(test.value)
+		dt, err := DataTypeOf(typ)
+		if err != nil || dt != test.want {
+			t.Errorf("DataTypeOf(%v) = (%v, %v), want %v", typ, dt, err, test.want)
+		}
+		// The Go type of each DataType maps back to it.
+		goType, err := test.want.GoType()
+		if err != nil {
+			t.Errorf("%v.GoType(): %v", test.want, err)
+			continue
+		}
+		if dt, err := DataTypeOf(goType); err != nil || dt != test.want {
+			t.Errorf("DataTypeOf(%v) = (%v, %v), want %v", goType, dt, err, test.want)
+		}
+	}
+	for _, v := range []interface{}{int(1), uint32(1), struct{}{}, []*int32{}} {
+		if dt, err := DataTypeOf(reflect.TypeOf(v)); err == nil {
+			t.Errorf("DataTypeOf(%T) = %v, want error", v, dt)
+		}
+	}
+	if typ, err := Qint8.GoType(); err == nil {
+		t.Errorf("Qint8.GoType() = %v, want error", typ)
+	}
+}
+
+func TestPromoteTypes(t *testing.T) {
+	tests := []struct {
+		a, b, want DataType
+	}{
+		{Float, Float, Float},
+		{Bool, Int8, Int8},
+		{Int8, Int32, Int32},
+		{Uint8, Uint16, Uint16},
+		{Uint8, Int8, Int16},
+		{Uint16, Int16, Int32},
+		{Uint8, Int32, Int32},
+		{Int8, Half, Half},
+		{Int16, Half, Float},
+		{Int32, Float, Double},
+		{Uint8, Bfloat16, Bfloat16},
+		{Half, Bfloat16, Float},
+		{Half, Double, Double},
+		{Int8, Complex64, Complex64},
+		{Int32, Complex64, Complex128},
+		{Double, Complex64, Complex128},
+		{Complex64, Complex128, Complex128},
+	}
+	for _, test := range tests {
+		for _, args := range [][2]DataType{{test.a, test.b}, {test.b, test.a}} {
+			if got, err := PromoteTypes(args[0], args[1]); err != nil || got != test.want {
+				t.Errorf("PromoteTypes(%v, %v) = (%v, %v), want %v", args[0], args[1], got, err, test.want)
+			}
+		}
+	}
+	for _, args := range [][2]DataType{{String, Float}, {Qint8, Int8}, {Resource, Bool}} {
+		if got, err := PromoteTypes(args[0], args[1]); err == nil {
+			t.Errorf("PromoteTypes(%v, %v) = %v, want error", args[0], args[1], got)
+		}
+	}
+}
+
+func TestRegisterDataType(t *testing.T) {
+	posit16 := DataType(120)
+	if err := RegisterDataType(posit16, DataTypeInfo{Name: "posit16", Proto: "DT_POSIT16", Size: 2}); err != nil {
+		t.Fatal(err)
+	}
+	for _, info := range []DataTypeInfo{
+		{Name: "posit16", Proto: "DT_POSIT16", Size: 2},
+		{Name: "posit8", Proto: "DT_POSIT8"},
+		{Name: "float32", Proto: "DT_POSIT8", Size: 1},
+	} {
+		if err := RegisterDataType(DataType(121), info); err == nil {
+			t.Errorf("RegisterDataType(%+v) succeeded, want error", info)
+		}
+	}
+	if err := RegisterDataType(Float, DataTypeInfo{Name: "f", Proto: "DT_F", Size: 4}); err == nil {
+		t.Error("Registering Float succeeded, want error")
+	}
+
+	if got, want := posit16.String(), "posit16"; got != want {
+		t.Errorf("Got %q, want %q", got, want)
+	}
+	if dt, err := ParseDataType("DT_POSIT16"); err != nil || dt != posit16 {
+		t.Errorf("ParseDataType(\"DT_POSIT16\") = (%v, %v), want %v", dt, err, posit16)
+	}
+	if got, want := posit16.Size(), 2; got != want {
+		t.Errorf("Got size %d, want %d", got, want)
+	}
+
+	contents := []byte{1, 2, 3, 4, 5, 6}
+	tensor, err := ReadTensor(posit16, []int64{3}, bytes.NewReader(contents))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tensor.DataType() != posit16 {
+		t.Errorf("Got DataType %v, want %v", tensor.DataType(), posit16)
+	}
+	var buf bytes.Buffer
+	if _, err := tensor.WriteContentsTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), contents) {
+		t.Errorf("Got contents %v, want %v", buf.Bytes(), contents)
+	}
+	if _, err := tensor.DecodeValue(); err == nil {
+		t.Error("DecodeValue succeeded, want error")
+	}
+	if _, err := encodeAttrValue(tensor); err != nil {
+		t.Errorf("Encoding an attribute value: %v", err)
+	}
+}
@@ -0,0 +1,427 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// DryRunResult describes a run of a Session validated by DryRun.
+type DryRunResult struct {
+	// Shapes are the shapes of the fetches, as inferred from the shapes
+	// of the fed tensors. Dimensions that depend on the values of
+	// tensors are unknown.
+	Shapes []Shape
+	// PeakBytes is an estimate of the peak size of the tensors alive
+	// during the run, assuming that operations run one at a time and
+	// that each tensor is freed once all the operations using it have
+	// run. It does not account for the memory used by the kernels
+	// themselves nor for variables.
+	PeakBytes int64
+	// Unknown is the number of tensors whose size could not be inferred,
+	// such as String tensors and those whose shape is not fully known,
+	// which are not included in PeakBytes.
+	Unknown int
+}
+
+// DryRun validates a run of the session, as Run(feeds, fetches, nil) would
+// perform it, without executing any operation. It checks that the fed
+// tensors have the type and shape of the outputs they are fed to, infers
+// the shapes of all the tensors of the run from those of the fed tensors,
+// returning the errors that this inference reports (such as the
+// mismatching dimensions of a matrix multiplication), checks that the
+// devices requested by the operations are valid device names, and
+// estimates the memory needed by the run.
+//
+// DryRun does not check that the requested devices are available, and
+// the inferred shapes only reflect the shape functions of the operations:
+// a successful DryRun does not guarantee a successful Run. It is meant
+// for admission control, for example to reject requests whose tensors
+// would not fit in memory before running them.
+func (s *Session) DryRun(feeds map[Output]*Tensor, fetches []Output) (*DryRunResult, error) {
+	d, err := s.newDryRun(feeds, fetches)
+	if err != nil {
+		return nil, err
+	}
+	defer d.graph.unref()
+	order, err := d.order(fetches)
+	if err != nil {
+		return nil, err
+	}
+	res := &DryRunResult{Shapes: make([]Shape, len(fetches))}
+	for i, o := range fetches {
+		res.Shapes[i] = d.output(o).Shape()
+	}
+	res.PeakBytes, res.Unknown = d.peakBytes(order, fetches)
+	return res, nil
+}
+
+// newDryRun validates feeds and fetches and returns the dryRun of the
+// graph of s with feeds, whose graph must be released with unref.
+func (s *Session) newDryRun(feeds map[Output]*Tensor, fetches []Output) (*dryRun, error) {
+	s.mu.Lock()
+	closed := s.c == nil
+	s.mu.Unlock()
+	if closed {
+		return nil, errors.New("session is closed")
+	}
+	for o, t := range feeds {
+		if err := s.checkOutput(o); err != nil {
+			return nil, fmt.Errorf("invalid feed: %v", err)
+		}
+		if t == nil {
+			return nil, fmt.Errorf("nil Tensor fed to %s", inputName(o))
+		}
+		if got, want := t.DataType(), o.DataType(); got != want {
+			return nil, fmt.Errorf("%v Tensor fed to %s, which has type %v", got, inputName(o), want)
+		}
+		if shape := MakeShape(t.Shape()...); !shape.IsCompatibleWith(o.Shape()) {
+			return nil, fmt.Errorf("%v Tensor fed to %s, which has shape %v", shape, inputName(o), o.Shape())
+		}
+	}
+	for _, o := range fetches {
+		if err := s.checkOutput(o); err != nil {
+			return nil, fmt.Errorf("invalid fetch: %v", err)
+		}
+	}
+	g, err := s.dryRuns.get(s.graph, feeds)
+	if err != nil {
+		return nil, err
+	}
+	d := &dryRun{dryGraph: g, fed: make(map[tensorID]int64, len(feeds))}
+	for o, t := range feeds {
+		d.fed[outputID(o)] = int64(len(tensorData(t.c)))
+	}
+	return d, nil
+}
+
+// maxDryGraphs is the number of dry-run graphs cached by a Session.
+const maxDryGraphs = 16
+
+// dryGraphCache holds the dry-run graphs of a Session by the outputs fed
+// and the shapes of the tensors fed to them, so that the graph of the
+// Session is only serialized and imported again for new feeds or once
+// operations have been added to it.
+type dryGraphCache struct {
+	mu      sync.Mutex
+	changes uint64 // The changes of the graph of the Session cached.
+	graphs  map[string]*dryGraph
+	closed  bool
+}
+
+// get returns the dry-run graph of g with feeds, referenced for the
+// caller, who must release it with unref.
+func (c *dryGraphCache) get(g *Graph, feeds map[Output]*Tensor) (*dryGraph, error) {
+	keys := make([]string, 0, len(feeds))
+	for o, t := range feeds {
+		keys = append(keys, fmt.Sprintf("%s %v %v", inputName(o), t.DataType(), t.Shape()))
+	}
+	sort.Strings(keys)
+	key := strings.Join(keys, "\n")
+	// Read before serializing the graph, so that operations added
+	// meanwhile invalidate the graph built.
+	changes := atomic.LoadUint64(&g.changes)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return nil, errors.New("session is closed")
+	}
+	if changes != c.changes {
+		c.reset()
+		c.changes = changes
+	}
+	d, ok := c.graphs[key]
+	if !ok {
+		var buf bytes.Buffer
+		if _, err := g.WriteTo(&buf); err != nil {
+			return nil, err
+		}
+		var err error
+		if d, err = importDryRun(buf.Bytes(), feeds); err != nil {
+			return nil, err
+		}
+		if len(c.graphs) >= maxDryGraphs {
+			c.reset()
+		}
+		if c.graphs == nil {
+			c.graphs = make(map[string]*dryGraph)
+		}
+		c.graphs[key] = d
+	}
+	// The graph is only closed with c.mu held, so it is still open.
+	if err := d.graph.ref(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// close closes the cached graphs and stops caching new ones, once the
+// Session is closed.
+func (c *dryGraphCache) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reset()
+	c.closed = true
+}
+
+// reset closes the cached graphs, which are deleted once the dry runs using
+// them are done. c.mu must be held.
+func (c *dryGraphCache) reset() {
+	for _, d := range c.graphs {
+		d.graph.Close()
+	}
+	c.graphs = nil
+}
+
+// checkOutput returns an error if o is not an output of the graph of s.
+func (s *Session) checkOutput(o Output) error {
+	if o.Op == nil {
+		return errors.New("Output has no Operation")
+	}
+	if o.Op.g != s.graph {
+		return fmt.Errorf("%s is not in the graph of the session", o.Op.Name())
+	}
+	if o.Index < 0 || o.Index >= o.Op.NumOutputs() {
+		return fmt.Errorf("operation %s has no output %d", o.Op.Name(), o.Index)
+	}
+	return nil
+}
+
+// dryNode is a NodeDef of the graph of a dry run.
+type dryNode struct {
+	name   string
+	inputs []tensorID // Including control inputs, of index -1.
+	device string
+}
+
+// tensorID identifies an output by the name of its operation and its
+// index, as parsed by ParseTensorName.
+type tensorID struct {
+	op    string
+	index int
+}
+
+func outputID(o Output) tensorID {
+	return tensorID{o.Op.Name(), o.Index}
+}
+
+// dryGraph is the graph of a Session in which the operations whose only
+// output is fed are replaced by Placeholders with the shape of the fed
+// tensors, so that importing it infers the shapes of the run.
+type dryGraph struct {
+	graph *Graph
+	nodes map[string]*dryNode
+}
+
+// dryRun is a run of a Session on a dryGraph.
+type dryRun struct {
+	*dryGraph
+	fed map[tensorID]int64 // Sizes of the fed tensors.
+}
+
+func importDryRun(graphDef []byte, feeds map[Output]*Tensor) (*dryGraph, error) {
+	d := &dryGraph{nodes: make(map[string]*dryNode)}
+	// The operations replaced by Placeholders.
+	replaced := make(map[string]*Tensor)
+	for o, t := range feeds {
+		if o.Op.NumOutputs() == 1 {
+			replaced[o.Op.Name()] = t
+		}
+	}
+	var def []byte
+	err := parseMessage(graphDef, func(field int, v uint64, b []byte) error {
+		if field != 1 { // node
+			def = appendBytesField(def, field, b)
+			return nil
+		}
+		n := new(dryNode)
+		err := parseMessage(b, func(field int, v uint64, b []byte) error {
+			switch field {
+			case 1:
+				n.name = string(b)
+			case 3:
+				op, index, err := ParseTensorName(string(b))
+				n.inputs = append(n.inputs, tensorID{op, index})
+				return err
+			case 4:
+				n.device = string(b)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		d.nodes[n.name] = n
+		t, ok := replaced[n.name]
+		if !ok {
+			def = appendBytesField(def, 1, b)
+			return nil
+		}
+		n.inputs = nil
+		node := appendBytesField(nil, 1, []byte(n.name))
+		node = appendBytesField(node, 2, []byte("Placeholder"))
+		if n.device != "" {
+			node = appendBytesField(node, 4, []byte(n.device))
+		}
+		attrs := map[string]interface{}{"dtype": t.DataType(), "shape": MakeShape(t.Shape()...)}
+		for _, name := range []string{"dtype", "shape"} {
+			v, err := encodeAttrValue(attrs[name])
+			if err != nil {
+				return err
+			}
+			entry := appendBytesField(nil, 1, []byte(name))
+			entry = appendBytesField(entry, 2, v)
+			node = appendBytesField(node, 5, entry)
+		}
+		def = appendBytesField(def, 1, node)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid GraphDef: %v", err)
+	}
+	d.graph = NewGraph()
+	if err := d.graph.Import(def, ""); err != nil {
+		d.graph.Close()
+		return nil, err
+	}
+	return d, nil
+}
+
+// validDevice matches the device names of TensorFlow, such as
+// "/job:worker/replica:0/task:1/device:GPU:0" or "/cpu:0".
+var validDevice = regexp.MustCompile(`^(/job:[A-Za-z][A-Za-z0-9_]*)?(/replica:(\d+|\*))?(/task:(\d+|\*))?(/device:[A-Za-z][A-Za-z0-9_]*(:(\d+|\*))?|/(?i:cpu|gpu):(\d+|\*))?$`)
+
+// order returns the operations run to compute fetches, each after its
+// inputs.
+func (d *dryGraph) order(fetches []Output) ([]*dryNode, error) {
+	var (
+		order   []*dryNode
+		visited = make(map[string]bool)
+		visit   func(name string) error
+	)
+	visit = func(name string) error {
+		if visited[name] {
+			return nil
+		}
+		visited[name] = true
+		n, ok := d.nodes[name]
+		if !ok {
+			return fmt.Errorf("operation %s not found", name)
+		}
+		if !validDevice.MatchString(n.device) {
+			return fmt.Errorf("operation %s: invalid device %q", name, n.device)
+		}
+		for _, in := range n.inputs {
+			if err := visit(in.op); err != nil {
+				return err
+			}
+		}
+		order = append(order, n)
+		return nil
+	}
+	for _, o := range fetches {
+		if err := visit(o.Op.Name()); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
+
+// output returns o, an output of the graph of the Session, in d.graph.
+func (d *dryGraph) output(o Output) Output {
+	return d.graph.Operation(o.Op.Name()).Output(o.Index)
+}
+
+// size returns the size in bytes of the tensors of o, an output of
+// d.graph, if it is known.
+func (d *dryRun) size(o Output) (int64, bool) {
+	if size, ok := d.fed[outputID(o)]; ok {
+		return size, true
+	}
+	return tensorSize(o)
+}
+
+// peakBytes returns the peak size of the tensors alive while running
+// order, and the number of tensors whose size is unknown.
+func (d *dryRun) peakBytes(order []*dryNode, fetches []Output) (peak int64, unknown int) {
+	fetched := make(map[tensorID]bool, len(fetches))
+	for _, o := range fetches {
+		fetched[outputID(o)] = true
+	}
+	// The number of operations of the run using each tensor.
+	uses := make(map[tensorID]int)
+	for _, n := range order {
+		for _, in := range n.inputs {
+			if in.index >= 0 {
+				uses[in]++
+			}
+		}
+	}
+	var live int64
+	sizes := make(map[tensorID]int64)
+	for _, n := range order {
+		op := d.graph.Operation(n.name)
+		var outputs []tensorID
+		for i := 0; i < op.NumOutputs(); i++ {
+			size, ok := d.size(op.Output(i))
+			if !ok {
+				unknown++
+				continue
+			}
+			id := outputID(op.Output(i))
+			sizes[id] = size
+			live += size
+			outputs = append(outputs, id)
+		}
+		if live > peak {
+			peak = live
+		}
+		for _, in := range n.inputs {
+			if in.index < 0 {
+				continue
+			}
+			if uses[in]--; uses[in] == 0 && !fetched[in] {
+				live -= sizes[in]
+			}
+		}
+		for _, id := range outputs {
+			if uses[id] == 0 && !fetched[id] {
+				live -= sizes[id]
+			}
+		}
+	}
+	return peak, unknown
+}
+
+// tensorSize returns the size in bytes of the tensors produced by o, if it
+// can be inferred from its type and shape.
+func tensorSize(o Output) (int64, bool) {
+	size := int64(o.DataType().Size())
+	shape := o.Shape()
+	if size == 0 || !shape.IsFullySpecified() {
+		return 0, false
+	}
+	for i := 0; i < shape.NumDimensions(); i++ {
+		size *= shape.Size(i)
+	}
+	return size, true
+}
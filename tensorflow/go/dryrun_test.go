@@ -0,0 +1,171 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDryRun(t *testing.T) {
+	g := NewGraph()
+	x, err := Placeholder(g, "x", Float)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := Const(g, "w", [][]float32{{1, 2}, {3, 4}, {5, 6}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	matmul, err := g.AddOperation(OpSpec{Type: "MatMul", Name: "y", Input: []Input{x, w}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	z, err := Neg(g, "z", matmul.Output(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewSession(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	in, err := NewTensor([][]float32{{1, 2, 3}, {1, 2, 3}, {1, 2, 3}, {1, 2, 3}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := s.DryRun(map[Output]*Tensor{x: in}, []Output{z})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := res.Shapes, []Shape{MakeShape(4, 2)}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Got shapes %v, want %v", got, want)
+	}
+	// x (48 bytes), w (24 bytes) and y (32 bytes) are alive when y is
+	// computed, after which only y and then z are.
+	if got, want := res.PeakBytes, int64(104); got != want {
+		t.Errorf("Got PeakBytes %d, want %d", got, want)
+	}
+	if res.Unknown != 0 {
+		t.Errorf("Got %d tensors of unknown size, want 0", res.Unknown)
+	}
+
+	// Without feeds, the shape of x is unknown.
+	res, err = s.DryRun(nil, []Output{w})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := res.PeakBytes, int64(24); got != want {
+		t.Errorf("Got PeakBytes %d, want %d", got, want)
+	}
+
+	other := NewGraph()
+	c, err := Const(other, "c", int32(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	badShape, err := NewTensor([][]float32{{1, 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	badType, err := NewTensor([][]int32{{1, 2, 3}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		feeds   map[Output]*Tensor
+		fetches []Output
+		want    string
+	}{
+		{map[Output]*Tensor{x: badShape}, []Output{z}, "must be equal"},
+		{map[Output]*Tensor{x: badType}, []Output{z}, "int32"},
+		{nil, []Output{c}, "not in the graph"},
+		{nil, []Output{{Op: matmul, Index: 1}}, "no output 1"},
+	}
+	for _, test := range tests {
+		if _, err := s.DryRun(test.feeds, test.fetches); err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("DryRun(%v, %v): got error %v, want an error containing %q", test.feeds, test.fetches, err, test.want)
+		}
+	}
+}
+
+func TestDryRunCache(t *testing.T) {
+	g := NewGraph()
+	x, err := Placeholder(g, "x", Float)
+	if err != nil {
+		t.Fatal(err)
+	}
+	y, err := Neg(g, "y", x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewSession(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	dryRun := func(value interface{}) *dryGraph {
+		in, err := NewTensor(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := s.DryRun(map[Output]*Tensor{x: in}, []Output{y}); err != nil {
+			t.Fatal(err)
+		}
+		return s.dryRuns.graphs[fmt.Sprintf("x %v %v", Float, in.Shape())]
+	}
+	// Runs with the same shapes share a graph.
+	first := dryRun([]float32{1, 2})
+	if first == nil || dryRun([]float32{3, 4}) != first {
+		t.Error("Dry-run graph not reused for the same shapes")
+	}
+	if dryRun([]float32{1, 2, 3}) == first || len(s.dryRuns.graphs) != 2 {
+		t.Errorf("Got %d graphs after a run with another shape, want 2", len(s.dryRuns.graphs))
+	}
+	// Adding operations to the graph discards the graphs cached.
+	if _, err := Neg(g, "z", y); err != nil {
+		t.Fatal(err)
+	}
+	if dryRun([]float32{1, 2}) == first || len(s.dryRuns.graphs) != 1 {
+		t.Errorf("Got %d graphs after adding an operation, want 1", len(s.dryRuns.graphs))
+	}
+	s.Close()
+	if _, err := s.DryRun(nil, []Output{y}); err == nil {
+		t.Error("DryRun succeeded after Close")
+	}
+}
+
+func TestValidDevice(t *testing.T) {
+	tests := map[string]bool{
+		"":              true,
+		"/cpu:0":        true,
+		"/GPU:1":        true,
+		"/device:GPU:0": true,
+		"/device:CPU:*": true,
+		"/job:worker/replica:0/task:1/device:GPU:0": true,
+		"/job:ps/task:0":           true,
+		"gpu:0":                    false,
+		"/gpu:zero":                false,
+		"/device:GPU:0/job:worker": false,
+	}
+	for name, want := range tests {
+		if got := validDevice.MatchString(name); got != want {
+			t.Errorf("validDevice(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
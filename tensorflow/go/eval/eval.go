@@ -0,0 +1,338 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package eval runs batch evaluations of models over datasets of
+// tf.Examples stored in TFRecord files, for example to check the quality
+// of a canary model before promoting it:
+//
+//	report, err := eval.EvaluateSavedModel(dir, []string{"serve"}, files, eval.Options{
+//		Input:   "input_example_tensor:0",
+//		Output:  "scores:0",
+//		Label:   "label",
+//		Metrics: []eval.Metric{eval.Accuracy(), eval.AUC()},
+//	})
+//	if err != nil {
+//		...
+//	}
+//	json.NewEncoder(os.Stdout).Encode(report)
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package eval
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	expb "github.com/tensorflow/tensorflow/tensorflow/go/core/example"
+	"github.com/tensorflow/tensorflow/tensorflow/go/featurestore"
+	"github.com/tensorflow/tensorflow/tensorflow/go/tfrecord"
+)
+
+// Runner runs a model. It is implemented by tf.ResilientModel.
+type Runner interface {
+	Run(feeds map[string]*tf.Tensor, fetches []string, targets []string) ([]*tf.Tensor, error)
+}
+
+// Feature feeds a dense feature of the examples to a model.
+type Feature struct {
+	// Feed is the name of the Tensor fed, such as "x:0".
+	Feed string
+	featurestore.FeatureSpec
+}
+
+// Options configures an evaluation.
+type Options struct {
+	// BatchSize is the number of examples per run. Zero means 64.
+	BatchSize int
+	// Parallelism is the number of concurrent runs. Zero means 1.
+	Parallelism int
+	// Input, if not empty, is the name of the Tensor fed with the batches
+	// of serialized tf.Examples, for models parsing them.
+	Input string
+	// Features are the dense features fed to models not parsing
+	// tf.Examples, keyed by feature name.
+	Features map[string]Feature
+	// Output is the name of the Tensor with the predictions, of shape
+	// [batch] or [batch, n].
+	Output string
+	// Label is the name of the feature holding the label of each example,
+	// a single float or integer.
+	Label string
+	// Metrics are the metrics computed. Each Metric is used by a single
+	// evaluation at a time.
+	Metrics []Metric
+	// Limit, if positive, is the maximum number of examples evaluated.
+	Limit int64
+}
+
+// Report is the outcome of an evaluation, meant to be encoded as JSON.
+type Report struct {
+	Files             []string           `json:"files"`
+	Examples          int64              `json:"examples"`
+	Batches           int64              `json:"batches"`
+	Metrics           map[string]float64 `json:"metrics"`
+	Seconds           float64            `json:"seconds"`
+	ExamplesPerSecond float64            `json:"examples_per_second"`
+}
+
+// batch is a batch of examples, with their predictions once run.
+type batch struct {
+	examples    []*expb.Example
+	labels      []float64
+	predictions [][]float64
+	err         error
+}
+
+// EvaluateSavedModel loads the SavedModel in exportDir and evaluates it
+// over the examples in files.
+func EvaluateSavedModel(exportDir string, tags []string, files []string, opts Options) (*Report, error) {
+	m, err := tf.LoadResilientModel(exportDir, tf.ResilientModelOptions{Tags: tags})
+	if err != nil {
+		return nil, err
+	}
+	defer m.Close()
+	return Evaluate(m, files, opts)
+}
+
+// Evaluate runs r over the examples in files, in batches, and computes the
+// metrics of its predictions. It stops at the first error.
+func Evaluate(r Runner, files []string, opts Options) (*Report, error) {
+	if (opts.Input == "") == (len(opts.Features) == 0) {
+		return nil, errors.New("exactly one of Input and Features must be set")
+	}
+	if opts.Output == "" || opts.Label == "" {
+		return nil, errors.New("Output and Label must be set")
+	}
+	if len(opts.Metrics) == 0 {
+		return nil, errors.New("no metrics")
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 64
+	}
+	if opts.Parallelism <= 0 {
+		opts.Parallelism = 1
+	}
+
+	start := time.Now()
+	var (
+		done     = make(chan struct{})
+		batches  = make(chan *batch, opts.Parallelism)
+		results  = make(chan *batch, opts.Parallelism)
+		readErr  error
+		workers  sync.WaitGroup
+		stopOnce sync.Once
+	)
+	stop := func() { stopOnce.Do(func() { close(done) }) }
+	go func() {
+		defer close(batches)
+		readErr = read(files, opts, batches, done)
+	}()
+	workers.Add(opts.Parallelism)
+	for i := 0; i < opts.Parallelism; i++ {
+		go func() {
+			defer workers.Done()
+			for b := range batches {
+				select {
+				case <-done:
+					continue
+				default:
+				}
+				b.predictions, b.err = run(r, b, opts)
+				results <- b
+			}
+		}()
+	}
+	go func() {
+		workers.Wait()
+		close(results)
+	}()
+
+	report := &Report{Files: files, Metrics: make(map[string]float64)}
+	var err error
+	for b := range results {
+		if err != nil {
+			continue
+		}
+		if err = b.err; err != nil {
+			stop()
+			continue
+		}
+		for _, m := range opts.Metrics {
+			m.Update(b.labels, b.predictions)
+		}
+		report.Examples += int64(len(b.labels))
+		report.Batches++
+	}
+	stop()
+	if err == nil {
+		err = readErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range opts.Metrics {
+		report.Metrics[m.Name()] = m.Result()
+	}
+	report.Seconds = time.Since(start).Seconds()
+	report.ExamplesPerSecond = float64(report.Examples) / report.Seconds
+	return report, nil
+}
+
+// read sends the examples in files to batches until done is closed.
+func read(files []string, opts Options, batches chan<- *batch, done <-chan struct{}) error {
+	var (
+		b     = new(batch)
+		count int64
+	)
+	send := func() bool {
+		select {
+		case batches <- b:
+			b = new(batch)
+			return true
+		case <-done:
+			return false
+		}
+	}
+	for _, name := range files {
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		r := tfrecord.NewReader(f)
+		for opts.Limit <= 0 || count < opts.Limit {
+			record, err := r.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				f.Close()
+				return fmt.Errorf("%s: %v", name, err)
+			}
+			e := new(expb.Example)
+			if err := proto.Unmarshal(record, e); err != nil {
+				f.Close()
+				return fmt.Errorf("%s: invalid example %d: %v", name, count, err)
+			}
+			label, err := labelOf(e, opts.Label)
+			if err != nil {
+				f.Close()
+				return fmt.Errorf("%s: example %d: %v", name, count, err)
+			}
+			b.examples = append(b.examples, e)
+			b.labels = append(b.labels, label)
+			count++
+			if len(b.examples) == opts.BatchSize && !send() {
+				f.Close()
+				return nil
+			}
+		}
+		f.Close()
+	}
+	if len(b.examples) > 0 {
+		send()
+	}
+	return nil
+}
+
+// labelOf returns the label of e, held by the named feature.
+func labelOf(e *expb.Example, name string) (float64, error) {
+	f := e.GetFeatures().GetFeature()[name]
+	if v := f.GetFloatList().GetValue(); len(v) == 1 {
+		return float64(v[0]), nil
+	}
+	if v := f.GetInt64List().GetValue(); len(v) == 1 {
+		return float64(v[0]), nil
+	}
+	return 0, fmt.Errorf("label %q is not a single number", name)
+}
+
+// run returns the predictions of r for the examples of b.
+func run(r Runner, b *batch, opts Options) ([][]float64, error) {
+	feeds := make(map[string]*tf.Tensor)
+	if opts.Input != "" {
+		t, err := featurestore.Serialized(b.examples)
+		if err != nil {
+			return nil, err
+		}
+		feeds[opts.Input] = t
+	} else {
+		specs := make(map[string]featurestore.FeatureSpec, len(opts.Features))
+		for name, f := range opts.Features {
+			specs[name] = f.FeatureSpec
+		}
+		tensors, err := featurestore.Dense(b.examples, specs)
+		if err != nil {
+			return nil, err
+		}
+		for name, f := range opts.Features {
+			feeds[f.Feed] = tensors[name]
+		}
+	}
+	out, err := r.Run(feeds, []string{opts.Output}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return predictions(out[0], len(b.examples))
+}
+
+// predictions converts t, of shape [n] or [n, k] and numeric type, to a
+// slice of the values predicted for each example.
+func predictions(t *tf.Tensor, n int) ([][]float64, error) {
+	shape := t.Shape()
+	if len(shape) == 0 || len(shape) > 2 || shape[0] != int64(n) {
+		return nil, fmt.Errorf("predictions of shape %v, want [%d] or [%d, k]", shape, n, n)
+	}
+	v := reflect.ValueOf(t.Value())
+	p := make([][]float64, n)
+	for i := range p {
+		row := v.Index(i)
+		if len(shape) == 1 {
+			f, err := toFloat(row)
+			if err != nil {
+				return nil, err
+			}
+			p[i] = []float64{f}
+			continue
+		}
+		p[i] = make([]float64, row.Len())
+		for j := range p[i] {
+			f, err := toFloat(row.Index(j))
+			if err != nil {
+				return nil, err
+			}
+			p[i][j] = f
+		}
+	}
+	return p, nil
+}
+
+func toFloat(v reflect.Value) (float64, error) {
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), nil
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), nil
+	}
+	return 0, fmt.Errorf("predictions of unsupported type %v", v.Type())
+}
@@ -0,0 +1,155 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eval
+
+import (
+	"errors"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	expb "github.com/tensorflow/tensorflow/tensorflow/go/core/example"
+	"github.com/tensorflow/tensorflow/tensorflow/go/featurestore"
+	"github.com/tensorflow/tensorflow/tensorflow/go/tfrecord"
+)
+
+const halfPlusTwo = "../../cc/saved_model/testdata/half_plus_two/00000123"
+
+func floatFeature(v float32) *expb.Feature {
+	return &expb.Feature{Kind: &expb.Feature_FloatList{FloatList: &expb.FloatList{Value: []float32{v}}}}
+}
+
+// writeDataset writes examples with features "x" and "y", where y is
+// 0.5x + 2 off by one for every other example.
+func writeDataset(t *testing.T, dir string, n int) string {
+	name := filepath.Join(dir, "eval.tfrecord")
+	f, err := os.Create(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	w := tfrecord.NewWriter(f)
+	for i := 0; i < n; i++ {
+		x := float32(i)
+		y := 0.5*x + 2 + float32(i%2)
+		b, err := proto.Marshal(&expb.Example{Features: &expb.Features{Feature: map[string]*expb.Feature{
+			"x": floatFeature(x),
+			"y": floatFeature(y),
+		}}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Write(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return name
+}
+
+func TestEvaluateSavedModel(t *testing.T) {
+	dir, err := ioutil.TempDir("", "eval")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := writeDataset(t, dir, 10)
+
+	opts := Options{
+		BatchSize:   3,
+		Parallelism: 2,
+		Features:    map[string]Feature{"x": {Feed: "x:0", FeatureSpec: featurestore.FeatureSpec{DataType: tf.Float}}},
+		Output:      "y:0",
+		Label:       "y",
+		Metrics:     []Metric{MeanSquaredError(), MeanAbsoluteError()},
+	}
+	report, err := EvaluateSavedModel(halfPlusTwo, []string{"serve"}, []string{file}, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Examples != 10 || report.Batches != 4 {
+		t.Errorf("Got %d examples in %d batches, want 10 in 4", report.Examples, report.Batches)
+	}
+	for _, name := range []string{"mean_squared_error", "mean_absolute_error"} {
+		if got, want := report.Metrics[name], 0.5; got != want {
+			t.Errorf("Got %s %v, want %v", name, got, want)
+		}
+	}
+
+	opts.Metrics = []Metric{MeanSquaredError()}
+	opts.Limit = 5
+	report, err = EvaluateSavedModel(halfPlusTwo, []string{"serve"}, []string{file}, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Examples != 5 {
+		t.Errorf("Got %d examples, want 5", report.Examples)
+	}
+}
+
+type failingRunner struct{}
+
+func (failingRunner) Run(map[string]*tf.Tensor, []string, []string) ([]*tf.Tensor, error) {
+	return nil, errors.New("failed")
+}
+
+func TestEvaluateError(t *testing.T) {
+	dir, err := ioutil.TempDir("", "eval")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := writeDataset(t, dir, 100)
+
+	opts := Options{BatchSize: 1, Parallelism: 4, Input: "input:0", Output: "y:0", Label: "y", Metrics: []Metric{Accuracy()}}
+	if _, err := Evaluate(failingRunner{}, []string{file}, opts); err == nil || err.Error() != "failed" {
+		t.Errorf("Got %v, want the error of the runner", err)
+	}
+	opts.Label = "z"
+	if _, err := Evaluate(failingRunner{}, []string{file}, opts); err == nil {
+		t.Error("Evaluate succeeded without labels")
+	}
+	if _, err := Evaluate(failingRunner{}, []string{filepath.Join(dir, "missing")}, opts); err == nil {
+		t.Error("Evaluate succeeded with a missing file")
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	labels := []float64{0, 0, 1, 1}
+	scores := [][]float64{{0.1}, {0.6}, {0.4}, {0.8}}
+	classes := [][]float64{{0.9, 0.1}, {0.4, 0.6}, {0.6, 0.4}, {0.2, 0.8}}
+	for _, test := range []struct {
+		metric      Metric
+		predictions [][]float64
+		want        float64
+	}{
+		{Accuracy(), scores, 0.5},
+		{Accuracy(), classes, 0.5},
+		{AUC(), scores, 0.75},
+		{AUC(), classes, 0.75},
+		{MeanSquaredError(), scores, (0.01 + 0.36 + 0.36 + 0.04) / 4},
+		{MeanAbsoluteError(), scores, (0.1 + 0.6 + 0.6 + 0.2) / 4},
+	} {
+		// Update in two batches.
+		test.metric.Update(labels[:1], test.predictions[:1])
+		test.metric.Update(labels[1:], test.predictions[1:])
+		if got := test.metric.Result(); math.Abs(got-test.want) > 1e-9 {
+			t.Errorf("%s: Got %v, want %v", test.metric.Name(), got, test.want)
+		}
+	}
+}
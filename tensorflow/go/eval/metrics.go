@@ -0,0 +1,140 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eval
+
+import (
+	"math"
+	"sort"
+)
+
+// Metric accumulates a measure of the quality of predictions over the
+// batches of an evaluation.
+type Metric interface {
+	// Name identifies the metric in a Report.
+	Name() string
+	// Update accumulates a batch, where labels[i] is the label of an
+	// example and predictions[i] the values predicted for it.
+	Update(labels []float64, predictions [][]float64)
+	// Result returns the value of the metric over the accumulated batches.
+	Result() float64
+}
+
+// Accuracy returns a Metric computing the fraction of examples whose label
+// is the predicted class: the index of the largest prediction or, for
+// binary classifiers predicting a single probability, whether it is at
+// least 0.5.
+func Accuracy() Metric { return new(accuracy) }
+
+type accuracy struct{ correct, total float64 }
+
+func (*accuracy) Name() string { return "accuracy" }
+
+func (m *accuracy) Update(labels []float64, predictions [][]float64) {
+	for i, p := range predictions {
+		var class float64
+		if len(p) == 1 {
+			if p[0] >= 0.5 {
+				class = 1
+			}
+		} else {
+			for j := range p {
+				if p[j] > p[int(class)] {
+					class = float64(j)
+				}
+			}
+		}
+		if class == labels[i] {
+			m.correct++
+		}
+		m.total++
+	}
+}
+
+func (m *accuracy) Result() float64 { return m.correct / m.total }
+
+// MeanSquaredError returns a Metric computing the mean squared difference
+// between labels and the first value predicted for each example.
+func MeanSquaredError() Metric {
+	return &meanError{name: "mean_squared_error", loss: func(d float64) float64 { return d * d }}
+}
+
+// MeanAbsoluteError returns a Metric computing the mean absolute difference
+// between labels and the first value predicted for each example.
+func MeanAbsoluteError() Metric { return &meanError{name: "mean_absolute_error", loss: math.Abs} }
+
+type meanError struct {
+	name       string
+	loss       func(float64) float64
+	sum, total float64
+}
+
+func (m *meanError) Name() string { return m.name }
+
+func (m *meanError) Update(labels []float64, predictions [][]float64) {
+	for i, p := range predictions {
+		m.sum += m.loss(p[0] - labels[i])
+		m.total++
+	}
+}
+
+func (m *meanError) Result() float64 { return m.sum / m.total }
+
+// AUC returns a Metric computing the area under the ROC curve of a binary
+// classifier, whose labels are 0 or 1 and whose score for the positive
+// class is the last value predicted for each example. It is exact, and
+// keeps all the scores in memory.
+func AUC() Metric { return new(auc) }
+
+type auc struct {
+	scores []float64
+	labels []bool
+}
+
+func (*auc) Name() string { return "auc" }
+
+func (m *auc) Update(labels []float64, predictions [][]float64) {
+	for i, p := range predictions {
+		m.scores = append(m.scores, p[len(p)-1])
+		m.labels = append(m.labels, labels[i] != 0)
+	}
+}
+
+// Result computes the Mann-Whitney U statistic, with tied scores ranked
+// by their average rank.
+func (m *auc) Result() float64 {
+	idx := make([]int, len(m.scores))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.Slice(idx, func(i, j int) bool { return m.scores[idx[i]] < m.scores[idx[j]] })
+	var positives, negatives, rankSum float64
+	for i := 0; i < len(idx); {
+		j := i
+		for j < len(idx) && m.scores[idx[j]] == m.scores[idx[i]] {
+			j++
+		}
+		rank := float64(i+j+1) / 2
+		for _, k := range idx[i:j] {
+			if m.labels[k] {
+				positives++
+				rankSum += rank
+			} else {
+				negatives++
+			}
+		}
+		i = j
+	}
+	return (rankSum - positives*(positives+1)/2) / (positives * negatives)
+}
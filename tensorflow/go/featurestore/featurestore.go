@@ -0,0 +1,249 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package featurestore standardizes the lookup-then-predict pattern of
+// services fetching the stored features of entities, such as users or
+// items, to join them with the features of a request before running a
+// model:
+//
+//	examples, err := featurestore.FetchAndJoin(store, userIDs, requests)
+//	if err != nil {
+//		...
+//	}
+//	inputs, err := featurestore.Serialized(examples)
+//	...
+//
+// Features are tf.Example protocol buffers. FeatureFetcher is implemented
+// in memory by Memory, and by Redis for features stored as serialized
+// tf.Examples in a Redis server.
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package featurestore
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	expb "github.com/tensorflow/tensorflow/tensorflow/go/core/example"
+)
+
+// FeatureFetcher fetches the features of entities identified by keys.
+type FeatureFetcher interface {
+	// Fetch returns the features of each of keys, in order, with nil for
+	// the keys that are not found.
+	Fetch(keys []string) ([]*expb.Example, error)
+}
+
+// Memory is a FeatureFetcher storing features in memory, for example to
+// cache a small table or in tests. Its methods are safe for concurrent use.
+type Memory struct {
+	mu       sync.RWMutex
+	examples map[string]*expb.Example
+}
+
+// NewMemory returns an empty Memory.
+func NewMemory() *Memory {
+	return &Memory{examples: make(map[string]*expb.Example)}
+}
+
+// Put sets the features of key, which must not be modified afterwards.
+func (m *Memory) Put(key string, e *expb.Example) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.examples[key] = e
+}
+
+// Delete removes the features of key.
+func (m *Memory) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.examples, key)
+}
+
+// Fetch implements FeatureFetcher.
+func (m *Memory) Fetch(keys []string) ([]*expb.Example, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	examples := make([]*expb.Example, len(keys))
+	for i, k := range keys {
+		examples[i] = m.examples[k]
+	}
+	return examples, nil
+}
+
+// Join returns an Example with the features of request and those of
+// fetched, the features of request taking precedence over fetched ones of
+// the same name, and those of later fetched Examples over earlier ones.
+// Nil Examples have no features. The features are not copied.
+func Join(request *expb.Example, fetched ...*expb.Example) *expb.Example {
+	joined := make(map[string]*expb.Feature)
+	for _, e := range append(fetched, request) {
+		for name, f := range e.GetFeatures().GetFeature() {
+			joined[name] = f
+		}
+	}
+	return &expb.Example{Features: &expb.Features{Feature: joined}}
+}
+
+// FetchAndJoin fetches the features of keys from f and joins them with the
+// features of the requests, returning an Example for each key. requests
+// may be nil, or have an Example, possibly nil, for each key.
+func FetchAndJoin(f FeatureFetcher, keys []string, requests []*expb.Example) ([]*expb.Example, error) {
+	if requests != nil && len(requests) != len(keys) {
+		return nil, fmt.Errorf("got %d requests for %d keys", len(requests), len(keys))
+	}
+	fetched, err := f.Fetch(keys)
+	if err != nil {
+		return nil, err
+	}
+	if len(fetched) != len(keys) {
+		return nil, fmt.Errorf("fetched %d examples for %d keys", len(fetched), len(keys))
+	}
+	joined := make([]*expb.Example, len(keys))
+	for i := range keys {
+		var request *expb.Example
+		if requests != nil {
+			request = requests[i]
+		}
+		joined[i] = Join(request, fetched[i])
+	}
+	return joined, nil
+}
+
+// Serialized returns a String Tensor of shape [len(examples)] with the
+// serialized examples, the input of models parsing tf.Examples, such as
+// those of the classification and regression signatures. Nil examples are
+// serialized as empty ones.
+func Serialized(examples []*expb.Example) (*tf.Tensor, error) {
+	serialized := make([]string, len(examples))
+	for i, e := range examples {
+		if e == nil {
+			continue // An empty Example has no bytes.
+		}
+		b, err := proto.Marshal(e)
+		if err != nil {
+			return nil, err
+		}
+		serialized[i] = string(b)
+	}
+	return tf.NewTensor(serialized)
+}
+
+// FeatureSpec describes a dense feature converted to a Tensor by Dense.
+type FeatureSpec struct {
+	// DataType is the type of the Tensor: Float, Int64 or String, for
+	// features of type FloatList, Int64List and BytesList respectively.
+	DataType tf.DataType
+	// Length is the number of values of the feature in each Example. Zero
+	// means that each Example has a single value, and the Tensor is a
+	// vector rather than a matrix.
+	Length int
+	// Default, if not nil, is the value of the feature in the Examples
+	// without it. Otherwise, such Examples are invalid.
+	Default *expb.Feature
+}
+
+// Dense converts the features of examples described by specs to Tensors,
+// keyed by feature name, of shape [len(examples)] or [len(examples),
+// Length], for example to be set in a signature.Feed.
+func Dense(examples []*expb.Example, specs map[string]FeatureSpec) (map[string]*tf.Tensor, error) {
+	names := make([]string, 0, len(specs))
+	for name := range specs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	tensors := make(map[string]*tf.Tensor, len(specs))
+	for _, name := range names {
+		spec := specs[name]
+		n := spec.Length
+		if n == 0 {
+			n = 1
+		}
+		var values interface{}
+		switch spec.DataType {
+		case tf.Float:
+			values = make([][]float32, len(examples))
+		case tf.Int64:
+			values = make([][]int64, len(examples))
+		case tf.String:
+			values = make([][]string, len(examples))
+		default:
+			return nil, fmt.Errorf("feature %q: unsupported type %v", name, spec.DataType)
+		}
+		for i, e := range examples {
+			f := e.GetFeatures().GetFeature()[name]
+			if f == nil {
+				f = spec.Default
+			}
+			if f == nil {
+				return nil, fmt.Errorf("feature %q missing in example %d", name, i)
+			}
+			var got int
+			switch v := values.(type) {
+			case [][]float32:
+				v[i] = f.GetFloatList().GetValue()
+				got = len(v[i])
+			case [][]int64:
+				v[i] = f.GetInt64List().GetValue()
+				got = len(v[i])
+			case [][]string:
+				for _, b := range f.GetBytesList().GetValue() {
+					v[i] = append(v[i], string(b))
+				}
+				got = len(v[i])
+			}
+			if got != n {
+				return nil, fmt.Errorf("feature %q has %d %v values in example %d, want %d", name, got, spec.DataType, i, n)
+			}
+		}
+		if spec.Length == 0 {
+			values = flatten(values)
+		}
+		t, err := tf.NewTensor(values)
+		if err != nil {
+			return nil, fmt.Errorf("feature %q: %v", name, err)
+		}
+		tensors[name] = t
+	}
+	return tensors, nil
+}
+
+// flatten returns the single values of each example in values.
+func flatten(values interface{}) interface{} {
+	switch v := values.(type) {
+	case [][]float32:
+		flat := make([]float32, len(v))
+		for i := range v {
+			flat[i] = v[i][0]
+		}
+		return flat
+	case [][]int64:
+		flat := make([]int64, len(v))
+		for i := range v {
+			flat[i] = v[i][0]
+		}
+		return flat
+	case [][]string:
+		flat := make([]string, len(v))
+		for i := range v {
+			flat[i] = v[i][0]
+		}
+		return flat
+	}
+	return values
+}
@@ -0,0 +1,121 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package featurestore
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	expb "github.com/tensorflow/tensorflow/tensorflow/go/core/example"
+)
+
+func floats(v ...float32) *expb.Feature {
+	return &expb.Feature{Kind: &expb.Feature_FloatList{FloatList: &expb.FloatList{Value: v}}}
+}
+
+func ints(v ...int64) *expb.Feature {
+	return &expb.Feature{Kind: &expb.Feature_Int64List{Int64List: &expb.Int64List{Value: v}}}
+}
+
+func example(features map[string]*expb.Feature) *expb.Example {
+	return &expb.Example{Features: &expb.Features{Feature: features}}
+}
+
+func TestFetchAndJoin(t *testing.T) {
+	m := NewMemory()
+	m.Put("alice", example(map[string]*expb.Feature{"age": ints(30), "score": floats(1)}))
+	m.Put("bob", example(map[string]*expb.Feature{"age": ints(40)}))
+	m.Delete("bob")
+
+	requests := []*expb.Example{
+		example(map[string]*expb.Feature{"score": floats(2), "hour": ints(9)}),
+		nil,
+	}
+	joined, err := FetchAndJoin(m, []string{"alice", "bob"}, requests)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*expb.Example{
+		example(map[string]*expb.Feature{"age": ints(30), "score": floats(2), "hour": ints(9)}),
+		example(map[string]*expb.Feature{}),
+	}
+	for i := range want {
+		if !proto.Equal(joined[i], want[i]) {
+			t.Errorf("Got %v, want %v", joined[i], want[i])
+		}
+	}
+	if _, err := FetchAndJoin(m, []string{"alice"}, requests); err == nil {
+		t.Error("FetchAndJoin succeeded with more requests than keys")
+	}
+}
+
+func TestSerialized(t *testing.T) {
+	examples := []*expb.Example{example(map[string]*expb.Feature{"age": ints(30)}), nil}
+	s, err := Serialized(examples)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := s.Shape(), []int64{2}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Got shape %v, want %v", got, want)
+	}
+	values := s.Value().([]string)
+	e := new(expb.Example)
+	if err := proto.Unmarshal([]byte(values[0]), e); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(e, examples[0]) {
+		t.Errorf("Got %v, want %v", e, examples[0])
+	}
+	if values[1] != "" {
+		t.Errorf("Got %q for a nil example, want an empty Example", values[1])
+	}
+}
+
+func TestDense(t *testing.T) {
+	examples := []*expb.Example{
+		example(map[string]*expb.Feature{"age": ints(30), "emb": floats(1, 2)}),
+		example(map[string]*expb.Feature{"emb": floats(3, 4)}),
+	}
+	specs := map[string]FeatureSpec{
+		"age": {DataType: tf.Int64, Default: ints(-1)},
+		"emb": {DataType: tf.Float, Length: 2},
+	}
+	tensors, err := Dense(examples, specs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := tensors["age"].Value(), []int64{30, -1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Got age %v, want %v", got, want)
+	}
+	if got, want := tensors["emb"].Value(), [][]float32{{1, 2}, {3, 4}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Got emb %v, want %v", got, want)
+	}
+
+	for name, spec := range map[string]FeatureSpec{
+		"missing": {DataType: tf.Int64},
+		"length":  {DataType: tf.Float, Length: 3},
+		"type":    {DataType: tf.Bool},
+	} {
+		specs := map[string]FeatureSpec{"emb": spec}
+		if name == "missing" {
+			specs = map[string]FeatureSpec{"age": spec}
+		}
+		if _, err := Dense(examples, specs); err == nil {
+			t.Errorf("%s: Dense succeeded", name)
+		}
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package featurestore
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	expb "github.com/tensorflow/tensorflow/tensorflow/go/core/example"
+)
+
+// RedisOptions configures a Redis FeatureFetcher.
+type RedisOptions struct {
+	// KeyPrefix is prepended to the keys passed to Fetch to form the Redis
+	// keys, for example "user:".
+	KeyPrefix string
+	// Password, if not empty, authenticates the connections.
+	Password string
+	// DB is the index of the database to select.
+	DB int
+	// Timeout bounds dialing and each request. Zero means no timeout.
+	Timeout time.Duration
+	// MaxIdleConns is the number of connections kept open between
+	// requests. Zero means 2.
+	MaxIdleConns int
+}
+
+// Redis is a FeatureFetcher reading serialized tf.Examples stored as Redis
+// string values, with a single MGET per Fetch. Its methods are safe for
+// concurrent use.
+type Redis struct {
+	addr string
+	opts RedisOptions
+	idle chan *redisConn
+
+	mu     sync.Mutex
+	closed bool
+}
+
+// NewRedis returns a Redis fetching features from the server at addr, such
+// as "localhost:6379". Connections are made as needed.
+func NewRedis(addr string, opts RedisOptions) *Redis {
+	if opts.MaxIdleConns == 0 {
+		opts.MaxIdleConns = 2
+	}
+	return &Redis{addr: addr, opts: opts, idle: make(chan *redisConn, opts.MaxIdleConns)}
+}
+
+// Fetch implements FeatureFetcher.
+func (r *Redis) Fetch(keys []string) ([]*expb.Example, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	args := make([]string, len(keys)+1)
+	args[0] = "MGET"
+	for i, k := range keys {
+		args[i+1] = r.opts.KeyPrefix + k
+	}
+	c, err := r.conn()
+	if err != nil {
+		return nil, err
+	}
+	values, err := c.do(args...)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+	r.release(c)
+	if len(values) != len(keys) {
+		return nil, fmt.Errorf("redis: MGET returned %d values for %d keys", len(values), len(keys))
+	}
+	examples := make([]*expb.Example, len(keys))
+	for i, v := range values {
+		if v == nil {
+			continue
+		}
+		e := new(expb.Example)
+		if err := proto.Unmarshal(v, e); err != nil {
+			return nil, fmt.Errorf("invalid example for key %q: %v", keys[i], err)
+		}
+		examples[i] = e
+	}
+	return examples, nil
+}
+
+// Close closes the idle connections. Connections in use are closed when
+// their request completes.
+func (r *Redis) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.closed {
+		r.closed = true
+		close(r.idle)
+		for c := range r.idle {
+			c.Close()
+		}
+	}
+	return nil
+}
+
+func (r *Redis) conn() (*redisConn, error) {
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		return nil, errors.New("redis: fetcher closed")
+	}
+	select {
+	case c := <-r.idle:
+		r.mu.Unlock()
+		return c, nil
+	default:
+	}
+	r.mu.Unlock()
+	conn, err := net.DialTimeout("tcp", r.addr, r.opts.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	c := &redisConn{Conn: conn, r: bufio.NewReader(conn), timeout: r.opts.Timeout}
+	if r.opts.Password != "" {
+		if _, err := c.do("AUTH", r.opts.Password); err != nil {
+			c.Close()
+			return nil, err
+		}
+	}
+	if r.opts.DB != 0 {
+		if _, err := c.do("SELECT", strconv.Itoa(r.opts.DB)); err != nil {
+			c.Close()
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+func (r *Redis) release(c *redisConn) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.closed {
+		select {
+		case r.idle <- c:
+			return
+		default:
+		}
+	}
+	c.Close()
+}
+
+// redisConn is a connection speaking the Redis serialization protocol,
+// limited to the replies of the commands used by Redis.
+type redisConn struct {
+	net.Conn
+	r       *bufio.Reader
+	timeout time.Duration
+}
+
+// do sends a command and returns its reply: the elements of an array
+// reply, nil for null ones, or the single value of other replies.
+func (c *redisConn) do(args ...string) ([][]byte, error) {
+	if c.timeout > 0 {
+		c.SetDeadline(time.Now().Add(c.timeout))
+	}
+	b := []byte("*" + strconv.Itoa(len(args)) + "\r\n")
+	for _, a := range args {
+		b = append(b, "$"+strconv.Itoa(len(a))+"\r\n"...)
+		b = append(b, a...)
+		b = append(b, "\r\n"...)
+	}
+	if _, err := c.Write(b); err != nil {
+		return nil, err
+	}
+	kind, line, err := c.line()
+	if err != nil {
+		return nil, err
+	}
+	if kind != '*' {
+		v, err := c.value(kind, line)
+		return [][]byte{v}, err
+	}
+	n, err := strconv.Atoi(line)
+	if err != nil {
+		return nil, fmt.Errorf("redis: invalid array length %q", line)
+	}
+	if n < 0 {
+		return nil, nil
+	}
+	values := make([][]byte, n)
+	for i := range values {
+		kind, line, err := c.line()
+		if err != nil {
+			return nil, err
+		}
+		if values[i], err = c.value(kind, line); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+// line reads a reply line, returning its type byte and the rest.
+func (c *redisConn) line() (byte, string, error) {
+	l, err := c.r.ReadString('\n')
+	if err != nil {
+		return 0, "", err
+	}
+	if len(l) < 3 || l[len(l)-2] != '\r' {
+		return 0, "", fmt.Errorf("redis: invalid reply %q", l)
+	}
+	return l[0], l[1 : len(l)-2], nil
+}
+
+// value returns the value of a non-array reply.
+func (c *redisConn) value(kind byte, line string) ([]byte, error) {
+	switch kind {
+	case '+', ':':
+		return []byte(line), nil
+	case '-':
+		return nil, fmt.Errorf("redis: %s", line)
+	case '$':
+		n, err := strconv.Atoi(line)
+		if err != nil {
+			return nil, fmt.Errorf("redis: invalid bulk length %q", line)
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		b := make([]byte, n+2)
+		if _, err := io.ReadFull(c.r, b); err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+	return nil, fmt.Errorf("redis: unsupported reply type %q", kind)
+}
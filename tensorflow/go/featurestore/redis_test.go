@@ -0,0 +1,112 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package featurestore
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	expb "github.com/tensorflow/tensorflow/tensorflow/go/core/example"
+)
+
+// fakeRedis serves MGET, AUTH and SELECT commands from values.
+func fakeRedis(t *testing.T, values map[string][]byte) (addr string, commands chan []string) {
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	commands = make(chan []string, 10)
+	go func() {
+		defer l.Close()
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		for {
+			var n int
+			if _, err := fmt.Fscanf(r, "*%d\r\n", &n); err != nil {
+				return
+			}
+			args := make([]string, n)
+			for i := range args {
+				var size int
+				if _, err := fmt.Fscanf(r, "$%d\r\n", &size); err != nil {
+					return
+				}
+				b := make([]byte, size+2)
+				if _, err := io.ReadFull(r, b); err != nil {
+					return
+				}
+				args[i] = string(b[:size])
+			}
+			commands <- args
+			switch strings.ToUpper(args[0]) {
+			case "MGET":
+				reply := "*" + strconv.Itoa(len(args)-1) + "\r\n"
+				for _, k := range args[1:] {
+					if v, ok := values[k]; ok {
+						reply += "$" + strconv.Itoa(len(v)) + "\r\n" + string(v) + "\r\n"
+					} else {
+						reply += "$-1\r\n"
+					}
+				}
+				io.WriteString(conn, reply)
+			case "AUTH", "SELECT":
+				io.WriteString(conn, "+OK\r\n")
+			default:
+				io.WriteString(conn, "-ERR unknown command\r\n")
+			}
+		}
+	}()
+	return l.Addr().String(), commands
+}
+
+func TestRedis(t *testing.T) {
+	alice := example(map[string]*expb.Feature{"age": ints(30)})
+	b, err := proto.Marshal(alice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, commands := fakeRedis(t, map[string][]byte{"user:alice": b})
+	r := NewRedis(addr, RedisOptions{KeyPrefix: "user:", Password: "secret", DB: 2})
+	defer r.Close()
+
+	for i := 0; i < 2; i++ {
+		examples, err := r.Fetch([]string{"alice", "bob"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(examples) != 2 || !proto.Equal(examples[0], alice) || examples[1] != nil {
+			t.Errorf("Got %v, want [%v <nil>]", examples, alice)
+		}
+	}
+	// The connection is authenticated once and reused.
+	var got []string
+	for len(commands) > 0 {
+		got = append(got, strings.Join(<-commands, " "))
+	}
+	want := []string{"AUTH secret", "SELECT 2", "MGET user:alice user:bob", "MGET user:alice user:bob"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("Got commands %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package federated
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+// TensorInfo describes a variable saved in a checkpoint.
+type TensorInfo struct {
+	DataType tf.DataType
+	Shape    []int64
+}
+
+// ListCheckpoint returns the variables saved in the checkpoint with the
+// given prefix, such as "model.ckpt-100" or "export/variables/variables",
+// keyed by name. Partitioned variables are not supported.
+//
+// The names and types are read from the .index file of the checkpoint, an
+// uncompressed table of BundleEntryProto protocol buffers
+// (https://www.tensorflow.org/code/tensorflow/core/protobuf/tensor_bundle.proto).
+func ListCheckpoint(prefix string) (map[string]TensorInfo, error) {
+	index, err := ioutil.ReadFile(prefix + ".index")
+	if err != nil {
+		return nil, err
+	}
+	vars := make(map[string]TensorInfo)
+	err = readTable(index, func(key string, value []byte) error {
+		// The empty key holds the BundleHeaderProto, and keys starting with
+		// a zero byte the slices of partitioned variables.
+		if key == "" || key[0] == 0 {
+			return nil
+		}
+		info, err := parseBundleEntry(value)
+		if err != nil {
+			return fmt.Errorf("invalid entry for %q: %v", key, err)
+		}
+		vars[key] = info
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s.index: %v", prefix, err)
+	}
+	return vars, nil
+}
+
+// ReadCheckpoint returns the values of the variables saved in the
+// checkpoint with the given prefix, keyed by name.
+func ReadCheckpoint(prefix string) (map[string]*tf.Tensor, error) {
+	vars, err := ListCheckpoint(prefix)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(vars))
+	for name := range vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	dtypes := make([]tf.DataType, len(names))
+	for i, name := range names {
+		dtypes[i] = vars[name].DataType
+	}
+	s := op.NewScope()
+	outputs := op.RestoreV2(s, op.Const(s, prefix), op.Const(s, names), op.Const(s, make([]string, len(names))), dtypes)
+	values, err := runScope(s, outputs, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	tensors := make(map[string]*tf.Tensor, len(names))
+	for i, name := range names {
+		tensors[name] = values[i]
+	}
+	return tensors, nil
+}
+
+// WriteCheckpoint saves tensors, keyed by variable name, as a checkpoint
+// with the given prefix, creating its directory if needed.
+func WriteCheckpoint(prefix string, tensors map[string]*tf.Tensor) error {
+	if len(tensors) == 0 {
+		return errors.New("no tensors to save")
+	}
+	if err := os.MkdirAll(filepath.Dir(prefix), 0755); err != nil {
+		return err
+	}
+	names := make([]string, 0, len(tensors))
+	for name := range tensors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	s := op.NewScope()
+	feeds := make(map[tf.Output]*tf.Tensor, len(names))
+	inputs := make([]tf.Output, len(names))
+	for i, name := range names {
+		inputs[i] = op.Placeholder(s.SubScope("tensor"), tensors[name].DataType())
+		feeds[inputs[i]] = tensors[name]
+	}
+	save := op.SaveV2(s, op.Const(s, prefix), op.Const(s, names), op.Const(s, make([]string, len(names))), inputs)
+	_, err := runScope(s, nil, feeds, []*tf.Operation{save})
+	return err
+}
+
+// runScope runs the graph of s in a new session.
+func runScope(s *op.Scope, fetches []tf.Output, feeds map[tf.Output]*tf.Tensor, targets []*tf.Operation) ([]*tf.Tensor, error) {
+	graph, err := s.Finalize()
+	if err != nil {
+		return nil, err
+	}
+	session, err := tf.NewSession(graph, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer session.Close()
+	return session.Run(feeds, fetches, targets)
+}
+
+// tableMagic ends the footer of a table.
+const tableMagic = 0xdb4775248b80fb57
+
+// readTable calls f with the entries of a table in the format of
+// https://www.tensorflow.org/code/tensorflow/core/lib/io/table_format.txt,
+// in order.
+func readTable(table []byte, f func(key string, value []byte) error) error {
+	const footerSize = 48
+	if len(table) < footerSize || binary.LittleEndian.Uint64(table[len(table)-8:]) != tableMagic {
+		return errors.New("not a table")
+	}
+	footer := table[len(table)-footerSize:]
+	// The footer holds the handles of the metaindex block, unused, and of
+	// the index block.
+	_, n := binary.Uvarint(footer)
+	_, m := binary.Uvarint(footer[n:])
+	if n <= 0 || m <= 0 {
+		return errors.New("invalid footer")
+	}
+	index, err := block(table, footer[n+m:])
+	if err != nil {
+		return err
+	}
+	return readBlock(index, func(_ string, handle []byte) error {
+		data, err := block(table, handle)
+		if err != nil {
+			return err
+		}
+		return readBlock(data, f)
+	})
+}
+
+// block returns the contents of the block of table with the given encoded
+// handle.
+func block(table, handle []byte) ([]byte, error) {
+	offset, n := binary.Uvarint(handle)
+	size, m := binary.Uvarint(handle[n:])
+	// Blocks are followed by their compression type and checksum.
+	if n <= 0 || m <= 0 || offset+size+5 > uint64(len(table)) {
+		return nil, errors.New("invalid block handle")
+	}
+	if table[offset+size] != 0 {
+		return nil, errors.New("compressed tables are not supported")
+	}
+	return table[offset : offset+size], nil
+}
+
+// readBlock calls f with the entries of a block, whose keys are
+// prefix-compressed.
+func readBlock(b []byte, f func(key string, value []byte) error) error {
+	if len(b) < 4 {
+		return errors.New("truncated block")
+	}
+	restarts := uint64(binary.LittleEndian.Uint32(b[len(b)-4:]))
+	if (restarts+1)*4 > uint64(len(b)) {
+		return errors.New("invalid block restarts")
+	}
+	entries := b[:uint64(len(b))-(restarts+1)*4]
+	var key []byte
+	for len(entries) > 0 {
+		var header [3]uint64
+		for i := range header {
+			v, n := binary.Uvarint(entries)
+			if n <= 0 {
+				return errors.New("invalid block entry")
+			}
+			header[i], entries = v, entries[n:]
+		}
+		shared, unshared, size := header[0], header[1], header[2]
+		if shared > uint64(len(key)) || unshared+size > uint64(len(entries)) {
+			return errors.New("invalid block entry")
+		}
+		key = append(key[:shared], entries[:unshared]...)
+		if err := f(string(key), entries[unshared:unshared+size]); err != nil {
+			return err
+		}
+		entries = entries[unshared+size:]
+	}
+	return nil
+}
+
+// parseBundleEntry returns the type and shape of a BundleEntryProto.
+func parseBundleEntry(b []byte) (TensorInfo, error) {
+	var info TensorInfo
+	for len(b) > 0 {
+		tag, n := binary.Uvarint(b)
+		if n <= 0 {
+			return info, errors.New("invalid tag")
+		}
+		b = b[n:]
+		var v uint64
+		var value []byte
+		switch tag & 7 {
+		case 0:
+			if v, n = binary.Uvarint(b); n <= 0 {
+				return info, errors.New("invalid varint")
+			}
+		case 1, 5:
+			if n = 8; tag&7 == 5 {
+				n = 4
+			}
+		case 2:
+			size, m := binary.Uvarint(b)
+			if m <= 0 || size > uint64(len(b)-m) {
+				return info, errors.New("invalid length")
+			}
+			n = m + int(size)
+			value = b[m:n]
+		default:
+			return info, fmt.Errorf("unsupported wire type %d", tag&7)
+		}
+		if n > len(b) {
+			return info, errors.New("truncated")
+		}
+		b = b[n:]
+		switch tag >> 3 {
+		case 1:
+			info.DataType = tf.DataType(v)
+		case 2:
+			var shape pb.TensorShapeProto
+			if err := proto.Unmarshal(value, &shape); err != nil {
+				return info, err
+			}
+			info.Shape = make([]int64, len(shape.Dim))
+			for i, d := range shape.Dim {
+				info.Shape[i] = d.Size
+			}
+		case 7:
+			return info, errors.New("partitioned variables are not supported")
+		}
+	}
+	return info, nil
+}
@@ -0,0 +1,235 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package federated implements the server side of federated averaging
+// (FedAvg): combining the variables trained by many clients, or saved in
+// many checkpoints, into their weighted average.
+//
+//	a := federated.NewAggregator()
+//	for _, update := range updates {
+//		if err := a.Add(update.Variables, float64(update.Examples)); err != nil {
+//			...
+//		}
+//	}
+//	average, err := a.Average()
+//	...
+//	err = federated.WriteCheckpoint("model/ckpt-2", average)
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package federated
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+// Aggregator accumulates the weighted average of sets of variables, keyed
+// by name, one set at a time so that only the running sums are kept in
+// memory. Every set must have the same variables, with the same types and
+// shapes.
+//
+// Float and Double variables are averaged. Variables of other types, such
+// as the global step, are taken from the first set.
+type Aggregator struct {
+	first  map[string]*tf.Tensor
+	sums   map[string][]float64
+	weight float64
+	sets   int
+}
+
+// NewAggregator returns an empty Aggregator.
+func NewAggregator() *Aggregator {
+	return &Aggregator{sums: make(map[string][]float64)}
+}
+
+// Add adds a set of variables with the given weight, usually the number of
+// examples they were trained on. Sets that do not match the first one are
+// rejected, leaving the Aggregator unchanged.
+func (a *Aggregator) Add(vars map[string]*tf.Tensor, weight float64) error {
+	if !(weight >= 0) || math.IsInf(weight, 1) {
+		return fmt.Errorf("invalid weight %v", weight)
+	}
+	if err := a.check(vars); err != nil {
+		return fmt.Errorf("set %d: %v", a.sets, err)
+	}
+	values := make(map[string][]float64)
+	for name, t := range vars {
+		if !averaged(t.DataType()) {
+			continue
+		}
+		v, err := floats(t)
+		if err != nil {
+			return fmt.Errorf("set %d: variable %q: %v", a.sets, name, err)
+		}
+		values[name] = v
+	}
+	if a.first == nil {
+		a.first = vars
+	}
+	for name, v := range values {
+		sum := a.sums[name]
+		if sum == nil {
+			sum = make([]float64, len(v))
+			a.sums[name] = sum
+		}
+		for i := range v {
+			sum[i] += weight * v[i]
+		}
+	}
+	a.weight += weight
+	a.sets++
+	return nil
+}
+
+// check returns an error if vars does not match the first set.
+func (a *Aggregator) check(vars map[string]*tf.Tensor) error {
+	if a.first == nil {
+		if len(vars) == 0 {
+			return errors.New("no variables")
+		}
+		return nil
+	}
+	for name := range a.first {
+		if _, ok := vars[name]; !ok {
+			return fmt.Errorf("missing variable %q", name)
+		}
+	}
+	for name, t := range vars {
+		want, ok := a.first[name]
+		if !ok {
+			return fmt.Errorf("unexpected variable %q", name)
+		}
+		if t.DataType() != want.DataType() {
+			return fmt.Errorf("variable %q has type %v, want %v", name, t.DataType(), want.DataType())
+		}
+		if !reflect.DeepEqual(t.Shape(), want.Shape()) {
+			return fmt.Errorf("variable %q has shape %v, want %v", name, t.Shape(), want.Shape())
+		}
+	}
+	return nil
+}
+
+// Sets returns the number of sets added.
+func (a *Aggregator) Sets() int { return a.sets }
+
+// Average returns the weighted average of the sets added.
+func (a *Aggregator) Average() (map[string]*tf.Tensor, error) {
+	if a.sets == 0 {
+		return nil, errors.New("no sets to average")
+	}
+	if a.weight == 0 {
+		return nil, errors.New("sets have a total weight of zero")
+	}
+	average := make(map[string]*tf.Tensor, len(a.first))
+	for name, t := range a.first {
+		sum, ok := a.sums[name]
+		if !ok {
+			average[name] = t
+			continue
+		}
+		var buf bytes.Buffer
+		for _, v := range sum {
+			v /= a.weight
+			if t.DataType() == tf.Float {
+				binary.Write(&buf, binary.LittleEndian, float32(v))
+			} else {
+				binary.Write(&buf, binary.LittleEndian, v)
+			}
+		}
+		var err error
+		if average[name], err = tf.ReadTensor(t.DataType(), t.Shape(), &buf); err != nil {
+			return nil, fmt.Errorf("variable %q: %v", name, err)
+		}
+	}
+	return average, nil
+}
+
+// Average returns the weighted average of sets of variables. A nil weights
+// weighs all the sets equally.
+func Average(sets []map[string]*tf.Tensor, weights []float64) (map[string]*tf.Tensor, error) {
+	if weights != nil && len(weights) != len(sets) {
+		return nil, fmt.Errorf("got %d weights for %d sets", len(weights), len(sets))
+	}
+	a := NewAggregator()
+	for i, vars := range sets {
+		weight := 1.0
+		if weights != nil {
+			weight = weights[i]
+		}
+		if err := a.Add(vars, weight); err != nil {
+			return nil, err
+		}
+	}
+	return a.Average()
+}
+
+// AverageCheckpoints writes to the checkpoint with prefix out the weighted
+// average of the checkpoints with the given prefixes, reading one at a
+// time. A nil weights weighs all the checkpoints equally.
+func AverageCheckpoints(out string, prefixes []string, weights []float64) error {
+	if weights != nil && len(weights) != len(prefixes) {
+		return fmt.Errorf("got %d weights for %d checkpoints", len(weights), len(prefixes))
+	}
+	a := NewAggregator()
+	for i, prefix := range prefixes {
+		vars, err := ReadCheckpoint(prefix)
+		if err != nil {
+			return err
+		}
+		weight := 1.0
+		if weights != nil {
+			weight = weights[i]
+		}
+		if err := a.Add(vars, weight); err != nil {
+			return fmt.Errorf("%s: %v", prefix, err)
+		}
+	}
+	average, err := a.Average()
+	if err != nil {
+		return err
+	}
+	return WriteCheckpoint(out, average)
+}
+
+func averaged(dt tf.DataType) bool {
+	return dt == tf.Float || dt == tf.Double
+}
+
+// floats returns the values of a Float or Double Tensor.
+func floats(t *tf.Tensor) ([]float64, error) {
+	var buf bytes.Buffer
+	if _, err := t.WriteContentsTo(&buf); err != nil {
+		return nil, err
+	}
+	b := buf.Bytes()
+	if t.DataType() == tf.Float {
+		v := make([]float64, len(b)/4)
+		for i := range v {
+			v[i] = float64(math.Float32frombits(binary.LittleEndian.Uint32(b[4*i:])))
+		}
+		return v, nil
+	}
+	v := make([]float64, len(b)/8)
+	for i := range v {
+		v[i] = math.Float64frombits(binary.LittleEndian.Uint64(b[8*i:]))
+	}
+	return v, nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package federated
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+const halfPlusTwo = "../../cc/saved_model/testdata/half_plus_two/00000123/variables/variables"
+
+func tensor(t *testing.T, v interface{}) *tf.Tensor {
+	tensor, err := tf.NewTensor(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tensor
+}
+
+func TestListAndReadCheckpoint(t *testing.T) {
+	vars, err := ListCheckpoint(halfPlusTwo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vars) != 3 {
+		t.Fatalf("Got %v, want variables a, b and c", vars)
+	}
+	for _, name := range []string{"a", "b", "c"} {
+		if info := vars[name]; info.DataType != tf.Float || len(info.Shape) != 0 {
+			t.Errorf("Got %q: %+v, want a Float scalar", name, info)
+		}
+	}
+	values, err := ReadCheckpoint(halfPlusTwo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := values["a"].Value(), float32(0.5); got != want {
+		t.Errorf("Got a = %v, want %v", got, want)
+	}
+	if got, want := values["b"].Value(), float32(2); got != want {
+		t.Errorf("Got b = %v, want %v", got, want)
+	}
+}
+
+func TestAverage(t *testing.T) {
+	sets := []map[string]*tf.Tensor{
+		{"w": tensor(t, []float32{1, 2}), "d": tensor(t, 1.0), "step": tensor(t, int64(10))},
+		{"w": tensor(t, []float32{4, 8}), "d": tensor(t, 4.0), "step": tensor(t, int64(20))},
+	}
+	average, err := Average(sets, []float64{2, 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := average["w"].Value(), []float32{2, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Got w = %v, want %v", got, want)
+	}
+	if got, want := average["d"].Value(), 2.0; got != want {
+		t.Errorf("Got d = %v, want %v", got, want)
+	}
+	if got, want := average["step"].Value(), int64(10); got != want {
+		t.Errorf("Got step = %v, want %v", got, want)
+	}
+
+	for _, set := range []map[string]*tf.Tensor{
+		{"w": tensor(t, []float32{1, 2}), "d": tensor(t, 1.0)},
+		{"w": tensor(t, []float32{1, 2, 3}), "d": tensor(t, 1.0), "step": tensor(t, int64(1))},
+		{"w": tensor(t, []float64{1, 2}), "d": tensor(t, 1.0), "step": tensor(t, int64(1))},
+		{"w": tensor(t, []float32{1, 2}), "d": tensor(t, 1.0), "step": tensor(t, int64(1)), "x": tensor(t, 1.0)},
+	} {
+		a := NewAggregator()
+		if err := a.Add(sets[0], 1); err != nil {
+			t.Fatal(err)
+		}
+		if err := a.Add(set, 1); err == nil {
+			t.Errorf("Adding %v succeeded, want an error", set)
+		}
+		if a.Sets() != 1 {
+			t.Errorf("Got %d sets, want 1", a.Sets())
+		}
+	}
+	if _, err := Average(sets, []float64{0, 0}); err == nil {
+		t.Error("Average succeeded with zero weights")
+	}
+}
+
+func TestAverageCheckpoints(t *testing.T) {
+	dir, err := ioutil.TempDir("", "federated")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	var prefixes []string
+	for i, v := range [][]float32{{1, 2}, {3, 6}} {
+		prefix := filepath.Join(dir, "client", "ckpt-"+strconv.Itoa(i))
+		if err := WriteCheckpoint(prefix, map[string]*tf.Tensor{"w": tensor(t, v)}); err != nil {
+			t.Fatal(err)
+		}
+		prefixes = append(prefixes, prefix)
+	}
+	out := filepath.Join(dir, "server", "ckpt")
+	if err := AverageCheckpoints(out, prefixes, nil); err != nil {
+		t.Fatal(err)
+	}
+	vars, err := ListCheckpoint(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (TensorInfo{tf.Float, []int64{2}}); !reflect.DeepEqual(vars["w"], want) {
+		t.Errorf("Got %+v, want %+v", vars["w"], want)
+	}
+	values, err := ReadCheckpoint(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := values["w"].Value(), []float32{2, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Got %v, want %v", got, want)
+	}
+}
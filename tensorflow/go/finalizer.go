@@ -0,0 +1,49 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import "sync"
+
+var finalizerDebug struct {
+	sync.Mutex
+	report func(typ string)
+}
+
+// DebugFinalizers arranges for report to be called whenever a Tensor, Graph,
+// Session, PartialRun or Library is garbage collected without having been
+// explicitly released (using Release or Close). typ is the name of the type
+// of the object, for example "Tensor".
+//
+// Memory allocated by the TensorFlow runtime is invisible to the Go garbage
+// collector, so relying on finalizers to free it can lead to unpredictable
+// memory usage. This is intended to help find the objects responsible.
+// report is called from the finalizer goroutine and must not block. A nil
+// report disables reporting.
+func DebugFinalizers(report func(typ string)) {
+	finalizerDebug.Lock()
+	finalizerDebug.report = report
+	finalizerDebug.Unlock()
+}
+
+// finalized is called by the finalizer of an object of type typ that was not
+// explicitly released.
+func finalized(typ string) {
+	finalizerDebug.Lock()
+	report := finalizerDebug.report
+	finalizerDebug.Unlock()
+	if report != nil {
+		report(typ)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestDebugFinalizers(t *testing.T) {
+	reports := make(chan string, 10)
+	DebugFinalizers(func(typ string) {
+		select {
+		case reports <- typ:
+		default:
+		}
+	})
+	defer DebugFinalizers(nil)
+
+	released, err := NewTensor(int32(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	released.Release()
+	released.Release()
+	if _, err := NewTensor(int32(2)); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.After(10 * time.Second)
+	for {
+		runtime.GC()
+		select {
+		case typ := <-reports:
+			// Objects leaked by other tests may be reported too.
+			if typ == "Tensor" {
+				return
+			}
+		case <-deadline:
+			t.Fatal("Tensor freed by its finalizer was not reported")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
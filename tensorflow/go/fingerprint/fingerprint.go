@@ -0,0 +1,403 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fingerprint identifies TensorFlow graphs and SavedModels, so that
+// deployment systems can check that a loaded model is the one that was
+// approved.
+//
+// Two kinds of identity are provided: Digest hashes every byte of a
+// SavedModel directory, while the Fingerprint of a SavedModel, stored in
+// its fingerprint.pb file, hashes its parts separately, including a
+// canonical hash of its graph that does not depend on the names of its
+// operations.
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package fingerprint
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+	savedmodelpb "github.com/tensorflow/tensorflow/tensorflow/go/core/protobuf"
+	"github.com/tensorflow/tensorflow/tensorflow/go/hostop"
+)
+
+// Graph returns the canonical hash of g, see GraphDef.
+func Graph(g *tf.Graph) (uint64, error) {
+	var buf bytes.Buffer
+	if _, err := g.WriteTo(&buf); err != nil {
+		return 0, err
+	}
+	return GraphDef(buf.Bytes())
+}
+
+// GraphDef returns the canonical hash of a serialized tensorflow.GraphDef
+// protocol buffer
+// (https://www.tensorflow.org/code/tensorflow/core/framework/graph.proto).
+//
+// The hash depends on the structure of the graph, and on the type, device
+// and attributes (including the values of constants) of its operations, but
+// not on their names or order, so renaming operations or reordering the
+// nodes of the GraphDef leaves it unchanged. Attributes whose names start
+// with "_", which are annotations such as "_output_shapes" and "_class"
+// (whose values are names), are ignored.
+func GraphDef(graphDef []byte) (uint64, error) {
+	var def pb.GraphDef
+	if err := proto.Unmarshal(graphDef, &def); err != nil {
+		return 0, fmt.Errorf("invalid GraphDef: %v", err)
+	}
+	return graphHash(&def)
+}
+
+// input is an edge of the graph: the index of the source node, and the
+// index of its output, or -1 for control inputs.
+type input struct {
+	node, output int
+}
+
+func graphHash(def *pb.GraphDef) (uint64, error) {
+	index := make(map[string]int, len(def.Node))
+	for i, n := range def.Node {
+		index[n.Name] = i
+	}
+	inputs := make([][]input, len(def.Node))
+	labels := make([]uint64, len(def.Node))
+	for i, n := range def.Node {
+		for _, name := range n.Input {
+			in, err := parseInput(name, index)
+			if err != nil {
+				return 0, fmt.Errorf("node %q: %v", n.Name, err)
+			}
+			inputs[i] = append(inputs[i], in)
+		}
+		l, err := nodeLabel(n)
+		if err != nil {
+			return 0, fmt.Errorf("node %q: %v", n.Name, err)
+		}
+		labels[i] = l
+	}
+
+	// Refine the labels of the nodes with those of their inputs until
+	// they no longer distinguish more nodes (Weisfeiler-Lehman), which
+	// also handles the cycles of while loops.
+	for distinct := countDistinct(labels); ; {
+		next := make([]uint64, len(labels))
+		for i := range labels {
+			var b []byte
+			b = appendUint64(b, labels[i])
+			var control []uint64
+			for _, in := range inputs[i] {
+				if in.output < 0 {
+					control = append(control, labels[in.node])
+					continue
+				}
+				b = appendUint64(b, labels[in.node])
+				b = appendUint64(b, uint64(in.output))
+			}
+			// The order of control inputs does not matter.
+			sort.Sort(uint64s(control))
+			b = append(b, '^')
+			for _, l := range control {
+				b = appendUint64(b, l)
+			}
+			next[i] = hostop.Fingerprint64(b)
+		}
+		labels = next
+		n := countDistinct(labels)
+		if n == distinct {
+			break
+		}
+		distinct = n
+	}
+
+	sorted := append([]uint64(nil), labels...)
+	sort.Sort(uint64s(sorted))
+	var b []byte
+	for _, l := range sorted {
+		b = appendUint64(b, l)
+	}
+	// A graph without versions or library is hashed as one with empty
+	// ones, which are equivalent.
+	versions, library := def.Versions, def.Library
+	if versions == nil {
+		versions = new(pb.VersionDef)
+	}
+	if library == nil {
+		library = new(pb.FunctionDefLibrary)
+	}
+	for _, m := range []proto.Message{versions, library} {
+		s, err := marshalDeterministic(m)
+		if err != nil {
+			return 0, err
+		}
+		b = appendBytes(b, s)
+	}
+	return hostop.Fingerprint64(b), nil
+}
+
+// parseInput parses an input of a NodeDef, such as "name:1" or "^name".
+func parseInput(name string, index map[string]int) (input, error) {
+	var in input
+	node, output, err := tf.ParseTensorName(name)
+	if err != nil {
+		return in, err
+	}
+	in.output = output
+	var ok bool
+	if in.node, ok = index[node]; !ok {
+		return in, fmt.Errorf("input %q not found", name)
+	}
+	return in, nil
+}
+
+// nodeLabel returns the hash of the properties of n other than its name
+// and inputs.
+func nodeLabel(n *pb.NodeDef) (uint64, error) {
+	b := appendBytes(nil, []byte(n.Op))
+	b = appendBytes(b, []byte(n.Device))
+	names := make([]string, 0, len(n.Attr))
+	for name := range n.Attr {
+		if !strings.HasPrefix(name, "_") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		v, err := marshalDeterministic(n.Attr[name])
+		if err != nil {
+			return 0, fmt.Errorf("attribute %q: %v", name, err)
+		}
+		b = appendBytes(b, []byte(name))
+		b = appendBytes(b, v)
+	}
+	return hostop.Fingerprint64(b), nil
+}
+
+// marshalDeterministic serializes m with map entries in a stable order.
+func marshalDeterministic(m proto.Message) ([]byte, error) {
+	var buf proto.Buffer
+	buf.SetDeterministic(true)
+	if err := buf.Marshal(m); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func countDistinct(labels []uint64) int {
+	seen := make(map[uint64]bool, len(labels))
+	for _, l := range labels {
+		seen[l] = true
+	}
+	return len(seen)
+}
+
+func appendUint64(b []byte, v uint64) []byte {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], v)
+	return append(b, buf[:]...)
+}
+
+// appendBytes appends s prefixed by its length, so that the concatenation
+// of several values is unambiguous.
+func appendBytes(b, s []byte) []byte {
+	return append(appendUint64(b, uint64(len(s))), s...)
+}
+
+type uint64s []uint64
+
+func (s uint64s) Len() int           { return len(s) }
+func (s uint64s) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s uint64s) Less(i, j int) bool { return s[i] < s[j] }
+
+// Fingerprint identifies the parts of a SavedModel, like the
+// tensorflow.FingerprintDef protocol buffer stored in the fingerprint.pb
+// file of SavedModels by recent versions of TensorFlow. The hashes are
+// 64-bit FarmHash fingerprints (see hostop.Fingerprint64).
+//
+// Except for SavedModelChecksum and CheckpointHash, the hashes are computed
+// by this package and only comparable to those computed by it.
+type Fingerprint struct {
+	// SavedModelChecksum is the hash of the saved_model.pb file.
+	SavedModelChecksum uint64
+	// GraphDefProgramHash is the canonical hash of the graph of the first
+	// MetaGraphDef, see GraphDef.
+	GraphDefProgramHash uint64
+	// SignatureDefHash is the hash of the SignatureDefs of the first
+	// MetaGraphDef.
+	SignatureDefHash uint64
+	// CheckpointHash is the hash of the variables/variables.index file, or
+	// 0 if the model has no variables.
+	CheckpointHash uint64
+}
+
+// FileName is the name of the file storing the Fingerprint of a SavedModel
+// in its directory.
+const FileName = "fingerprint.pb"
+
+// SavedModel computes the Fingerprint of the SavedModel in exportDir.
+func SavedModel(exportDir string) (*Fingerprint, error) {
+	b, err := ioutil.ReadFile(filepath.Join(exportDir, "saved_model.pb"))
+	if err != nil {
+		return nil, err
+	}
+	var sm savedmodelpb.SavedModel
+	if err := proto.Unmarshal(b, &sm); err != nil {
+		return nil, fmt.Errorf("invalid SavedModel: %v", err)
+	}
+	if len(sm.MetaGraphs) == 0 {
+		return nil, fmt.Errorf("SavedModel in %s has no MetaGraphDef", exportDir)
+	}
+	f := &Fingerprint{SavedModelChecksum: hostop.Fingerprint64(b)}
+	mg := sm.MetaGraphs[0]
+	if f.GraphDefProgramHash, err = graphHash(mg.GraphDef); err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(mg.SignatureDef))
+	for k := range mg.SignatureDef {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var sigs []byte
+	for _, k := range keys {
+		s, err := marshalDeterministic(mg.SignatureDef[k])
+		if err != nil {
+			return nil, err
+		}
+		sigs = appendBytes(sigs, []byte(k))
+		sigs = appendBytes(sigs, s)
+	}
+	f.SignatureDefHash = hostop.Fingerprint64(sigs)
+	index, err := ioutil.ReadFile(filepath.Join(exportDir, "variables", "variables.index"))
+	switch {
+	case err == nil:
+		f.CheckpointHash = hostop.Fingerprint64(index)
+	case !os.IsNotExist(err):
+		return nil, err
+	}
+	return f, nil
+}
+
+// fingerprintDef is the tensorflow.FingerprintDef protocol buffer, which
+// this version of TensorFlow does not define.
+type fingerprintDef struct {
+	SavedModelChecksum   uint64         `protobuf:"varint,1,opt,name=saved_model_checksum,json=savedModelChecksum,proto3"`
+	GraphDefProgramHash  uint64         `protobuf:"varint,2,opt,name=graph_def_program_hash,json=graphDefProgramHash,proto3"`
+	SignatureDefHash     uint64         `protobuf:"varint,3,opt,name=signature_def_hash,json=signatureDefHash,proto3"`
+	SavedObjectGraphHash uint64         `protobuf:"varint,4,opt,name=saved_object_graph_hash,json=savedObjectGraphHash,proto3"`
+	CheckpointHash       uint64         `protobuf:"varint,5,opt,name=checkpoint_hash,json=checkpointHash,proto3"`
+	Version              *pb.VersionDef `protobuf:"bytes,6,opt,name=version,proto3"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *fingerprintDef) Reset()         { *m = fingerprintDef{} }
+func (m *fingerprintDef) String() string { return proto.CompactTextString(m) }
+func (*fingerprintDef) ProtoMessage()    {}
+
+// Read reads the Fingerprint stored in the fingerprint.pb file of the
+// SavedModel in exportDir.
+func Read(exportDir string) (*Fingerprint, error) {
+	b, err := ioutil.ReadFile(filepath.Join(exportDir, FileName))
+	if err != nil {
+		return nil, err
+	}
+	var def fingerprintDef
+	if err := proto.Unmarshal(b, &def); err != nil {
+		return nil, fmt.Errorf("invalid FingerprintDef: %v", err)
+	}
+	return &Fingerprint{
+		SavedModelChecksum:  def.SavedModelChecksum,
+		GraphDefProgramHash: def.GraphDefProgramHash,
+		SignatureDefHash:    def.SignatureDefHash,
+		CheckpointHash:      def.CheckpointHash,
+	}, nil
+}
+
+// Write stores f in the fingerprint.pb file of the SavedModel in
+// exportDir.
+func Write(exportDir string, f *Fingerprint) error {
+	b, err := proto.Marshal(&fingerprintDef{
+		SavedModelChecksum:  f.SavedModelChecksum,
+		GraphDefProgramHash: f.GraphDefProgramHash,
+		SignatureDefHash:    f.SignatureDefHash,
+		CheckpointHash:      f.CheckpointHash,
+		Version:             &pb.VersionDef{Producer: 1},
+	})
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(exportDir, FileName), b, 0644)
+}
+
+// Digest returns the SHA-256 hash of the names and contents of all the
+// files of the SavedModel in exportDir, except its fingerprint.pb file, so
+// that a model is identified byte for byte.
+func Digest(exportDir string) ([sha256.Size]byte, error) {
+	var digest [sha256.Size]byte
+	var files []string
+	err := filepath.Walk(exportDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(exportDir, path)
+		if err != nil {
+			return err
+		}
+		if rel = filepath.ToSlash(rel); rel != FileName {
+			files = append(files, rel)
+		}
+		return nil
+	})
+	if err != nil {
+		return digest, err
+	}
+	// Walk visits files in lexical order already, but sort the
+	// slash-separated names for the same digest on all platforms.
+	sort.Strings(files)
+	h := sha256.New()
+	for _, rel := range files {
+		f, err := os.Open(filepath.Join(exportDir, filepath.FromSlash(rel)))
+		if err != nil {
+			return digest, err
+		}
+		info, err := f.Stat()
+		if err == nil {
+			h.Write(appendBytes(nil, []byte(rel)))
+			h.Write(appendUint64(nil, uint64(info.Size())))
+			_, err = io.Copy(h, f)
+		}
+		f.Close()
+		if err != nil {
+			return digest, err
+		}
+	}
+	copy(digest[:], h.Sum(nil))
+	return digest, nil
+}
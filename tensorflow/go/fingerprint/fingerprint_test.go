@@ -0,0 +1,162 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fingerprint
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+func graph(t *testing.T, prefix string, c float32, swap bool) *tf.Graph {
+	s := op.NewScope().SubScope(prefix)
+	x := op.Placeholder(s, tf.Float)
+	y := op.Const(s.SubScope("c"), c)
+	if swap {
+		x, y = y, x
+	}
+	op.Sub(s, x, y)
+	g, err := s.Finalize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func TestGraph(t *testing.T) {
+	hash := func(g *tf.Graph) uint64 {
+		h, err := Graph(g)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return h
+	}
+	want := hash(graph(t, "a", 1, false))
+	if got := hash(graph(t, "renamed", 1, false)); got != want {
+		t.Errorf("Renaming operations changed the hash from %x to %x", want, got)
+	}
+	if got := hash(graph(t, "a", 2, false)); got == want {
+		t.Error("Changing a constant did not change the hash")
+	}
+	if got := hash(graph(t, "a", 1, true)); got == want {
+		t.Error("Swapping inputs did not change the hash")
+	}
+}
+
+func TestGraphDef(t *testing.T) {
+	// A loop: the order of the nodes does not matter either.
+	def := &pb.GraphDef{Node: []*pb.NodeDef{
+		{Name: "merge", Op: "Merge", Input: []string{"enter", "next"}},
+		{Name: "next", Op: "NextIteration", Input: []string{"merge:0", "^enter"}},
+		{Name: "enter", Op: "Enter", Attr: map[string]*pb.AttrValue{
+			"_class": {Value: &pb.AttrValue_S{S: []byte("loc:@merge")}},
+		}},
+	}}
+	hash := func() uint64 {
+		b, err := proto.Marshal(def)
+		if err != nil {
+			t.Fatal(err)
+		}
+		h, err := GraphDef(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return h
+	}
+	want := hash()
+	def.Node[0], def.Node[2] = def.Node[2], def.Node[0]
+	def.Node[0].Attr["_class"].Value = &pb.AttrValue_S{S: []byte("loc:@other")}
+	if got := hash(); got != want {
+		t.Errorf("Got %x, want %x", got, want)
+	}
+	def.Node[1].Input = []string{"missing"}
+	b, err := proto.Marshal(def)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := GraphDef(b); err == nil {
+		t.Error("GraphDef with a missing input succeeded, want an error")
+	}
+}
+
+func TestSavedModel(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fingerprint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	src := "../../cc/saved_model/testdata/half_plus_two/00000123"
+	if err := copyDir(src, dir); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := SavedModel(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.SavedModelChecksum == 0 || f.GraphDefProgramHash == 0 || f.SignatureDefHash == 0 || f.CheckpointHash == 0 {
+		t.Errorf("Got %+v, want non-zero hashes", f)
+	}
+	digest, err := Digest(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Write(dir, f); err != nil {
+		t.Fatal(err)
+	}
+	read, err := Read(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *read != *f {
+		t.Errorf("Read: got %+v, want %+v", read, f)
+	}
+	// The fingerprint file does not change the digest, but other files do.
+	if got, err := Digest(dir); err != nil || got != digest {
+		t.Errorf("Digest after Write: got (%x, %v), want %x", got, err, digest)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "assets.extra"), []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := Digest(dir); err != nil || got == digest {
+		t.Errorf("Digest after adding a file: got (%x, %v), want a different digest", got, err)
+	}
+}
+
+func copyDir(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return os.MkdirAll(filepath.Join(dst, rel), 0755)
+		}
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(filepath.Join(dst, rel), b, 0644)
+	})
+}
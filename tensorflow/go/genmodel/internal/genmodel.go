@@ -0,0 +1,334 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package internal generates Go packages with typed clients for the
+// signatures of SavedModels.
+//
+// The generated package has a Model type with one method per signature,
+// taking a request struct with a field for each input and returning a
+// response struct with a field for each output. Fields are nested slices
+// of the Go type of the tensor when its rank is known, and *tf.Tensor
+// otherwise. Inputs are validated against the types and known dimensions
+// of the signature before running the model.
+package internal
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/signature"
+)
+
+// Generate writes to w the source of the package named pkg, with a typed
+// client for the signatures sigs of the MetaGraphDef tagged with tags.
+// source describes the model in the header of the file.
+func Generate(w io.Writer, pkg, source string, tags []string, sigs map[string]signature.Signature) error {
+	keys := make([]string, 0, len(sigs))
+	for key := range sigs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	args := tmplArgs{Package: pkg, Source: source, Tags: tags}
+	methods := make(names)
+	for _, key := range keys {
+		sig := sigs[key]
+		m := method{Key: key, Name: methods.unique(exportedName(key))}
+		var err error
+		if m.Inputs, err = fields(m.Name+"Input", sig.Inputs); err != nil {
+			return fmt.Errorf("signature %q: %v", key, err)
+		}
+		if m.Outputs, err = fields(m.Name+"Output", sig.Outputs); err != nil {
+			return fmt.Errorf("signature %q: %v", key, err)
+		}
+		args.Methods = append(args.Methods, m)
+	}
+	return tmplPackage.Execute(w, args)
+}
+
+type tmplArgs struct {
+	Package, Source string
+	Tags            []string
+	Methods         []method
+}
+
+// method describes the method generated for a signature.
+type method struct {
+	Key, Name       string
+	Inputs, Outputs []field
+}
+
+// field describes the field generated for an input or output of a
+// signature.
+type field struct {
+	// Key is the key of the tensor in the signature, Name the name of
+	// the field and Const the name of the constant holding TensorName,
+	// the name of the tensor in the graph.
+	Key, Name, Const, TensorName string
+	// GoType is the type of the field, DataType the name of the
+	// tf.DataType constant of the tensor and Dims its dimensions as a Go
+	// expression, "nil" if its rank is unknown. Type and Shape describe
+	// the tensor in the documentation of the field.
+	GoType, DataType, Dims string
+	Type, Shape            string
+}
+
+func fields(constPrefix string, infos map[string]signature.TensorInfo) ([]field, error) {
+	keys := make([]string, 0, len(infos))
+	for key := range infos {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	used := make(names)
+	var ret []field
+	for _, key := range keys {
+		info := infos[key]
+		dt, ok := dataTypeNames[info.DataType]
+		if !ok {
+			return nil, fmt.Errorf("%q: unsupported type %v", key, info.DataType)
+		}
+		f := field{
+			Key:        key,
+			Name:       used.unique(exportedName(key)),
+			TensorName: info.Name,
+			GoType:     "*tf.Tensor",
+			DataType:   "tf." + dt,
+			Dims:       "nil",
+			Type:       info.DataType.String(),
+			Shape:      info.Shape.String(),
+		}
+		f.Const = constPrefix + f.Name
+		if dims, err := info.Shape.ToSlice(); err == nil {
+			strs := make([]string, len(dims))
+			for i, d := range dims {
+				strs[i] = fmt.Sprint(d)
+			}
+			f.Dims = "[]int64{" + strings.Join(strs, ", ") + "}"
+			if elem, ok := goTypes[info.DataType]; ok {
+				f.GoType = strings.Repeat("[]", len(dims)) + elem
+			}
+		}
+		ret = append(ret, f)
+	}
+	return ret, nil
+}
+
+// dataTypeNames are the names of the tf.DataType constants.
+var dataTypeNames = map[tf.DataType]string{
+	tf.Float:      "Float",
+	tf.Double:     "Double",
+	tf.Int32:      "Int32",
+	tf.Uint8:      "Uint8",
+	tf.Int16:      "Int16",
+	tf.Int8:       "Int8",
+	tf.String:     "String",
+	tf.Complex64:  "Complex64",
+	tf.Int64:      "Int64",
+	tf.Bool:       "Bool",
+	tf.Qint8:      "Qint8",
+	tf.Quint8:     "Quint8",
+	tf.Qint32:     "Qint32",
+	tf.Bfloat16:   "Bfloat16",
+	tf.Qint16:     "Qint16",
+	tf.Quint16:    "Quint16",
+	tf.Uint16:     "Uint16",
+	tf.Complex128: "Complex128",
+	tf.Half:       "Half",
+	tf.Resource:   "Resource",
+}
+
+// goTypes are the Go types of the elements of tensors converted by
+// tf.NewTensor and Tensor.Value. Tensors of other types are represented
+// by *tf.Tensor.
+var goTypes = map[tf.DataType]string{
+	tf.Float:      "float32",
+	tf.Double:     "float64",
+	tf.Int32:      "int32",
+	tf.Uint8:      "uint8",
+	tf.Int16:      "int16",
+	tf.Int8:       "int8",
+	tf.String:     "string",
+	tf.Complex64:  "complex64",
+	tf.Int64:      "int64",
+	tf.Bool:       "bool",
+	tf.Uint16:     "uint16",
+	tf.Complex128: "complex128",
+}
+
+// exportedName converts a key such as "serving_default" or "input-ids" to
+// an exported Go identifier such as "ServingDefault" or "InputIds".
+func exportedName(key string) string {
+	var buf []rune
+	upper := true
+	for _, r := range key {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		buf = append(buf, r)
+	}
+	if len(buf) == 0 || !unicode.IsLetter(buf[0]) {
+		buf = append([]rune("X"), buf...)
+	}
+	return string(buf)
+}
+
+// names makes identifiers unique.
+type names map[string]bool
+
+func (n names) unique(name string) string {
+	unique := name
+	for i := 2; n[unique]; i++ {
+		unique = fmt.Sprintf("%s%d", name, i)
+	}
+	n[unique] = true
+	return unique
+}
+
+var tmplPackage = template.Must(template.New("package").Parse(`// Code generated by genmodel from {{.Source}}. DO NOT EDIT.
+
+// Package {{.Package}} is a typed client for the signatures of the
+// SavedModel {{.Source}}.
+package {{.Package}}
+
+import (
+	"fmt"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+// Tags are the tags of the MetaGraphDef loaded by Load.
+var Tags = []string{ {{- range $i, $t := .Tags}}{{if $i}}, {{end}}{{printf "%q" $t}}{{end -}} }
+{{range .Methods}}
+// Names of the tensors of the signature {{printf "%q" .Key}}.
+const (
+{{- range .Inputs}}
+	{{.Const}} = {{printf "%q" .TensorName}}
+{{- end}}
+{{- range .Outputs}}
+	{{.Const}} = {{printf "%q" .TensorName}}
+{{- end}}
+)
+{{end}}
+// Model runs the signatures of a SavedModel.
+type Model struct {
+	SavedModel *tf.SavedModel
+}
+
+// Load loads the SavedModel exported in exportDir, with the MetaGraphDef
+// tagged with Tags.
+func Load(exportDir string, options *tf.SessionOptions) (*Model, error) {
+	m, err := tf.LoadSavedModel(exportDir, Tags, options)
+	if err != nil {
+		return nil, err
+	}
+	return &Model{SavedModel: m}, nil
+}
+{{range .Methods}}{{$m := .}}
+// {{.Name}}Request holds the inputs of the signature {{printf "%q" .Key}}.
+type {{.Name}}Request struct {
+{{- range .Inputs}}
+	// {{.Name}} is the input {{printf "%q" .Key}}, a {{.Type}} tensor of shape {{.Shape}}.
+	{{.Name}} {{.GoType}}
+{{- end}}
+}
+
+// {{.Name}}Response holds the outputs of the signature {{printf "%q" .Key}}.
+type {{.Name}}Response struct {
+{{- range .Outputs}}
+	// {{.Name}} is the output {{printf "%q" .Key}}, a {{.Type}} tensor of shape {{.Shape}}.
+	{{.Name}} {{.GoType}}
+{{- end}}
+}
+
+// {{.Name}} runs the signature {{printf "%q" .Key}}.
+func (m *Model) {{.Name}}(req *{{.Name}}Request) (*{{.Name}}Response, error) {
+	graph := m.SavedModel.Graph
+	feeds := make(map[tf.Output]*tf.Tensor, {{len .Inputs}})
+{{- range .Inputs}}
+	if err := feed(graph, feeds, {{printf "%q" .Key}}, {{.Const}}, req.{{.Name}}, {{.DataType}}, {{.Dims}}); err != nil {
+		return nil, fmt.Errorf("{{$m.Name}}: %v", err)
+	}
+{{- end}}
+	fetches, err := outputs(graph{{range .Outputs}}, {{.Const}}{{end}})
+	if err != nil {
+		return nil, fmt.Errorf("{{$m.Name}}: %v", err)
+	}
+	results, err := m.SavedModel.Session.Run(feeds, fetches, nil)
+	if err != nil {
+		return nil, err
+	}
+	res := new({{.Name}}Response)
+{{- range $i, $o := .Outputs}}
+{{- if eq .GoType "*tf.Tensor"}}
+	res.{{.Name}} = results[{{$i}}]
+{{- else}}
+	if v, ok := results[{{$i}}].Value().({{.GoType}}); ok {
+		res.{{.Name}} = v
+	} else {
+		return nil, fmt.Errorf("{{$m.Name}}: output %q is a %v tensor of shape %v, want {{.GoType}}", {{printf "%q" .Key}}, results[{{$i}}].DataType(), results[{{$i}}].Shape())
+	}
+{{- end}}
+{{- end}}
+	return res, nil
+}
+{{end}}
+// feed validates value, the input key of a signature, and adds it to feeds
+// as the tensor name of graph.
+func feed(graph *tf.Graph, feeds map[tf.Output]*tf.Tensor, key, name string, value interface{}, dt tf.DataType, dims []int64) error {
+	t, ok := value.(*tf.Tensor)
+	if !ok || t == nil {
+		if value == nil || ok {
+			return fmt.Errorf("input %q is not set", key)
+		}
+		var err error
+		if t, err = tf.NewTensor(value); err != nil {
+			return fmt.Errorf("input %q: %v", key, err)
+		}
+	}
+	if t.DataType() != dt {
+		return fmt.Errorf("input %q: got a %v tensor, want %v", key, t.DataType(), dt)
+	}
+	if dims != nil && !tf.MakeShape(t.Shape()...).IsCompatibleWith(tf.MakeShape(dims...)) {
+		return fmt.Errorf("input %q: got shape %v, want %v", key, tf.MakeShape(t.Shape()...), tf.MakeShape(dims...))
+	}
+	o, err := outputs(graph, name)
+	if err != nil {
+		return err
+	}
+	feeds[o[0]] = t
+	return nil
+}
+
+// outputs returns the outputs of graph with the given names, in the form
+// "operation:index".
+func outputs(graph *tf.Graph, names ...string) ([]tf.Output, error) {
+	ret := make([]tf.Output, len(names))
+	for i, name := range names {
+		var err error
+		if ret[i], err = graph.OutputByName(name); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+`))
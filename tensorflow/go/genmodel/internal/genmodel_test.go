@@ -0,0 +1,116 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"bytes"
+	"go/format"
+	"strings"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/signature"
+)
+
+func TestGenerate(t *testing.T) {
+	sigs := map[string]signature.Signature{
+		"serving_default": {
+			Inputs: map[string]signature.TensorInfo{
+				"x":   {Name: "x:0", DataType: tf.Float, Shape: tf.MakeShape(-1, 1)},
+				"ids": {Name: "ids:0", DataType: tf.Int64, Shape: tf.ScalarShape()},
+				"any": {Name: "any:0", DataType: tf.String, Shape: tf.Shape{}},
+			},
+			Outputs: map[string]signature.TensorInfo{
+				"y": {Name: "y:1", DataType: tf.Qint8, Shape: tf.MakeShape(2)},
+			},
+		},
+		"serving-default": {
+			Outputs: map[string]signature.TensorInfo{
+				"y": {Name: "z:0", DataType: tf.Bool, Shape: tf.MakeShape(2)},
+			},
+		},
+	}
+	var buf bytes.Buffer
+	if err := Generate(&buf, "mymodel", "my_model", []string{"serve", "gpu"}, sigs); err != nil {
+		t.Fatal(err)
+	}
+	got, err := format.Source(buf.Bytes())
+	if err != nil {
+		t.Fatalf("Unable to format: %v\n%s", err, buf.Bytes())
+	}
+	for _, want := range []string{
+		"package mymodel\n",
+		`var Tags = []string{"serve", "gpu"}`,
+		`ServingDefault2InputAny = "any:0"`,
+		`ServingDefaultOutputY = "z:0"`,
+		"\tIds int64\n",
+		"\tX [][]float32\n",
+		"\tAny *tf.Tensor\n",
+		"\tY *tf.Tensor\n",
+		"\tY []bool\n",
+		`// X is the input "x", a float32 tensor of shape [?, 1].`,
+		"func (m *Model) ServingDefault(req *ServingDefaultRequest) (*ServingDefaultResponse, error) {",
+		"func (m *Model) ServingDefault2(req *ServingDefault2Request) (*ServingDefault2Response, error) {",
+		`if err := feed(graph, feeds, "x", ServingDefault2InputX, req.X, tf.Float, []int64{-1, 1}); err != nil {`,
+		`if err := feed(graph, feeds, "any", ServingDefault2InputAny, req.Any, tf.String, nil); err != nil {`,
+	} {
+		if !bytes.Contains(got, []byte(want)) {
+			t.Errorf("Generated code does not contain %q:\n%s", want, got)
+		}
+	}
+
+	sigs["bad"] = signature.Signature{Inputs: map[string]signature.TensorInfo{"v": {Name: "v:0", DataType: tf.DataType(99)}}}
+	if err := Generate(&buf, "mymodel", "my_model", nil, sigs); err == nil || !strings.Contains(err.Error(), `signature "bad"`) {
+		t.Errorf("Got error %v, want an unsupported type", err)
+	}
+}
+
+func TestGenerateSavedModel(t *testing.T) {
+	model, err := tf.LoadSavedModel("../../../cc/saved_model/testdata/half_plus_two/00000123", []string{"serve"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer model.Session.Close()
+	sigs, err := signature.FromSavedModel(model)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := Generate(&buf, "halfplustwo", "00000123", []string{"serve"}, sigs); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := format.Source(buf.Bytes()); err != nil {
+		t.Fatalf("Unable to format: %v\n%s", err, buf.Bytes())
+	}
+	if want := "func (m *Model) RegressXToY("; !strings.Contains(buf.String(), want) {
+		t.Errorf("Generated code does not contain %q:\n%s", want, buf.String())
+	}
+}
+
+func TestExportedName(t *testing.T) {
+	tests := map[string]string{
+		"serving_default": "ServingDefault",
+		"input-ids":       "InputIds",
+		"x":               "X",
+		"0":               "X0",
+		"":                "X",
+		"logits:0":        "Logits0",
+	}
+	for key, want := range tests {
+		if got := exportedName(key); got != want {
+			t.Errorf("exportedName(%q) = %q, want %q", key, got, want)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command genmodel generates a Go package with a typed client for the
+// signatures of a SavedModel, with one method per signature taking and
+// returning structs with a field for each input and output:
+//
+//	genmodel -export_dir /path/to/model -package mymodel -outfile mymodel/mymodel.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/genmodel/internal"
+	"github.com/tensorflow/tensorflow/tensorflow/go/signature"
+)
+
+func main() {
+	var (
+		exportDir = flag.String("export_dir", "", "Directory of the SavedModel.")
+		tags      = flag.String("tags", "serve", "Comma-separated tags of the MetaGraphDef whose signatures are generated.")
+		pkg       = flag.String("package", "", "Name of the generated package. Defaults to the name of the directory of -outfile")
+		filename  = flag.String("outfile", "", "File to write generated source code to.")
+		buf       bytes.Buffer
+	)
+	flag.Parse()
+	if *exportDir == "" || *filename == "" {
+		log.Fatal("-export_dir and -outfile must be set")
+	}
+	if *pkg == "" {
+		abs, err := filepath.Abs(*filename)
+		if err != nil {
+			log.Fatal(err)
+		}
+		*pkg = filepath.Base(filepath.Dir(abs))
+	}
+	tagList := strings.Split(*tags, ",")
+	model, err := tf.LoadSavedModel(*exportDir, tagList, nil)
+	if err != nil {
+		log.Fatalf("Unable to load %s: %v", *exportDir, err)
+	}
+	defer model.Session.Close()
+	sigs, err := signature.FromSavedModel(model)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := internal.Generate(&buf, *pkg, filepath.Base(filepath.Clean(*exportDir)), tagList, sigs); err != nil {
+		log.Fatal(err)
+	}
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("Failed to generate valid source? 'go fmt' failed: %v", err)
+	}
+	os.MkdirAll(filepath.Dir(*filename), 0755)
+	if err := ioutil.WriteFile(*filename, formatted, 0644); err != nil {
+		log.Fatalf("Failed to write to %q: %v", *filename, err)
+	}
+}
@@ -17,6 +17,7 @@
 set -e
 
 go get github.com/golang/protobuf/proto
+go get github.com/golang/protobuf/ptypes/any
 go get github.com/golang/protobuf/protoc-gen-go
 
 cd $(dirname $0)
@@ -39,8 +40,34 @@ fi
 # Ensure that protoc-gen-go is available in $PATH
 # Since ${PROTOC} will require it.
 export PATH=$PATH:${GOPATH}/bin
-mkdir -p ./internal/proto
-${PROTOC} \
-  -I ${TF_DIR} \
-  --go_out=./internal/proto \
-  ${TF_DIR}/tensorflow/core/framework/*.proto
+
+# The generated packages are placed under tensorflow/go/core (mirroring the
+# location of the .proto files under tensorflow/core) so that they can be
+# imported by the tensorflow package and its subpackages.
+GO_PREFIX="github.com/tensorflow/tensorflow/tensorflow/go"
+GO_DIR="$(pwd)/.."
+PROTOS=(
+  tensorflow/core/framework/*.proto
+  tensorflow/core/protobuf/{config,debug,meta_graph,named_tensor,saved_model,saver}.proto
+  tensorflow/core/util/event.proto
+  tensorflow/core/example/{example,feature}.proto
+)
+cd ${TF_DIR}
+MAPPINGS="Mgoogle/protobuf/any.proto=github.com/golang/protobuf/ptypes/any"
+for FILE in ${PROTOS[@]}
+do
+  MAPPINGS="${MAPPINGS},M${FILE}=${GO_PREFIX}/$(dirname ${FILE#tensorflow/})"
+done
+OUT=$(mktemp -d)
+trap "rm -rf ${OUT}" EXIT
+# protoc-gen-go requires all files in a single invocation to belong to the
+# same Go package, so generate one directory at a time.
+for DIR in $(dirname ${PROTOS[@]} | sort -u)
+do
+  ${PROTOC} \
+    -I ${TF_DIR} \
+    --go_out=${MAPPINGS}:${OUT} \
+    $(ls ${PROTOS[@]} | grep "^${DIR}/")
+done
+mkdir -p ${GO_DIR}/core
+cp -r ${OUT}/tensorflow/core/* ${GO_DIR}/core/
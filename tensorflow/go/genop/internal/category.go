@@ -0,0 +1,231 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"text/template"
+
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+)
+
+// Category is a group of operations whose functions are generated in a
+// package of their own by GeneratePackagesForRegisteredOps.
+type Category struct {
+	// Name is the name of the package.
+	Name string
+	// Patterns match the names of the operations of the category, in the
+	// syntax of path.Match (e.g., "Sparse*").
+	Patterns []string
+}
+
+var packageName = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// ParseCategories parses a list of categories, one per line, of the form
+//
+//	name: pattern...
+//
+// Blank lines and lines starting with # are ignored.
+func ParseCategories(r io.Reader) ([]Category, error) {
+	var (
+		categories []Category
+		seen       = make(map[string]bool)
+		scanner    = bufio.NewScanner(r)
+	)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		i := strings.Index(text, ":")
+		if i < 0 {
+			return nil, fmt.Errorf("line %d: missing colon after the category name", line)
+		}
+		c := Category{Name: strings.TrimSpace(text[:i]), Patterns: strings.Fields(text[i+1:])}
+		if !packageName.MatchString(c.Name) || identifier(c.Name) != c.Name {
+			return nil, fmt.Errorf("line %d: %q is not a valid package name", line, c.Name)
+		}
+		if seen[c.Name] {
+			return nil, fmt.Errorf("line %d: duplicate category %q", line, c.Name)
+		}
+		seen[c.Name] = true
+		for _, p := range c.Patterns {
+			if _, err := path.Match(p, ""); err != nil {
+				return nil, fmt.Errorf("line %d: invalid pattern %q", line, p)
+			}
+		}
+		categories = append(categories, c)
+	}
+	return categories, scanner.Err()
+}
+
+// categorize returns the name of the first of categories with a pattern
+// matching opName, or "" if there is none.
+func categorize(categories []Category, opName string) string {
+	for _, c := range categories {
+		for _, p := range c.Patterns {
+			if ok, _ := path.Match(p, opName); ok {
+				return c.Name
+			}
+		}
+	}
+	return ""
+}
+
+// GeneratePackagesForRegisteredOps is like GenerateFunctionsForRegisteredOps,
+// but writes the functions for the operations of each category to a package
+// of its own, in the file <dir>/<name>/<name>.go. The functions take the
+// Scopes of the op package, whose import path is opPackage. Operations are
+// placed in the first category matching them, and skipped if there is
+// none. header is copied to the start of each file.
+//
+// It returns the number of functions generated for each category. No
+// package is generated for categories without functions.
+//
+// Together with GenerateCoreFunctionsForRegisteredOps, this keeps the op
+// package small, so that programs using few operations only compile and
+// link the packages of those operations.
+func GeneratePackagesForRegisteredOps(dir, opPackage string, categories []Category, header []byte) (map[string]int, error) {
+	ops, err := registeredOps()
+	if err != nil {
+		return nil, err
+	}
+	return generatePackages(dir, opPackage, categories, header, ops)
+}
+
+func generatePackages(dir, opPackage string, categories []Category, header []byte, ops *pb.OpList) (map[string]int, error) {
+	byCategory := make(map[string][]*pb.OpDef)
+	for _, op := range ops.Op {
+		if blacklist[op.Name] {
+			continue
+		}
+		if name := categorize(categories, op.Name); name != "" {
+			byCategory[name] = append(byCategory[name], op)
+		}
+	}
+	counts := make(map[string]int)
+	for _, c := range categories {
+		var fns bytes.Buffer
+		for _, op := range byCategory[c.Name] {
+			n := fns.Len()
+			if err := generateQualifiedFunctionForOp(&fns, op, "op."); err != nil {
+				return nil, err
+			}
+			if fns.Len() > n {
+				counts[c.Name]++
+			}
+		}
+		if counts[c.Name] == 0 {
+			continue
+		}
+		var buf bytes.Buffer
+		if len(header) > 0 {
+			buf.Write(header)
+			buf.WriteString("\n\n")
+		}
+		err := tmplPackageHeader.Execute(&buf, struct{ Generator, Package, OpPackage string }{
+			reflect.TypeOf(tmplArgs{}).PkgPath(), c.Name, opPackage,
+		})
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(fns.Bytes())
+		formatted, err := format.Source(buf.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("failed to format package %s: %v", c.Name, err)
+		}
+		pkgDir := filepath.Join(dir, c.Name)
+		if err := os.MkdirAll(pkgDir, 0755); err != nil {
+			return nil, err
+		}
+		if err := ioutil.WriteFile(filepath.Join(pkgDir, c.Name+".go"), formatted, 0644); err != nil {
+			return nil, err
+		}
+	}
+	return counts, nil
+}
+
+// GenerateCoreFunctionsForRegisteredOps is like
+// GenerateFunctionsForRegisteredOps, but only generates functions for the
+// named operations, such as those used by the hand-written code of the op
+// package. IsStateful still covers all the operations.
+func GenerateCoreFunctionsForRegisteredOps(w io.Writer, names []string) error {
+	ops, err := registeredOps()
+	if err != nil {
+		return err
+	}
+	return generateCoreFunctions(w, names, ops)
+}
+
+func generateCoreFunctions(w io.Writer, names []string, ops *pb.OpList) error {
+	wanted := make(map[string]bool)
+	for _, name := range names {
+		wanted[name] = true
+	}
+	core := &pb.OpList{}
+	for _, op := range ops.Op {
+		if wanted[op.Name] {
+			core.Op = append(core.Op, op)
+			delete(wanted, op.Name)
+		}
+	}
+	for name := range wanted {
+		return fmt.Errorf("operation %q is not registered", name)
+	}
+	thisPackage := reflect.TypeOf(tmplArgs{}).PkgPath()
+	if err := tmplHeader.Execute(w, thisPackage); err != nil {
+		return err
+	}
+	for _, op := range core.Op {
+		if blacklist[op.Name] {
+			continue
+		}
+		if err := generateFunctionForOp(w, op); err != nil {
+			return err
+		}
+	}
+	return generateStatefulTable(w, ops)
+}
+
+var tmplPackageHeader = template.Must(template.New("package").Parse(`// DO NOT EDIT
+// This file was machine generated by {{.Generator}}
+//
+// WARNING: This generation of wrapper function for TensorFlow ops is in an
+// experimental state. The generated API can change without notice.
+
+// Package {{.Package}} provides functions adding the {{.Package}} operations
+// of TensorFlow to the graphs built with the Scopes of package op.
+package {{.Package}}
+
+import (
+	"fmt"
+	"sync"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	op "{{.OpPackage}}"
+)
+
+` + helperSource))
@@ -0,0 +1,151 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+)
+
+func TestParseCategories(t *testing.T) {
+	got, err := ParseCategories(strings.NewReader(`
+# Comment.
+math: Add Sub*
+misc:   *
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Category{{"math", []string{"Add", "Sub*"}}, {"misc", []string{"*"}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Got %v, want %v", got, want)
+	}
+	for _, c := range []struct {
+		name, want string
+	}{
+		{"Add", "math"},
+		{"SubGradient", "math"},
+		{"AddN", "misc"},
+	} {
+		if got := categorize(want, c.name); got != c.want {
+			t.Errorf("%s: got category %q, want %q", c.name, got, c.want)
+		}
+	}
+	for _, invalid := range []string{
+		"math Add",
+		"Math: Add",
+		"func: Add",
+		"math: Add\nmath: Sub",
+		"math: [",
+	} {
+		if _, err := ParseCategories(strings.NewReader(invalid)); err == nil {
+			t.Errorf("%q: got no error", invalid)
+		}
+	}
+}
+
+func TestGeneratePackages(t *testing.T) {
+	dir, err := ioutil.TempDir("", "genop")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	var ops pb.OpList
+	if err := proto.UnmarshalText(`
+op: < name: "NoOp" summary: "No. Op." >
+op: < name: "ControlTrigger" summary: "Does nothing." >
+op: < name: "Const" summary: "Blacklisted." >
+op: < name: "Neg" input_arg: < name: "x" type_attr: "T" > output_arg: < name: "y" type_attr: "T" > attr: < name: "T" type: "type" allowed_values: < list: < type: DT_FLOAT type: DT_INT32 > > > summary: "Negates." >
+op: < name: "Uncategorized" summary: "Skipped." >
+`, &ops); err != nil {
+		t.Fatal(err)
+	}
+	categories := []Category{
+		{"control", []string{"NoOp", "ControlTrigger"}},
+		{"math", []string{"Neg"}},
+		{"empty", []string{"Const"}},
+	}
+	SetTracing(true)
+	defer SetTracing(false)
+	counts, err := generatePackages(dir, "example.com/op", categories, []byte("// Header"), &ops)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]int{"control": 2, "math": 1}; !reflect.DeepEqual(counts, want) {
+		t.Errorf("Got counts %v, want %v", counts, want)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "empty")); !os.IsNotExist(err) {
+		t.Errorf("Got %v for the package of a category without functions, want it not to exist", err)
+	}
+	for name, want := range map[string][]string{
+		"control": {
+			"// Header",
+			"package control",
+			`op "example.com/op"`,
+			"func NoOp(scope *op.Scope) (o *tf.Operation) {",
+			`defer op.StartTrace(scope, "NoOp")()`,
+		},
+		"math": {
+			"func Neg(scope *op.Scope, x tf.Output) (y tf.Output) {",
+		},
+	} {
+		src, err := ioutil.ReadFile(filepath.Join(dir, name, name+".go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := parser.ParseFile(token.NewFileSet(), "", src, 0); err != nil {
+			t.Errorf("Generated invalid source: %v\n%s", err, src)
+		}
+		for _, w := range want {
+			if !bytes.Contains(src, []byte(w)) {
+				t.Errorf("%s: generated source does not contain %q:\n%s", name, w, src)
+			}
+		}
+	}
+}
+
+func TestGenerateCoreFunctions(t *testing.T) {
+	var ops pb.OpList
+	if err := proto.UnmarshalText(`
+op: < name: "NoOp" summary: "No. Op." >
+op: < name: "VariableV2" summary: "A variable." is_stateful: true >
+`, &ops); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := generateCoreFunctions(&buf, []string{"NoOp"}, &ops); err != nil {
+		t.Fatal(err)
+	}
+	src := buf.String()
+	if !strings.Contains(src, "func NoOp(scope *Scope)") || strings.Contains(src, "func VariableV2(") {
+		t.Errorf("Got functions for other operations than NoOp:\n%s", src)
+	}
+	if !strings.Contains(src, `"VariableV2": true`) {
+		t.Errorf("IsStateful does not cover VariableV2:\n%s", src)
+	}
+	if err := generateCoreFunctions(&buf, []string{"NoSuchOp"}, &ops); err == nil {
+		t.Error("Got no error for an unregistered operation")
+	}
+}
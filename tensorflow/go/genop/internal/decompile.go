@@ -0,0 +1,709 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/codec"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+)
+
+// DecompileOptions configures DecompileGraphDef.
+type DecompileOptions struct {
+	// Package is the name of the generated package and Func the name of
+	// the generated function, "BuildGraph" if empty.
+	Package, Func string
+	// Source describes the GraphDef in the header of the generated file.
+	Source string
+	// MaxInlineElements is the largest number of elements of the
+	// constants written as Go literals. Larger constants, and those that
+	// cannot be written as literals, are externalized.
+	MaxInlineElements int
+}
+
+// DecompileGraphDef writes to w the source of a Go package with a function
+// reconstructing the graph of the serialized GraphDef graphDef with the
+// functions of the op package, as a starting point for migrating frozen
+// graphs to graph-building code.
+//
+// The generated function takes a Scope and returns the operations it
+// added, by name of their node in graphDef. Each node is added in a
+// SubScope named after it, with its device and control dependencies.
+// Attributes inferred from the inputs and optional attributes set to
+// their default value are omitted. Operations without a generated
+// function, such as those with reference inputs, are added with op.Raw.
+//
+// Externalized constants are returned, by node name, and passed to the
+// generated function in its weights argument.
+func DecompileGraphDef(w io.Writer, graphDef []byte, opts DecompileOptions) (map[string]*tf.Tensor, error) {
+	var def pb.GraphDef
+	if err := proto.Unmarshal(graphDef, &def); err != nil {
+		return nil, err
+	}
+	ops, err := registeredOps()
+	if err != nil {
+		return nil, err
+	}
+	return decompile(w, &def, ops, opts)
+}
+
+// decompiled is the state of decompile.
+type decompiled struct {
+	opts    DecompileOptions
+	opDefs  map[string]*pb.OpDef
+	nodes   map[string]*decompiledNode
+	order   []*decompiledNode
+	vars    names
+	weights map[string]*tf.Tensor
+	body    bytes.Buffer
+}
+
+// decompiledNode describes the code generated for a node.
+type decompiledNode struct {
+	def   *pb.NodeDef
+	opDef *pb.OpDef
+	// raw is set if the node is added with op.Raw, in which case its
+	// outputs are obtained from the operation in the variable handle.
+	raw bool
+	// handle is the Go expression of the *tf.Operation of the node.
+	handle string
+	// outputs are the Go expressions of the outputs of the node, and
+	// outputArg the index of the output argument of each output.
+	outputs   []string
+	outputArg []int
+	// vars are the names of the variables of the output arguments, "_"
+	// for those not used.
+	vars  []string
+	state int
+}
+
+const (
+	unvisited = iota
+	visiting
+	visited
+)
+
+func decompile(w io.Writer, def *pb.GraphDef, ops *pb.OpList, opts DecompileOptions) (map[string]*tf.Tensor, error) {
+	if opts.Func == "" {
+		opts.Func = "BuildGraph"
+	}
+	d := &decompiled{
+		opts:    opts,
+		opDefs:  make(map[string]*pb.OpDef, len(ops.Op)),
+		nodes:   make(map[string]*decompiledNode, len(def.Node)),
+		vars:    names{"s": true, "weights": true, "ops": true, "op": true, "tf": true, "init": true},
+		weights: make(map[string]*tf.Tensor),
+	}
+	for _, op := range ops.Op {
+		d.opDefs[op.Name] = op
+	}
+	for _, node := range def.Node {
+		if _, ok := d.nodes[node.Name]; ok {
+			return nil, fmt.Errorf("duplicate node %q", node.Name)
+		}
+		n, err := d.plan(node)
+		if err != nil {
+			return nil, fmt.Errorf("node %q: %v", node.Name, err)
+		}
+		d.nodes[node.Name] = n
+	}
+	for _, node := range def.Node {
+		if err := d.visit(d.nodes[node.Name]); err != nil {
+			return nil, err
+		}
+	}
+	for _, n := range d.order {
+		if err := d.write(n); err != nil {
+			return nil, fmt.Errorf("node %q: %v", n.def.Name, err)
+		}
+	}
+	err := tmplDecompiled.Execute(w, struct {
+		DecompileOptions
+		Weights bool
+		Body    string
+	}{opts, len(d.weights) > 0, d.body.String()})
+	return d.weights, err
+}
+
+// plan chooses how node is added and names the variables of its outputs.
+func (d *decompiled) plan(node *pb.NodeDef) (*decompiledNode, error) {
+	opDef, ok := d.opDefs[node.Op]
+	if !ok {
+		return nil, fmt.Errorf("operation %q is not registered", node.Op)
+	}
+	n := &decompiledNode{def: node, opDef: opDef}
+	base := d.vars.unique(varName(node.Name))
+	// The runtime rejects the attributes unknown to opDef, such as those
+	// of newer versions of TensorFlow, but for those starting with an
+	// underscore.
+	if unknown := unknownAttrs(node, opDef); len(unknown) > 0 {
+		return nil, fmt.Errorf("%s has no attribute %q", node.Op, unknown[0])
+	}
+	if node.Op == "Const" {
+		n.handle = base + ".Op"
+		n.outputs = []string{base}
+		n.outputArg = []int{0}
+		n.vars = []string{base}
+		return n, nil
+	}
+	n.raw = !hasFunction(opDef) || blacklist[opDef.Name]
+	n.vars = make([]string, len(opDef.OutputArg))
+	for i, arg := range opDef.OutputArg {
+		size, err := argSize(node, opDef, arg)
+		if err != nil {
+			return nil, err
+		}
+		v := base
+		if len(opDef.OutputArg) > 1 {
+			v = d.vars.unique(base + camelCase(arg.Name))
+		}
+		for j := 0; j < size; j++ {
+			n.outputArg = append(n.outputArg, i)
+			if isListArg(arg) {
+				n.outputs = append(n.outputs, fmt.Sprintf("%s[%d]", v, j))
+			} else {
+				n.outputs = append(n.outputs, v)
+			}
+		}
+		n.vars[i] = "_"
+		if n.handle == "" && size > 0 {
+			n.vars[i] = v
+			n.handle = n.outputs[len(n.outputs)-1] + ".Op"
+		}
+	}
+	if len(opDef.OutputArg) == 0 {
+		n.handle = base
+	} else if n.handle == "" {
+		// All the outputs are empty lists.
+		n.raw = true
+	}
+	if n.raw {
+		n.handle = base
+		for i := range n.outputs {
+			n.outputs[i] = fmt.Sprintf("%s.Output(%d)", base, i)
+		}
+	}
+	return n, nil
+}
+
+// visit appends n to d.order after the nodes it depends on.
+func (d *decompiled) visit(n *decompiledNode) error {
+	switch n.state {
+	case visited:
+		return nil
+	case visiting:
+		return fmt.Errorf("node %q is part of a cycle, which cannot be decompiled", n.def.Name)
+	}
+	n.state = visiting
+	for _, in := range n.def.Input {
+		src, index, err := d.input(in)
+		if err != nil {
+			return fmt.Errorf("node %q: %v", n.def.Name, err)
+		}
+		if err := d.visit(src); err != nil {
+			return err
+		}
+		if index >= 0 && !src.raw {
+			src.vars[src.outputArg[index]] = strings.SplitN(src.outputs[index], "[", 2)[0]
+		}
+	}
+	n.state = visited
+	d.order = append(d.order, n)
+	return nil
+}
+
+// input returns the node and the index of the output named by in, an
+// input of a NodeDef, or -1 for a control input.
+func (d *decompiled) input(in string) (*decompiledNode, int, error) {
+	name, index, err := tf.ParseTensorName(in)
+	if err != nil {
+		return nil, 0, err
+	}
+	src, ok := d.nodes[name]
+	if !ok {
+		return nil, 0, fmt.Errorf("input %q: no node %q", in, name)
+	}
+	if index >= len(src.outputs) {
+		return nil, 0, fmt.Errorf("input %q: node %q has %d outputs", in, name, len(src.outputs))
+	}
+	return src, index, nil
+}
+
+// write writes the statements adding n to d.body.
+func (d *decompiled) write(n *decompiledNode) error {
+	var inputs, controls []string
+	for _, in := range n.def.Input {
+		src, index, _ := d.input(in)
+		if index < 0 {
+			controls = append(controls, fmt.Sprintf("ops[%q]", src.def.Name))
+		} else {
+			inputs = append(inputs, src.outputs[index])
+		}
+	}
+	scope := fmt.Sprintf("s.SubScope(%q)", n.def.Name)
+	if n.def.Device != "" {
+		scope += fmt.Sprintf(".WithDevice(%q)", n.def.Device)
+	}
+	if len(controls) > 0 {
+		scope += ".WithControlDependencies(" + strings.Join(controls, ", ") + ")"
+	}
+	// Attributes starting with an underscore, such as "_class", are set by
+	// the scope, as serialized AttrValues.
+	var hints []string
+	for _, name := range sortedAttrs(n.def) {
+		if !strings.HasPrefix(name, "_") {
+			continue
+		}
+		lit, err := attrValueProtoLiteral(n.def.Attr[name])
+		if err != nil {
+			return fmt.Errorf("attribute %q: %v", name, err)
+		}
+		hints = append(hints, fmt.Sprintf("%q: %s", name, lit))
+	}
+	if len(hints) > 0 {
+		scope += ".WithAttrs(map[string]interface{}{" + strings.Join(hints, ", ") + "})"
+	}
+	if n.def.Op == "Const" {
+		value, err := d.constValue(n.def)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&d.body, "\t%s := op.Const(%s, %s)\n", n.vars[0], scope, value)
+		fmt.Fprintf(&d.body, "\tops[%q] = %s\n", n.def.Name, n.handle)
+		return nil
+	}
+	args, err := groupInputs(n, inputs)
+	if err != nil {
+		return err
+	}
+	tmpl := newTmplArgs(n.opDef)
+	if n.raw {
+		var attrs []string
+		for _, a := range append(tmpl.RequiredAttrs, tmpl.OptionalAttrs...) {
+			v, ok := n.def.Attr[a.Name]
+			if !ok || (a.DefaultValue != nil && proto.Equal(v, a.DefaultValue)) {
+				continue
+			}
+			lit, err := attrLiteral(a.Type, v, true)
+			if err != nil {
+				return fmt.Errorf("attribute %q: %v", a.Name, err)
+			}
+			attrs = append(attrs, fmt.Sprintf("%q: %s", a.Name, lit))
+		}
+		for i, arg := range n.opDef.InputArg {
+			if isListArg(arg) {
+				args[i] = "tf.OutputList" + strings.TrimPrefix(args[i], "[]tf.Output")
+			}
+		}
+		attrMap := "nil"
+		if len(attrs) > 0 {
+			attrMap = "map[string]interface{}{" + strings.Join(attrs, ", ") + "}"
+		}
+		inputList := "nil"
+		if len(args) > 0 {
+			inputList = "[]tf.Input{" + strings.Join(args, ", ") + "}"
+		}
+		fmt.Fprintf(&d.body, "\t%s := op.Raw(%s, %q, %s, %s)\n", n.handle, scope, n.def.Op, inputList, attrMap)
+		fmt.Fprintf(&d.body, "\tops[%q] = %s\n", n.def.Name, n.handle)
+		return nil
+	}
+	for _, a := range tmpl.RequiredAttrs {
+		v, ok := n.def.Attr[a.Name]
+		if !ok {
+			return fmt.Errorf("missing required attribute %q", a.Name)
+		}
+		lit, err := attrLiteral(a.Type, v, false)
+		if err != nil {
+			return fmt.Errorf("attribute %q: %v", a.Name, err)
+		}
+		args = append(args, lit)
+	}
+	for _, a := range tmpl.OptionalAttrs {
+		v, ok := n.def.Attr[a.Name]
+		if !ok || proto.Equal(v, a.DefaultValue) {
+			continue
+		}
+		lit, err := attrLiteral(a.Type, v, false)
+		if err != nil {
+			return fmt.Errorf("attribute %q: %v", a.Name, err)
+		}
+		args = append(args, fmt.Sprintf("op.%s%s(%s)", n.opDef.Name, camelCase(a.Name), lit))
+	}
+	call := fmt.Sprintf("op.%s(%s)", n.opDef.Name, strings.Join(append([]string{scope}, args...), ", "))
+	if len(n.opDef.OutputArg) == 0 {
+		fmt.Fprintf(&d.body, "\t%s := %s\n", n.handle, call)
+	} else {
+		fmt.Fprintf(&d.body, "\t%s := %s\n", strings.Join(n.vars, ", "), call)
+	}
+	fmt.Fprintf(&d.body, "\tops[%q] = %s\n", n.def.Name, n.handle)
+	return nil
+}
+
+// constValue returns the Go expression of the value of a Const node,
+// externalizing it to d.weights if it is too large or cannot be written as
+// a literal.
+func (d *decompiled) constValue(node *pb.NodeDef) (string, error) {
+	b, err := proto.Marshal(node.Attr["value"].GetTensor())
+	if err != nil {
+		return "", err
+	}
+	t, err := codec.Proto.Decode(b)
+	if err != nil {
+		return "", fmt.Errorf("value: %v", err)
+	}
+	size := int64(1)
+	for _, dim := range t.Shape() {
+		size *= dim
+	}
+	if _, ok := literalTypes[t.DataType()]; ok && size <= int64(d.opts.MaxInlineElements) {
+		if lit, ok := literal(reflect.ValueOf(t.Value()), true); ok {
+			return lit, nil
+		}
+	}
+	d.weights[node.Name] = t
+	return fmt.Sprintf("weights[%q]", node.Name), nil
+}
+
+// literalTypes are the types of the constants that can be written as Go
+// literals.
+var literalTypes = map[tf.DataType]bool{
+	tf.Float:      true,
+	tf.Double:     true,
+	tf.Int32:      true,
+	tf.Uint8:      true,
+	tf.Int16:      true,
+	tf.Int8:       true,
+	tf.String:     true,
+	tf.Complex64:  true,
+	tf.Int64:      true,
+	tf.Bool:       true,
+	tf.Uint16:     true,
+	tf.Complex128: true,
+}
+
+// literal returns the Go literal of v, a value returned by Tensor.Value,
+// converted to its type if typed is set. It returns false if v contains
+// non-finite floating-point numbers.
+func literal(v reflect.Value, typed bool) (string, bool) {
+	var s string
+	switch v.Kind() {
+	case reflect.Slice:
+		elems := make([]string, v.Len())
+		for i := range elems {
+			var ok bool
+			if elems[i], ok = literal(v.Index(i), false); !ok {
+				return "", false
+			}
+		}
+		s = "{" + strings.Join(elems, ", ") + "}"
+		if typed {
+			s = v.Type().String() + s
+		}
+		return s, true
+	case reflect.String:
+		return strconv.Quote(v.String()), true
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		s = strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint8, reflect.Uint16:
+		s = strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		var ok bool
+		if s, ok = floatLiteral(v.Float(), v.Type().Bits()); !ok {
+			return "", false
+		}
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		re, ok1 := floatLiteral(real(c), v.Type().Bits()/2)
+		im, ok2 := floatLiteral(imag(c), v.Type().Bits()/2)
+		if !ok1 || !ok2 {
+			return "", false
+		}
+		s = fmt.Sprintf("complex(%s, %s)", re, im)
+	default:
+		return "", false
+	}
+	if typed {
+		s = fmt.Sprintf("%s(%s)", v.Type(), s)
+	}
+	return s, true
+}
+
+func floatLiteral(f float64, bits int) (string, bool) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return "", false
+	}
+	return strconv.FormatFloat(f, 'g', -1, bits), true
+}
+
+// attrLiteral returns the Go expression of the value v of an attribute of
+// type typ, converted to the Go type of the attribute if typed is set.
+func attrLiteral(typ string, v *pb.AttrValue, typed bool) (string, error) {
+	list, base := parseTFType(typ)
+	if !list {
+		switch base {
+		case "string":
+			return strconv.Quote(string(v.GetS())), nil
+		case "bool":
+			return strconv.FormatBool(v.GetB()), nil
+		case "int":
+			if typed {
+				return fmt.Sprintf("int64(%d)", v.GetI()), nil
+			}
+			return strconv.FormatInt(v.GetI(), 10), nil
+		case "float":
+			f, ok := floatLiteral(float64(v.GetF()), 32)
+			if !ok {
+				return "", fmt.Errorf("%v cannot be written as a literal", v.GetF())
+			}
+			if typed {
+				return "float32(" + f + ")", nil
+			}
+			return f, nil
+		case "type":
+			return dtypeLiteral(v.GetType())
+		case "shape":
+			return shapeLiteral(v.GetShape()), nil
+		case "func":
+			if f := v.GetFunc(); len(f.GetAttr()) == 0 {
+				return fmt.Sprintf("tf.NameAttrList{Name: %q}", f.GetName()), nil
+			}
+			return "", fmt.Errorf("functions with attributes are not supported")
+		}
+		return "", fmt.Errorf("attributes of type %q are not supported", typ)
+	}
+	gotype, err := goType(typ)
+	if err != nil {
+		return "", err
+	}
+	l := v.GetList()
+	var elems []string
+	switch base {
+	case "string":
+		for _, s := range l.GetS() {
+			elems = append(elems, strconv.Quote(string(s)))
+		}
+	case "bool":
+		for _, b := range l.GetB() {
+			elems = append(elems, strconv.FormatBool(b))
+		}
+	case "int":
+		for _, i := range l.GetI() {
+			elems = append(elems, strconv.FormatInt(i, 10))
+		}
+	case "float":
+		for _, f := range l.GetF() {
+			lit, ok := floatLiteral(float64(f), 32)
+			if !ok {
+				return "", fmt.Errorf("%v cannot be written as a literal", f)
+			}
+			elems = append(elems, lit)
+		}
+	case "type":
+		for _, dt := range l.GetType() {
+			lit, err := dtypeLiteral(dt)
+			if err != nil {
+				return "", err
+			}
+			elems = append(elems, lit)
+		}
+	case "shape":
+		for _, s := range l.GetShape() {
+			elems = append(elems, shapeLiteral(s))
+		}
+	default:
+		return "", fmt.Errorf("attributes of type %q are not supported", typ)
+	}
+	return gotype + "{" + strings.Join(elems, ", ") + "}", nil
+}
+
+func dtypeLiteral(dt pb.DataType) (string, error) {
+	c, ok := dtypeConsts[dt]
+	if !ok {
+		return "", fmt.Errorf("type %v has no Go equivalent", dt)
+	}
+	return "tf." + c, nil
+}
+
+func shapeLiteral(s *pb.TensorShapeProto) string {
+	if s.GetUnknownRank() {
+		return "tf.Shape{}"
+	}
+	if len(s.GetDim()) == 0 {
+		return "tf.ScalarShape()"
+	}
+	dims := make([]string, len(s.Dim))
+	for i, d := range s.Dim {
+		dims[i] = strconv.FormatInt(d.Size, 10)
+	}
+	return "tf.MakeShape(" + strings.Join(dims, ", ") + ")"
+}
+
+// groupInputs groups inputs, the expressions of the data inputs of n, by
+// input argument of its operation.
+func groupInputs(n *decompiledNode, inputs []string) ([]string, error) {
+	var args []string
+	for _, arg := range n.opDef.InputArg {
+		size, err := argSize(n.def, n.opDef, arg)
+		if err != nil {
+			return nil, err
+		}
+		if size > len(inputs) {
+			return nil, fmt.Errorf("missing inputs for argument %q", arg.Name)
+		}
+		if isListArg(arg) {
+			args = append(args, "[]tf.Output{"+strings.Join(inputs[:size], ", ")+"}")
+		} else {
+			args = append(args, inputs[0])
+		}
+		inputs = inputs[size:]
+	}
+	if len(inputs) > 0 {
+		return nil, fmt.Errorf("%d unexpected inputs", len(inputs))
+	}
+	return args, nil
+}
+
+// argSize returns the number of tensors of the input or output argument arg
+// of node.
+func argSize(node *pb.NodeDef, opDef *pb.OpDef, arg *pb.OpDef_ArgDef) (int, error) {
+	name := arg.NumberAttr
+	if name == "" {
+		name = arg.TypeListAttr
+	}
+	if name == "" {
+		return 1, nil
+	}
+	v, ok := node.Attr[name]
+	if !ok {
+		a := attrDef(opDef, name)
+		if a == nil || a.DefaultValue == nil {
+			return 0, fmt.Errorf("missing attribute %q", name)
+		}
+		v = a.DefaultValue
+	}
+	if arg.NumberAttr != "" {
+		return int(v.GetI()), nil
+	}
+	return len(v.GetList().GetType()), nil
+}
+
+// unknownAttrs returns the sorted names of the attributes of node unknown
+// to opDef, but for those starting with an underscore.
+func unknownAttrs(node *pb.NodeDef, opDef *pb.OpDef) []string {
+	var names []string
+	for _, name := range sortedAttrs(node) {
+		if !strings.HasPrefix(name, "_") && attrDef(opDef, name) == nil {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func sortedAttrs(node *pb.NodeDef) []string {
+	names := make([]string, 0, len(node.Attr))
+	for name := range node.Attr {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// attrValueProtoLiteral returns the Go expression of v as a
+// tf.AttrValueProto.
+func attrValueProtoLiteral(v *pb.AttrValue) (string, error) {
+	b, err := proto.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("tf.AttrValueProto(%q)", b), nil
+}
+
+func attrDef(opDef *pb.OpDef, name string) *pb.OpDef_AttrDef {
+	for _, a := range opDef.Attr {
+		if a.Name == name {
+			return a
+		}
+	}
+	return nil
+}
+
+// varName converts the name of a node such as "dense_1/MatMul" to a Go
+// identifier such as "dense1MatMul".
+func varName(name string) string {
+	var buf []rune
+	upper := false
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = len(buf) > 0
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		} else if len(buf) == 0 {
+			r = unicode.ToLower(r)
+		}
+		buf = append(buf, r)
+	}
+	if len(buf) == 0 || !unicode.IsLetter(buf[0]) {
+		buf = append([]rune("n"), buf...)
+	}
+	return identifier(string(buf))
+}
+
+// names makes identifiers unique.
+type names map[string]bool
+
+func (n names) unique(name string) string {
+	unique := name
+	for i := 2; n[unique]; i++ {
+		unique = fmt.Sprintf("%s%d", name, i)
+	}
+	n[unique] = true
+	return unique
+}
+
+var tmplDecompiled = template.Must(template.New("decompiled").Parse(`// Generated by genop from {{.Source}}, to be maintained by hand.
+
+package {{.Package}}
+
+import (
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+// {{.Func}} adds the operations of {{.Source}} to the graph of s and returns
+// them by name of their node in the GraphDef.
+{{- if .Weights}} The values of the large
+// constants are taken from weights, by node name.{{end}}
+func {{.Func}}(s *op.Scope{{if .Weights}}, weights map[string]*tf.Tensor{{end}}) map[string]*tf.Operation {
+	ops := make(map[string]*tf.Operation)
+{{.Body}}	return ops
+}
+`))
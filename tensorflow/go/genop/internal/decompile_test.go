@@ -0,0 +1,134 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"bytes"
+	"go/format"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+)
+
+const decompileOps = `
+op: < name: "Const" output_arg: < name: "output" type_attr: "dtype" > attr: < name: "value" type: "tensor" > attr: < name: "dtype" type: "type" > summary: "Blacklisted." >
+op: < name: "Placeholder" output_arg: < name: "output" type_attr: "dtype" > attr: < name: "dtype" type: "type" > attr: < name: "shape" type: "shape" default_value: < shape: < unknown_rank: true > > > summary: "Feeds." >
+op: < name: "Mul" input_arg: < name: "x" type_attr: "T" > input_arg: < name: "y" type_attr: "T" > output_arg: < name: "z" type_attr: "T" > attr: < name: "T" type: "type" > summary: "Multiplies." >
+op: < name: "Unpack" input_arg: < name: "value" type_attr: "T" > output_arg: < name: "output" type_attr: "T" number_attr: "num" > attr: < name: "num" type: "int" > attr: < name: "T" type: "type" > attr: < name: "axis" type: "int" default_value: < i: 0 > > summary: "Unpacks." >
+op: < name: "Identity" input_arg: < name: "input" type_attr: "T" > output_arg: < name: "output" type_attr: "T" > attr: < name: "T" type: "type" > summary: "Forwards." >
+op: < name: "NoOp" summary: "No. Op." >
+op: < name: "Assign" input_arg: < name: "ref" type_attr: "T" is_ref: true > input_arg: < name: "value" type_attr: "T" > output_arg: < name: "output_ref" type_attr: "T" is_ref: true > attr: < name: "T" type: "type" > attr: < name: "use_locking" type: "bool" default_value: < b: false > > summary: "Assigns." >
+`
+
+func TestDecompile(t *testing.T) {
+	var ops pb.OpList
+	if err := proto.UnmarshalText(decompileOps, &ops); err != nil {
+		t.Fatal(err)
+	}
+	var def pb.GraphDef
+	if err := proto.UnmarshalText(`
+node: < name: "y" op: "Mul" input: "x" input: "w" device: "/cpu:0" attr: < key: "T" value: < type: DT_FLOAT > > >
+node: < name: "x" op: "Placeholder" attr: < key: "dtype" value: < type: DT_FLOAT > > attr: < key: "shape" value: < shape: < dim: < size: -1 > dim: < size: 3 > > > > >
+node: < name: "w" op: "Const" attr: < key: "dtype" value: < type: DT_FLOAT > > attr: < key: "value" value: < tensor: < dtype: DT_FLOAT tensor_shape: < dim: < size: 3 > > float_val: 1 float_val: 2 float_val: 3 > > > >
+node: < name: "b" op: "Const" attr: < key: "dtype" value: < type: DT_FLOAT > > attr: < key: "value" value: < tensor: < dtype: DT_FLOAT tensor_shape: < > float_val: 0.5 > > > >
+node: < name: "parts" op: "Unpack" input: "y" attr: < key: "num" value: < i: 3 > > attr: < key: "axis" value: < i: 0 > > attr: < key: "T" value: < type: DT_FLOAT > > attr: < key: "_class" value: < s: "ignored" > > >
+node: < name: "z" op: "Identity" input: "parts:2" input: "^init" attr: < key: "T" value: < type: DT_FLOAT > > >
+node: < name: "init" op: "NoOp" >
+node: < name: "v" op: "Assign" input: "x" input: "b" attr: < key: "T" value: < type: DT_FLOAT > > attr: < key: "use_locking" value: < b: true > > >
+`, &def); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	weights, err := decompile(&buf, &def, &ops, DecompileOptions{Package: "model", Source: "model.pb", MaxInlineElements: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(weights) != 1 || weights["w"] == nil {
+		t.Errorf("Got weights %v, want the constant w", weights)
+	}
+	got, err := format.Source(buf.Bytes())
+	if err != nil {
+		t.Fatalf("Unable to format: %v\n%s", err, buf.Bytes())
+	}
+	src := string(got)
+	var last int
+	for _, want := range []string{
+		"func BuildGraph(s *op.Scope, weights map[string]*tf.Tensor) map[string]*tf.Operation {",
+		`x := op.Placeholder(s.SubScope("x"), tf.Float, op.PlaceholderShape(tf.MakeShape(-1, 3)))`,
+		`w := op.Const(s.SubScope("w"), weights["w"])`,
+		`y := op.Mul(s.SubScope("y").WithDevice("/cpu:0"), x, w)`,
+		`b := op.Const(s.SubScope("b"), float32(0.5))`,
+		`parts := op.Unpack(s.SubScope("parts").WithAttrs(map[string]interface{}{"_class": tf.AttrValueProto("\x12\aignored")}), y, 3)`,
+		`init2 := op.NoOp(s.SubScope("init"))`,
+		`z := op.Identity(s.SubScope("z").WithControlDependencies(ops["init"]), parts[2])`,
+		`ops["z"] = z.Op`,
+		`v := op.Raw(s.SubScope("v"), "Assign", []tf.Input{x, b}, map[string]interface{}{"use_locking": true})`,
+	} {
+		i := strings.Index(src, want)
+		if i < last {
+			t.Errorf("%q not found after offset %d in\n%s", want, last, src)
+			continue
+		}
+		last = i
+	}
+}
+
+func TestDecompileHiddenAttrs(t *testing.T) {
+	var ops pb.OpList
+	if err := proto.UnmarshalText(decompileOps, &ops); err != nil {
+		t.Fatal(err)
+	}
+	var def pb.GraphDef
+	if err := proto.UnmarshalText(`
+node: < name: "x" op: "NoOp" attr: < key: "_hint" value: < b: true > > >
+`, &def); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if _, err := decompile(&buf, &def, &ops, DecompileOptions{Package: "model"}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := format.Source(buf.Bytes())
+	if err != nil {
+		t.Fatalf("Unable to format: %v\n%s", err, buf.Bytes())
+	}
+	if want := `s.SubScope("x").WithAttrs(map[string]interface{}{"_hint": tf.AttrValueProto("(\x01")})`; !strings.Contains(string(got), want) {
+		t.Errorf("%q not found in\n%s", want, got)
+	}
+}
+
+func TestDecompileErrors(t *testing.T) {
+	var ops pb.OpList
+	if err := proto.UnmarshalText(decompileOps, &ops); err != nil {
+		t.Fatal(err)
+	}
+	for _, graph := range []string{
+		`node: < name: "x" op: "Unregistered" >`,
+		`node: < name: "x" op: "Identity" input: "y" attr: < key: "T" value: < type: DT_FLOAT > > >`,
+		`node: < name: "x" op: "Identity" input: "y" > node: < name: "y" op: "Identity" input: "x" >`,
+		`node: < name: "x" op: "NoOp" > node: < name: "x" op: "NoOp" >`,
+		`node: < name: "x" op: "NoOp" attr: < key: "unknown" value: < b: true > > >`,
+	} {
+		var def pb.GraphDef
+		if err := proto.UnmarshalText(graph, &def); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := decompile(new(bytes.Buffer), &def, &ops, DecompileOptions{Package: "model"}); err == nil {
+			t.Errorf("%s: got no error", graph)
+		}
+	}
+}
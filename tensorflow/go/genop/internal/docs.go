@@ -0,0 +1,99 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"text/template"
+
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+)
+
+// DocsBuildTag is the build tag under which the file written by
+// GenerateDocsForRegisteredOps is compiled into the op package.
+const DocsBuildTag = "opdocs"
+
+// GenerateDocsForRegisteredOps writes to w a Go source file for the op
+// package embedding the summaries and the documentation of the arguments
+// of each TensorFlow operation registered in the address space of the
+// calling process, for op.Doc. The file is only compiled with the
+// DocsBuildTag build tag, as it considerably increases the size of
+// binaries.
+func GenerateDocsForRegisteredOps(w io.Writer) error {
+	ops, err := registeredOps()
+	if err != nil {
+		return err
+	}
+	return generateDocsForOps(w, ops)
+}
+
+func generateDocsForOps(w io.Writer, ops *pb.OpList) error {
+	var documented []*pb.OpDef
+	for _, op := range ops.Op {
+		if op.Summary != "" && !strings.HasPrefix(op.Name, "_") {
+			documented = append(documented, op)
+		}
+	}
+	return tmplDocs.Execute(w, struct {
+		Generator string
+		Tag       string
+		Ops       []*pb.OpDef
+	}{reflect.TypeOf(tmplArgs{}).PkgPath(), DocsBuildTag, documented})
+}
+
+var tmplDocs = template.Must(template.New("docs").Parse(`// DO NOT EDIT
+// This file was machine generated by {{.Generator}}
+
+//go:build {{.Tag}}
+// +build {{.Tag}}
+
+package op
+
+func init() {
+	opDocs = map[string]OpDoc{
+{{- range .Ops}}
+		{{printf "%q" .Name}}: {
+			Summary: {{printf "%q" .Summary}},
+{{- if .Description}}
+			Description: {{printf "%q" .Description}},
+{{- end}}
+{{- if .InputArg}}
+			Inputs: []ArgDoc{
+{{- range .InputArg}}
+				{Name: {{printf "%q" .Name}}{{if .Description}}, Description: {{printf "%q" .Description}}{{end}}},
+{{- end}}
+			},
+{{- end}}
+{{- if .OutputArg}}
+			Outputs: []ArgDoc{
+{{- range .OutputArg}}
+				{Name: {{printf "%q" .Name}}{{if .Description}}, Description: {{printf "%q" .Description}}{{end}}},
+{{- end}}
+			},
+{{- end}}
+{{- if .Attr}}
+			Attrs: []ArgDoc{
+{{- range .Attr}}
+				{Name: {{printf "%q" .Name}}{{if .Description}}, Description: {{printf "%q" .Description}}{{end}}},
+{{- end}}
+			},
+{{- end}}
+		},
+{{- end}}
+	}
+}
+`))
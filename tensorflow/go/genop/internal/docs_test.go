@@ -0,0 +1,80 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"bytes"
+	"go/format"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+)
+
+func TestGenerateDocs(t *testing.T) {
+	var ops pb.OpList
+	if err := proto.UnmarshalText(`
+op: <
+  name: "Neg"
+  input_arg: < name: "x" type_attr: "T" description: "The input." >
+  output_arg: < name: "y" type_attr: "T" >
+  attr: < name: "T" type: "type" >
+  summary: "Computes numerical negative value element-wise."
+  description: "I.e., \\(y = -x\\)."
+>
+op: < name: "NoOp" summary: "Does nothing." >
+op: < name: "Undocumented" >
+op: < name: "_Recv" summary: "Receives the named tensor." >
+`, &ops); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := generateDocsForOps(&buf, &ops); err != nil {
+		t.Fatal(err)
+	}
+	got, err := format.Source(buf.Bytes())
+	if err != nil {
+		t.Fatalf("Unable to format: %v\n%s", err, buf.Bytes())
+	}
+	want := `//go:build opdocs
+// +build opdocs
+
+package op
+
+func init() {
+	opDocs = map[string]OpDoc{
+		"Neg": {
+			Summary:     "Computes numerical negative value element-wise.",
+			Description: "I.e., \\(y = -x\\).",
+			Inputs: []ArgDoc{
+				{Name: "x", Description: "The input."},
+			},
+			Outputs: []ArgDoc{
+				{Name: "y"},
+			},
+			Attrs: []ArgDoc{
+				{Name: "T"},
+			},
+		},
+		"NoOp": {
+			Summary: "Does nothing.",
+		},
+	}
+}
+`
+	if !bytes.HasSuffix(got, []byte(want)) {
+		t.Errorf("Got:\n%s\nWant it to end with:\n%s", got, want)
+	}
+}
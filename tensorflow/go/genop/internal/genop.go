@@ -34,12 +34,13 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"sort"
 	"strings"
 	"text/template"
 	"unsafe"
 
 	"github.com/golang/protobuf/proto"
-	pb "github.com/tensorflow/tensorflow/tensorflow/go/genop/internal/proto/tensorflow/core/framework"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
 )
 
 // GenerateFunctionsForRegisteredOps writes a Go source code file to w
@@ -53,6 +54,17 @@ func GenerateFunctionsForRegisteredOps(w io.Writer) error {
 	return generateFunctionsForOps(w, ops)
 }
 
+// tracing is set by SetTracing.
+var tracing bool
+
+// SetTracing sets whether the functions generated for operations report
+// their execution to the op.BuildTracer of the scope, if any, so that the
+// construction of large graphs can be profiled. It is disabled by default,
+// as the generated code is then a little larger and slower.
+func SetTracing(enabled bool) {
+	tracing = enabled
+}
+
 func registeredOps() (*pb.OpList, error) {
 	buf := C.TF_GetAllOpList()
 	defer C.TF_DeleteBuffer(buf)
@@ -67,16 +79,18 @@ func registeredOps() (*pb.OpList, error) {
 	return list, err
 }
 
+// blacklist contains the operations for which no function is generated.
+var blacklist = map[string]bool{
+	"Const":           true,
+	"PyFunc":          true,
+	"PyFuncStateless": true,
+}
+
 func generateFunctionsForOps(w io.Writer, ops *pb.OpList) error {
 	thisPackage := reflect.TypeOf(tmplArgs{}).PkgPath()
 	if err := tmplHeader.Execute(w, thisPackage); err != nil {
 		return err
 	}
-	blacklist := map[string]bool{
-		"Const":           true,
-		"PyFunc":          true,
-		"PyFuncStateless": true,
-	}
 	for _, op := range ops.Op {
 		if blacklist[op.Name] {
 			continue
@@ -85,37 +99,94 @@ func generateFunctionsForOps(w io.Writer, ops *pb.OpList) error {
 			return err
 		}
 	}
-	return nil
+	return generateStatefulTable(w, ops)
+}
+
+// generateStatefulTable writes the IsStateful function, which unlike the
+// functions for each operation covers all the operations in ops, as graphs
+// may contain operations that cannot be created through the generated API.
+func generateStatefulTable(w io.Writer, ops *pb.OpList) error {
+	return tmplStateful.Execute(w, statefulOps(ops))
+}
+
+// statefulOps returns the sorted names of the stateful operations in ops.
+func statefulOps(ops *pb.OpList) []string {
+	var names []string
+	for _, op := range ops.Op {
+		if op.IsStateful && !strings.HasPrefix(op.Name, "_") {
+			names = append(names, op.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// WritePurityReport writes to w a plain text report listing the stateful
+// operations registered in the address space of the calling process,
+// followed by the number of stateful and pure operations.
+func WritePurityReport(w io.Writer) error {
+	ops, err := registeredOps()
+	if err != nil {
+		return err
+	}
+	stateful := statefulOps(ops)
+	for _, name := range stateful {
+		if _, err := fmt.Fprintln(w, name); err != nil {
+			return err
+		}
+	}
+	var total int
+	for _, op := range ops.Op {
+		if !strings.HasPrefix(op.Name, "_") {
+			total++
+		}
+	}
+	_, err = fmt.Fprintf(w, "\n%d stateful, %d pure operations\n", len(stateful), total-len(stateful))
+	return err
 }
 
 func generateFunctionForOp(w io.Writer, op *pb.OpDef) error {
-	if strings.HasPrefix(op.Name, "_") { // Internal operation
+	return generateQualifiedFunctionForOp(w, op, "")
+}
+
+// generateQualifiedFunctionForOp is like generateFunctionForOp, qualifying
+// the identifiers of the op package with qualifier (e.g., "op.") so that
+// the function can be generated in another package.
+func generateQualifiedFunctionForOp(w io.Writer, op *pb.OpDef, qualifier string) error {
+	if !hasFunction(op) {
 		return nil
 	}
+	args := newTmplArgs(op)
+	args.Qualifier = qualifier
+	return tmplOp.Execute(w, args)
+}
+
+// hasFunction reports whether a function is generated for op.
+func hasFunction(op *pb.OpDef) bool {
+	if strings.HasPrefix(op.Name, "_") { // Internal operation
+		return false
+	}
 	// Ignore operations where the Go types corresponding to the TensorFlow
-	// type haven't been worked out (such as "func"s).
+	// type haven't been worked out.
 	for _, a := range op.Attr {
 		if _, err := goType(a.Type); err != nil {
-			return nil
+			return false
 		}
 	}
 	// Also, haven't figured out reference types yet, so ignore those too.
 	for _, a := range op.InputArg {
 		if a.IsRef {
-			return nil
+			return false
 		}
 	}
 	for _, a := range op.OutputArg {
 		if a.IsRef {
-			return nil
+			return false
 		}
 	}
-	if op.Summary == "" {
-		// Undocumented operation, perhaps a sign of not being ready to
-		// export.
-		return nil
-	}
-	return tmplOp.Execute(w, newTmplArgs(op))
+	// Undocumented operations are perhaps a sign of not being ready to
+	// export.
+	return op.Summary != ""
 }
 
 var (
@@ -136,33 +207,36 @@ var (
 
 package op
 
-import tf "github.com/tensorflow/tensorflow/tensorflow/go"
+import (
+	"fmt"
+	"sync"
 
-// optionalAttr is an intentionally un-exported type to hide
-// details of how optional attributes to operations are implemented.
-type optionalAttr map[string]interface{}
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
 
-func makeOutputList(op *tf.Operation, start int, output string) ([]tf.Output, int, error) {
-	size, err := op.OutputListSize(output)
-	if err != nil {
-		return nil, start, err
-	}
-	list := make([]tf.Output, size)
-	for i := 0; i < size; i++ {
-		list[i] = op.Output(start + i)
-	}
-	return list, start + size, nil
+` + helperSource))
+
+	tmplStateful = template.Must(template.New("stateful").Parse(`
+// statefulOps is the set of operations with OpDef.is_stateful set.
+var statefulOps = map[string]bool{
+{{- range .}}
+	{{printf "%q" .}}: true,
+{{- end}}
+}
+
+// IsStateful reports whether operations of type opType are stateful: they
+// may produce different outputs for the same inputs or have side effects,
+// such as updating variables or queues. Stateful operations must not be
+// deduplicated or have their results cached, and their relative order
+// may need to be enforced with control dependencies.
+//
+// IsStateful returns false for unknown operation types.
+func IsStateful(opType string) bool {
+	return statefulOps[opType]
 }
 `))
 
-	tmplOp = template.Must(template.New("op").Funcs(template.FuncMap{
-		"MakeComment": makeComment,
-		"GoType":      goType,
-		"CamelCase":   camelCase,
-		"Identifier":  identifier,
-		"IsListArg":   isListArg,
-		"IsListAttr":  isListAttr,
-	}).Parse(`
+	tmplOp = template.Must(template.New("op").Funcs(templateFuncs).Parse(`
 {{if .OptionalAttrs -}}
 {{/* Type for specifying all optional attributes. */ -}}
 // {{.Op.Name}}Attr is an optional argument to {{.Op.Name}}.
@@ -179,6 +253,10 @@ type {{.Op.Name}}Attr func(optionalAttr)
 //
 // {{if IsListAttr .}}REQUIRES: len(value) >= {{.Minimum}}{{else}}REQUIRES: value >= {{.Minimum}}{{end}}
 {{- end}}
+{{- with AllowedDTypes .}}
+//
+// REQUIRES: value is one of {{Join . ", "}}
+{{- end}}
 func {{$.Op.Name}}{{CamelCase .Name}}(value {{GoType .Type}}) {{$.Op.Name}}Attr {
 	return func(m optionalAttr) {
 		m[{{printf "%q" .Name}}] = value
@@ -218,7 +296,7 @@ func {{$.Op.Name}}{{CamelCase .Name}}(value {{GoType .Type}}) {{$.Op.Name}}Attr
 {{- else }}
 {{- if .DescribeOutputs}}
 //
-{{- if ((len .Op.OutputArg) eq 1) }}
+{{- if eq (len .Op.OutputArg) 1 }}
 // Returns {{range .Op.OutputArg}}{{MakeComment .Description}}{{end}}
 {{- else }}
 // Returns:
@@ -228,6 +306,11 @@ func {{$.Op.Name}}{{CamelCase .Name}}(value {{GoType .Type}}) {{$.Op.Name}}Attr
 {{- end -}}
 {{- end -}}
 {{- end -}}
+
+{{- range $a := .RequiredAttrs}}{{with AllowedDTypes $a}}
+//
+// REQUIRES: {{Identifier $a.Name}} is one of {{Join . ", "}}
+{{- end}}{{end -}}
 {{- /*
 
   The function signature.
@@ -243,7 +326,7 @@ func {{.Op.Name}}
   (4) Variadic list of optional attributes
 */ -}}
 
-(scope *Scope
+(scope *{{.Qualifier}}Scope
 {{- range $i, $a := .Op.InputArg}}, {{Identifier $a.Name}} {{if IsListArg $a}}[]{{end}}tf.Output{{end -}}
 {{range $i, $a := .RequiredAttrs}}, {{Identifier $a.Name}} {{GoType $a.Type}}{{end -}}
 {{if .OptionalAttrs}}, optional ...{{.Op.Name}}Attr{{end -}}
@@ -259,14 +342,31 @@ func {{.Op.Name}}
 	if scope.Err() != nil {
 		return
 	}
+	{{if .Trace -}}
+	{{if .Qualifier -}}
+	defer {{.Qualifier}}StartTrace(scope, {{printf "%q" .Op.Name}})()
+	{{else -}}
+	defer scope.trace({{printf "%q" .Op.Name}})()
+	{{end -}}
+	{{end -}}
 	{{if .HasAttrs -}}
-	attrs := map[string]interface{}{ {{- range .RequiredAttrs}}{{printf "%q" .Name}}: {{Identifier .Name}},{{end}}}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	{{range .RequiredAttrs -}}
+	attrs[{{printf "%q" .Name}}] = {{Identifier .Name}}
+	{{end -}}
 	{{if .OptionalAttrs -}}
 	for _, a := range optional {
 		a(attrs)
 	}
 	{{end -}}
 	{{end -}}
+	{{range .ValidatedAttrs -}}
+	if err := validateDType(attrs, {{printf "%q" .Name}}, {{Join (AllowedDTypes .) ", "}}); err != nil {
+		scope.UpdateErr({{printf "%q" $.Op.Name}}, err)
+		return
+	}
+	{{end -}}
 	opspec := tf.OpSpec{
 		Type: {{printf "%q" .Op.Name}},
 		{{if .Op.InputArg -}}
@@ -308,6 +408,60 @@ func {{.Op.Name}}
 `))
 )
 
+// helperSource is the code shared by the generated functions of a package.
+const helperSource = `// optionalAttr is an intentionally un-exported type to hide
+// details of how optional attributes to operations are implemented.
+type optionalAttr map[string]interface{}
+
+// attrPool recycles the attribute maps of the generated functions. A map is
+// only read while its operation is added to the graph, so building large
+// graphs does not need to allocate a new map for every operation.
+var attrPool = sync.Pool{
+	New: func() interface{} { return make(map[string]interface{}, 8) },
+}
+
+// newAttrs returns an empty attribute map, to be passed to releaseAttrs once
+// the operation has been added.
+func newAttrs() map[string]interface{} {
+	return attrPool.Get().(map[string]interface{})
+}
+
+// releaseAttrs clears attrs and returns it to attrPool.
+func releaseAttrs(attrs map[string]interface{}) {
+	for name := range attrs {
+		delete(attrs, name)
+	}
+	attrPool.Put(attrs)
+}
+
+func makeOutputList(op *tf.Operation, start int, output string) ([]tf.Output, int, error) {
+	size, err := op.OutputListSize(output)
+	if err != nil {
+		return nil, start, err
+	}
+	list := make([]tf.Output, size)
+	for i := 0; i < size; i++ {
+		list[i] = op.Output(start + i)
+	}
+	return list, start + size, nil
+}
+
+// validateDType returns an error if the attribute name is set in attrs to a
+// DataType other than those allowed.
+func validateDType(attrs map[string]interface{}, name string, allowed ...tf.DataType) error {
+	dt, ok := attrs[name].(tf.DataType)
+	if !ok {
+		return nil
+	}
+	for _, a := range allowed {
+		if dt == a {
+			return nil
+		}
+	}
+	return fmt.Errorf("attribute %s: %v is not one of the allowed types %v", name, dt, allowed)
+}
+`
+
 type tmplArgs struct {
 	Op *pb.OpDef
 	// Op.Attr is split into two categories
@@ -317,10 +471,16 @@ type tmplArgs struct {
 	//     values) and thus do not appear in the function signature.
 	RequiredAttrs []*pb.OpDef_AttrDef
 	OptionalAttrs []*pb.OpDef_AttrDef
+	// Trace is set if the function should report its execution to the
+	// BuildTracer of the scope. See SetTracing.
+	Trace bool
+	// Qualifier qualifies the identifiers of the op package, e.g. "op."
+	// when the function is generated in another package.
+	Qualifier string
 }
 
 func newTmplArgs(op *pb.OpDef) *tmplArgs {
-	ret := tmplArgs{Op: op}
+	ret := tmplArgs{Op: op, Trace: tracing}
 	if len(op.Attr) == 0 {
 		return &ret
 	}
@@ -351,6 +511,21 @@ func newTmplArgs(op *pb.OpDef) *tmplArgs {
 	return &ret
 }
 
+// ValidatedAttrs returns the attributes of type "type" with a list of
+// allowed values that are not inferred from the inputs, and must be
+// validated by the generated function.
+func (a *tmplArgs) ValidatedAttrs() []*pb.OpDef_AttrDef {
+	var attrs []*pb.OpDef_AttrDef
+	for _, list := range [][]*pb.OpDef_AttrDef{a.RequiredAttrs, a.OptionalAttrs} {
+		for _, attr := range list {
+			if len(allowedDTypes(attr)) > 0 {
+				attrs = append(attrs, attr)
+			}
+		}
+	}
+	return attrs
+}
+
 func (a *tmplArgs) HasAttrs() bool { return len(a.RequiredAttrs)+len(a.OptionalAttrs) > 0 }
 func (a *tmplArgs) DescribeArguments() bool {
 	for _, arg := range a.Op.InputArg {
@@ -404,9 +579,11 @@ func goType(tfType string) (string, error) {
 	case "shape":
 		gotype = "tf.Shape"
 	case "tensor":
-		gotype = "tf.Tensor"
+		gotype = "*tf.Tensor"
 	case "string":
 		gotype = "string"
+	case "func":
+		gotype = "tf.NameAttrList"
 	default:
 		return "", fmt.Errorf("%q is not a recognized DataType", tfType)
 	}
@@ -419,7 +596,11 @@ func goType(tfType string) (string, error) {
 func camelCase(snakeCase string) string {
 	words := strings.Split(snakeCase, "_")
 	for i, w := range words {
-		words[i] = strings.ToUpper(string(w[0])) + w[1:]
+		if w == "" {
+			// Leading, trailing or repeated underscores.
+			continue
+		}
+		words[i] = strings.ToUpper(w[:1]) + w[1:]
 	}
 	return strings.Join(words, "")
 }
@@ -443,6 +624,51 @@ func identifier(s string) string {
 	return s
 }
 
+// allowedDTypes returns the Go expressions of the values allowed for attr if
+// it is an attribute of type "type" with a list of allowed values, such as
+// []string{"tf.Float", "tf.Double"}.
+func allowedDTypes(attr *pb.OpDef_AttrDef) []string {
+	if attr.Type != "type" || attr.AllowedValues == nil {
+		return nil
+	}
+	var dtypes []string
+	for _, dt := range attr.AllowedValues.GetList().GetType() {
+		if c, ok := dtypeConsts[dt]; ok {
+			dtypes = append(dtypes, "tf."+c)
+		} else {
+			// A type without a Go equivalent: validating against a
+			// partial list would reject valid values.
+			return nil
+		}
+	}
+	return dtypes
+}
+
+// dtypeConsts maps DataTypes to the names of the corresponding constants in
+// the tf package.
+var dtypeConsts = map[pb.DataType]string{
+	pb.DataType_DT_FLOAT:      "Float",
+	pb.DataType_DT_DOUBLE:     "Double",
+	pb.DataType_DT_INT32:      "Int32",
+	pb.DataType_DT_UINT8:      "Uint8",
+	pb.DataType_DT_INT16:      "Int16",
+	pb.DataType_DT_INT8:       "Int8",
+	pb.DataType_DT_STRING:     "String",
+	pb.DataType_DT_COMPLEX64:  "Complex64",
+	pb.DataType_DT_INT64:      "Int64",
+	pb.DataType_DT_BOOL:       "Bool",
+	pb.DataType_DT_QINT8:      "Qint8",
+	pb.DataType_DT_QUINT8:     "Quint8",
+	pb.DataType_DT_QINT32:     "Qint32",
+	pb.DataType_DT_BFLOAT16:   "Bfloat16",
+	pb.DataType_DT_QINT16:     "Qint16",
+	pb.DataType_DT_QUINT16:    "Quint16",
+	pb.DataType_DT_UINT16:     "Uint16",
+	pb.DataType_DT_COMPLEX128: "Complex128",
+	pb.DataType_DT_HALF:       "Half",
+	pb.DataType_DT_RESOURCE:   "Resource",
+}
+
 func isListArg(argdef *pb.OpDef_ArgDef) bool {
 	return argdef.TypeListAttr != "" || argdef.NumberAttr != ""
 }
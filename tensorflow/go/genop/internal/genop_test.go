@@ -17,10 +17,11 @@ package internal
 import (
 	"bytes"
 	"go/format"
+	"strings"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
-	pb "github.com/tensorflow/tensorflow/tensorflow/go/genop/internal/proto/tensorflow/core/framework"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
 )
 
 func TestGenerateOp(t *testing.T) {
@@ -98,6 +99,106 @@ func Add(scope *Scope, x tf.Output, y tf.Output) (z tf.Output) {
 	op := scope.AddOperation(opspec)
 	return op.Output(0)
 }
+`,
+		},
+		{
+			tag: "AllowedDataTypes",
+			opdef: `
+name: "RandomUniform"
+input_arg: <
+  name: "shape"
+  type_attr: "T"
+>
+output_arg: <
+  name: "output"
+  type_attr: "dtype"
+>
+attr: <
+  name: "dtype"
+  type: "type"
+  allowed_values: <
+    list: <
+      type: DT_HALF
+      type: DT_FLOAT
+    >
+  >
+>
+attr: <
+  name: "T"
+  type: "type"
+  allowed_values: <
+    list: <
+      type: DT_INT32
+      type: DT_INT64
+    >
+  >
+>
+summary: "Outputs random values from a uniform distribution."
+`,
+			wanted: `
+// Outputs random values from a uniform distribution.
+//
+// REQUIRES: dtype is one of tf.Half, tf.Float
+func RandomUniform(scope *Scope, shape tf.Output, dtype tf.DataType) (output tf.Output) {
+	if scope.Err() != nil {
+		return
+	}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["dtype"] = dtype
+	if err := validateDType(attrs, "dtype", tf.Half, tf.Float); err != nil {
+		scope.UpdateErr("RandomUniform", err)
+		return
+	}
+	opspec := tf.OpSpec{
+		Type: "RandomUniform",
+		Input: []tf.Input{
+			shape,
+		},
+		Attrs: attrs,
+	}
+	op := scope.AddOperation(opspec)
+	return op.Output(0)
+}
+`,
+		},
+		{
+			tag: "FuncAttr",
+			opdef: `
+name: "Call"
+input_arg: <
+  name: "x"
+  type: DT_FLOAT
+>
+output_arg: <
+  name: "y"
+  type: DT_FLOAT
+>
+attr: <
+  name: "f"
+  type: "func"
+>
+summary: "Calls f."
+`,
+			wanted: `
+// Calls f.
+func Call(scope *Scope, x tf.Output, f tf.NameAttrList) (y tf.Output) {
+	if scope.Err() != nil {
+		return
+	}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["f"] = f
+	opspec := tf.OpSpec{
+		Type: "Call",
+		Input: []tf.Input{
+			x,
+		},
+		Attrs: attrs,
+	}
+	op := scope.AddOperation(opspec)
+	return op.Output(0)
+}
 `,
 		},
 		{
@@ -128,7 +229,9 @@ func Cast(scope *Scope, x tf.Output, DstT tf.DataType) (y tf.Output) {
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"DstT": DstT}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["DstT"] = DstT
 	opspec := tf.OpSpec{
 		Type: "Cast",
 		Input: []tf.Input{
@@ -232,7 +335,8 @@ func DecodeJpeg(scope *Scope, contents tf.Output, optional ...DecodeJpegAttr) (i
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -333,6 +437,8 @@ type ShapeNAttr func(optionalAttr)
 
 // ShapeNOutType sets the optional out_type attribute to value.
 // If not specified, defaults to type:DT_INT32
+//
+// REQUIRES: value is one of tf.Int32, tf.Int64
 func ShapeNOutType(value tf.DataType) ShapeNAttr {
 	return func(m optionalAttr) {
 		m["out_type"] = value
@@ -346,10 +452,15 @@ func ShapeN(scope *Scope, input []tf.Output, optional ...ShapeNAttr) (output []t
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
+	if err := validateDType(attrs, "out_type", tf.Int32, tf.Int64); err != nil {
+		scope.UpdateErr("ShapeN", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "ShapeN",
 		Input: []tf.Input{
@@ -397,3 +508,99 @@ func ShapeN(scope *Scope, input []tf.Output, optional ...ShapeNAttr) (output []t
 		})
 	}
 }
+
+func TestGenerateOpWithTracing(t *testing.T) {
+	SetTracing(true)
+	defer SetTracing(false)
+	var opdef pb.OpDef
+	if err := proto.UnmarshalText(`name: "NoOp" summary: "No. Op."`, &opdef); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := generateFunctionForOp(&buf, &opdef); err != nil {
+		t.Fatal(err)
+	}
+	got, err := format.Source(buf.Bytes())
+	if err != nil {
+		t.Fatalf("Unable to format: %v\n%s", err, buf.Bytes())
+	}
+	want, err := format.Source([]byte(`
+// No. Op.
+//
+// Returns the created operation.
+func NoOp(scope *Scope) (o *tf.Operation) {
+	if scope.Err() != nil {
+		return
+	}
+	defer scope.trace("NoOp")()
+	opspec := tf.OpSpec{
+		Type: "NoOp",
+	}
+	return scope.AddOperation(opspec)
+}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("Got:\n%s\nWant:\n%s\n", got, want)
+	}
+}
+
+func TestGenerateStatefulTable(t *testing.T) {
+	var ops pb.OpList
+	if err := proto.UnmarshalText(`
+op: < name: "VariableV2" is_stateful: true >
+op: < name: "Add" >
+op: < name: "_Recv" is_stateful: true >
+op: < name: "RandomUniform" is_stateful: true >
+`, &ops); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := generateStatefulTable(&buf, &ops); err != nil {
+		t.Fatal(err)
+	}
+	got, err := format.Source(buf.Bytes())
+	if err != nil {
+		t.Fatalf("Unable to format: %v\n%s", err, buf.Bytes())
+	}
+	wantTable := `var statefulOps = map[string]bool{
+	"RandomUniform": true,
+	"VariableV2":    true,
+}`
+	if !bytes.Contains(got, []byte(wantTable)) {
+		t.Errorf("Got:\n%s\nWant it to contain:\n%s", got, wantTable)
+	}
+	if !bytes.Contains(got, []byte("func IsStateful(opType string) bool {")) {
+		t.Errorf("IsStateful not generated:\n%s", got)
+	}
+}
+
+func TestCamelCase(t *testing.T) {
+	tests := map[string]string{
+		"":             "",
+		"foo":          "Foo",
+		"foo_bar":      "FooBar",
+		"_foo__bar_":   "FooBar",
+		"data_format":  "DataFormat",
+		"T":            "T",
+		"use_cudnn_on": "UseCudnnOn",
+	}
+	for in, want := range tests {
+		if got := camelCase(in); got != want {
+			t.Errorf("camelCase(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func FuzzCamelCase(f *testing.F) {
+	for _, s := range []string{"", "_", "__", "a_b", "_a", "a_", "\xff_x"} {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		if got := camelCase(s); strings.Contains(got, "_") {
+			t.Errorf("camelCase(%q) = %q contains an underscore", s, got)
+		}
+	})
+}
@@ -0,0 +1,103 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// gradientSources are the directories, relative to the root of the
+// TensorFlow sources, of the files registering gradients.
+var gradientSources = []string{
+	"tensorflow/core/ops",
+	"tensorflow/cc/gradients",
+}
+
+// gradientRegistration matches the registrations of the gradient functions
+// of operations, and of the operations declared not differentiable.
+var gradientRegistration = regexp.MustCompile(`\bREGISTER_(?:OP_GRADIENT|GRADIENT_OP|NO_GRADIENT_OP)\(\s*"([A-Za-z0-9_]+)"`)
+
+// GenerateGradientTable writes to w a Go source file for the op package
+// listing the operations with a gradient registered in the TensorFlow
+// sources under srcDir (the root of the tensorflow repository) from which
+// the runtime is built, for op.HasGradient. The C API does not expose the
+// registry of gradients, so the table is built from the sources.
+func GenerateGradientTable(w io.Writer, srcDir string) error {
+	var ops []string
+	for _, dir := range gradientSources {
+		files, err := filepath.Glob(filepath.Join(srcDir, filepath.FromSlash(dir), "*.cc"))
+		if err != nil {
+			return err
+		}
+		for _, file := range files {
+			if strings.HasSuffix(file, "_test.cc") {
+				continue
+			}
+			src, err := ioutil.ReadFile(file)
+			if err != nil {
+				return err
+			}
+			ops = append(ops, gradientOps(string(src))...)
+		}
+	}
+	return generateGradientTable(w, ops)
+}
+
+// gradientOps returns the operations whose gradients are registered in
+// src, the source of a C++ file.
+func gradientOps(src string) []string {
+	var ops []string
+	for _, m := range gradientRegistration.FindAllStringSubmatch(src, -1) {
+		ops = append(ops, m[1])
+	}
+	return ops
+}
+
+func generateGradientTable(w io.Writer, ops []string) error {
+	seen := make(map[string]bool)
+	var names []string
+	for _, op := range ops {
+		if !seen[op] && !strings.HasPrefix(op, "_") {
+			seen[op] = true
+			names = append(names, op)
+		}
+	}
+	sort.Strings(names)
+	return tmplGradients.Execute(w, struct {
+		Generator string
+		Ops       []string
+	}{reflect.TypeOf(tmplArgs{}).PkgPath(), names})
+}
+
+var tmplGradients = template.Must(template.New("gradients").Parse(`// DO NOT EDIT
+// This file was machine generated by {{.Generator}}
+
+package op
+
+func init() {
+	opGradients = map[string]bool{
+{{- range .Ops}}
+		{{printf "%q" .}}: true,
+{{- end}}
+	}
+}
+`))
@@ -0,0 +1,63 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"bytes"
+	"go/format"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGradientOps(t *testing.T) {
+	src := `
+REGISTER_OP_GRADIENT("Neg", NegGrad);
+REGISTER_OP_GRADIENT(
+    "Conv2D", Conv2DGrad);
+REGISTER_GRADIENT_OP("MatMul", MatMulGrad);
+REGISTER_NO_GRADIENT_OP("Const");
+// Not a registration: MY_REGISTER_OP_GRADIENT("Other", OtherGrad);
+#define REGISTER_OP_GRADIENT(name, fn)
+`
+	if got, want := gradientOps(src), []string{"Neg", "Conv2D", "MatMul", "Const"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Got %v, want %v", got, want)
+	}
+}
+
+func TestGenerateGradientTable(t *testing.T) {
+	var buf bytes.Buffer
+	if err := generateGradientTable(&buf, []string{"Neg", "MatMul", "_ListToArray", "Neg", "Conv2D"}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := format.Source(buf.Bytes())
+	if err != nil {
+		t.Fatalf("Unable to format: %v\n%s", err, buf.Bytes())
+	}
+	want := `package op
+
+func init() {
+	opGradients = map[string]bool{
+		"Conv2D": true,
+		"MatMul": true,
+		"Neg":    true,
+	}
+}
+`
+	// Skip the header naming the generator.
+	if i := strings.Index(string(got), "package op"); i < 0 || string(got[i:]) != want {
+		t.Errorf("Got:\n%s\nWant:\n%s", got, want)
+	}
+}
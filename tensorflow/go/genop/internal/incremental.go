@@ -0,0 +1,196 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+)
+
+// sumFile is the name of the file recording the hash of the source
+// generated for each file by GenerateFilesForRegisteredOps.
+const sumFile = "genop.sum"
+
+// FileStats summarizes the changes made by GenerateFilesForRegisteredOps.
+type FileStats struct {
+	// Generated is the number of files written.
+	Generated int
+	// Unchanged is the number of files left untouched as their contents
+	// would not have changed.
+	Unchanged int
+	// Removed is the number of files removed as their operation is no
+	// longer registered or has no function generated anymore.
+	Removed int
+}
+
+// GenerateFilesForRegisteredOps is like GenerateFunctionsForRegisteredOps,
+// but writes the function for each operation to its own file in dir, named
+// wrapper_<lowercase operation name>.go, and the code shared by all of them
+// to wrappers.go. header is copied to the start of each file. The header
+// template is only used for wrappers.go, the other files start with a
+// fixed package clause and import.
+//
+// The hash of the source generated for each file is recorded in dir, and
+// files whose source would not change are not rewritten. This keeps
+// regeneration fast and the changes minimal when the generated code is
+// checked into version control. The hash covers the generated source
+// rather than the OpDef, so changes to the templates or flags of genop are
+// picked up too.
+func GenerateFilesForRegisteredOps(dir string, header []byte) (*FileStats, error) {
+	ops, err := registeredOps()
+	if err != nil {
+		return nil, err
+	}
+	return generateFiles(dir, header, ops)
+}
+
+func generateFiles(dir string, header []byte, ops *pb.OpList) (*FileStats, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	oldSums, err := readSums(filepath.Join(dir, sumFile))
+	if err != nil {
+		return nil, err
+	}
+	var (
+		stats       = new(FileStats)
+		sums        = make(map[string]string)
+		thisPackage = reflect.TypeOf(tmplArgs{}).PkgPath()
+	)
+	write := func(name string, src []byte) error {
+		h := sha256.Sum256(src)
+		sum := hex.EncodeToString(h[:])
+		sums[name] = sum
+		path := filepath.Join(dir, name)
+		if oldSums[name] == sum {
+			if _, err := os.Stat(path); err == nil {
+				stats.Unchanged++
+				return nil
+			}
+		}
+		formatted, err := format.Source(src)
+		if err != nil {
+			return fmt.Errorf("failed to format %s: %v", name, err)
+		}
+		if err := ioutil.WriteFile(path, formatted, 0644); err != nil {
+			return err
+		}
+		stats.Generated++
+		return nil
+	}
+
+	var buf bytes.Buffer
+	writeHeader := func() {
+		buf.Reset()
+		if len(header) > 0 {
+			buf.Write(header)
+			buf.WriteString("\n\n")
+		}
+	}
+	writeHeader()
+	if err := tmplHeader.Execute(&buf, thisPackage); err != nil {
+		return nil, err
+	}
+	if err := generateStatefulTable(&buf, ops); err != nil {
+		return nil, err
+	}
+	if err := write("wrappers.go", buf.Bytes()); err != nil {
+		return nil, err
+	}
+	for _, op := range ops.Op {
+		if blacklist[op.Name] {
+			continue
+		}
+		var fn bytes.Buffer
+		if err := generateFunctionForOp(&fn, op); err != nil {
+			return nil, err
+		}
+		if fn.Len() == 0 {
+			continue
+		}
+		writeHeader()
+		fmt.Fprintf(&buf, "// DO NOT EDIT\n// This file was machine generated by %s\n\npackage op\n\nimport tf \"github.com/tensorflow/tensorflow/tensorflow/go\"\n", thisPackage)
+		buf.Write(fn.Bytes())
+		if err := write("wrapper_"+strings.ToLower(op.Name)+".go", buf.Bytes()); err != nil {
+			return nil, err
+		}
+	}
+
+	for name := range oldSums {
+		if _, ok := sums[name]; ok {
+			continue
+		}
+		if err := os.Remove(filepath.Join(dir, name)); err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		stats.Removed++
+	}
+	if stats.Generated > 0 || stats.Removed > 0 || len(oldSums) != len(sums) {
+		if err := writeSums(filepath.Join(dir, sumFile), sums); err != nil {
+			return nil, err
+		}
+	}
+	return stats, nil
+}
+
+// readSums reads a file written by writeSums. A missing file is treated as
+// an empty one.
+func readSums(path string) (map[string]string, error) {
+	sums := make(map[string]string)
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return sums, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("%s: invalid line %q", path, scanner.Text())
+		}
+		sums[fields[1]] = fields[0]
+	}
+	return sums, scanner.Err()
+}
+
+// writeSums writes one line per file, with the hash of its generated source
+// and its name.
+func writeSums(path string, sums map[string]string) error {
+	names := make([]string, 0, len(sums))
+	for name := range sums {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var buf bytes.Buffer
+	for _, name := range names {
+		fmt.Fprintf(&buf, "%s  %s\n", sums[name], name)
+	}
+	return ioutil.WriteFile(path, buf.Bytes(), 0644)
+}
@@ -0,0 +1,100 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+)
+
+func TestGenerateFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "genop")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	generate := func(ops string, want FileStats) {
+		t.Helper()
+		var list pb.OpList
+		if err := proto.UnmarshalText(ops, &list); err != nil {
+			t.Fatal(err)
+		}
+		got, err := generateFiles(dir, []byte("// Header"), &list)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if *got != want {
+			t.Errorf("Got %+v, want %+v", *got, want)
+		}
+	}
+	files := func() []string {
+		infos, err := ioutil.ReadDir(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, info := range infos {
+			names = append(names, info.Name())
+		}
+		return names
+	}
+
+	generate(`
+op: < name: "NoOp" summary: "No. Op." >
+op: < name: "ControlTrigger" summary: "Does nothing." >
+op: < name: "Const" summary: "Blacklisted." >
+op: < name: "Undocumented" >
+`, FileStats{Generated: 3})
+	if got, want := files(), []string{"genop.sum", "wrapper_controltrigger.go", "wrapper_noop.go", "wrappers.go"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Got files %q, want %q", got, want)
+	}
+	src, err := ioutil.ReadFile(filepath.Join(dir, "wrapper_noop.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), "", src, 0); err != nil {
+		t.Errorf("Generated invalid source: %v\n%s", err, src)
+	}
+
+	// Only the changed operation is regenerated.
+	generate(`
+op: < name: "NoOp" summary: "No. Op." >
+op: < name: "ControlTrigger" summary: "Does nothing, differently." >
+`, FileStats{Generated: 1, Unchanged: 2})
+
+	// Files of operations that are no longer registered are removed.
+	generate(`
+op: < name: "NoOp" summary: "No. Op." >
+`, FileStats{Unchanged: 2, Removed: 1})
+	if got, want := files(), []string{"genop.sum", "wrapper_noop.go", "wrappers.go"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Got files %q, want %q", got, want)
+	}
+
+	// A deleted file is regenerated.
+	if err := os.Remove(filepath.Join(dir, "wrapper_noop.go")); err != nil {
+		t.Fatal(err)
+	}
+	generate(`
+op: < name: "NoOp" summary: "No. Op." >
+`, FileStats{Generated: 1, Unchanged: 1})
+}
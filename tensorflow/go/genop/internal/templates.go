@@ -0,0 +1,112 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// templateFuncs are the functions available to the templates generating
+// source code, including those loaded by LoadTemplates.
+var templateFuncs = template.FuncMap{
+	"MakeComment":   makeComment,
+	"GoType":        goType,
+	"CamelCase":     camelCase,
+	"Identifier":    identifier,
+	"IsListArg":     isListArg,
+	"IsListAttr":    isListAttr,
+	"AllowedDTypes": allowedDTypes,
+	"HasPrefix":     strings.HasPrefix,
+	"HasSuffix":     strings.HasSuffix,
+	"Join":          strings.Join,
+	"ToLower":       strings.ToLower,
+	"ToUpper":       strings.ToUpper,
+	"TrimPrefix":    strings.TrimPrefix,
+	"TrimSuffix":    strings.TrimSuffix,
+}
+
+// LoadTemplates replaces the templates used to generate source code by
+// those in the directory dir, allowing wrappers to be generated with, for
+// example, custom logging, tracing or naming conventions. Each of the
+// following files, if present, replaces the corresponding template:
+//
+//	header.tmpl        The start of the generated file. Executed with the
+//	                   import path of this package.
+//	op.tmpl            The code for an operation. Executed with a value
+//	                   whose Op field is the *OpDef of the operation, and
+//	                   whose RequiredAttrs and OptionalAttrs fields are
+//	                   its attributes that have no default value and those
+//	                   that do.
+//	stateful.tmpl      The IsStateful function. Executed with the sorted
+//	                   names of the stateful operations.
+//	typed_header.tmpl  Like header.tmpl, for the -typed mode of genop.
+//	typed_op.tmpl      Like op.tmpl, for the -typed mode of genop, with an
+//	                   additional Constraint field.
+//
+// All the other files with a .tmpl extension in dir are parsed before those
+// and can define named templates, such as a logging statement, for use by
+// all of them. The functions available to the templates are those used by
+// the default templates (MakeComment, GoType, CamelCase, Identifier,
+// IsListArg, IsListAttr and AllowedDTypes) and HasPrefix, HasSuffix, Join, ToLower,
+// ToUpper, TrimPrefix and TrimSuffix from the strings package.
+func LoadTemplates(dir string) error {
+	targets := map[string]**template.Template{
+		"header.tmpl":       &tmplHeader,
+		"op.tmpl":           &tmplOp,
+		"stateful.tmpl":     &tmplStateful,
+		"typed_header.tmpl": &tmplTypedHeader,
+		"typed_op.tmpl":     &tmplTypedOp,
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*.tmpl"))
+	if err != nil {
+		return err
+	}
+	contents := make(map[string]string)
+	shared := template.New("").Funcs(templateFuncs)
+	for _, file := range files {
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		name := filepath.Base(file)
+		if _, ok := targets[name]; ok {
+			contents[name] = string(b)
+			continue
+		}
+		if _, err := shared.New(name).Parse(string(b)); err != nil {
+			return fmt.Errorf("failed to parse %s: %v", file, err)
+		}
+	}
+	// Parse all the templates before replacing any of them, so that an
+	// error leaves the templates unchanged.
+	parsed := make(map[string]*template.Template)
+	for name, text := range contents {
+		t, err := shared.Clone()
+		if err != nil {
+			return err
+		}
+		if parsed[name], err = t.New(name).Parse(text); err != nil {
+			return fmt.Errorf("failed to parse %s: %v", filepath.Join(dir, name), err)
+		}
+	}
+	for name, t := range parsed {
+		*targets[name] = t
+	}
+	return nil
+}
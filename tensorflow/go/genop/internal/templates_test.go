@@ -0,0 +1,81 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+)
+
+func TestLoadTemplates(t *testing.T) {
+	header, op, stateful := tmplHeader, tmplOp, tmplStateful
+	defer func() { tmplHeader, tmplOp, tmplStateful = header, op, stateful }()
+
+	dir, err := ioutil.TempDir("", "genop")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	files := map[string]string{
+		"trace.tmpl": `{{define "trace"}}defer trace({{printf "%q" (ToLower .Op.Name)}})(){{end}}`,
+		"op.tmpl": `
+func {{.Op.Name}}(scope *Scope) (o *tf.Operation) {
+	{{template "trace" .}}
+	return scope.AddOperation(tf.OpSpec{Type: {{printf "%q" .Op.Name}}})
+}
+`,
+	}
+	for name, text := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(text), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := LoadTemplates(dir); err != nil {
+		t.Fatal(err)
+	}
+	if tmplHeader != header || tmplStateful != stateful {
+		t.Error("templates without a file in the directory were replaced")
+	}
+
+	var opdef pb.OpDef
+	if err := proto.UnmarshalText(`name: "NoOp" summary: "No. Op."`, &opdef); err != nil {
+		t.Fatal(err)
+	}
+	var buf strings.Builder
+	if err := generateFunctionForOp(&buf, &opdef); err != nil {
+		t.Fatal(err)
+	}
+	if want := `defer trace("noop")()`; !strings.Contains(buf.String(), want) {
+		t.Errorf("Got:\n%s\nWant it to contain %q", buf.String(), want)
+	}
+
+	// A template that fails to parse leaves all the templates unchanged.
+	loaded := tmplOp
+	if err := ioutil.WriteFile(filepath.Join(dir, "header.tmpl"), []byte("{{.Unterminated"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := LoadTemplates(dir); err == nil {
+		t.Error("expected an error for an invalid template")
+	}
+	if tmplHeader != header || tmplOp != loaded {
+		t.Error("templates were replaced despite an error")
+	}
+}
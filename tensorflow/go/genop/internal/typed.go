@@ -0,0 +1,154 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/template"
+
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+)
+
+// GenerateTypedFunctionsForRegisteredOps writes a Go source code file to w
+// containing generic functions, operating on op.Typed values, for the
+// operations named in names.
+//
+// Only operations whose inputs and outputs are all single tensors of the
+// type given by the same attribute are supported. The generated functions
+// delegate to the functions generated by GenerateFunctionsForRegisteredOps.
+func GenerateTypedFunctionsForRegisteredOps(w io.Writer, names []string) error {
+	ops, err := registeredOps()
+	if err != nil {
+		return err
+	}
+	return generateTypedFunctionsForOps(w, ops, names)
+}
+
+func generateTypedFunctionsForOps(w io.Writer, ops *pb.OpList, names []string) error {
+	byName := make(map[string]*pb.OpDef, len(ops.Op))
+	for _, op := range ops.Op {
+		byName[op.Name] = op
+	}
+	thisPackage := reflect.TypeOf(tmplArgs{}).PkgPath()
+	if err := tmplTypedHeader.Execute(w, thisPackage); err != nil {
+		return err
+	}
+	for _, name := range names {
+		op, ok := byName[name]
+		if !ok {
+			return fmt.Errorf("operation %q is not registered", name)
+		}
+		args, err := newTypedTmplArgs(op)
+		if err != nil {
+			return fmt.Errorf("operation %q: %v", name, err)
+		}
+		if err := tmplTypedOp.Execute(w, args); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type typedTmplArgs struct {
+	*tmplArgs
+	// Constraint is the type constraint of the type parameter of the
+	// generated function.
+	Constraint string
+}
+
+func newTypedTmplArgs(op *pb.OpDef) (*typedTmplArgs, error) {
+	if len(op.InputArg) == 0 {
+		return nil, fmt.Errorf("operations without inputs are not supported")
+	}
+	var typeAttr string
+	for _, args := range [][]*pb.OpDef_ArgDef{op.InputArg, op.OutputArg} {
+		for _, a := range args {
+			if isListArg(a) || a.IsRef || a.TypeAttr == "" || (typeAttr != "" && a.TypeAttr != typeAttr) {
+				return nil, fmt.Errorf("argument %q is not of the type shared by all arguments", a.Name)
+			}
+			typeAttr = a.TypeAttr
+		}
+	}
+	args := &typedTmplArgs{tmplArgs: newTmplArgs(op), Constraint: "op.TensorType"}
+	if len(args.RequiredAttrs) > 0 {
+		return nil, fmt.Errorf("required attribute %q is not supported", args.RequiredAttrs[0].Name)
+	}
+	for _, attr := range op.Attr {
+		if attr.Name != typeAttr || attr.AllowedValues == nil {
+			continue
+		}
+		var types []string
+		for _, dt := range attr.AllowedValues.GetList().GetType() {
+			if t, ok := goTypes[dt]; ok {
+				types = append(types, t)
+			}
+		}
+		if len(types) == 0 {
+			return nil, fmt.Errorf("none of the allowed types of %q have a Go equivalent", typeAttr)
+		}
+		args.Constraint = strings.Join(types, " | ")
+	}
+	return args, nil
+}
+
+// goTypes maps the DataTypes of tensors to the Go types used to represent
+// their elements, matching tf.NewTensor.
+var goTypes = map[pb.DataType]string{
+	pb.DataType_DT_FLOAT:      "float32",
+	pb.DataType_DT_DOUBLE:     "float64",
+	pb.DataType_DT_INT32:      "int32",
+	pb.DataType_DT_UINT8:      "uint8",
+	pb.DataType_DT_INT16:      "int16",
+	pb.DataType_DT_INT8:       "int8",
+	pb.DataType_DT_STRING:     "string",
+	pb.DataType_DT_COMPLEX64:  "complex64",
+	pb.DataType_DT_INT64:      "int64",
+	pb.DataType_DT_BOOL:       "bool",
+	pb.DataType_DT_UINT16:     "uint16",
+	pb.DataType_DT_COMPLEX128: "complex128",
+}
+
+var (
+	tmplTypedHeader = template.Must(template.New("typedHeader").Parse(`// DO NOT EDIT
+// This file was machine generated by {{.}}
+//
+// WARNING: This generation of wrapper function for TensorFlow ops is in an
+// experimental state. The generated API can change without notice.
+
+//go:build go1.18
+// +build go1.18
+
+package typed
+
+import "github.com/tensorflow/tensorflow/tensorflow/go/op"
+`))
+
+	tmplTypedOp = template.Must(template.New("typedOp").Funcs(templateFuncs).Parse(`
+// {{.Op.Name}} is like op.{{.Op.Name}}, for tensors of type T.
+func {{.Op.Name}}[T {{.Constraint}}](scope *op.Scope
+{{- range .Op.InputArg}}, {{Identifier .Name}} op.Typed[T]{{end -}}
+{{if .OptionalAttrs}}, optional ...op.{{.Op.Name}}Attr{{end -}}
+) ({{range $i, $a := .Op.OutputArg}}{{if $i}}, {{end}}{{Identifier $a.Name}} op.Typed[T]{{end}}) {
+	{{range $i, $a := .Op.OutputArg}}{{if $i}}, {{end}}{{Identifier $a.Name}}.Output{{end}} = op.{{.Op.Name}}(scope
+	{{- range .Op.InputArg}}, {{Identifier .Name}}.Output{{end -}}
+	{{if .OptionalAttrs}}, optional...{{end -}}
+	)
+	return
+}
+`))
+)
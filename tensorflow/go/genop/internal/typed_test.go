@@ -0,0 +1,107 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"bytes"
+	"go/format"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+)
+
+const typedTestOps = `
+op {
+  name: "MatMul"
+  input_arg { name: "a" type_attr: "T" }
+  input_arg { name: "b" type_attr: "T" }
+  output_arg { name: "product" type_attr: "T" }
+  attr { name: "transpose_a" type: "bool" default_value { b: false } }
+  attr {
+    name: "T"
+    type: "type"
+    allowed_values { list { type: DT_HALF type: DT_FLOAT type: DT_INT32 } }
+  }
+  summary: "Multiply the matrix \"a\" by the matrix \"b\"."
+}
+op {
+  name: "Identity"
+  input_arg { name: "input" type_attr: "T" }
+  output_arg { name: "output" type_attr: "T" }
+  attr { name: "T" type: "type" }
+  summary: "Return a tensor with the same shape and contents as the input tensor or value."
+}
+op {
+  name: "Cast"
+  input_arg { name: "x" type_attr: "SrcT" }
+  output_arg { name: "y" type_attr: "DstT" }
+  attr { name: "SrcT" type: "type" }
+  attr { name: "DstT" type: "type" }
+  summary: "Cast x of type SrcT to y of DstT."
+}
+op {
+  name: "HalfOnly"
+  input_arg { name: "x" type_attr: "T" }
+  output_arg { name: "y" type_attr: "T" }
+  attr {
+    name: "T"
+    type: "type"
+    allowed_values { list { type: DT_HALF } }
+  }
+  summary: "Not supported."
+}
+`
+
+func TestGenerateTypedFunctions(t *testing.T) {
+	var ops pb.OpList
+	if err := proto.UnmarshalText(typedTestOps, &ops); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := generateTypedFunctionsForOps(&buf, &ops, []string{"MatMul", "Identity"}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := format.Source(buf.Bytes())
+	if err != nil {
+		t.Fatalf("Unable to format: %v\n%s", err, buf.Bytes())
+	}
+	const wantFuncs = `
+// MatMul is like op.MatMul, for tensors of type T.
+func MatMul[T float32 | int32](scope *op.Scope, a op.Typed[T], b op.Typed[T], optional ...op.MatMulAttr) (product op.Typed[T]) {
+	product.Output = op.MatMul(scope, a.Output, b.Output, optional...)
+	return
+}
+
+// Identity is like op.Identity, for tensors of type T.
+func Identity[T op.TensorType](scope *op.Scope, input op.Typed[T]) (output op.Typed[T]) {
+	output.Output = op.Identity(scope, input.Output)
+	return
+}
+`
+	if !strings.HasSuffix(string(got), wantFuncs) {
+		t.Errorf("Got:\n%s\nWant it to end with:\n%s", got, wantFuncs)
+	}
+	if !strings.Contains(string(got), "//go:build go1.18\n") {
+		t.Errorf("Generated code is missing the go1.18 build constraint:\n%s", got)
+	}
+
+	for _, name := range []string{"Cast", "HalfOnly", "NoSuchOp"} {
+		if err := generateTypedFunctionsForOps(&buf, &ops, []string{name}); err == nil {
+			t.Errorf("Generated a typed function for %s", name)
+		}
+	}
+}
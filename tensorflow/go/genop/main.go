@@ -15,6 +15,11 @@
 //go:generate sh generate.sh
 
 // Command genop generates a Go source file with functions for TensorFlow ops.
+//
+// With -decompile, it instead generates a function reconstructing the graph
+// of a GraphDef with those functions, to migrate frozen graphs to Go code:
+//
+//	genop -decompile frozen.pb -outfile model/graph.go -weights_outfile model/weights.safetensors
 package main
 
 import (
@@ -25,31 +30,144 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/tensorflow/tensorflow/tensorflow/go/genop/internal"
+	"github.com/tensorflow/tensorflow/tensorflow/go/safetensors"
 )
 
 func main() {
 	var (
 		filename = flag.String("outfile", "", "File to write generated source code to.")
+		outdir   = flag.String("outdir", "", "Directory to write generated source code to, with one file per operation. Files whose contents would not change are not rewritten. Cannot be used with -typed")
 		header   = flag.String("header", "", "Path to a file whose contents will be copied into the generated file. Can be empty")
+		typed    = flag.String("typed", "", "Comma-separated list of operations for which to generate generic functions operating on op.Typed values, instead of generating functions for all operations. Can be empty")
+		tmplDir  = flag.String("template_dir", "", "Directory containing templates replacing those used to generate source code. See internal.LoadTemplates for the expected files. Can be empty")
+		trace    = flag.Bool("trace", false, "Generate functions reporting their execution to the op.BuildTracer of the scope, to profile graph construction.")
+		purity   = flag.String("purity_report", "", "File to write a report of the stateful operations to, instead of generating source code. Can be empty")
+		docs     = flag.String("docs_outfile", "", "File to write the documentation of the operations to, for op.Doc, instead of generating functions. The file is only compiled with the "+internal.DocsBuildTag+" build tag. Can be empty")
+		grads    = flag.String("gradients_outfile", "", "File to write the table of the operations with a registered gradient to, for op.HasGradient, instead of generating functions. Requires -tensorflow_src. Can be empty")
+		tfSrc    = flag.String("tensorflow_src", "", "Root of the TensorFlow sources, in which -gradients_outfile finds the registered gradients")
+		pkgdir   = flag.String("pkgdir", "", "Directory to write one package per category of operations to, instead of generating source code for the op package. Requires -categories")
+		catfile  = flag.String("categories", "", "File listing the categories of operations for -pkgdir, one per line of the form \"name: pattern...\". See categories.txt")
+		opPkg    = flag.String("op_package", "github.com/tensorflow/tensorflow/tensorflow/go/op", "Import path of the op package, for -pkgdir")
+		coreOps  = flag.String("core_ops", "", "Comma-separated list of operations for which to generate functions in -outfile, instead of all operations, leaving the others to the packages generated with -pkgdir. Can be empty")
+		graph    = flag.String("decompile", "", "Serialized GraphDef to write a Go function reconstructing with the op package to -outfile, instead of generating functions for operations. Can be empty")
+		pkg      = flag.String("package", "", "Name of the package of the function generated by -decompile. Defaults to the name of the directory of -outfile")
+		funcName = flag.String("func", "BuildGraph", "Name of the function generated by -decompile")
+		inline   = flag.Int("max_inline_elements", 64, "Largest number of elements of the constants inlined by -decompile. Larger constants are written to -weights_outfile")
+		weights  = flag.String("weights_outfile", "", "File to write the constants externalized by -decompile to, in the safetensors format. Required if any constant is externalized")
 		buf      bytes.Buffer
 	)
 	flag.Parse()
-	if *filename == "" {
-		log.Fatal("-outfile must be set")
+	internal.SetTracing(*trace)
+	if *tmplDir != "" {
+		if err := internal.LoadTemplates(*tmplDir); err != nil {
+			log.Fatal(err)
+		}
 	}
-	if *header != "" {
-		hdr, err := ioutil.ReadFile(*header)
+	if *purity != "" {
+		if err := internal.WritePurityReport(&buf); err != nil {
+			log.Fatal(err)
+		}
+		if err := ioutil.WriteFile(*purity, buf.Bytes(), 0644); err != nil {
+			log.Fatalf("Failed to write to %q: %v", *purity, err)
+		}
+		return
+	}
+	if *docs != "" {
+		if err := internal.GenerateDocsForRegisteredOps(&buf); err != nil {
+			log.Fatal(err)
+		}
+		formatted, err := format.Source(buf.Bytes())
+		if err != nil {
+			log.Fatalf("Failed to generate valid source? 'go fmt' failed: %v", err)
+		}
+		if err := ioutil.WriteFile(*docs, formatted, 0644); err != nil {
+			log.Fatalf("Failed to write to %q: %v", *docs, err)
+		}
+		return
+	}
+	if *grads != "" {
+		if *tfSrc == "" {
+			log.Fatal("-gradients_outfile requires -tensorflow_src")
+		}
+		if err := internal.GenerateGradientTable(&buf, *tfSrc); err != nil {
+			log.Fatal(err)
+		}
+		formatted, err := format.Source(buf.Bytes())
 		if err != nil {
+			log.Fatalf("Failed to generate valid source? 'go fmt' failed: %v", err)
+		}
+		if err := ioutil.WriteFile(*grads, formatted, 0644); err != nil {
+			log.Fatalf("Failed to write to %q: %v", *grads, err)
+		}
+		return
+	}
+	if *graph != "" {
+		decompile(*graph, *filename, *pkg, *funcName, *inline, *weights)
+		return
+	}
+	var hdr []byte
+	if *header != "" {
+		var err error
+		if hdr, err = ioutil.ReadFile(*header); err != nil {
 			log.Fatalf("Unable to read %s: %v", *header, err)
 		}
+	}
+	if *pkgdir != "" {
+		if *catfile == "" {
+			log.Fatal("-pkgdir requires -categories")
+		}
+		f, err := os.Open(*catfile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		categories, err := internal.ParseCategories(f)
+		f.Close()
+		if err != nil {
+			log.Fatalf("%s: %v", *catfile, err)
+		}
+		counts, err := internal.GeneratePackagesForRegisteredOps(*pkgdir, *opPkg, categories, hdr)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, c := range categories {
+			if counts[c.Name] > 0 {
+				log.Printf("%s: %d functions", c.Name, counts[c.Name])
+			}
+		}
+		return
+	}
+	if *outdir != "" {
+		if *typed != "" {
+			log.Fatal("-outdir cannot be used with -typed")
+		}
+		stats, err := internal.GenerateFilesForRegisteredOps(*outdir, hdr)
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("%d files generated, %d unchanged, %d removed", stats.Generated, stats.Unchanged, stats.Removed)
+		return
+	}
+	if *filename == "" {
+		log.Fatal("-outfile or -outdir must be set")
+	}
+	if len(hdr) > 0 {
 		buf.Write(hdr)
 		buf.WriteString("\n\n")
 	}
 	os.MkdirAll(filepath.Dir(*filename), 0755)
 
-	if err := internal.GenerateFunctionsForRegisteredOps(&buf); err != nil {
+	if *typed != "" {
+		if err := internal.GenerateTypedFunctionsForRegisteredOps(&buf, strings.Split(*typed, ",")); err != nil {
+			log.Fatal(err)
+		}
+	} else if *coreOps != "" {
+		if err := internal.GenerateCoreFunctionsForRegisteredOps(&buf, strings.Split(*coreOps, ",")); err != nil {
+			log.Fatal(err)
+		}
+	} else if err := internal.GenerateFunctionsForRegisteredOps(&buf); err != nil {
 		log.Fatal(err)
 	}
 	formatted, err := format.Source(buf.Bytes())
@@ -60,3 +178,52 @@ func main() {
 		log.Fatalf("Failed to write to %q: %v", *filename, err)
 	}
 }
+
+// decompile writes to filename the source of a function reconstructing the
+// GraphDef in graph, and the externalized constants to weightsFile.
+func decompile(graph, filename, pkg, funcName string, maxInline int, weightsFile string) {
+	if filename == "" {
+		log.Fatal("-decompile requires -outfile")
+	}
+	if pkg == "" {
+		abs, err := filepath.Abs(filename)
+		if err != nil {
+			log.Fatal(err)
+		}
+		pkg = filepath.Base(filepath.Dir(abs))
+	}
+	def, err := ioutil.ReadFile(graph)
+	if err != nil {
+		log.Fatalf("Unable to read %s: %v", graph, err)
+	}
+	var buf bytes.Buffer
+	weights, err := internal.DecompileGraphDef(&buf, def, internal.DecompileOptions{
+		Package:           pkg,
+		Func:              funcName,
+		Source:            filepath.Base(graph),
+		MaxInlineElements: maxInline,
+	})
+	if err != nil {
+		log.Fatalf("%s: %v", graph, err)
+	}
+	if len(weights) > 0 {
+		if weightsFile == "" {
+			log.Fatalf("%d constants are too large to be inlined: -weights_outfile must be set", len(weights))
+		}
+		var wbuf bytes.Buffer
+		if err := safetensors.Write(&wbuf, weights, nil); err != nil {
+			log.Fatal(err)
+		}
+		if err := ioutil.WriteFile(weightsFile, wbuf.Bytes(), 0644); err != nil {
+			log.Fatalf("Failed to write to %q: %v", weightsFile, err)
+		}
+	}
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("Failed to generate valid source? 'go fmt' failed: %v", err)
+	}
+	os.MkdirAll(filepath.Dir(filename), 0755)
+	if err := ioutil.WriteFile(filename, formatted, 0644); err != nil {
+		log.Fatalf("Failed to write to %q: %v", filename, err)
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gfile accesses files through the file systems of the TensorFlow
+// runtime, like tf.gfile in Python. Paths are interpreted as by the
+// runtime when it loads SavedModels or reads checkpoints and TFRecords:
+// besides local paths, the URIs of the file systems built into the runtime
+// or registered by plugin libraries, such as gs://, s3:// or hdfs://, can
+// be used.
+//
+// Files are read and written whole by the ReadFile, WriteFile and
+// MatchingFiles operations of the runtime, which exposes no other access to
+// its file systems: there is no reading part of a file, and Open and Stat
+// read files whole, which is slow for large files of remote file systems.
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package gfile
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"runtime"
+	"sort"
+	"strings"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+// LoadFileSystem loads a plugin library registering file systems into the
+// runtime, such as the S3 or HDFS file systems built as separate libraries.
+func LoadFileSystem(filename string) error {
+	_, err := tf.LoadLibrary(filename)
+	return err
+}
+
+// FS accesses the file systems of the runtime. It is safe for concurrent use
+// by multiple goroutines.
+type FS struct {
+	session  *tf.Session
+	path     tf.Output // Placeholder for the path or pattern.
+	contents tf.Output // Placeholder for the contents to write.
+	read     tf.Output
+	size     tf.Output
+	matches  tf.Output
+	write    *tf.Operation
+}
+
+// New returns an FS, which should be closed once no longer needed.
+func New() (*FS, error) {
+	s := op.NewScope()
+	fs := &FS{
+		path:     op.Placeholder(s.SubScope("path"), tf.String, op.PlaceholderShape(tf.ScalarShape())),
+		contents: op.Placeholder(s.SubScope("contents"), tf.String, op.PlaceholderShape(tf.ScalarShape())),
+	}
+	fs.read = op.ReadFile(s, fs.path)
+	fs.size = op.Size(s, op.DecodeRaw(s, fs.read, tf.Uint8), op.SizeOutType(tf.Int64))
+	fs.matches = op.MatchingFiles(s, fs.path)
+	fs.write = op.WriteFile(s, fs.path, fs.contents)
+	graph, err := s.Finalize()
+	if err != nil {
+		return nil, err
+	}
+	if fs.session, err = tf.NewSession(graph, nil); err != nil {
+		return nil, err
+	}
+	return fs, nil
+}
+
+// Close releases the resources of fs.
+func (fs *FS) Close() error {
+	return fs.session.Close()
+}
+
+// ReadFile returns the contents of the file path.
+func (fs *FS) ReadFile(path string) ([]byte, error) {
+	out, err := fs.run("read", path, nil, fs.read)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(out.Value().(string)), nil
+}
+
+// WriteFile writes data to the file path, replacing it if it exists.
+func (fs *FS) WriteFile(path string, data []byte) error {
+	contents, err := tf.NewTensor(string(data))
+	if err != nil {
+		return err
+	}
+	_, err = fs.run("write", path, contents, tf.Output{})
+	return err
+}
+
+// Glob returns the sorted paths of the files matching pattern, in which
+// wildcards are only supported in the last element of the path.
+func (fs *FS) Glob(pattern string) ([]string, error) {
+	out, err := fs.run("glob", pattern, nil, fs.matches)
+	if err != nil {
+		return nil, err
+	}
+	matches := out.Value().([]string)
+	sort.Strings(matches)
+	return matches, nil
+}
+
+// Exists reports whether the file path exists.
+func (fs *FS) Exists(path string) (bool, error) {
+	matches, err := fs.Glob(escapeGlob(path))
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return len(matches) > 0, err
+}
+
+// FileInfo describes a file, as returned by Stat.
+type FileInfo struct {
+	// Size is the length of the file in bytes, or 0 for a directory.
+	Size  int64
+	IsDir bool
+}
+
+// Stat returns a description of the file path. Since the runtime has no
+// operation returning the size of a file, the file is read whole by the
+// runtime, but not copied to Go. A directory is recognized by its children,
+// or, when empty, by the error reading it.
+func (fs *FS) Stat(path string) (*FileInfo, error) {
+	escaped := escapeGlob(path)
+	matches, err := fs.Glob(escaped)
+	if err == nil && len(matches) == 0 {
+		err = &os.PathError{Op: "stat", Path: path, Err: os.ErrNotExist}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if children, err := fs.Glob(escaped + "/*"); err == nil && len(children) > 0 {
+		return &FileInfo{IsDir: true}, nil
+	}
+	out, err := fs.run("stat", path, nil, fs.size)
+	if errors.Is(err, &tf.StatusError{Code: tf.FailedPrecondition}) {
+		// Reading a directory fails as for EISDIR.
+		return &FileInfo{IsDir: true}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &FileInfo{Size: out.Value().(int64)}, nil
+}
+
+// escapeGlob returns the pattern matching path only, escaping its
+// wildcards.
+func escapeGlob(path string) string {
+	if runtime.GOOS == "windows" {
+		// Windows paths cannot contain * and ?, and the runtime matches
+		// them with PathMatchSpec, which has no escapes.
+		return path
+	}
+	var b strings.Builder
+	for _, c := range path {
+		if strings.ContainsRune(`*?[\`, c) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+// Open returns a reader of the contents of the file path, which is read
+// whole into memory: for large files, prefer reading them with the
+// operations of the runtime in a graph, such as TFRecordReader.
+func (fs *FS) Open(path string) (*bytes.Reader, error) {
+	b, err := fs.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(b), nil
+}
+
+// run writes contents to path if not nil, and otherwise fetches fetch for
+// path.
+// NotFound errors are reported as os.ErrNotExist.
+func (fs *FS) run(opName, path string, contents *tf.Tensor, fetch tf.Output) (*tf.Tensor, error) {
+	p, err := tf.NewTensor(path)
+	if err != nil {
+		return nil, err
+	}
+	feeds := map[tf.Output]*tf.Tensor{fs.path: p}
+	var (
+		fetches []tf.Output
+		targets []*tf.Operation
+	)
+	if contents != nil {
+		feeds[fs.contents] = contents
+		targets = []*tf.Operation{fs.write}
+	} else {
+		fetches = []tf.Output{fetch}
+	}
+	out, err := fs.session.Run(feeds, fetches, targets)
+	if err != nil {
+		if errors.Is(err, &tf.StatusError{Code: tf.NotFound}) {
+			err = os.ErrNotExist
+		}
+		return nil, &os.PathError{Op: opName, Path: path, Err: err}
+	}
+	if len(out) == 0 {
+		return nil, nil
+	}
+	return out[0], nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gfile
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+func TestFS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestFS")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fs, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fs.Close()
+
+	a, b := filepath.Join(dir, "a.txt"), filepath.Join(dir, "b.txt")
+	data := []byte("hello\x00world")
+	for _, path := range []string{b, a} {
+		if err := fs.WriteFile(path, data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got, err := fs.ReadFile(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(data) {
+		t.Errorf("Got %q, want %q", got, data)
+	}
+	if onDisk, err := ioutil.ReadFile(a); err != nil || string(onDisk) != string(data) {
+		t.Errorf("Got %q (%v) on disk, want %q", onDisk, err, data)
+	}
+	matches, err := fs.Glob(filepath.Join(dir, "*.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{a, b}; !reflect.DeepEqual(matches, want) {
+		t.Errorf("Got matches %v, want %v", matches, want)
+	}
+	for path, want := range map[string]bool{a: true, filepath.Join(dir, "c.txt"): false} {
+		if got, err := fs.Exists(path); err != nil || got != want {
+			t.Errorf("Exists(%q): got %v, %v, want %v", path, got, err, want)
+		}
+	}
+	if _, err := fs.ReadFile(filepath.Join(dir, "c.txt")); !os.IsNotExist(err) {
+		t.Errorf("Got error %v reading a missing file, want a not exist error", err)
+	}
+	// Exists and Stat do not interpret wildcards.
+	odd := filepath.Join(dir, "[a].txt")
+	if err := fs.WriteFile(odd, data); err != nil {
+		t.Fatal(err)
+	}
+	for path, want := range map[string]bool{odd: true, filepath.Join(dir, "?.txt"): false} {
+		if got, err := fs.Exists(path); err != nil || got != want {
+			t.Errorf("Exists(%q): got %v, %v, want %v", path, got, err, want)
+		}
+	}
+	for path, want := range map[string]FileInfo{odd: {Size: int64(len(data))}, dir: {IsDir: true}} {
+		if got, err := fs.Stat(path); err != nil || *got != want {
+			t.Errorf("Stat(%q): got %+v, %v, want %+v", path, got, err, want)
+		}
+	}
+	if _, err := fs.Stat(filepath.Join(dir, "*.txt")); !os.IsNotExist(err) {
+		t.Errorf("Got error %v for Stat of a missing file, want a not exist error", err)
+	}
+}
+
+func TestEscapeGlob(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Paths are not escaped on Windows")
+	}
+	if got, want := escapeGlob(`/a/[b]*?\c`), `/a/\[b]\*\?\\c`; got != want {
+		t.Errorf("Got %q, want %q", got, want)
+	}
+}
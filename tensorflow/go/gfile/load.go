@@ -0,0 +1,318 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gfile
+
+import (
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+// RetryPolicy configures the retries of failed reads.
+type RetryPolicy struct {
+	// MaxAttempts is the number of attempts of each read, including the
+	// first one. Defaults to 5.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry, multiplied by
+	// Multiplier before each other retry up to MaxBackoff. They default to
+	// 100ms, 10s and 2.
+	InitialBackoff, MaxBackoff time.Duration
+	Multiplier                 float64
+	// Retryable reports whether a failed read is retried. Defaults to
+	// IsTransient.
+	Retryable func(error) bool
+}
+
+// IsTransient reports whether err is a *tf.StatusError with a code that
+// usually reports a transient failure of a remote file system:
+// Unavailable, DeadlineExceeded or Aborted.
+func IsTransient(err error) bool {
+	var serr *tf.StatusError
+	if !errors.As(err, &serr) {
+		return false
+	}
+	switch serr.Code {
+	case tf.Unavailable, tf.DeadlineExceeded, tf.Aborted:
+		return true
+	}
+	return false
+}
+
+// sleep is replaced by tests.
+var sleep = time.Sleep
+
+// do calls f until it succeeds, fails with an error that is not retryable,
+// or was attempted p.MaxAttempts times.
+func (p RetryPolicy) do(f func() error) error {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = 5
+	}
+	if p.InitialBackoff <= 0 {
+		p.InitialBackoff = 100 * time.Millisecond
+	}
+	if p.MaxBackoff <= 0 {
+		p.MaxBackoff = 10 * time.Second
+	}
+	if p.Multiplier < 1 {
+		p.Multiplier = 2
+	}
+	if p.Retryable == nil {
+		p.Retryable = IsTransient
+	}
+	backoff := p.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		err := f()
+		if err == nil || attempt >= p.MaxAttempts || !p.Retryable(err) {
+			return err
+		}
+		sleep(backoff)
+		if backoff = time.Duration(float64(backoff) * p.Multiplier); backoff > p.MaxBackoff {
+			backoff = p.MaxBackoff
+		}
+	}
+}
+
+// LoadProgress reports the progress of StageSavedModel.
+type LoadProgress struct {
+	// File is the path of the file just copied, or skipped since it was
+	// copied by a previous attempt.
+	File string
+	// Files is the number of files copied so far, out of TotalFiles.
+	Files, TotalFiles int
+	// Bytes is the number of bytes copied so far.
+	Bytes int64
+}
+
+// LoadOptions configures StageSavedModel and LoadSavedModel.
+type LoadOptions struct {
+	Retry RetryPolicy
+	// Progress, if not nil, is called after each file is copied.
+	Progress func(LoadProgress)
+}
+
+// savedModelDirs are the directories of a SavedModel, relative to its
+// export directory, besides the saved_model.pb or saved_model.pbtxt file.
+var savedModelDirs = []string{"variables", "assets", "assets.extra"}
+
+// stagingManifest is the file of the local directory of StageSavedModel
+// listing the export directory and the size and CRC-32C of each file copied
+// from it.
+const stagingManifest = ".staged"
+
+// StageSavedModel copies the SavedModel in exportDir, typically in a remote
+// file system, to localDir, retrying the reads that fail according to
+// opts.Retry.
+//
+// Each file is written to localDir only once it is read whole, and listed
+// in a manifest in localDir, so that an interrupted copy resumes from the
+// files not copied yet when StageSavedModel is called again. The files
+// already copied are only skipped if they are unchanged in localDir and
+// were copied from exportDir, which is not expected to change, as usual for
+// versioned export directories.
+func (fs *FS) StageSavedModel(exportDir, localDir string, opts *LoadOptions) error {
+	var o LoadOptions
+	if opts != nil {
+		o = *opts
+	}
+	exportDir = strings.TrimSuffix(exportDir, "/")
+	files, err := fs.glob(escapeGlob(exportDir)+"/saved_model.pb*", o.Retry)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no SavedModel found in %s", exportDir)
+	}
+	for _, dir := range savedModelDirs {
+		matches, err := fs.walk(exportDir+"/"+dir, o.Retry)
+		if err != nil {
+			return err
+		}
+		files = append(files, matches...)
+	}
+	staged, err := readManifest(localDir, exportDir)
+	if err != nil {
+		return err
+	}
+	progress := LoadProgress{TotalFiles: len(files)}
+	for _, file := range files {
+		rel := strings.TrimPrefix(file, exportDir+"/")
+		if !staged[rel].matches(filepath.Join(localDir, filepath.FromSlash(rel))) {
+			var data []byte
+			err := o.Retry.do(func() (err error) {
+				data, err = fs.ReadFile(file)
+				return err
+			})
+			if errors.Is(err, &tf.StatusError{Code: tf.FailedPrecondition}) {
+				// An empty directory, which has nothing to copy.
+				progress.TotalFiles--
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if err := stageFile(localDir, rel, data); err != nil {
+				return err
+			}
+			progress.Bytes += int64(len(data))
+		}
+		progress.File = file
+		progress.Files++
+		if o.Progress != nil {
+			o.Progress(progress)
+		}
+	}
+	return nil
+}
+
+// glob returns the files matching pattern, or none if its directory does
+// not exist, retrying the failed attempts according to retry.
+func (fs *FS) glob(pattern string, retry RetryPolicy) ([]string, error) {
+	var matches []string
+	err := retry.do(func() (err error) {
+		matches, err = fs.Glob(pattern)
+		if os.IsNotExist(err) {
+			err = nil
+		}
+		return err
+	})
+	return matches, err
+}
+
+// walk returns the files in dir and all its subdirectories. The runtime
+// cannot tell a file from a directory, which is recognized by its children:
+// an empty directory is returned as a file.
+func (fs *FS) walk(dir string, retry RetryPolicy) ([]string, error) {
+	var all []string
+	dirs := make(map[string]bool)
+	for pattern := escapeGlob(dir) + "/*"; ; pattern += "/*" {
+		matches, err := fs.glob(pattern, retry)
+		if err != nil {
+			return nil, err
+		}
+		if len(matches) == 0 {
+			break
+		}
+		for _, m := range matches {
+			dirs[m[:strings.LastIndex(m, "/")]] = true
+		}
+		all = append(all, matches...)
+	}
+	var files []string
+	for _, f := range all {
+		if !dirs[f] {
+			files = append(files, f)
+		}
+	}
+	return files, nil
+}
+
+// stagedFile is the size and CRC-32C of a file copied by StageSavedModel.
+type stagedFile struct {
+	size int64
+	crc  uint32
+}
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// matches reports whether the file path is the one described by f.
+func (f *stagedFile) matches(path string) bool {
+	if f == nil {
+		return false
+	}
+	data, err := ioutil.ReadFile(path)
+	return err == nil && int64(len(data)) == f.size && crc32.Checksum(data, crcTable) == f.crc
+}
+
+// readManifest returns the files listed in the manifest of localDir, if
+// they were copied from exportDir, and starts a new manifest otherwise.
+func readManifest(localDir, exportDir string) (map[string]*stagedFile, error) {
+	path := filepath.Join(localDir, stagingManifest)
+	staged := make(map[string]*stagedFile)
+	b, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	lines := strings.Split(string(b), "\n")
+	if lines[0] == exportDir {
+		for _, line := range lines[1:] {
+			var (
+				f   stagedFile
+				rel string
+			)
+			if _, err := fmt.Sscanf(line, "%d %x %q", &f.size, &f.crc, &rel); err == nil {
+				staged[rel] = &f
+			}
+		}
+		return staged, nil
+	}
+	return staged, writeLocal(path, []byte(exportDir+"\n"))
+}
+
+// stageFile writes data to the file rel of localDir and adds it to the
+// manifest.
+func stageFile(localDir, rel string, data []byte) error {
+	if err := writeLocal(filepath.Join(localDir, filepath.FromSlash(rel)), data); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(filepath.Join(localDir, stagingManifest), os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(f, "%d %08x %q\n", len(data), crc32.Checksum(data, crcTable), rel)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// LoadSavedModel loads the SavedModel in exportDir, typically in a remote
+// file system, with tf.LoadSavedModel after copying it to localDir with
+// StageSavedModel.
+func (fs *FS) LoadSavedModel(exportDir, localDir string, tags []string, options *tf.SessionOptions, opts *LoadOptions) (*tf.SavedModel, error) {
+	if err := fs.StageSavedModel(exportDir, localDir, opts); err != nil {
+		return nil, err
+	}
+	return tf.LoadSavedModel(localDir, tags, options)
+}
+
+// writeLocal writes data to a temporary file renamed to path, creating the
+// directory of path if needed.
+func writeLocal(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(filepath.Dir(path), ".tmp")
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), path)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return err
+}
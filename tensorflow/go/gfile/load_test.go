@@ -0,0 +1,148 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gfile
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	defer func(s func(time.Duration)) { sleep = s }(sleep)
+	var slept []time.Duration
+	sleep = func(d time.Duration) { slept = append(slept, d) }
+
+	unavailable := &tf.StatusError{Code: tf.Unavailable, Message: "connection reset"}
+	for _, test := range []struct {
+		failures int
+		err      error
+		attempts int
+		backoffs []time.Duration
+		wantErr  bool
+	}{
+		{failures: 0, err: unavailable, attempts: 1},
+		{failures: 3, err: unavailable, attempts: 4, backoffs: []time.Duration{100, 200, 300}},
+		{failures: 9, err: unavailable, attempts: 5, backoffs: []time.Duration{100, 200, 300, 300}, wantErr: true},
+		{failures: 9, err: errors.New("permanent"), attempts: 1, wantErr: true},
+	} {
+		slept = nil
+		attempts := 0
+		p := RetryPolicy{InitialBackoff: 100, MaxBackoff: 300}
+		err := p.do(func() error {
+			if attempts++; attempts <= test.failures {
+				return test.err
+			}
+			return nil
+		})
+		if (err != nil) != test.wantErr || attempts != test.attempts || !reflect.DeepEqual(slept, test.backoffs) {
+			t.Errorf("%+v: got error %v after %d attempts and backoffs %v", test, err, attempts, slept)
+		}
+	}
+}
+
+func TestLoadSavedModel(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestLoadSavedModel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fs, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fs.Close()
+
+	const exportDir = "../../cc/saved_model/testdata/half_plus_two/00000123"
+	var progress []LoadProgress
+	opts := &LoadOptions{Progress: func(p LoadProgress) { progress = append(progress, p) }}
+	model, err := fs.LoadSavedModel(exportDir, dir, []string{"serve"}, nil, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer model.Close()
+	if model.Graph.Operation("y") == nil {
+		t.Error("\"y\" not found in the graph")
+	}
+	if len(progress) != 4 {
+		t.Fatalf("Got progress %+v, want one report per file", progress)
+	}
+	last := progress[len(progress)-1]
+	if last.Files != 4 || last.TotalFiles != 4 || last.Bytes == 0 {
+		t.Errorf("Got final progress %+v", last)
+	}
+
+	// Staging again skips the files already copied, unless changed.
+	progress = nil
+	if err := ioutil.WriteFile(filepath.Join(dir, "saved_model.pb"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := fs.StageSavedModel(exportDir, dir, opts); err != nil {
+		t.Fatal(err)
+	}
+	if last := progress[len(progress)-1]; last.Files != 4 || last.Bytes == 0 || progress[0].Bytes != last.Bytes {
+		t.Errorf("Got progress %+v when staging again, want only saved_model.pb copied", progress)
+	}
+
+	if err := fs.StageSavedModel(dir+"/missing", dir, nil); err == nil {
+		t.Error("StageSavedModel succeeded without a SavedModel")
+	}
+}
+
+func TestStagingManifest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestStagingManifest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	staged, err := readManifest(dir, "gs://bucket/model/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(staged) != 0 {
+		t.Errorf("Got %v staged in an empty directory", staged)
+	}
+	for _, rel := range []string{"saved_model.pb", "assets/nested dir/vocab.txt"} {
+		if err := stageFile(dir, rel, []byte(rel)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if staged, err = readManifest(dir, "gs://bucket/model/1"); err != nil {
+		t.Fatal(err)
+	}
+	nested := filepath.Join(dir, "assets", "nested dir", "vocab.txt")
+	if !staged["saved_model.pb"].matches(filepath.Join(dir, "saved_model.pb")) || !staged["assets/nested dir/vocab.txt"].matches(nested) {
+		t.Errorf("Staged files %v not matched", staged)
+	}
+	if err := ioutil.WriteFile(nested, []byte("changed"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if staged["assets/nested dir/vocab.txt"].matches(nested) {
+		t.Error("Changed file matched")
+	}
+	// Staging another export directory starts a new manifest.
+	if staged, err = readManifest(dir, "gs://bucket/model/2"); err != nil || len(staged) != 0 {
+		t.Errorf("Got %v, %v for another export directory", staged, err)
+	}
+	if staged, err = readManifest(dir, "gs://bucket/model/1"); err != nil || len(staged) != 0 {
+		t.Errorf("Got %v, %v after staging another export directory", staged, err)
+	}
+}
@@ -18,29 +18,100 @@ package tensorflow
 //
 // #include <stdlib.h>
 // #include <string.h>
+//
+// // c_api.h declares this function as
+// // TF_GraphImportGraphDefOptionsRemapControlDependency, but the library
+// // defines it under this name.
+// extern void TF_ImportGraphDefOptionsRemapControlDependency(
+//     TF_ImportGraphDefOptions* opts, const char* src_name, TF_Operation* dst);
 import "C"
 
 import (
 	"fmt"
 	"io"
 	"runtime"
+	"sync"
+	"sync/atomic"
 	"unsafe"
 )
 
 // Graph represents a computation graph. Graphs may be shared between sessions.
 type Graph struct {
+	// changes counts, atomically, the additions of operations, for the
+	// Sessions of the Graph to tell when their dry-run graphs are stale.
+	// It comes first to be 64-bit aligned on 32-bit platforms.
+	changes uint64
+
 	c *C.TF_Graph
+
+	// refs counts the users of c: the Graph itself until it is closed,
+	// and every Session created with it until the Session is closed.
+	mu     sync.Mutex
+	refs   int
+	closed bool
+
+	variables variableCache
 }
 
 // NewGraph returns a new Graph.
 func NewGraph() *Graph {
-	g := &Graph{C.TF_NewGraph()}
+	g := &Graph{c: C.TF_NewGraph(), refs: 1}
 	runtime.SetFinalizer(g, (*Graph).finalizer)
 	return g
 }
 
 func (g *Graph) finalizer() {
+	// Sessions refer to their Graph, so no Session can be using g.
+	if !g.closed {
+		finalized("Graph")
+	}
+	if g.c != nil {
+		C.TF_DeleteGraph(g.c)
+	}
+}
+
+// Close releases the resources associated with the Graph without waiting for
+// it to be garbage collected. Sessions created with the Graph remain usable
+// and the resources are released once the last of them is closed. Neither the
+// Graph nor its Operations may be used after Close. Close may be called
+// multiple times.
+func (g *Graph) Close() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.closed {
+		return nil
+	}
+	g.closed = true
+	g.unrefLocked()
+	return nil
+}
+
+// ref records a new Session using g, failing if g has been closed.
+func (g *Graph) ref() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.closed {
+		return fmt.Errorf("graph is closed")
+	}
+	g.refs++
+	return nil
+}
+
+// unref records that a Session is no longer using g.
+func (g *Graph) unref() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.unrefLocked()
+}
+
+func (g *Graph) unrefLocked() {
+	g.refs--
+	if g.refs > 0 {
+		return
+	}
+	runtime.SetFinalizer(g, nil)
 	C.TF_DeleteGraph(g.c)
+	g.c = nil
 }
 
 // WriteTo writes out a serialized representation of g to w.
@@ -72,12 +143,64 @@ func (g *Graph) WriteTo(w io.Writer) (int64, error) {
 //
 // Names of imported nodes will be prefixed with prefix.
 func (g *Graph) Import(def []byte, prefix string) error {
+	return g.importGraphDef(def, prefix, nil)
+}
+
+// GraphImportOptions holds parameters for the ImportWithOptions function.
+type GraphImportOptions struct {
+	// Prefix is prepended to the names of the imported nodes.
+	Prefix string
+
+	// inputMapping maps inputs of the imported nodes, "name:index", to
+	// the outputs of g they are replaced with.
+	inputMapping map[string]Output
+}
+
+// AddInputMapping replaces the inputs of the imported nodes reading
+// output srcIndex of the node named src, before any prefix is added, with
+// dst, an output of the graph imported into.
+func (o *GraphImportOptions) AddInputMapping(src string, srcIndex int, dst Output) {
+	if o.inputMapping == nil {
+		o.inputMapping = make(map[string]Output)
+	}
+	o.inputMapping[fmt.Sprintf("%s:%d", src, srcIndex)] = dst
+}
+
+// ImportWithOptions imports the nodes and edges from a serialized
+// representation of another Graph into g, like Import, as configured by
+// options.
+func (g *Graph) ImportWithOptions(def []byte, options GraphImportOptions) error {
+	return g.importGraphDef(def, options.Prefix, options.inputMapping)
+}
+
+// importGraphDef is like Import, with the inputs of the nodes of def that
+// are keys of inputs, "name:index" or "^name" for control inputs, mapped to
+// the values, which are outputs of operations already in g, or the
+// operations only for control inputs.
+func (g *Graph) importGraphDef(def []byte, prefix string, inputs map[string]Output) error {
+	if len(def) == 0 {
+		// An empty GraphDef is a valid, empty graph.
+		return nil
+	}
 	cprefix := C.CString(prefix)
 	defer C.free(unsafe.Pointer(cprefix))
 
 	opts := C.TF_NewImportGraphDefOptions()
 	defer C.TF_DeleteImportGraphDefOptions(opts)
 	C.TF_ImportGraphDefOptionsSetPrefix(opts, cprefix)
+	for in, dst := range inputs {
+		name, index, err := ParseTensorName(in)
+		if err != nil {
+			return err
+		}
+		cname := C.CString(name)
+		defer C.free(unsafe.Pointer(cname))
+		if index < 0 {
+			C.TF_ImportGraphDefOptionsRemapControlDependency(opts, cname, dst.Op.cop())
+			continue
+		}
+		C.TF_ImportGraphDefOptionsAddInputMapping(opts, cname, C.int(index), dst.c())
+	}
 
 	buf := C.TF_NewBuffer()
 	defer C.TF_DeleteBuffer(buf)
@@ -94,6 +217,7 @@ func (g *Graph) Import(def []byte, prefix string) error {
 
 	status := newStatus()
 	C.TF_GraphImportGraphDef(g.c, buf, opts, status.c)
+	atomic.AddUint64(&g.changes, 1)
 	if err := status.Err(); err != nil {
 		return err
 	}
@@ -109,7 +233,27 @@ func (g *Graph) Operation(name string) *Operation {
 	if cop == nil {
 		return nil
 	}
-	return &Operation{cop, g}
+	return &Operation{c: cop, g: g}
+}
+
+// OutputByName returns the Output of g named name, in the form
+// "operation:index" or "operation", as parsed by ParseTensorName.
+func (g *Graph) OutputByName(name string) (Output, error) {
+	opName, index, err := ParseTensorName(name)
+	if err != nil {
+		return Output{}, err
+	}
+	if index < 0 {
+		return Output{}, fmt.Errorf("%q names an operation, not an output", name)
+	}
+	op := g.Operation(opName)
+	if op == nil {
+		return Output{}, fmt.Errorf("operation %q not found in the graph", opName)
+	}
+	if index >= op.NumOutputs() {
+		return Output{}, fmt.Errorf("operation %q has no output %d", opName, index)
+	}
+	return op.Output(index), nil
 }
 
 // OpSpec is the specification of an Operation to be added to a Graph
@@ -138,7 +282,20 @@ type OpSpec struct {
 	// operation.
 	Attrs map[string]interface{}
 
-	// Other possible fields: Device, ColocateWith, ControlInputs.
+	// Device on which the operation should be placed, in the format used
+	// by the TensorFlow runtime (e.g., "/job:worker/task:0/device:GPU:1").
+	// Any device type known to the runtime can be used, including those
+	// registered by plugin libraries (see LoadLibrary), such as TPUs.
+	// If empty, the placement is left to the runtime.
+	Device string
+
+	// ControlInputs are operations that must be executed before this
+	// operation, although it does not use their outputs. For example,
+	// an operation can depend on an Assert operation validating its
+	// inputs.
+	ControlInputs []*Operation
+
+	// Other possible fields: ColocateWith.
 }
 
 // AddOperation adds an operation to g.
@@ -146,12 +303,39 @@ func (g *Graph) AddOperation(args OpSpec) (*Operation, error) {
 	if args.Name == "" {
 		args.Name = args.Type
 	}
+	// Add the operations pending in a GraphBatch first, since their
+	// TF_Operations cannot be missing once the description is created.
+	for _, in := range args.Input {
+		var list OutputList
+		switch in := in.(type) {
+		case Output:
+			list = OutputList{in}
+		case OutputList:
+			list = in
+		}
+		for _, o := range list {
+			if err := o.Op.resolve(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, op := range args.ControlInputs {
+		if err := op.resolve(); err != nil {
+			return nil, err
+		}
+	}
 	cname := C.CString(args.Name)
 	ctype := C.CString(args.Type)
 	cdesc := C.TF_NewOperation(g.c, ctype, cname)
 	C.free(unsafe.Pointer(cname))
 	C.free(unsafe.Pointer(ctype))
 
+	if args.Device != "" {
+		cdevice := C.CString(args.Device)
+		C.TF_SetDevice(cdesc, cdevice)
+		C.free(unsafe.Pointer(cdevice))
+	}
+
 	for _, in := range args.Input {
 		switch in := in.(type) {
 		case Output:
@@ -162,9 +346,12 @@ func (g *Graph) AddOperation(args OpSpec) (*Operation, error) {
 			for i, v := range in {
 				list[i] = v.c()
 			}
-			C.TF_AddInputList(cdesc, &list[0], C.int(size))
+			C.TF_AddInputList(cdesc, ptrOutput(list), C.int(size))
 		}
 	}
+	for _, op := range args.ControlInputs {
+		C.TF_AddControlInput(cdesc, op.cop())
+	}
 	status := newStatus()
 	for name, value := range args.Attrs {
 		if err := setAttr(cdesc, status, name, value); err != nil {
@@ -183,7 +370,12 @@ func (g *Graph) AddOperation(args OpSpec) (*Operation, error) {
 		c: C.TF_FinishOperation(cdesc, status.c),
 		g: g,
 	}
-	return op, status.Err()
+	atomic.AddUint64(&g.changes, 1)
+	if err := status.Err(); err != nil {
+		err.(*StatusError).Op = args.Name
+		return op, err
+	}
+	return op, nil
 }
 
 func setAttr(cdesc *C.TF_OperationDescription, status *status, name string, value interface{}) error {
@@ -196,6 +388,10 @@ func setAttr(cdesc *C.TF_OperationDescription, status *status, name string, valu
 		C.free(unsafe.Pointer(cstr))
 	case []string:
 		size := len(value)
+		if size == 0 {
+			C.TF_SetAttrStringList(cdesc, cAttrName, nil, nil, 0)
+			break
+		}
 		list := make([]unsafe.Pointer, size)
 		lens := make([]C.size_t, size)
 		for i, s := range value {
@@ -210,6 +406,10 @@ func setAttr(cdesc *C.TF_OperationDescription, status *status, name string, valu
 		C.TF_SetAttrInt(cdesc, cAttrName, C.int64_t(value))
 	case []int64:
 		size := len(value)
+		if size == 0 {
+			C.TF_SetAttrIntList(cdesc, cAttrName, nil, 0)
+			break
+		}
 		list := make([]C.int64_t, size)
 		for i, v := range value {
 			list[i] = C.int64_t(v)
@@ -219,6 +419,10 @@ func setAttr(cdesc *C.TF_OperationDescription, status *status, name string, valu
 		C.TF_SetAttrFloat(cdesc, cAttrName, C.float(value))
 	case []float32:
 		size := len(value)
+		if size == 0 {
+			C.TF_SetAttrFloatList(cdesc, cAttrName, nil, 0)
+			break
+		}
 		list := make([]C.float, size)
 		for i, v := range value {
 			list[i] = C.float(v)
@@ -232,6 +436,10 @@ func setAttr(cdesc *C.TF_OperationDescription, status *status, name string, valu
 		C.TF_SetAttrBool(cdesc, cAttrName, v)
 	case []bool:
 		size := len(value)
+		if size == 0 {
+			C.TF_SetAttrBoolList(cdesc, cAttrName, nil, 0)
+			break
+		}
 		list := make([]C.uchar, size)
 		for i, v := range value {
 			if v {
@@ -242,9 +450,18 @@ func setAttr(cdesc *C.TF_OperationDescription, status *status, name string, valu
 	case DataType:
 		C.TF_SetAttrType(cdesc, cAttrName, C.TF_DataType(value))
 	case []DataType:
-		list := (*C.TF_DataType)(&value[0])
+		var list *C.TF_DataType
+		if len(value) > 0 {
+			list = (*C.TF_DataType)(&value[0])
+		}
 		C.TF_SetAttrTypeList(cdesc, cAttrName, list, C.int(len(value)))
 	case *Tensor:
+		if value == nil {
+			return fmt.Errorf("bad value for attribute %q: nil Tensor", name)
+		}
+		if value.c == nil {
+			return fmt.Errorf("bad value for attribute %q: released Tensor", name)
+		}
 		C.TF_SetAttrTensor(cdesc, cAttrName, value.c, status.c)
 		if err := status.Err(); err != nil {
 			return fmt.Errorf("bad value for attribute %q: %v", name, err)
@@ -253,9 +470,15 @@ func setAttr(cdesc *C.TF_OperationDescription, status *status, name string, valu
 		size := len(value)
 		list := make([]*C.TF_Tensor, size)
 		for i, v := range value {
+			if v == nil {
+				return fmt.Errorf("bad value for attribute %q: nil Tensor at index %d", name, i)
+			}
+			if v.c == nil {
+				return fmt.Errorf("bad value for attribute %q: released Tensor at index %d", name, i)
+			}
 			list[i] = v.c
 		}
-		C.TF_SetAttrTensorList(cdesc, cAttrName, &list[0], C.int(size), status.c)
+		C.TF_SetAttrTensorList(cdesc, cAttrName, ptrTensor(list), C.int(size), status.c)
 		if err := status.Err(); err != nil {
 			return fmt.Errorf("bad value for attribute %q: %v", name, err)
 		}
@@ -276,7 +499,24 @@ func setAttr(cdesc *C.TF_OperationDescription, status *status, name string, valu
 				dimsp[i] = &dims[i][0]
 			}
 		}
+		if len(value) == 0 {
+			C.TF_SetAttrShapeList(cdesc, cAttrName, nil, nil, 0)
+			break
+		}
 		C.TF_SetAttrShapeList(cdesc, cAttrName, &dimsp[0], &ndims[0], C.int(len(value)))
+	case NameAttrList, []NameAttrList, AttrValueProto:
+		proto, err := encodeAttrValue(value)
+		if err != nil {
+			return fmt.Errorf("bad value for attribute %q: %v", name, err)
+		}
+		var p unsafe.Pointer
+		if len(proto) > 0 {
+			p = unsafe.Pointer(&proto[0])
+		}
+		C.TF_SetAttrValueProto(cdesc, cAttrName, p, C.size_t(len(proto)), status.c)
+		if err := status.Err(); err != nil {
+			return fmt.Errorf("bad value for attribute %q: %v", name, err)
+		}
 	default:
 		return fmt.Errorf("attribute %q has a type (%T) which is not valid for operation attributes", name, value)
 	}
@@ -0,0 +1,371 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// GraphBatch adds operations to a Graph in batches, to speed up the
+// construction of very large graphs. Graph.AddOperation calls into
+// TensorFlow for each input and attribute of every operation, while
+// GraphBatch encodes the operations as NodeDef protocol buffers in Go and
+// imports them into the graph with a single call when the batch is
+// flushed. The inputs of the operations of a batch may also be operations
+// already in the graph, each of which then leaves a NoOp operation named
+// import_input_N in the graph, as with Graph.ImportFrom.
+//
+// The Operations returned by GraphBatch.AddOperation are pending until then.
+// They can be used as inputs to other operations of the batch and their Name
+// is known, but using them otherwise (e.g., calling Output.DataType, or
+// passing them to Graph.AddOperation or Session.Run) flushes the batch
+// first. Flushing fails if any of the operations of the batch is invalid, in
+// which case none of them is added to the graph: those Operations then
+// return zero values, or the error of the flush where they can (e.g.,
+// OutputListSize, Graph.AddOperation and Session.Run), so Flush should be
+// called explicitly once construction is complete. Err reports the failures
+// of implicit flushes.
+//
+// A GraphBatch, and the Operations pending in it, are not safe for
+// concurrent use by multiple goroutines.
+type GraphBatch struct {
+	g     *Graph
+	ops   map[string]*OpInfo
+	nodes []byte // Encoded GraphDef.node fields.
+	names map[string]bool
+	flush *batchFlush
+	err   error // The error of the first failed flush.
+}
+
+// batchFlush is a set of operations added to the graph together by
+// GraphBatch.Flush.
+type batchFlush struct {
+	batch *GraphBatch
+	done  bool
+	err   error
+}
+
+// pendingOp is the state of an Operation pending in a GraphBatch.
+type pendingOp struct {
+	flush *batchFlush
+	name  string
+	// outputs are the types of the outputs of the operation, or nil if
+	// they cannot be determined before adding it to the graph.
+	outputs []DataType
+}
+
+// NewBatch returns a GraphBatch adding operations to g.
+func (g *Graph) NewBatch() *GraphBatch {
+	b := &GraphBatch{g: g}
+	b.reset()
+	return b
+}
+
+func (b *GraphBatch) reset() {
+	b.nodes = nil
+	b.names = make(map[string]bool)
+	b.flush = &batchFlush{batch: b}
+}
+
+// AddOperation is like Graph.AddOperation, adding the operation to the
+// batch. Errors such as invalid inputs or missing attributes are reported
+// by Flush.
+//
+// Operations that GraphBatch cannot encode, such as those with reference
+// inputs or outputs, are added directly to the graph after flushing the
+// batch.
+func (b *GraphBatch) AddOperation(args OpSpec) (*Operation, error) {
+	if args.Name == "" {
+		args.Name = args.Type
+	}
+	if b.names[args.Name] {
+		return nil, fmt.Errorf("duplicate operation name %q in the batch", args.Name)
+	}
+	info, err := b.opInfo(args.Type)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil || !batchable(info, args) {
+		if err := b.Flush(); err != nil {
+			return nil, err
+		}
+		return b.g.AddOperation(args)
+	}
+	attrs := make(map[string]interface{}, len(args.Attrs))
+	for name, value := range args.Attrs {
+		attrs[name] = value
+	}
+	// Infer the attributes that Graph.AddOperation infers from the types
+	// of the inputs.
+	var inputs []string
+	for i, in := range args.Input {
+		arg := info.Inputs[i]
+		var list OutputList
+		switch in := in.(type) {
+		case Output:
+			list = OutputList{in}
+		case OutputList:
+			list = in
+			if arg.NumberAttr != "" {
+				setDefault(attrs, arg.NumberAttr, int64(len(list)))
+			}
+		}
+		types := make([]DataType, len(list))
+		for j, o := range list {
+			if o.Op == nil {
+				return nil, fmt.Errorf("input %d of operation %q has no Operation", i, args.Name)
+			}
+			if p := o.Op.p; p != nil && p.flush != b.flush {
+				// Pending in another GraphBatch, or in a failed flush.
+				if err := o.Op.resolve(); err != nil {
+					return nil, err
+				}
+			}
+			types[j] = b.outputType(o)
+			inputs = append(inputs, inputName(o))
+		}
+		switch {
+		case arg.TypeListAttr != "":
+			setDefault(attrs, arg.TypeListAttr, types)
+		case arg.TypeAttr != "" && len(types) > 0:
+			setDefault(attrs, arg.TypeAttr, types[0])
+		}
+	}
+	for _, op := range args.ControlInputs {
+		if op == nil {
+			return nil, fmt.Errorf("nil control input of operation %q", args.Name)
+		}
+		if p := op.p; p != nil && p.flush != b.flush {
+			if err := op.resolve(); err != nil {
+				return nil, err
+			}
+		}
+		inputs = append(inputs, "^"+op.Name())
+	}
+
+	node := appendBytesField(nil, 1, []byte(args.Name))
+	node = appendBytesField(node, 2, []byte(args.Type))
+	for _, in := range inputs {
+		node = appendBytesField(node, 3, []byte(in))
+	}
+	if args.Device != "" {
+		node = appendBytesField(node, 4, []byte(args.Device))
+	}
+	names := make([]string, 0, len(attrs))
+	for name := range attrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		v, err := encodeAttrValue(attrs[name])
+		if err != nil {
+			return nil, fmt.Errorf("bad value for attribute %q: %v", name, err)
+		}
+		// attr is a map<string, AttrValue>.
+		entry := appendBytesField(nil, 1, []byte(name))
+		entry = appendBytesField(entry, 2, v)
+		node = appendBytesField(node, 5, entry)
+	}
+	b.nodes = appendBytesField(b.nodes, 1, node)
+	b.names[args.Name] = true
+	return &Operation{g: b.g, p: &pendingOp{
+		flush:   b.flush,
+		name:    args.Name,
+		outputs: outputTypes(info, attrs),
+	}}, nil
+}
+
+// Flush adds the pending operations of the batch to the graph. If any of
+// them is invalid, none of them is added and the error is returned.
+func (b *GraphBatch) Flush() error {
+	if len(b.nodes) == 0 {
+		return nil
+	}
+	f, nodes, names := b.flush, b.nodes, b.names
+	b.reset()
+	versions, err := graphDefVersions()
+	if err == nil {
+		// The inputs that are not operations of the batch are operations
+		// already in the graph: added directly, before the batch, or by
+		// an earlier flush.
+		inGraph := func(op string) bool { return !names[op] }
+		err = b.g.importNodes(nodes, appendBytesField(nil, 4, versions), "", inGraph)
+	}
+	f.done, f.err = true, err
+	if b.err == nil {
+		b.err = err
+	}
+	return err
+}
+
+// Err returns the error of the first flush of the batch that failed,
+// including those flushing the batch implicitly, or nil if none failed.
+func (b *GraphBatch) Err() error {
+	return b.err
+}
+
+// Len returns the number of pending operations in the batch.
+func (b *GraphBatch) Len() int {
+	return len(b.names)
+}
+
+func (b *GraphBatch) opInfo(opType string) (*OpInfo, error) {
+	if b.ops == nil {
+		ops, err := RegisteredOps()
+		if err != nil {
+			return nil, err
+		}
+		b.ops = make(map[string]*OpInfo, len(ops))
+		for i := range ops {
+			b.ops[ops[i].Name] = &ops[i]
+		}
+	}
+	return b.ops[opType], nil
+}
+
+// outputType returns the type of o, flushing the batch if o is pending in
+// it with an unknown type.
+func (b *GraphBatch) outputType(o Output) DataType {
+	if p := o.Op.p; p != nil && o.Index < len(p.outputs) {
+		return p.outputs[o.Index]
+	}
+	return o.DataType()
+}
+
+// batchable reports whether args can be encoded by a GraphBatch: its
+// inputs must match the arguments of the operation and no argument may be a
+// reference.
+func batchable(info *OpInfo, args OpSpec) bool {
+	if len(args.Input) != len(info.Inputs) {
+		return false
+	}
+	for i, arg := range info.Inputs {
+		_, isList := args.Input[i].(OutputList)
+		if arg.IsRef || isList != (arg.NumberAttr != "" || arg.TypeListAttr != "") {
+			return false
+		}
+	}
+	for _, arg := range info.Outputs {
+		if arg.IsRef {
+			return false
+		}
+	}
+	return true
+}
+
+// outputTypes returns the types of the outputs of an operation with the
+// given attributes, or nil if they depend on attributes that are not set
+// (e.g., attributes with a default value).
+func outputTypes(info *OpInfo, attrs map[string]interface{}) []DataType {
+	var types []DataType
+	for _, arg := range info.Outputs {
+		n := 1
+		if arg.NumberAttr != "" {
+			v, ok := attrs[arg.NumberAttr].(int64)
+			if !ok {
+				return nil
+			}
+			n = int(v)
+		}
+		switch {
+		case arg.TypeListAttr != "":
+			list, ok := attrs[arg.TypeListAttr].([]DataType)
+			if !ok {
+				return nil
+			}
+			types = append(types, list...)
+		case arg.TypeAttr != "":
+			dt, ok := attrs[arg.TypeAttr].(DataType)
+			if !ok {
+				return nil
+			}
+			for i := 0; i < n; i++ {
+				types = append(types, dt)
+			}
+		default:
+			for i := 0; i < n; i++ {
+				types = append(types, arg.Type)
+			}
+		}
+	}
+	return types
+}
+
+func setDefault(attrs map[string]interface{}, name string, value interface{}) {
+	if _, ok := attrs[name]; !ok {
+		attrs[name] = value
+	}
+}
+
+// inputName returns the name of o in the inputs of a NodeDef.
+func inputName(o Output) string {
+	if o.Index == 0 {
+		return o.Op.Name()
+	}
+	return o.Op.Name() + ":" + strconv.Itoa(o.Index)
+}
+
+// resolve adds the GraphBatch in which op is pending to the graph, if needed,
+// and sets op.c. op remains pending, with a nil op.c, if the batch could not
+// be added.
+func (op *Operation) resolve() error {
+	if op.p == nil {
+		return nil
+	}
+	f := op.p.flush
+	if !f.done {
+		f.batch.Flush()
+	}
+	if f.err != nil {
+		return fmt.Errorf("operation %q of a GraphBatch could not be added to the graph: %v", op.p.name, f.err)
+	}
+	added := op.g.Operation(op.p.name)
+	if added == nil {
+		return fmt.Errorf("operation %q of a GraphBatch not found in the graph", op.p.name)
+	}
+	op.c, op.p = added.c, nil
+	return nil
+}
+
+var graphDefVersion struct {
+	once sync.Once
+	def  []byte
+	err  error
+}
+
+// graphDefVersions returns the encoded VersionDef of the GraphDefs produced
+// by the TensorFlow runtime, since the graph importing a GraphDef takes the
+// minimum of their versions.
+func graphDefVersions() ([]byte, error) {
+	graphDefVersion.once.Do(func() {
+		g := NewGraph()
+		defer g.Close()
+		var buf bytes.Buffer
+		if _, graphDefVersion.err = g.WriteTo(&buf); graphDefVersion.err != nil {
+			return
+		}
+		graphDefVersion.err = parseMessage(buf.Bytes(), func(field int, v uint64, b []byte) error {
+			if field == 4 { // versions
+				graphDefVersion.def = append([]byte(nil), b...)
+			}
+			return nil
+		})
+	})
+	return graphDefVersion.def, graphDefVersion.err
+}
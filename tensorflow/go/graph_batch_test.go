@@ -0,0 +1,233 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func addBatched(t testing.TB, b *GraphBatch, args OpSpec) Output {
+	op, err := b.AddOperation(args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return op.Output(0)
+}
+
+func TestGraphBatch(t *testing.T) {
+	g := NewGraph()
+	b := g.NewBatch()
+	c, err := NewTensor([]float32{1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := addBatched(t, b, OpSpec{Type: "Placeholder", Name: "x", Attrs: map[string]interface{}{"dtype": Float}})
+	y := addBatched(t, b, OpSpec{Type: "Const", Name: "y", Attrs: map[string]interface{}{"dtype": Float, "value": c}})
+	sum := addBatched(t, b, OpSpec{Type: "Add", Name: "sum", Input: []Input{x, y}})
+	addN := addBatched(t, b, OpSpec{Type: "AddN", Name: "addn", Input: []Input{OutputList{x, y, sum}}})
+	idOp, err := b.AddOperation(OpSpec{Type: "IdentityN", Name: "id", Input: []Input{OutputList{addN, sum}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := b.Len(), 5; got != want {
+		t.Errorf("Got %d pending operations, want %d", got, want)
+	}
+	if got, want := sum.Op.Name(), "sum"; got != want {
+		t.Errorf("Got name %q, want %q", got, want)
+	}
+	if g.Operation("sum") != nil {
+		t.Fatal("Operation added to the graph before the batch is flushed")
+	}
+	if err := b.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if b.Len() != 0 {
+		t.Errorf("Got %d pending operations after Flush", b.Len())
+	}
+	if got, want := idOp.NumOutputs(), 2; got != want {
+		t.Errorf("Got %d outputs, want %d", got, want)
+	}
+
+	s, err := NewSession(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	feed, err := NewTensor([]float32{10, 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := s.Run(map[Output]*Tensor{x: feed}, []Output{idOp.Output(0), idOp.Output(1)}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := out[0].Value(), []float32{22, 44}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Got %v, want %v", got, want)
+	}
+	if got, want := out[1].Value(), []float32{11, 22}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Got %v, want %v", got, want)
+	}
+}
+
+func TestGraphBatchImplicitFlush(t *testing.T) {
+	g := NewGraph()
+	b := g.NewBatch()
+	x := addBatched(t, b, OpSpec{Type: "Placeholder", Name: "x", Attrs: map[string]interface{}{"dtype": Int32}})
+	neg := addBatched(t, b, OpSpec{Type: "Neg", Name: "neg", Input: []Input{x}})
+	// Inspecting a pending operation adds the batch to the graph.
+	if got, want := neg.DataType(), Int32; got != want {
+		t.Errorf("Got type %v, want %v", got, want)
+	}
+	if g.Operation("neg") == nil || b.Len() != 0 {
+		t.Error("Batch not flushed by Output.DataType")
+	}
+	// Operations added with Graph.AddOperation can consume pending ones.
+	y := addBatched(t, b, OpSpec{Type: "Placeholder", Name: "y", Attrs: map[string]interface{}{"dtype": Int32}})
+	if _, err := Neg(g, "negy", y); err != nil {
+		t.Fatal(err)
+	}
+	if g.Operation("y") == nil {
+		t.Error("Batch not flushed by Graph.AddOperation")
+	}
+}
+
+func TestGraphBatchExternalInputs(t *testing.T) {
+	g := NewGraph()
+	x, err := Placeholder(g, "x", Int32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dim, err := NewTensor(int32(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Each flush adds operations consuming operations added to the graph
+	// directly or by the earlier flushes.
+	b := g.NewBatch()
+	neg := addBatched(t, b, OpSpec{Type: "Neg", Name: "neg", Input: []Input{x}})
+	axis := addBatched(t, b, OpSpec{Type: "Const", Name: "axis", Attrs: map[string]interface{}{"dtype": Int32, "value": dim}})
+	if err := b.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	split, err := b.AddOperation(OpSpec{Type: "Split", Name: "split", Input: []Input{axis, neg}, Attrs: map[string]interface{}{"num_split": int64(2)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Getting the size of the list output flushes the batch implicitly.
+	if n, err := split.OutputListSize("output"); err != nil || n != 2 {
+		t.Fatalf("Got %d outputs and error %v, want 2 outputs", n, err)
+	}
+	sum := addBatched(t, b, OpSpec{
+		Type:          "Add",
+		Name:          "sum",
+		Input:         []Input{split.Output(0), split.Output(1)},
+		ControlInputs: []*Operation{x.Op, neg.Op},
+	})
+	if err := b.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(sum.Op.ControlInputs()); got != 2 {
+		t.Errorf("Got %d control inputs, want 2", got)
+	}
+
+	s, err := NewSession(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	feed, err := NewTensor([]int32{1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := s.Run(map[Output]*Tensor{x: feed}, []Output{sum}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := out[0].Value(), []int32{-3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Got %v, want %v", got, want)
+	}
+}
+
+func TestGraphBatchErrors(t *testing.T) {
+	g := NewGraph()
+	b := g.NewBatch()
+	x := addBatched(t, b, OpSpec{Type: "Placeholder", Name: "x", Attrs: map[string]interface{}{"dtype": Float}})
+	if _, err := b.AddOperation(OpSpec{Type: "Placeholder", Name: "x", Attrs: map[string]interface{}{"dtype": Float}}); err == nil {
+		t.Error("Duplicate name accepted")
+	}
+	y := addBatched(t, b, OpSpec{Type: "Placeholder", Name: "y", Attrs: map[string]interface{}{"dtype": Int32}})
+	sum := addBatched(t, b, OpSpec{Type: "Add", Name: "sum", Input: []Input{x, y}})
+	if err := b.Flush(); err == nil {
+		t.Fatal("Flush succeeded with inputs of different types")
+	}
+	if g.Operation("x") != nil {
+		t.Error("Operations of a failed batch added to the graph")
+	}
+	if b.Err() == nil {
+		t.Error("Failed flush not reported by Err")
+	}
+	// The operations of the failed batch return zero values or errors.
+	if dt, n := sum.DataType(), sum.Op.NumOutputs(); dt != 0 || n != 0 {
+		t.Errorf("Got type %v and %d outputs, want zero values", dt, n)
+	}
+	if _, err := sum.Op.OutputListSize("z"); err == nil || !strings.Contains(err.Error(), `"sum"`) {
+		t.Errorf("Got error %v, want one naming the operation", err)
+	}
+	if _, err := Neg(g, "neg", sum); err == nil {
+		t.Error("Operation of a failed batch accepted as an input")
+	}
+	if _, err := b.AddOperation(OpSpec{Type: "Neg", Name: "neg", Input: []Input{x}}); err == nil {
+		t.Error("Operation of a failed batch accepted as an input of the batch")
+	}
+}
+
+func BenchmarkGraphConstruction(b *testing.B) {
+	const n = 1000
+	build := func(add func(OpSpec) (*Operation, error)) {
+		one, err := NewTensor(float32(1))
+		if err != nil {
+			b.Fatal(err)
+		}
+		op, err := add(OpSpec{Type: "Const", Name: "c", Attrs: map[string]interface{}{"dtype": Float, "value": one}})
+		if err != nil {
+			b.Fatal(err)
+		}
+		x := op.Output(0)
+		for i := 0; i < n; i++ {
+			op, err := add(OpSpec{Type: "Add", Name: fmt.Sprint("add", i), Input: []Input{x, x}})
+			if err != nil {
+				b.Fatal(err)
+			}
+			x = op.Output(0)
+		}
+	}
+	b.Run("AddOperation", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			build(NewGraph().AddOperation)
+		}
+	})
+	b.Run("GraphBatch", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			batch := NewGraph().NewBatch()
+			build(batch.AddOperation)
+			if err := batch.Flush(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,512 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+)
+
+// ImportOptions configures Graph.ImportFrom.
+type ImportOptions struct {
+	// Prefix is prepended to the names of the imported nodes, as by
+	// Graph.Import.
+	Prefix string
+	// ChunkBytes is the approximate size of the parts of the GraphDef
+	// imported at once. Defaults to 64MB.
+	ChunkBytes int
+	// RewriteNode, if not nil, is called with each serialized NodeDef
+	// read, and returns the NodeDef imported in its place, for example to
+	// replace large constants by ImmutableConst operations memory mapping
+	// their contents (see the arena package).
+	RewriteNode func(nodeDef []byte) ([]byte, error)
+	// Progress, if not nil, is called after each part of the GraphDef is
+	// imported.
+	Progress func(ImportProgress)
+}
+
+// ImportProgress reports the progress of Graph.ImportFrom.
+type ImportProgress struct {
+	// Nodes is the number of nodes imported so far.
+	Nodes int
+	// Bytes is the number of bytes of the GraphDef read so far, out of
+	// TotalBytes.
+	Bytes, TotalBytes int64
+}
+
+// ImportFrom imports the nodes and edges of a serialized GraphDef read from
+// r into g, like Import, without holding the whole GraphDef in memory.
+//
+// The nodes are read one by one and imported in parts of about
+// opts.ChunkBytes bytes, so GraphDefs larger than the 2GB limit of protocol
+// buffers, or than the memory available to hold them besides the graph,
+// can be imported. Nodes are kept for a later part until all their inputs
+// have been read, so the GraphDef does not need to be sorted. A single node
+// must still be smaller than 2GB; large constants can be moved out of the
+// graph with opts.RewriteNode.
+//
+// The runtime only imports nodes whose inputs are nodes of the same
+// GraphDef, so each operation of an earlier part used by a later part is
+// stood for in the later part by a NoOp operation, named import_input_N
+// under the prefix, which the inputs of the part name in its place and
+// which is remapped to the operation when the part is imported.
+// Those NoOp operations are left in g but never run.
+//
+// Unlike Import, ImportFrom is not atomic: if an error occurs, the parts
+// imported before it are left in g.
+func (g *Graph) ImportFrom(r io.ReadSeeker, opts *ImportOptions) error {
+	var o ImportOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.ChunkBytes <= 0 {
+		o.ChunkBytes = 64 << 20
+	}
+	total, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	if total == 0 {
+		return nil
+	}
+
+	// The function library and versions usually follow the nodes, but are
+	// needed to import them, so they are read first.
+	var header, library []byte
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	err = readGraphDefFields(bufio.NewReader(r), func(field int, wire uint64, v uint64, br *bufio.Reader, n int) error {
+		switch {
+		case field == 2 && wire == 2: // library
+			b, err := readBytes(br, n)
+			library = appendBytesField(library, 2, b)
+			return err
+		case field == 3 && wire == 0: // version (deprecated)
+			header = appendVarintField(header, 3, v)
+		case field == 4 && wire == 2: // versions
+			b, err := readBytes(br, n)
+			header = appendBytesField(header, 4, b)
+			return err
+		case wire == 2:
+			_, err := br.Discard(n)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("invalid GraphDef: %v", err)
+	}
+	// The nodes are imported with their names already prefixed, so
+	// importing the library, or the versions alone, also checks the prefix
+	// against the names of the operations of g.
+	if len(library) > 0 || o.Prefix != "" {
+		def := append(library, header...)
+		if len(def) == 0 {
+			def = appendBytesField(nil, 4, nil) // versions
+		}
+		if err := g.Import(def, o.Prefix); err != nil {
+			return err
+		}
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	cr := &countingReader{r: r}
+	br := bufio.NewReader(cr)
+	im := &graphImport{
+		g:        g,
+		opts:     &o,
+		header:   header,
+		imported: make(map[string]bool),
+		read:     func() int64 { return cr.n - int64(br.Buffered()) },
+		progress: ImportProgress{TotalBytes: total},
+	}
+	err = readGraphDefFields(br, func(field int, wire uint64, v uint64, br *bufio.Reader, n int) error {
+		switch {
+		case field == 1 && wire == 2:
+			node, err := readBytes(br, n)
+			if err != nil {
+				return err
+			}
+			return im.add(node)
+		case wire == 2:
+			_, err := br.Discard(n)
+			return err
+		}
+		return nil
+	})
+	if err == nil {
+		err = im.flush(true)
+	}
+	return err
+}
+
+// graphImport is the state of Graph.ImportFrom.
+type graphImport struct {
+	g        *Graph
+	opts     *ImportOptions
+	header   []byte // Encoded GraphDef.version and versions fields.
+	imported map[string]bool
+	read     func() int64 // Returns the number of bytes read.
+	chunk    []*importNode
+	size     int
+	progress ImportProgress
+}
+
+// importNode is a node of a GraphDef read by Graph.ImportFrom.
+type importNode struct {
+	name   string
+	inputs []string // The operations of the inputs.
+	def    []byte   // Encoded NodeDef.
+}
+
+func (im *graphImport) add(def []byte) error {
+	if im.opts.RewriteNode != nil {
+		var err error
+		if def, err = im.opts.RewriteNode(def); err != nil {
+			return err
+		}
+	}
+	n := &importNode{def: def}
+	err := parseMessage(def, func(field int, v uint64, b []byte) error {
+		switch field {
+		case 1:
+			n.name = string(b)
+		case 3:
+			op, _, err := ParseTensorName(string(b))
+			n.inputs = append(n.inputs, op)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("invalid node: %v", err)
+	}
+	if len(n.def) > math.MaxInt32-len(im.header)-16 {
+		return fmt.Errorf("node %q is too large to be imported (%d bytes)", n.name, len(n.def))
+	}
+	if im.size > 0 && im.size+len(n.def) > im.opts.ChunkBytes {
+		if err := im.flush(false); err != nil {
+			return err
+		}
+	}
+	im.chunk = append(im.chunk, n)
+	im.size += len(n.def)
+	return nil
+}
+
+// flush imports the nodes of the chunk, but for those with inputs that have
+// not been read yet unless last is set, and keeps the others for the next
+// chunk.
+func (im *graphImport) flush(last bool) error {
+	chunk := make(map[string]bool, len(im.chunk))
+	for _, n := range im.chunk {
+		chunk[n.name] = true
+	}
+	// Keep the nodes with unknown inputs, and then those with inputs kept,
+	// until none is left to keep.
+	kept := make(map[string]bool)
+	for changed := !last; changed; {
+		changed = false
+		for _, n := range im.chunk {
+			if kept[n.name] {
+				continue
+			}
+			for _, op := range n.inputs {
+				if kept[op] || (!chunk[op] && !im.imported[op]) {
+					kept[n.name], changed = true, true
+					break
+				}
+			}
+		}
+	}
+	var (
+		def, rest []*importNode
+		size      int
+	)
+	for _, n := range im.chunk {
+		if kept[n.name] {
+			rest = append(rest, n)
+			size += len(n.def)
+			continue
+		}
+		def = append(def, n)
+	}
+	if len(def) == 0 {
+		// Let the chunk grow until the missing inputs are read.
+		return nil
+	}
+	var buf []byte
+	for _, n := range def {
+		buf = appendBytesField(buf, 1, n.def)
+	}
+	imported := func(op string) bool { return !chunk[op] && im.imported[op] }
+	if err := im.g.importNodes(buf, im.header, im.opts.Prefix, imported); err != nil {
+		return err
+	}
+	for _, n := range def {
+		im.imported[n.name] = true
+	}
+	im.chunk, im.size = rest, size
+	im.progress.Nodes += len(def)
+	im.progress.Bytes = im.read()
+	if im.opts.Progress != nil {
+		im.opts.Progress(im.progress)
+	}
+	return nil
+}
+
+// importNodes imports nodes, encoded GraphDef.node fields, into g with
+// header, the encoded versions of their GraphDef, prefixing their names with
+// prefix like Import does. The inputs of the nodes that name operations for
+// which imported returns true refer to operations already in g, named with
+// prefix, rather than to other nodes.
+func (g *Graph) importNodes(nodes, header []byte, prefix string, imported func(op string) bool) error {
+	exists := func(name string) bool { return g.Operation(name) != nil }
+	def, inputs, err := stubImportedInputs(nodes, prefix, imported, exists)
+	if err != nil {
+		return fmt.Errorf("invalid GraphDef: %v", err)
+	}
+	if def == nil {
+		return g.importGraphDef(append(nodes[:len(nodes):len(nodes)], header...), prefix, nil)
+	}
+	mapping := make(map[string]Output, len(inputs))
+	for stub, in := range inputs {
+		name, index, err := ParseTensorName(in)
+		if err != nil {
+			return err
+		}
+		op := g.Operation(name)
+		if op == nil {
+			return fmt.Errorf("input %q not found in the graph", in)
+		}
+		mapping[stub] = Output{op, index}
+	}
+	return g.importGraphDef(append(def, header...), "", mapping)
+}
+
+// stubImportedInputs returns nodes, encoded GraphDef.node fields, with
+// their names, inputs and colocation groups prefixed with prefix, and their
+// inputs naming operations for which imported returns true rewritten to
+// name NoOp stubs added to the returned nodes, since the runtime only
+// imports nodes whose inputs name nodes of the same GraphDef. The returned
+// map maps those inputs to the inputs of the graph they stand for, which
+// the stubs are remapped to by importGraphDef. Names for which exists
+// returns true are not used for stubs.
+//
+// It returns nil nodes if there is nothing to rewrite, that is if prefix is
+// empty and no input is imported.
+func stubImportedInputs(nodes []byte, prefix string, imported, exists func(name string) bool) (def []byte, inputs map[string]string, err error) {
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	names := make(map[string]bool)
+	rewrite := prefix != ""
+	err = parseMessage(nodes, func(field int, v uint64, node []byte) error {
+		return parseMessage(node, func(field int, v uint64, b []byte) error {
+			switch field {
+			case 1:
+				names[prefix+string(b)] = true
+			case 3:
+				op, _, err := ParseTensorName(string(b))
+				rewrite = rewrite || imported(op)
+				return err
+			}
+			return nil
+		})
+	})
+	if err != nil || !rewrite {
+		return nil, nil, err
+	}
+	stubs := make(map[string]string) // Names of the stubs by operation.
+	inputs = make(map[string]string)
+	next := 0
+	// stubInput returns in, an input of the output index of op, rewritten
+	// to name the stub of op.
+	stubInput := func(in, op string, index int) string {
+		stub, ok := stubs[op]
+		for ; !ok; next++ {
+			stub = fmt.Sprintf("%simport_input_%d", prefix, next)
+			ok = !names[stub] && !exists(stub)
+		}
+		stubs[op] = stub
+		var s string
+		if index < 0 {
+			s = "^" + stub
+			inputs[s] = "^" + prefix + op
+		} else {
+			s = stub + in[len(op):]
+			inputs[s] = prefix + in
+		}
+		return s
+	}
+	err = parseMessage(nodes, func(_ int, _ uint64, node []byte) error {
+		var rewritten []byte
+		err := parseMessage(node, func(field int, v uint64, b []byte) error {
+			// All the fields of a NodeDef are length-delimited.
+			switch field {
+			case 1: // name
+				b = []byte(prefix + string(b))
+			case 3: // input
+				in := string(b)
+				op, index, err := ParseTensorName(in)
+				switch {
+				case err != nil:
+					return err
+				case imported(op):
+					in = stubInput(in, op, index)
+				case index < 0:
+					in = "^" + prefix + op
+				default:
+					in = prefix + in
+				}
+				b = []byte(in)
+			case 5: // attr
+				var err error
+				if b, err = prefixColocation(b, prefix); err != nil {
+					return err
+				}
+			}
+			rewritten = appendBytesField(rewritten, field, b)
+			return nil
+		})
+		def = appendBytesField(def, 1, rewritten)
+		return err
+	})
+	ops := make([]string, 0, len(stubs))
+	for op := range stubs {
+		ops = append(ops, op)
+	}
+	sort.Strings(ops)
+	for _, op := range ops {
+		stub := appendBytesField(nil, 1, []byte(stubs[op]))
+		stub = appendBytesField(stub, 2, []byte("NoOp"))
+		def = appendBytesField(def, 1, stub)
+	}
+	return def, inputs, err
+}
+
+// prefixColocation returns entry, an entry of the attributes of a NodeDef,
+// with the names of the nodes of its colocation groups prefixed with prefix
+// if it is the "_class" attribute.
+func prefixColocation(entry []byte, prefix string) ([]byte, error) {
+	var (
+		key    string
+		groups []string
+	)
+	err := parseMessage(entry, func(field int, v uint64, b []byte) error {
+		switch field {
+		case 1:
+			key = string(b)
+		case 2:
+			return parseMessage(b, func(field int, v uint64, b []byte) error {
+				if field != 1 { // list
+					return nil
+				}
+				return parseMessage(b, func(field int, v uint64, b []byte) error {
+					if field == 2 { // s
+						groups = append(groups, string(b))
+					}
+					return nil
+				})
+			})
+		}
+		return nil
+	})
+	if err != nil || key != "_class" {
+		return entry, err
+	}
+	var list []byte
+	for _, g := range groups {
+		if strings.HasPrefix(g, "loc:@") {
+			g = "loc:@" + prefix + g[len("loc:@"):]
+		}
+		list = appendBytesField(list, 2, []byte(g))
+	}
+	rewritten := appendBytesField(nil, 1, []byte(key))
+	return appendBytesField(rewritten, 2, appendBytesField(nil, 1, list)), nil
+}
+
+// readGraphDefFields calls f for each field of the serialized protocol
+// buffer message read from br, with the value of varint fields in v. For
+// length-delimited fields, f must read or discard exactly the n bytes of
+// their contents from br.
+func readGraphDefFields(br *bufio.Reader, f func(field int, wire uint64, v uint64, br *bufio.Reader, n int) error) error {
+	for {
+		key, err := binary.ReadUvarint(br)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errTruncated
+		}
+		var (
+			v uint64
+			n int
+		)
+		switch key & 7 {
+		case 0: // varint
+			if v, err = binary.ReadUvarint(br); err != nil {
+				return errTruncated
+			}
+		case 1: // 64-bit
+			_, err = br.Discard(8)
+		case 2: // length-delimited
+			var l uint64
+			if l, err = binary.ReadUvarint(br); err == nil && l > math.MaxInt32 {
+				return fmt.Errorf("field %d is too large (%d bytes)", key>>3, l)
+			}
+			n = int(l)
+		case 5: // 32-bit
+			_, err = br.Discard(4)
+		default:
+			return fmt.Errorf("unsupported wire type %d", key&7)
+		}
+		if err != nil {
+			return errTruncated
+		}
+		if err := f(int(key>>3), key&7, v, br, n); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				return errTruncated
+			}
+			return err
+		}
+	}
+}
+
+// countingReader counts the bytes read from r.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(b []byte) (int, error) {
+	n, err := c.r.Read(b)
+	c.n += int64(n)
+	return n, err
+}
+
+func readBytes(br *bufio.Reader, n int) ([]byte, error) {
+	b := make([]byte, n)
+	_, err := io.ReadFull(br, b)
+	return b, err
+}
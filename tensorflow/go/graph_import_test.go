@@ -0,0 +1,258 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// importTestGraph returns a serialized graph computing sum = -input + weights,
+// with its nodes in reverse order if reversed is set.
+func importTestGraph(t *testing.T, reversed bool) []byte {
+	g := NewGraph()
+	input, err := Placeholder(g, "input", Float)
+	if err != nil {
+		t.Fatal(err)
+	}
+	neg, err := Neg(g, "neg", input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	weights, err := Const(g, "weights", make([]float32, 1024))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Add(g, "sum", neg, weights); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if _, err := g.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reversed {
+		return buf.Bytes()
+	}
+	var nodes [][]byte
+	var rest []byte
+	err = parseMessage(buf.Bytes(), func(field int, v uint64, b []byte) error {
+		if field == 1 {
+			nodes = append([][]byte{b}, nodes...)
+		} else {
+			rest = appendBytesField(rest, field, b)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var def []byte
+	for _, n := range nodes {
+		def = appendBytesField(def, 1, n)
+	}
+	return append(def, rest...)
+}
+
+func runImportTestGraph(t *testing.T, g *Graph, prefix string) {
+	s, err := NewSession(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	input := make([]float32, 1024)
+	input[1] = 2
+	feed, err := NewTensor(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := s.Run(
+		map[Output]*Tensor{g.Operation(prefix + "input").Output(0): feed},
+		[]Output{g.Operation(prefix + "sum").Output(0)},
+		nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := out[0].Value().([]float32); got[0] != 0 || got[1] != -2 {
+		t.Errorf("Got %v..., want [0 -2 0...]", got[:2])
+	}
+}
+
+func TestGraphImportFrom(t *testing.T) {
+	for _, reversed := range []bool{false, true} {
+		def := importTestGraph(t, reversed)
+		g := NewGraph()
+		var progress []ImportProgress
+		opts := &ImportOptions{
+			Prefix:     "imported",
+			ChunkBytes: 1,
+			Progress:   func(p ImportProgress) { progress = append(progress, p) },
+		}
+		if err := g.ImportFrom(bytes.NewReader(def), opts); err != nil {
+			t.Fatalf("reversed=%v: %v", reversed, err)
+		}
+		if err := hasOperations(g, "imported/input", "imported/neg", "imported/weights", "imported/sum"); err != nil {
+			t.Fatalf("reversed=%v: %v", reversed, err)
+		}
+		runImportTestGraph(t, g, "imported/")
+
+		if len(progress) == 0 {
+			t.Fatalf("reversed=%v: Progress not called", reversed)
+		}
+		last := progress[len(progress)-1]
+		if want := (ImportProgress{Nodes: 4, Bytes: int64(len(def)), TotalBytes: int64(len(def))}); last != want {
+			t.Errorf("reversed=%v: Got final progress %+v, want %+v", reversed, last, want)
+		}
+		if !reversed && len(progress) != 4 {
+			t.Errorf("Got %d progress reports, want one per node", len(progress))
+		}
+	}
+}
+
+func TestGraphImportFromRewriteNode(t *testing.T) {
+	def := importTestGraph(t, false)
+	var nodes int
+	// Replaces the Neg operation by an Identity operation.
+	rewrite := func(node []byte) ([]byte, error) {
+		nodes++
+		var out []byte
+		err := parseMessage(node, func(field int, v uint64, b []byte) error {
+			if field == 2 && string(b) == "Neg" {
+				b = []byte("Identity")
+			}
+			out = appendBytesField(out, field, b)
+			return nil
+		})
+		return out, err
+	}
+	g := NewGraph()
+	if err := g.ImportFrom(bytes.NewReader(def), &ImportOptions{RewriteNode: rewrite}); err != nil {
+		t.Fatal(err)
+	}
+	if nodes != 4 {
+		t.Errorf("Got %d nodes rewritten, want 4", nodes)
+	}
+	if got, want := g.Operation("neg").Type(), "Identity"; got != want {
+		t.Errorf("Got a %s operation, want %s", got, want)
+	}
+
+	fail := func([]byte) ([]byte, error) { return nil, errors.New("failed") }
+	if err := NewGraph().ImportFrom(bytes.NewReader(def), &ImportOptions{RewriteNode: fail}); err == nil {
+		t.Error("ImportFrom succeeded with a failing RewriteNode")
+	}
+}
+
+func TestGraphImportFromErrors(t *testing.T) {
+	def := importTestGraph(t, false)
+	for _, test := range []struct {
+		name string
+		def  []byte
+	}{
+		{"truncated", def[:len(def)/2]},
+		{"missing input", appendBytesField(nil, 1, appendBytesField(
+			appendBytesField(appendBytesField(nil, 1, []byte("neg")), 2, []byte("Neg")),
+			3, []byte("missing")))},
+	} {
+		g := NewGraph()
+		if err := g.ImportFrom(bytes.NewReader(test.def), nil); err == nil {
+			t.Errorf("%s: ImportFrom succeeded", test.name)
+		}
+	}
+}
+
+func TestStubImportedInputs(t *testing.T) {
+	node := func(name, op string, inputs ...string) []byte {
+		def := appendBytesField(nil, 1, []byte(name))
+		def = appendBytesField(def, 2, []byte(op))
+		for _, in := range inputs {
+			def = appendBytesField(def, 3, []byte(in))
+		}
+		return def
+	}
+	class := appendBytesField(nil, 1, []byte("_class"))
+	class = appendBytesField(class, 2, appendBytesField(nil, 1, appendBytesField(nil, 2, []byte("loc:@weights"))))
+	var nodes []byte
+	nodes = appendBytesField(nodes, 1, node("neg", "Neg", "input"))
+	nodes = appendBytesField(nodes, 1, appendBytesField(node("sum", "AddN", "neg", "weights:1", "input:0", "^init"), 5, class))
+	imported := func(op string) bool { return op == "input" || op == "weights" || op == "init" }
+	exists := func(name string) bool { return name == "p/import_input_0" }
+
+	def, inputs, err := stubImportedInputs(nodes, "p", imported, exists)
+	if err != nil {
+		t.Fatal(err)
+	}
+	type nodeDef struct {
+		name, op string
+		inputs   []string
+		class    []string
+	}
+	var got []nodeDef
+	err = parseMessage(def, func(_ int, _ uint64, b []byte) error {
+		var n nodeDef
+		got = append(got, n)
+		return parseMessage(b, func(field int, _ uint64, b []byte) error {
+			n := &got[len(got)-1]
+			switch field {
+			case 1:
+				n.name = string(b)
+			case 2:
+				n.op = string(b)
+			case 3:
+				n.inputs = append(n.inputs, string(b))
+			case 5:
+				return parseMessage(b, func(field int, _ uint64, b []byte) error {
+					if field != 2 {
+						return nil
+					}
+					return parseMessage(b, func(_ int, _ uint64, b []byte) error {
+						return parseMessage(b, func(_ int, _ uint64, b []byte) error {
+							n.class = append(n.class, string(b))
+							return nil
+						})
+					})
+				})
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []nodeDef{
+		{name: "p/neg", op: "Neg", inputs: []string{"p/import_input_1"}},
+		{name: "p/sum", op: "AddN", inputs: []string{"p/neg", "p/import_input_2:1", "p/import_input_1:0", "^p/import_input_3"}, class: []string{"loc:@p/weights"}},
+		{name: "p/import_input_3", op: "NoOp"},
+		{name: "p/import_input_1", op: "NoOp"},
+		{name: "p/import_input_2", op: "NoOp"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Got nodes %+v, want %+v", got, want)
+	}
+	wantInputs := map[string]string{
+		"p/import_input_1":   "p/input",
+		"p/import_input_2:1": "p/weights:1",
+		"p/import_input_1:0": "p/input:0",
+		"^p/import_input_3":  "^p/init",
+	}
+	if !reflect.DeepEqual(inputs, wantInputs) {
+		t.Errorf("Got inputs %v, want %v", inputs, wantInputs)
+	}
+
+	// Without prefix nor imported inputs, the nodes are imported as is.
+	if def, _, err := stubImportedInputs(nodes, "", func(string) bool { return false }, exists); def != nil || err != nil {
+		t.Errorf("Got nodes %q and error %v, want neither", def, err)
+	}
+}
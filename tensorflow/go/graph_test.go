@@ -62,3 +62,78 @@ func TestGraphWriteToAndImport(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestGraphImportWithOptions(t *testing.T) {
+	src := NewGraph()
+	input, err := Placeholder(src, "input", Int64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Neg(src, "neg", input); err != nil {
+		t.Fatal(err)
+	}
+	buf := new(bytes.Buffer)
+	if _, err := src.WriteTo(buf); err != nil {
+		t.Fatal(err)
+	}
+
+	g := NewGraph()
+	c, err := Const(g, "c", int64(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var opts GraphImportOptions
+	opts.Prefix = "imported"
+	opts.AddInputMapping("input", 0, c)
+	if err := g.ImportWithOptions(buf.Bytes(), opts); err != nil {
+		t.Fatal(err)
+	}
+	neg := g.Operation("imported/neg")
+	if neg == nil {
+		t.Fatal("imported/neg not found")
+	}
+	if in := neg.Input(0); in.Op.Name() != "c" {
+		t.Errorf("imported/neg reads %s, want c", in.Op.Name())
+	}
+}
+
+func TestGraphImportEmpty(t *testing.T) {
+	g := NewGraph()
+	if err := g.Import(nil, ""); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if _, err := g.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	err := parseMessage(buf.Bytes(), func(field int, _ uint64, b []byte) error {
+		if field == 1 { // node
+			return fmt.Errorf("got node %q, want none", b)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func FuzzGraphImport(f *testing.F) {
+	g := NewGraph()
+	input, err := Placeholder(g, "input", Int64)
+	if err != nil {
+		f.Fatal(err)
+	}
+	if _, err := Neg(g, "neg", input); err != nil {
+		f.Fatal(err)
+	}
+	buf := new(bytes.Buffer)
+	if _, err := g.WriteTo(buf); err != nil {
+		f.Fatal(err)
+	}
+	f.Add(buf.Bytes())
+	f.Add([]byte{})
+	f.Fuzz(func(t *testing.T, def []byte) {
+		// Import must either succeed or fail with an error.
+		NewGraph().Import(def, "fuzz")
+	})
+}
@@ -0,0 +1,503 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// WriteTextTo writes the GraphDef of g in the protocol buffer text format
+// (as in .pbtxt files), for human review of the graphs built in Go and for
+// storing them in version control.
+//
+// The output is deterministic: fields are written in the order of their
+// numbers and the entries of maps, such as the attributes of each node,
+// are sorted by key. Fields unknown to this package are written as
+// comments.
+func (g *Graph) WriteTextTo(w io.Writer) (int64, error) {
+	var def bytes.Buffer
+	if _, err := g.WriteTo(&def); err != nil {
+		return 0, err
+	}
+	var t textWriter
+	if err := t.message(def.Bytes(), graphDefText); err != nil {
+		return 0, fmt.Errorf("invalid GraphDef: %v", err)
+	}
+	return t.buf.WriteTo(w)
+}
+
+// textKind is the type of a field of a protocol buffer message, which
+// determines how its values are decoded and written.
+type textKind int
+
+const (
+	textString     textKind = iota // string and bytes
+	textInt                        // int32 and int64
+	textUint                       // uint32 and uint64
+	textBool                       // bool
+	textDataType                   // the DataType enum
+	textFloat                      // float
+	textDouble                     // double
+	textSubmessage                 // messages
+)
+
+type textField struct {
+	name string
+	kind textKind
+	msg  *textMessage // For textSubmessage fields.
+}
+
+// textMessage describes the fields of a message type, keyed by number.
+type textMessage struct {
+	fields map[int]textField
+	isMap  bool // For the entries of maps: key is field 1, value field 2.
+}
+
+// The messages of GraphDef, as documented in the .proto files of
+// https://www.tensorflow.org/code/tensorflow/core/framework/.
+var (
+	graphDefText          = &textMessage{}
+	nodeDefText           = &textMessage{}
+	attrValueText         = &textMessage{}
+	attrListText          = &textMessage{}
+	nameAttrListText      = &textMessage{}
+	tensorShapeText       = &textMessage{}
+	tensorText            = &textMessage{}
+	resourceHandleText    = &textMessage{}
+	variantText           = &textMessage{}
+	fullTypeText          = &textMessage{}
+	functionLibraryText   = &textMessage{}
+	functionDefText       = &textMessage{}
+	opDefText             = &textMessage{}
+	argDefText            = &textMessage{}
+	attrMapText           = mapText(textString, textField{kind: textSubmessage, msg: attrValueText})
+	stringToStringMapText = mapText(textString, textField{kind: textString})
+)
+
+func mapText(key textKind, value textField) *textMessage {
+	value.name = "value"
+	return &textMessage{isMap: true, fields: map[int]textField{1: {name: "key", kind: key}, 2: value}}
+}
+
+func messageField(name string, m *textMessage) textField {
+	return textField{name: name, kind: textSubmessage, msg: m}
+}
+
+func init() {
+	graphDefText.fields = map[int]textField{
+		1: messageField("node", nodeDefText),
+		2: messageField("library", functionLibraryText),
+		3: {name: "version", kind: textInt},
+		4: messageField("versions", &textMessage{fields: map[int]textField{
+			1: {name: "producer", kind: textInt},
+			2: {name: "min_consumer", kind: textInt},
+			3: {name: "bad_consumers", kind: textInt},
+		}}),
+	}
+	nodeDefText.fields = map[int]textField{
+		1: {name: "name", kind: textString},
+		2: {name: "op", kind: textString},
+		3: {name: "input", kind: textString},
+		4: {name: "device", kind: textString},
+		5: messageField("attr", attrMapText),
+		6: messageField("experimental_debug_info", &textMessage{fields: map[int]textField{
+			1: {name: "original_node_names", kind: textString},
+			2: {name: "original_func_names", kind: textString},
+		}}),
+		7: messageField("experimental_type", fullTypeText),
+	}
+	attrValueText.fields = map[int]textField{
+		1:  messageField("list", attrListText),
+		2:  {name: "s", kind: textString},
+		3:  {name: "i", kind: textInt},
+		4:  {name: "f", kind: textFloat},
+		5:  {name: "b", kind: textBool},
+		6:  {name: "type", kind: textDataType},
+		7:  messageField("shape", tensorShapeText),
+		8:  messageField("tensor", tensorText),
+		9:  {name: "placeholder", kind: textString},
+		10: messageField("func", nameAttrListText),
+	}
+	attrListText.fields = map[int]textField{
+		2: {name: "s", kind: textString},
+		3: {name: "i", kind: textInt},
+		4: {name: "f", kind: textFloat},
+		5: {name: "b", kind: textBool},
+		6: {name: "type", kind: textDataType},
+		7: messageField("shape", tensorShapeText),
+		8: messageField("tensor", tensorText),
+		9: messageField("func", nameAttrListText),
+	}
+	nameAttrListText.fields = map[int]textField{
+		1: {name: "name", kind: textString},
+		2: messageField("attr", attrMapText),
+	}
+	tensorShapeText.fields = map[int]textField{
+		2: messageField("dim", &textMessage{fields: map[int]textField{
+			1: {name: "size", kind: textInt},
+			2: {name: "name", kind: textString},
+		}}),
+		3: {name: "unknown_rank", kind: textBool},
+	}
+	tensorText.fields = map[int]textField{
+		1:  {name: "dtype", kind: textDataType},
+		2:  messageField("tensor_shape", tensorShapeText),
+		3:  {name: "version_number", kind: textInt},
+		4:  {name: "tensor_content", kind: textString},
+		5:  {name: "float_val", kind: textFloat},
+		6:  {name: "double_val", kind: textDouble},
+		7:  {name: "int_val", kind: textInt},
+		8:  {name: "string_val", kind: textString},
+		9:  {name: "scomplex_val", kind: textFloat},
+		10: {name: "int64_val", kind: textInt},
+		11: {name: "bool_val", kind: textBool},
+		12: {name: "dcomplex_val", kind: textDouble},
+		13: {name: "half_val", kind: textInt},
+		14: messageField("resource_handle_val", resourceHandleText),
+		15: messageField("variant_val", variantText),
+		16: {name: "uint32_val", kind: textUint},
+		17: {name: "uint64_val", kind: textUint},
+	}
+	resourceHandleText.fields = map[int]textField{
+		1: {name: "device", kind: textString},
+		2: {name: "container", kind: textString},
+		3: {name: "name", kind: textString},
+		4: {name: "hash_code", kind: textUint},
+		5: {name: "maybe_type_name", kind: textString},
+		6: messageField("dtypes_and_shapes", &textMessage{fields: map[int]textField{
+			1: {name: "dtype", kind: textDataType},
+			2: messageField("shape", tensorShapeText),
+		}}),
+	}
+	variantText.fields = map[int]textField{
+		1: {name: "type_name", kind: textString},
+		2: {name: "metadata", kind: textString},
+		3: messageField("tensors", tensorText),
+	}
+	fullTypeText.fields = map[int]textField{
+		1: {name: "type_id", kind: textInt},
+		2: messageField("args", fullTypeText),
+		3: {name: "s", kind: textString},
+		4: {name: "i", kind: textInt},
+	}
+	functionLibraryText.fields = map[int]textField{
+		1: messageField("function", functionDefText),
+		2: messageField("gradient", &textMessage{fields: map[int]textField{
+			1: {name: "function_name", kind: textString},
+			2: {name: "gradient_func", kind: textString},
+		}}),
+		3: messageField("registered_gradients", &textMessage{fields: map[int]textField{
+			1: {name: "gradient_func", kind: textString},
+			2: {name: "registered_op_type", kind: textString},
+		}}),
+	}
+	functionDefText.fields = map[int]textField{
+		1: messageField("signature", opDefText),
+		3: messageField("node_def", nodeDefText),
+		4: messageField("ret", stringToStringMapText),
+		5: messageField("attr", attrMapText),
+		6: messageField("control_ret", stringToStringMapText),
+		7: messageField("arg_attr", mapText(textUint, messageField("", &textMessage{fields: map[int]textField{
+			1: messageField("attr", attrMapText),
+		}}))),
+		8: messageField("resource_arg_unique_id", mapText(textUint, textField{kind: textUint})),
+	}
+	opDefText.fields = map[int]textField{
+		1: {name: "name", kind: textString},
+		2: messageField("input_arg", argDefText),
+		3: messageField("output_arg", argDefText),
+		4: messageField("attr", &textMessage{fields: map[int]textField{
+			1: {name: "name", kind: textString},
+			2: {name: "type", kind: textString},
+			3: messageField("default_value", attrValueText),
+			4: {name: "description", kind: textString},
+			5: {name: "has_minimum", kind: textBool},
+			6: {name: "minimum", kind: textInt},
+			7: messageField("allowed_values", attrValueText),
+		}}),
+		5: {name: "summary", kind: textString},
+		6: {name: "description", kind: textString},
+		8: messageField("deprecation", &textMessage{fields: map[int]textField{
+			1: {name: "version", kind: textInt},
+			2: {name: "explanation", kind: textString},
+		}}),
+		16: {name: "is_aggregate", kind: textBool},
+		17: {name: "is_stateful", kind: textBool},
+		18: {name: "is_commutative", kind: textBool},
+		19: {name: "allows_uninitialized_input", kind: textBool},
+		20: {name: "control_output", kind: textString},
+		21: {name: "is_distributed_communication", kind: textBool},
+	}
+	argDefText.fields = map[int]textField{
+		1:  {name: "name", kind: textString},
+		2:  {name: "description", kind: textString},
+		3:  {name: "type", kind: textDataType},
+		4:  {name: "type_attr", kind: textString},
+		5:  {name: "number_attr", kind: textString},
+		6:  {name: "type_list_attr", kind: textString},
+		16: {name: "is_ref", kind: textBool},
+		17: messageField("experimental_full_type", fullTypeText),
+	}
+}
+
+// rawField is a field of a serialized message: its number, wire type and
+// either its integer value or its contents.
+type rawField struct {
+	num, wire int
+	v         uint64
+	b         []byte
+}
+
+// readFields returns the fields of the serialized message b, sorted by
+// number, keeping the order of repeated fields.
+func readFields(b []byte) ([]rawField, error) {
+	var fields []rawField
+	err := parseFields(b, func(field, wire int, v uint64, b []byte) error {
+		fields = append(fields, rawField{num: field, wire: wire, v: v, b: b})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(fields, func(i, j int) bool { return fields[i].num < fields[j].num })
+	return fields, nil
+}
+
+type textWriter struct {
+	buf    bytes.Buffer
+	indent int
+}
+
+func (t *textWriter) line(format string, args ...interface{}) {
+	t.buf.WriteString(strings.Repeat("  ", t.indent))
+	fmt.Fprintf(&t.buf, format, args...)
+	t.buf.WriteByte('\n')
+}
+
+// message writes the fields of the serialized message b of type m.
+func (t *textWriter) message(b []byte, m *textMessage) error {
+	fields, err := readFields(b)
+	if err != nil {
+		return err
+	}
+	for start := 0; start < len(fields); {
+		end := start + 1
+		for end < len(fields) && fields[end].num == fields[start].num {
+			end++
+		}
+		if err := t.field(fields[start:end], m); err != nil {
+			return err
+		}
+		start = end
+	}
+	return nil
+}
+
+// field writes the values of a field, all of the same number.
+func (t *textWriter) field(values []rawField, m *textMessage) error {
+	def, ok := m.fields[values[0].num]
+	if !ok {
+		t.line("# unknown field %d", values[0].num)
+		return nil
+	}
+	if def.kind != textSubmessage {
+		for _, v := range values {
+			if err := t.scalars(def, v); err != nil {
+				return fmt.Errorf("%s: %v", def.name, err)
+			}
+		}
+		return nil
+	}
+	if def.msg.isMap {
+		var err error
+		if values, err = sortEntries(values, def.msg); err != nil {
+			return fmt.Errorf("%s: %v", def.name, err)
+		}
+	}
+	for _, v := range values {
+		if v.wire != 2 {
+			return fmt.Errorf("%s: wire type %d for a message", def.name, v.wire)
+		}
+		t.line("%s {", def.name)
+		t.indent++
+		if err := t.message(v.b, def.msg); err != nil {
+			return fmt.Errorf("%s: %v", def.name, err)
+		}
+		t.indent--
+		t.line("}")
+	}
+	return nil
+}
+
+// sortEntries sorts the entries of a map by key.
+func sortEntries(entries []rawField, m *textMessage) ([]rawField, error) {
+	keys := make([]string, len(entries))
+	ints := make([]uint64, len(entries))
+	for i, e := range entries {
+		fields, err := readFields(e.b)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range fields {
+			if f.num == 1 {
+				keys[i], ints[i] = string(f.b), f.v
+			}
+		}
+	}
+	idx := make([]int, len(entries))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		if m.fields[1].kind == textString {
+			return keys[idx[i]] < keys[idx[j]]
+		}
+		return ints[idx[i]] < ints[idx[j]]
+	})
+	sorted := make([]rawField, len(entries))
+	for i, j := range idx {
+		sorted[i] = entries[j]
+	}
+	return sorted, nil
+}
+
+// scalars writes a value of a scalar field, or the values of a packed
+// repeated field.
+func (t *textWriter) scalars(def textField, f rawField) error {
+	if def.kind == textString {
+		if f.wire != 2 {
+			return fmt.Errorf("wire type %d for a string", f.wire)
+		}
+		t.line("%s: %s", def.name, quoteText(f.b))
+		return nil
+	}
+	if f.wire != 2 {
+		t.line("%s: %s", def.name, formatText(def.kind, f.v))
+		return nil
+	}
+	b := f.b
+	for len(b) > 0 {
+		var v uint64
+		switch def.kind {
+		case textFloat:
+			if len(b) < 4 {
+				return errTruncated
+			}
+			v, b = uint64(binary.LittleEndian.Uint32(b)), b[4:]
+		case textDouble:
+			if len(b) < 8 {
+				return errTruncated
+			}
+			v, b = binary.LittleEndian.Uint64(b), b[8:]
+		default:
+			var n int
+			if v, n = binary.Uvarint(b); n <= 0 {
+				return errTruncated
+			}
+			b = b[n:]
+		}
+		t.line("%s: %s", def.name, formatText(def.kind, v))
+	}
+	return nil
+}
+
+// formatText formats a scalar value of the given kind.
+func formatText(kind textKind, v uint64) string {
+	switch kind {
+	case textInt:
+		return strconv.FormatInt(int64(v), 10)
+	case textBool:
+		return strconv.FormatBool(v != 0)
+	case textDataType:
+		return dataTypeProtoName(DataType(v))
+	case textFloat:
+		return formatTextFloat(float64(math.Float32frombits(uint32(v))), 32)
+	case textDouble:
+		return formatTextFloat(math.Float64frombits(v), 64)
+	}
+	return strconv.FormatUint(v, 10)
+}
+
+func formatTextFloat(f float64, bits int) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "inf"
+	case math.IsInf(f, -1):
+		return "-inf"
+	case math.IsNaN(f):
+		return "nan"
+	}
+	return strconv.FormatFloat(f, 'g', -1, bits)
+}
+
+// dataTypeProtoName returns the name of a value of the DataType enum, such
+// as "DT_FLOAT" or "DT_FLOAT_REF", or its number if it is unknown.
+func dataTypeProtoName(dt DataType) string {
+	// Reference types are offset by 100.
+	suffix := ""
+	if dt > 100 {
+		dt, suffix = dt-100, "_REF"
+	}
+	for _, n := range dataTypeNames {
+		if n.dt == dt {
+			return n.proto + suffix
+		}
+	}
+	if info, ok := registeredType(dt); ok {
+		return info.Proto + suffix
+	}
+	if suffix != "" {
+		dt += 100
+	}
+	return strconv.Itoa(int(dt))
+}
+
+// quoteText quotes b as a string of the text format, escaping quotes,
+// backslashes and bytes that are not printable ASCII characters.
+func quoteText(b []byte) string {
+	var s bytes.Buffer
+	s.WriteByte('"')
+	for _, c := range b {
+		switch c {
+		case '\n':
+			s.WriteString(`\n`)
+		case '\r':
+			s.WriteString(`\r`)
+		case '\t':
+			s.WriteString(`\t`)
+		case '"':
+			s.WriteString(`\"`)
+		case '\'':
+			s.WriteString(`\'`)
+		case '\\':
+			s.WriteString(`\\`)
+		default:
+			if c < 0x20 || c >= 0x7f {
+				fmt.Fprintf(&s, `\%03o`, c)
+			} else {
+				s.WriteByte(c)
+			}
+		}
+	}
+	s.WriteByte('"')
+	return s.String()
+}
@@ -0,0 +1,143 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGraphWriteTextTo(t *testing.T) {
+	g := NewGraph()
+	x, err := Placeholder(g, "x", Float)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := Const(g, "c", []float32{1.5, -2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Add(g, "sum", x, c); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if _, err := g.WriteTextTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	text := buf.String()
+	for _, want := range []string{
+		`node {
+  name: "x"
+  op: "Placeholder"
+  attr {
+    key: "dtype"
+    value {
+      type: DT_FLOAT
+    }
+  }`,
+		`node {
+  name: "sum"
+  op: "Add"
+  input: "x"
+  input: "c"
+  attr {
+    key: "T"
+    value {
+      type: DT_FLOAT
+    }
+  }
+}`,
+		`      tensor {
+        dtype: DT_FLOAT
+        tensor_shape {
+          dim {
+            size: 2
+          }
+        }
+        tensor_content: "\000\000\300?\000\000\000\300"
+      }`,
+		"versions {\n  producer: ",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("%s\ndoes not contain\n%s", text, want)
+		}
+	}
+	// The attributes of Const are sorted by key.
+	if i, j := strings.Index(text, `key: "dtype"`), strings.Index(text, `key: "value"`); i < 0 || j < i {
+		t.Errorf("Attributes of c are not sorted:\n%s", text)
+	}
+
+	var again bytes.Buffer
+	if _, err := g.WriteTextTo(&again); err != nil {
+		t.Fatal(err)
+	}
+	if again.String() != text {
+		t.Error("WriteTextTo is not deterministic")
+	}
+}
+
+func TestTextFormatting(t *testing.T) {
+	if got, want := quoteText([]byte("a\"b\\c\n'\x01\xff")), `"a\"b\\c\n\'\001\377"`; got != want {
+		t.Errorf("Got %s, want %s", got, want)
+	}
+	tests := []struct {
+		kind textKind
+		v    uint64
+		want string
+	}{
+		{textInt, math.MaxUint64, "-1"},
+		{textUint, math.MaxUint64, "18446744073709551615"},
+		{textBool, 1, "true"},
+		{textDataType, uint64(Int64), "DT_INT64"},
+		{textDataType, uint64(Float) + 100, "DT_FLOAT_REF"},
+		{textDataType, 99, "99"},
+		{textFloat, uint64(math.Float32bits(0.1)), "0.1"},
+		{textFloat, uint64(math.Float32bits(float32(math.Inf(-1)))), "-inf"},
+		{textDouble, math.Float64bits(1e100), "1e+100"},
+	}
+	for _, test := range tests {
+		if got := formatText(test.kind, test.v); got != test.want {
+			t.Errorf("formatText(%v, %d) = %q, want %q", test.kind, test.v, got, test.want)
+		}
+	}
+}
+
+func TestReadFields(t *testing.T) {
+	b := appendBytesField(nil, 3, []byte("a"))
+	b = appendVarintField(b, 1, 7)
+	b = append(b, 2<<3|5, 1, 0, 0, 0)             // fixed32
+	b = append(b, 2<<3|1, 2, 0, 0, 0, 0, 0, 0, 0) // fixed64
+	b = appendBytesField(b, 3, []byte("b"))
+	fields, err := readFields(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []rawField{
+		{num: 1, wire: 0, v: 7},
+		{num: 2, wire: 5, v: 1},
+		{num: 2, wire: 1, v: 2},
+		{num: 3, wire: 2, b: []byte("a")},
+		{num: 3, wire: 2, b: []byte("b")},
+	}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("Got %+v, want %+v", fields, want)
+	}
+	if _, err := readFields(b[:len(b)-1]); err == nil {
+		t.Error("readFields succeeded with a truncated message")
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hostop
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// Fingerprint64 returns the 64-bit fingerprint of s, which is what
+// TensorFlow's StringToHashBucketFast uses to pick a bucket. It is a port
+// of farmhashna::Hash64 from https://github.com/google/farmhash.
+func Fingerprint64(s []byte) uint64 {
+	n := len(s)
+	switch {
+	case n <= 16:
+		return hashLen0to16(s)
+	case n <= 32:
+		return hashLen17to32(s)
+	case n <= 64:
+		return hashLen33to64(s)
+	}
+
+	x := uint64(81)
+	y := x*k1 + 113
+	z := shiftMix(y*k2+113) * k2
+	var v, w [2]uint64
+	x = x*k2 + fetch64(s, 0)
+
+	end := ((n - 1) / 64) * 64
+	last64 := end + ((n - 1) & 63) - 63
+	for i := 0; i != end; i += 64 {
+		p := s[i:]
+		x = rotate(x+y+v[0]+fetch64(p, 8), 37) * k1
+		y = rotate(y+v[1]+fetch64(p, 48), 42) * k1
+		x ^= w[1]
+		y += v[0] + fetch64(p, 40)
+		z = rotate(z+w[0], 33) * k1
+		v = weakHashLen32WithSeeds(p, v[1]*k1, x+w[0])
+		w = weakHashLen32WithSeeds(p[32:], z+w[1], y+fetch64(p, 16))
+		z, x = x, z
+	}
+	mul := k1 + ((z & 0xff) << 1)
+	p := s[last64:]
+	w[0] += uint64((n - 1) & 63)
+	v[0] += w[0]
+	w[0] += v[0]
+	x = rotate(x+y+v[0]+fetch64(p, 8), 37) * mul
+	y = rotate(y+v[1]+fetch64(p, 48), 42) * mul
+	x ^= w[1] * 9
+	y += v[0]*9 + fetch64(p, 40)
+	z = rotate(z+w[0], 33) * mul
+	v = weakHashLen32WithSeeds(p, v[1]*mul, x+w[0])
+	w = weakHashLen32WithSeeds(p[32:], z+w[1], y+fetch64(p, 16))
+	z, x = x, z
+	return hashLen16(hashLen16(v[0], w[0], mul)+shiftMix(y)*k0+z,
+		hashLen16(v[1], w[1], mul)+x, mul)
+}
+
+const (
+	k0 uint64 = 0xc3a5c85c97cb3127
+	k1 uint64 = 0xb492b66fbe98f273
+	k2 uint64 = 0x9ae16a3b2f90404f
+)
+
+func fetch64(s []byte, i int) uint64 { return binary.LittleEndian.Uint64(s[i:]) }
+
+func fetch32(s []byte, i int) uint64 { return uint64(binary.LittleEndian.Uint32(s[i:])) }
+
+func rotate(v uint64, shift uint) uint64 { return bits.RotateLeft64(v, -int(shift)) }
+
+func shiftMix(v uint64) uint64 { return v ^ (v >> 47) }
+
+func hashLen16(u, v, mul uint64) uint64 {
+	a := (u ^ v) * mul
+	a ^= a >> 47
+	b := (v ^ a) * mul
+	b ^= b >> 47
+	return b * mul
+}
+
+func hashLen0to16(s []byte) uint64 {
+	n := len(s)
+	switch {
+	case n >= 8:
+		mul := k2 + uint64(n)*2
+		a := fetch64(s, 0) + k2
+		b := fetch64(s, n-8)
+		c := rotate(b, 37)*mul + a
+		d := (rotate(a, 25) + b) * mul
+		return hashLen16(c, d, mul)
+	case n >= 4:
+		mul := k2 + uint64(n)*2
+		a := fetch32(s, 0)
+		return hashLen16(uint64(n)+(a<<3), fetch32(s, n-4), mul)
+	case n > 0:
+		a, b, c := uint32(s[0]), uint32(s[n>>1]), uint32(s[n-1])
+		y := a + (b << 8)
+		z := uint32(n) + (c << 2)
+		return shiftMix(uint64(y)*k2^uint64(z)*k0) * k2
+	}
+	return k2
+}
+
+func hashLen17to32(s []byte) uint64 {
+	n := len(s)
+	mul := k2 + uint64(n)*2
+	a := fetch64(s, 0) * k1
+	b := fetch64(s, 8)
+	c := fetch64(s, n-8) * mul
+	d := fetch64(s, n-16) * k2
+	return hashLen16(rotate(a+b, 43)+rotate(c, 30)+d, a+rotate(b+k2, 18)+c, mul)
+}
+
+func hashLen33to64(s []byte) uint64 {
+	n := len(s)
+	mul := k2 + uint64(n)*2
+	a := fetch64(s, 0) * k2
+	b := fetch64(s, 8)
+	c := fetch64(s, n-8) * mul
+	d := fetch64(s, n-16) * k2
+	y := rotate(a+b, 43) + rotate(c, 30) + d
+	z := hashLen16(y, a+rotate(b+k2, 18)+c, mul)
+	e := fetch64(s, 16) * mul
+	f := fetch64(s, 24)
+	g := (y + fetch64(s, n-32)) * mul
+	h := (z + fetch64(s, n-24)) * mul
+	return hashLen16(rotate(e+f, 43)+rotate(g, 30)+h, e+rotate(f+a, 18)+g, mul)
+}
+
+func weakHashLen32WithSeeds(s []byte, a, b uint64) [2]uint64 {
+	w, x, y, z := fetch64(s, 0), fetch64(s, 8), fetch64(s, 16), fetch64(s, 24)
+	a += w
+	b = rotate(b+a+z, 21)
+	c := a
+	a += x
+	a += y
+	b += rotate(a, 44)
+	return [2]uint64{a + z, b + c}
+}
@@ -0,0 +1,92 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hostop provides pure-Go implementations of a few TensorFlow
+// operations commonly used for input preprocessing.
+//
+// The implementations produce the same results as the kernels they mirror,
+// so preprocessing can run on the host when a session round-trip is not
+// worth it (for example for a single request) and in a graph otherwise
+// (see signature.Prepend) without the two diverging.
+//
+// Fallbacks are registered for StringJoin, StringSplit and
+// StringToHashBucketFast. The runtime the Go API is built against has no
+// regular expression or case conversion operations, so there is nothing
+// for a host-side version of those to be identical to; applications that
+// need them can Register their own.
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package hostop
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+// Func computes the outputs of an operation from its inputs and
+// attributes, using the same conventions as tf.OpSpec.
+type Func func(inputs []*tf.Tensor, attrs map[string]interface{}) ([]*tf.Tensor, error)
+
+var (
+	mu       sync.RWMutex
+	registry = make(map[string]Func)
+)
+
+// Register makes f the host implementation of operations of type opType,
+// replacing any previously registered one.
+func Register(opType string, f Func) {
+	mu.Lock()
+	defer mu.Unlock()
+	registry[opType] = f
+}
+
+// Lookup returns the host implementation of operations of type opType.
+func Lookup(opType string) (Func, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	f, ok := registry[opType]
+	return f, ok
+}
+
+// Registered returns the sorted list of operation types that have a host
+// implementation.
+func Registered() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	types := make([]string, 0, len(registry))
+	for t := range registry {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Run executes the host implementation of an operation of type opType.
+func Run(opType string, inputs []*tf.Tensor, attrs map[string]interface{}) ([]*tf.Tensor, error) {
+	f, ok := Lookup(opType)
+	if !ok {
+		return nil, fmt.Errorf("no host implementation of %q", opType)
+	}
+	return f(inputs, attrs)
+}
+
+func init() {
+	Register("StringJoin", stringJoin)
+	Register("StringSplit", stringSplit)
+	Register("StringToHashBucketFast", stringToHashBucketFast)
+}
@@ -0,0 +1,173 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hostop
+
+import (
+	"reflect"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+func TestFingerprint64(t *testing.T) {
+	tests := []struct {
+		s    string
+		want uint64
+	}{
+		{"", 0x9ae16a3b2f90404f},
+		{"a", 12917804110809363939},
+		{"b", 11795596070477164822},
+		{"c", 11430444447143000872},
+		{"d", 4470636696479570465},
+		{"Hello", 15404698994557526151},
+		{"World", 18308117990299812472},
+	}
+	for _, test := range tests {
+		if got := Fingerprint64([]byte(test.s)); got != test.want {
+			t.Errorf("Fingerprint64(%q) = %d, want %d", test.s, got, test.want)
+		}
+	}
+}
+
+func TestStringSplit(t *testing.T) {
+	indices, values, shape := StringSplit([]string{"a b,c", "", ",,d"}, " ,")
+	if want := [][]int64{{0, 0}, {0, 1}, {0, 2}, {2, 0}}; !reflect.DeepEqual(indices, want) {
+		t.Errorf("indices: got %v, want %v", indices, want)
+	}
+	if want := []string{"a", "b", "c", "d"}; !reflect.DeepEqual(values, want) {
+		t.Errorf("values: got %v, want %v", values, want)
+	}
+	if want := []int64{3, 3}; !reflect.DeepEqual(shape, want) {
+		t.Errorf("shape: got %v, want %v", shape, want)
+	}
+}
+
+// TestGraphParity checks that the host implementations produce the same
+// outputs as the kernels they mirror.
+func TestGraphParity(t *testing.T) {
+	input := []string{"hello world", "a,b  c", "", "x", "résumé, naïve"}
+	in, err := tf.NewTensor(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	comma, err := tf.NewTensor(", ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	empty, err := tf.NewTensor("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	prefix, err := tf.NewTensor("id")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		graph  func(s *op.Scope, inputs []tf.Output) []tf.Output
+		inputs []*tf.Tensor
+		attrs  map[string]interface{}
+	}{
+		{
+			name: "StringSplit",
+			graph: func(s *op.Scope, inputs []tf.Output) []tf.Output {
+				indices, values, shape := op.StringSplit(s, inputs[0], inputs[1])
+				return []tf.Output{indices, values, shape}
+			},
+			inputs: []*tf.Tensor{in, comma},
+		},
+		{
+			name: "StringSplit",
+			graph: func(s *op.Scope, inputs []tf.Output) []tf.Output {
+				indices, values, shape := op.StringSplit(s, inputs[0], inputs[1])
+				return []tf.Output{indices, values, shape}
+			},
+			inputs: []*tf.Tensor{in, empty},
+		},
+		{
+			name: "StringToHashBucketFast",
+			graph: func(s *op.Scope, inputs []tf.Output) []tf.Output {
+				return []tf.Output{op.StringToHashBucketFast(s, inputs[0], 1000)}
+			},
+			inputs: []*tf.Tensor{in},
+			attrs:  map[string]interface{}{"num_buckets": int64(1000)},
+		},
+		{
+			name: "StringJoin",
+			graph: func(s *op.Scope, inputs []tf.Output) []tf.Output {
+				return []tf.Output{op.StringJoin(s, inputs, op.StringJoinSeparator("/"))}
+			},
+			inputs: []*tf.Tensor{prefix, in, in},
+			attrs:  map[string]interface{}{"separator": "/"},
+		},
+	}
+	for _, test := range tests {
+		s := op.NewScope()
+		feeds := make(map[tf.Output]*tf.Tensor)
+		var placeholders []tf.Output
+		for _, in := range test.inputs {
+			p := op.Placeholder(s.SubScope("input"), in.DataType())
+			feeds[p] = in
+			placeholders = append(placeholders, p)
+		}
+		fetches := test.graph(s, placeholders)
+		graph, err := s.Finalize()
+		if err != nil {
+			t.Fatal(err)
+		}
+		sess, err := tf.NewSession(graph, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := sess.Run(feeds, fetches, nil)
+		sess.Close()
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+
+		got, err := Run(test.name, test.inputs, test.attrs)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if len(got) != len(want) {
+			t.Errorf("%s: got %d outputs, want %d", test.name, len(got), len(want))
+			continue
+		}
+		for i := range got {
+			if !reflect.DeepEqual(got[i].Shape(), want[i].Shape()) || !reflect.DeepEqual(got[i].Value(), want[i].Value()) {
+				t.Errorf("%s: output %d: got %v, want %v", test.name, i, got[i].Value(), want[i].Value())
+			}
+		}
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	in, err := tf.NewTensor([]string{"a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Run("RegexReplace", []*tf.Tensor{in}, nil); err == nil {
+		t.Error("expected an error for an operation without a host implementation")
+	}
+	if _, err := Run("StringToHashBucketFast", []*tf.Tensor{in}, nil); err == nil {
+		t.Error("expected an error for a missing num_buckets attribute")
+	}
+	if _, err := Run("StringSplit", []*tf.Tensor{in, in}, nil); err == nil {
+		t.Error("expected an error for a non-scalar delimiter")
+	}
+}
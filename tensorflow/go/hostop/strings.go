@@ -0,0 +1,265 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hostop
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"strings"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+// StringSplit splits each element of input on any of the bytes in
+// delimiter, skipping empty tokens, or into single bytes if delimiter is
+// empty. The tokens are returned as a SparseTensor: the [row, column] of
+// each token, the tokens, and the dense shape [len(input), max tokens].
+func StringSplit(input []string, delimiter string) (indices [][]int64, values []string, shape []int64) {
+	indices, values = [][]int64{}, []string{}
+	var width int64
+	for i, s := range input {
+		var tokens []string
+		if delimiter == "" {
+			tokens = make([]string, len(s))
+			for j := range s {
+				tokens[j] = s[j : j+1]
+			}
+		} else {
+			tokens = splitAny(s, delimiter)
+		}
+		for j, t := range tokens {
+			indices = append(indices, []int64{int64(i), int64(j)})
+			values = append(values, t)
+		}
+		if n := int64(len(tokens)); n > width {
+			width = n
+		}
+	}
+	return indices, values, []int64{int64(len(input)), width}
+}
+
+// splitAny is strings.FieldsFunc on bytes rather than runes, as the
+// StringSplit kernel treats the delimiter as a set of bytes.
+func splitAny(s, delimiter string) []string {
+	var tokens []string
+	start := 0
+	for i := 0; i <= len(s); i++ {
+		if i < len(s) && strings.IndexByte(delimiter, s[i]) < 0 {
+			continue
+		}
+		if i > start {
+			tokens = append(tokens, s[start:i])
+		}
+		start = i + 1
+	}
+	return tokens
+}
+
+// StringToHashBucketFast returns the bucket in [0, numBuckets) that
+// TensorFlow's StringToHashBucketFast operation assigns to s.
+func StringToHashBucketFast(s string, numBuckets int64) int64 {
+	return int64(Fingerprint64([]byte(s)) % uint64(numBuckets))
+}
+
+func stringSplit(inputs []*tf.Tensor, attrs map[string]interface{}) ([]*tf.Tensor, error) {
+	if err := checkInputs("StringSplit", inputs, 2); err != nil {
+		return nil, err
+	}
+	if len(inputs[0].Shape()) != 1 {
+		return nil, fmt.Errorf("StringSplit: input must be a vector, got shape %v", inputs[0].Shape())
+	}
+	if len(inputs[1].Shape()) != 0 {
+		return nil, fmt.Errorf("StringSplit: delimiter must be a scalar, got shape %v", inputs[1].Shape())
+	}
+	input, err := stringsOf(inputs[0])
+	if err != nil {
+		return nil, err
+	}
+	delimiter, err := stringsOf(inputs[1])
+	if err != nil {
+		return nil, err
+	}
+	indices, values, shape := StringSplit(input, delimiter[0])
+	flat := make([]int64, 0, 2*len(indices))
+	for _, idx := range indices {
+		flat = append(flat, idx...)
+	}
+	return newTensors(
+		func() (*tf.Tensor, error) { return int64Tensor(flat, []int64{int64(len(indices)), 2}) },
+		func() (*tf.Tensor, error) { return tf.NewTensor(values) },
+		func() (*tf.Tensor, error) { return tf.NewTensor(shape) },
+	)
+}
+
+func stringToHashBucketFast(inputs []*tf.Tensor, attrs map[string]interface{}) ([]*tf.Tensor, error) {
+	if err := checkInputs("StringToHashBucketFast", inputs, 1); err != nil {
+		return nil, err
+	}
+	numBuckets, err := intAttr(attrs, "num_buckets")
+	if err != nil {
+		return nil, fmt.Errorf("StringToHashBucketFast: %v", err)
+	}
+	if numBuckets < 1 {
+		return nil, fmt.Errorf("StringToHashBucketFast: num_buckets must be at least 1, got %d", numBuckets)
+	}
+	input, err := stringsOf(inputs[0])
+	if err != nil {
+		return nil, err
+	}
+	buckets := make([]int64, len(input))
+	for i, s := range input {
+		buckets[i] = StringToHashBucketFast(s, numBuckets)
+	}
+	return newTensors(func() (*tf.Tensor, error) { return int64Tensor(buckets, inputs[0].Shape()) })
+}
+
+func stringJoin(inputs []*tf.Tensor, attrs map[string]interface{}) ([]*tf.Tensor, error) {
+	var separator string
+	if v, ok := attrs["separator"]; ok {
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("StringJoin: attribute separator must be a string, not %T", v)
+		}
+		separator = s
+	}
+	var shape []int64
+	flat := make([][]string, len(inputs))
+	for i, t := range inputs {
+		if t.DataType() != tf.String {
+			return nil, fmt.Errorf("StringJoin: input %d has type %v, not String", i, t.DataType())
+		}
+		var err error
+		if flat[i], err = stringsOf(t); err != nil {
+			return nil, err
+		}
+		if len(t.Shape()) == 0 {
+			continue
+		}
+		if shape == nil {
+			shape = t.Shape()
+		} else if !reflect.DeepEqual(shape, t.Shape()) {
+			return nil, fmt.Errorf("StringJoin: input shapes do not match: %v vs. %v", shape, t.Shape())
+		}
+	}
+	n := 1
+	for _, d := range shape {
+		n *= int(d)
+	}
+	output := make([]string, n)
+	parts := make([]string, len(inputs))
+	for i := range output {
+		for j, f := range flat {
+			if len(inputs[j].Shape()) == 0 {
+				parts[j] = f[0]
+			} else {
+				parts[j] = f[i]
+			}
+		}
+		output[i] = strings.Join(parts, separator)
+	}
+	return newTensors(func() (*tf.Tensor, error) { return stringTensor(output, shape) })
+}
+
+func checkInputs(opType string, inputs []*tf.Tensor, n int) error {
+	if len(inputs) != n {
+		return fmt.Errorf("%s: expected %d inputs, got %d", opType, n, len(inputs))
+	}
+	for i, t := range inputs {
+		if t.DataType() != tf.String {
+			return fmt.Errorf("%s: input %d has type %v, not String", opType, i, t.DataType())
+		}
+	}
+	return nil
+}
+
+func intAttr(attrs map[string]interface{}, name string) (int64, error) {
+	switch v := attrs[name].(type) {
+	case int64:
+		return v, nil
+	case int:
+		return int64(v), nil
+	case nil:
+		return 0, fmt.Errorf("missing attribute %s", name)
+	default:
+		return 0, fmt.Errorf("attribute %s must be an integer, not %T", name, v)
+	}
+}
+
+// newTensors creates the outputs of an operation, stopping at the first
+// error.
+func newTensors(fns ...func() (*tf.Tensor, error)) ([]*tf.Tensor, error) {
+	tensors := make([]*tf.Tensor, len(fns))
+	for i, fn := range fns {
+		var err error
+		if tensors[i], err = fn(); err != nil {
+			return nil, err
+		}
+	}
+	return tensors, nil
+}
+
+// stringsOf returns the elements of a String Tensor of any shape, in
+// row-major order.
+func stringsOf(t *tf.Tensor) ([]string, error) {
+	val, err := t.DecodeValue()
+	if err != nil {
+		return nil, err
+	}
+	var flat []string
+	var walk func(v reflect.Value)
+	walk = func(v reflect.Value) {
+		if v.Kind() != reflect.Slice {
+			flat = append(flat, v.String())
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			walk(v.Index(i))
+		}
+	}
+	walk(reflect.ValueOf(val))
+	return flat, nil
+}
+
+// stringTensor is the inverse of stringsOf.
+func stringTensor(flat []string, shape []int64) (*tf.Tensor, error) {
+	typ := reflect.TypeOf("")
+	for range shape {
+		typ = reflect.SliceOf(typ)
+	}
+	var build func(typ reflect.Type, dims []int64) reflect.Value
+	build = func(typ reflect.Type, dims []int64) reflect.Value {
+		if len(dims) == 0 {
+			v := reflect.ValueOf(flat[0])
+			flat = flat[1:]
+			return v
+		}
+		s := reflect.MakeSlice(typ, int(dims[0]), int(dims[0]))
+		for i := 0; i < s.Len(); i++ {
+			s.Index(i).Set(build(typ.Elem(), dims[1:]))
+		}
+		return s
+	}
+	return tf.NewTensor(build(typ, shape).Interface())
+}
+
+func int64Tensor(flat []int64, shape []int64) (*tf.Tensor, error) {
+	var buf bytes.Buffer
+	if err := binary.Write(&buf, binary.LittleEndian, flat); err != nil {
+		return nil, err
+	}
+	return tf.ReadTensor(tf.Int64, shape, &buf)
+}
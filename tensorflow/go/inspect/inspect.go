@@ -0,0 +1,286 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package inspect summarizes the graphs loaded by a program, and serves the
+// summaries over HTTP for quick inspection of what a production binary
+// actually loaded:
+//
+//	http.Handle("/debug/model", inspect.Handler(model))
+//
+// The handler is opt-in, as the summaries reveal the structure of the
+// model: it should only be registered on debugging endpoints.
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package inspect
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+	"github.com/tensorflow/tensorflow/tensorflow/go/signature"
+)
+
+// MaxConstants is the number of constants listed in Summary.LargestConstants.
+const MaxConstants = 10
+
+// Summary describes a graph.
+type Summary struct {
+	// Operations is the number of operations in the graph.
+	Operations int `json:"operations"`
+	// OpTypes and Devices count the operations by type and by requested
+	// device (the empty string for operations without a device), in
+	// decreasing order.
+	OpTypes []Count `json:"op_types"`
+	Devices []Count `json:"devices"`
+	// Signatures lists the signatures of the model, by key.
+	Signatures []Signature `json:"signatures,omitempty"`
+	// LargestConstants lists the MaxConstants largest constants, in
+	// decreasing order of size.
+	LargestConstants []Constant `json:"largest_constants"`
+}
+
+// Count is the number of operations with a property, such as their type.
+type Count struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// Signature describes a signature of a model.
+type Signature struct {
+	Key        string   `json:"key"`
+	MethodName string   `json:"method_name"`
+	Inputs     []Tensor `json:"inputs"`
+	Outputs    []Tensor `json:"outputs"`
+}
+
+// Tensor describes an input or output of a Signature.
+type Tensor struct {
+	// Key is the name of the tensor in the signature, and Name its name
+	// in the graph.
+	Key      string `json:"key"`
+	Name     string `json:"name"`
+	DataType string `json:"dtype"`
+	Shape    string `json:"shape"`
+}
+
+// Constant describes a Const operation.
+type Constant struct {
+	Name     string `json:"name"`
+	DataType string `json:"dtype"`
+	Shape    string `json:"shape"`
+	Bytes    int64  `json:"bytes"`
+}
+
+// Summarize returns the Summary of a loaded SavedModel.
+func Summarize(m *tf.SavedModel) (*Summary, error) {
+	var buf bytes.Buffer
+	if _, err := m.Graph.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+	s, err := SummarizeGraphDef(buf.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	sigs, err := signature.FromSavedModel(m)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(sigs))
+	for key := range sigs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		sig := sigs[key]
+		s.Signatures = append(s.Signatures, Signature{
+			Key:        key,
+			MethodName: sig.MethodName,
+			Inputs:     tensors(sig.Inputs),
+			Outputs:    tensors(sig.Outputs),
+		})
+	}
+	return s, nil
+}
+
+func tensors(infos map[string]signature.TensorInfo) []Tensor {
+	ret := make([]Tensor, 0, len(infos))
+	for key, info := range infos {
+		ret = append(ret, Tensor{Key: key, Name: info.Name, DataType: info.DataType.String(), Shape: info.Shape.String()})
+	}
+	sort.Sort(tensorsByKey(ret))
+	return ret
+}
+
+// SummarizeGraphDef returns the Summary of a graph given as a serialized
+// tensorflow.GraphDef protocol buffer
+// (https://www.tensorflow.org/code/tensorflow/core/framework/graph.proto).
+// The summary has no signatures.
+func SummarizeGraphDef(graphDef []byte) (*Summary, error) {
+	var def pb.GraphDef
+	if err := proto.Unmarshal(graphDef, &def); err != nil {
+		return nil, fmt.Errorf("invalid GraphDef: %v", err)
+	}
+	types := make(map[string]int)
+	devices := make(map[string]int)
+	var consts []Constant
+	for _, n := range def.Node {
+		types[n.Op]++
+		devices[n.Device]++
+		if t := n.Attr["value"].GetTensor(); n.Op == "Const" && t != nil {
+			consts = append(consts, constant(n.Name, t))
+		}
+	}
+	sort.Sort(constantsBySize(consts))
+	if len(consts) > MaxConstants {
+		consts = consts[:MaxConstants]
+	}
+	return &Summary{
+		Operations:       len(def.Node),
+		OpTypes:          counts(types),
+		Devices:          counts(devices),
+		LargestConstants: consts,
+	}, nil
+}
+
+func constant(name string, t *pb.TensorProto) Constant {
+	dt := tf.DataType(t.Dtype)
+	var dims []int64
+	elements := int64(1)
+	for _, d := range t.TensorShape.GetDim() {
+		dims = append(dims, d.Size)
+		elements *= d.Size
+	}
+	c := Constant{Name: name, DataType: dt.String(), Shape: tf.MakeShape(dims...).String()}
+	if dt == tf.String {
+		for _, s := range t.StringVal {
+			c.Bytes += int64(len(s))
+		}
+	} else {
+		c.Bytes = elements * int64(dt.Size())
+	}
+	return c
+}
+
+func counts(m map[string]int) []Count {
+	ret := make([]Count, 0, len(m))
+	for name, n := range m {
+		ret = append(ret, Count{Name: name, Count: n})
+	}
+	sort.Sort(byCount(ret))
+	return ret
+}
+
+type byCount []Count
+
+func (c byCount) Len() int      { return len(c) }
+func (c byCount) Swap(i, j int) { c[i], c[j] = c[j], c[i] }
+func (c byCount) Less(i, j int) bool {
+	if c[i].Count != c[j].Count {
+		return c[i].Count > c[j].Count
+	}
+	return c[i].Name < c[j].Name
+}
+
+type constantsBySize []Constant
+
+func (c constantsBySize) Len() int      { return len(c) }
+func (c constantsBySize) Swap(i, j int) { c[i], c[j] = c[j], c[i] }
+func (c constantsBySize) Less(i, j int) bool {
+	if c[i].Bytes != c[j].Bytes {
+		return c[i].Bytes > c[j].Bytes
+	}
+	return c[i].Name < c[j].Name
+}
+
+type tensorsByKey []Tensor
+
+func (t tensorsByKey) Len() int           { return len(t) }
+func (t tensorsByKey) Swap(i, j int)      { t[i], t[j] = t[j], t[i] }
+func (t tensorsByKey) Less(i, j int) bool { return t[i].Key < t[j].Key }
+
+// Handler returns an http.Handler serving the Summary of m, as an HTML page
+// or, if the request has a "format=json" query parameter, as JSON. The
+// summary is computed by the first request and cached, as summarizing a
+// model reads all its constants: operations added to the graph afterwards
+// are not included.
+func Handler(m *tf.SavedModel) http.Handler {
+	var (
+		mu      sync.Mutex
+		summary *Summary
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		s := summary
+		if s == nil {
+			var err error
+			if s, err = Summarize(m); err != nil {
+				mu.Unlock()
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			summary = s
+		}
+		mu.Unlock()
+		if r.FormValue("format") == "json" {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(s)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if err := summaryPage.Execute(w, s); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
+
+var summaryPage = template.Must(template.New("summary").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Model summary</title></head>
+<body>
+<h1>Model summary</h1>
+<p>{{.Operations}} operations. <a href="?format=json">JSON</a></p>
+{{range .Signatures}}
+<h2>Signature {{.Key}}</h2>
+<p>{{.MethodName}}</p>
+<table>
+<tr><th></th><th>Key</th><th>Tensor</th><th>Type</th><th>Shape</th></tr>
+{{range .Inputs}}<tr><td>input</td><td>{{.Key}}</td><td>{{.Name}}</td><td>{{.DataType}}</td><td>{{.Shape}}</td></tr>
+{{end}}{{range .Outputs}}<tr><td>output</td><td>{{.Key}}</td><td>{{.Name}}</td><td>{{.DataType}}</td><td>{{.Shape}}</td></tr>
+{{end}}</table>
+{{end}}
+<h2>Operations by type</h2>
+<table>
+{{range .OpTypes}}<tr><td>{{.Name}}</td><td>{{.Count}}</td></tr>
+{{end}}</table>
+<h2>Operations by device</h2>
+<table>
+{{range .Devices}}<tr><td>{{if .Name}}{{.Name}}{{else}}(none){{end}}</td><td>{{.Count}}</td></tr>
+{{end}}</table>
+<h2>Largest constants</h2>
+<table>
+<tr><th>Name</th><th>Type</th><th>Shape</th><th>Bytes</th></tr>
+{{range .LargestConstants}}<tr><td>{{.Name}}</td><td>{{.DataType}}</td><td>{{.Shape}}</td><td>{{.Bytes}}</td></tr>
+{{end}}</table>
+</body>
+</html>
+`))
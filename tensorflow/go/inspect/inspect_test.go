@@ -0,0 +1,98 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inspect
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+func TestSummarizeGraphDef(t *testing.T) {
+	s := op.NewScope()
+	x := op.Placeholder(s.SubScope("x"), tf.Float)
+	w := op.Const(s.SubScope("w"), [][]float32{{1, 2}, {3, 4}})
+	b := op.Const(s.SubScope("b"), []float32{1, 2})
+	op.Add(s.WithDevice("/device:CPU:0"), op.MatMul(s, x, w), b)
+	graph, err := s.Finalize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if _, err := graph.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	got, err := SummarizeGraphDef(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Summary{
+		Operations: 5,
+		OpTypes:    []Count{{"Const", 2}, {"Add", 1}, {"MatMul", 1}, {"Placeholder", 1}},
+		Devices:    []Count{{"", 4}, {"/device:CPU:0", 1}},
+		LargestConstants: []Constant{
+			{Name: "w/Const", DataType: "float32", Shape: "[2, 2]", Bytes: 16},
+			{Name: "b/Const", DataType: "float32", Shape: "[2]", Bytes: 8},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Got %+v, want %+v", got, want)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	m, err := tf.LoadSavedModel("../../cc/saved_model/testdata/half_plus_two/00000123", []string{"serve"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+	h := Handler(m)
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/debug/model?format=json", nil))
+	var s Summary
+	if err := json.NewDecoder(w.Body).Decode(&s); err != nil {
+		t.Fatal(err)
+	}
+	if s.Operations == 0 || len(s.Signatures) == 0 {
+		t.Errorf("Got an incomplete summary %+v", s)
+	}
+
+	// The summary is cached.
+	if _, err := m.Graph.AddOperation(tf.OpSpec{Type: "NoOp", Name: "added"}); err != nil {
+		t.Fatal(err)
+	}
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/debug/model?format=json", nil))
+	var cached Summary
+	if err := json.NewDecoder(w.Body).Decode(&cached); err != nil {
+		t.Fatal(err)
+	}
+	if cached.Operations != s.Operations {
+		t.Errorf("Got %d operations, want the %d cached", cached.Operations, s.Operations)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/debug/model", nil))
+	if body := w.Body.String(); !strings.Contains(body, "regress_x_to_y") {
+		t.Errorf("Signature regress_x_to_y not found in page:\n%s", body)
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interactive
+
+import (
+	"errors"
+	"sync"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+// Context holds a graph to which operations are added interactively, and a
+// session evaluating them, standing in for the eager execution of the
+// Python API:
+//
+//	s := interactive.Default().Scope()
+//	sum := op.Add(s, op.Const(s.SubScope("a"), int32(1)), op.Const(s.SubScope("b"), int32(2)))
+//	interactive.Eval(sum)
+//
+// The methods of Context are safe for concurrent use.
+type Context struct {
+	mu      sync.Mutex
+	scope   *op.Scope
+	graph   *tf.Graph
+	opts    *tf.SessionOptions
+	session *tf.Session // Created by the first Eval.
+	closed  bool
+}
+
+// NewContext returns a Context with an empty graph, evaluating operations
+// in sessions created with opts, which may be nil.
+func NewContext(opts *tf.SessionOptions) *Context {
+	g := tf.NewGraph()
+	return &Context{scope: op.NewScopeWithGraph(g), graph: g, opts: opts}
+}
+
+// Scope returns the Scope adding operations to the graph of c. It must not
+// be finalized.
+func (c *Context) Scope() *op.Scope {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.scope
+}
+
+// Eval runs the operations needed to compute outputs, which must be
+// operations of the graph of c, and returns their values.
+func (c *Context) Eval(outputs ...tf.Output) ([]*tf.Tensor, error) {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return nil, errors.New("context is closed")
+	}
+	if err := c.scope.Err(); err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+	if c.session == nil {
+		var err error
+		if c.session, err = tf.NewSession(c.graph, c.opts); err != nil {
+			c.mu.Unlock()
+			return nil, err
+		}
+	}
+	session := c.session
+	c.mu.Unlock()
+	// A concurrent Reset waits for the run to complete before closing the
+	// session.
+	return session.Run(nil, outputs, nil)
+}
+
+// Reset closes the session of c, discarding its state such as the values
+// of variables, so that the next Eval runs in a new session created with
+// opts, which may be nil. The operations of the graph are kept, so that
+// the Outputs obtained before the reset remain valid.
+func (c *Context) Reset(opts *tf.SessionOptions) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return errors.New("context is closed")
+	}
+	c.opts = opts
+	return c.closeSessionLocked()
+}
+
+// ResetGraph is like Reset, but also replaces the graph of c with an empty
+// one, with a new Scope, so that cells adding operations can be run again
+// without their names colliding with those of the operations they added
+// before. The Outputs and the Scope obtained before the reset must not be
+// used afterwards.
+func (c *Context) ResetGraph(opts *tf.SessionOptions) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return errors.New("context is closed")
+	}
+	c.opts = opts
+	if err := c.closeSessionLocked(); err != nil {
+		return err
+	}
+	old := c.graph
+	c.graph = tf.NewGraph()
+	c.scope = op.NewScopeWithGraph(c.graph)
+	return old.Close()
+}
+
+// Close closes the session and the graph of c, which must not be used
+// afterwards.
+func (c *Context) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	if err := c.closeSessionLocked(); err != nil {
+		return err
+	}
+	return c.graph.Close()
+}
+
+func (c *Context) closeSessionLocked() error {
+	if c.session == nil {
+		return nil
+	}
+	session := c.session
+	c.session = nil
+	return session.Close()
+}
+
+// defaultContext is the Context returned by Default.
+var defaultContext struct {
+	sync.Mutex
+	c *Context
+}
+
+// Default returns the Context shared by the cells of a notebook, creating
+// it with default session options on the first call, and again after it is
+// closed.
+func Default() *Context {
+	defaultContext.Lock()
+	defer defaultContext.Unlock()
+	if c := defaultContext.c; c == nil || c.isClosed() {
+		defaultContext.c = NewContext(nil)
+	}
+	return defaultContext.c
+}
+
+func (c *Context) isClosed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.closed
+}
+
+// Eval evaluates outputs in the Default Context.
+func Eval(outputs ...tf.Output) ([]*tf.Tensor, error) {
+	return Default().Eval(outputs...)
+}
+
+// Reset resets the Default Context with opts, see Context.Reset.
+func Reset(opts *tf.SessionOptions) error {
+	return Default().Reset(opts)
+}
+
+// ResetGraph resets the Default Context with opts and an empty graph, see
+// Context.ResetGraph.
+func ResetGraph(opts *tf.SessionOptions) error {
+	return Default().ResetGraph(opts)
+}
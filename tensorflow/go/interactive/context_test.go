@@ -0,0 +1,106 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interactive
+
+import (
+	"reflect"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+func TestContext(t *testing.T) {
+	c := NewContext(nil)
+	defer c.Close()
+	s := c.Scope()
+	sum := op.Add(s, op.Const(s.SubScope("a"), int32(1)), op.Const(s.SubScope("b"), int32(2)))
+	for i := 0; i < 2; i++ {
+		results, err := c.Eval(sum)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := results[0].Value(); !reflect.DeepEqual(got, int32(3)) {
+			t.Errorf("Got %v, want 3", got)
+		}
+		// Outputs remain valid after a reset.
+		if err := c.Reset(nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Operations added after the first Eval can be evaluated.
+	neg := op.Neg(s, sum)
+	results, err := c.Eval(neg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := results[0].Value(); !reflect.DeepEqual(got, int32(-3)) {
+		t.Errorf("Got %v, want -3", got)
+	}
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Eval(sum); err == nil {
+		t.Error("Eval succeeded after Close")
+	}
+}
+
+func TestContextResetGraph(t *testing.T) {
+	c := NewContext(nil)
+	defer c.Close()
+	// A cell run twice adds operations of the same names.
+	for i := 0; i < 2; i++ {
+		s := c.Scope().SubScope("cell")
+		results, err := c.Eval(op.Const(s, int32(i)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := results[0].Value(); !reflect.DeepEqual(got, int32(i)) {
+			t.Errorf("Got %v, want %d", got, i)
+		}
+		if err := c.ResetGraph(nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestDefault(t *testing.T) {
+	if Default() != Default() {
+		t.Error("Default returned different Contexts")
+	}
+	s := Default().Scope().SubScope("TestDefault")
+	results, err := Eval(op.Const(s, []float32{1, 2}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := Show(results[0]).String(), "Tensor(float32, [2])\n[1 2]"; got != want {
+		t.Errorf("Got %q, want %q", got, want)
+	}
+	if err := Reset(&tf.SessionOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// A new Default Context replaces a closed one.
+	closed := Default()
+	if err := closed.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if Default() == closed {
+		t.Error("Default returned a closed Context")
+	}
+	if _, err := Eval(op.Const(Default().Scope(), int32(1))); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interactive
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+	"github.com/tensorflow/tensorflow/tensorflow/go/inspect"
+)
+
+// MaxListedOperations is the number of operations above which FormatGraph
+// summarizes a graph instead of listing its operations.
+const MaxListedOperations = 50
+
+// FormatOptions controls the summarization of large tensors, as in NumPy.
+type FormatOptions struct {
+	// Threshold is the number of elements above which a tensor is
+	// summarized: only the first and last EdgeItems elements of each
+	// dimension are shown. It defaults to 1000.
+	Threshold int64
+	// EdgeItems defaults to 3.
+	EdgeItems int
+}
+
+func (o FormatOptions) withDefaults() FormatOptions {
+	if o.Threshold <= 0 {
+		o.Threshold = 1000
+	}
+	if o.EdgeItems <= 0 {
+		o.EdgeItems = 3
+	}
+	return o
+}
+
+// FormatTensor returns a representation of the type, shape and values of
+// t, for example:
+//
+//	Tensor(float32, [2, 3])
+//	[[1 2 3]
+//	 [4 5 6]]
+//
+// The values of tensors of types without a Go representation, such as
+// quantized types, are omitted.
+func FormatTensor(t *tf.Tensor, opts FormatOptions) string {
+	opts = opts.withDefaults()
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "Tensor(%v, %v)\n", t.DataType(), tf.MakeShape(t.Shape()...))
+	value, err := t.DecodeValue()
+	if err != nil {
+		fmt.Fprintf(&buf, "<%v>", err)
+		return buf.String()
+	}
+	writeArray(&buf, reflect.ValueOf(value), 0, numElements(t.Shape()) > opts.Threshold, opts.EdgeItems)
+	return buf.String()
+}
+
+// FormatOperation returns a one line description of op, with the
+// operations of its inputs and the types and shapes of its outputs, for
+// example:
+//
+//	MatMul = MatMul(x/Placeholder:0, w/Const:0) -> (float32 [?, 2]) on /device:CPU:0
+func FormatOperation(op *tf.Operation) string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%s = %s(", op.Name(), op.Type())
+	for i := 0; i < op.NumInputs(); i++ {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		in := op.Input(i)
+		fmt.Fprintf(&buf, "%s:%d", in.Op.Name(), in.Index)
+	}
+	buf.WriteString(") -> (")
+	for i := 0; i < op.NumOutputs(); i++ {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		out := op.Output(i)
+		fmt.Fprintf(&buf, "%v %v", out.DataType(), out.Shape())
+	}
+	buf.WriteString(")")
+	if device := op.Device(); device != "" {
+		fmt.Fprintf(&buf, " on %s", device)
+	}
+	return buf.String()
+}
+
+// FormatGraph returns a description of g: its operations, as formatted by
+// FormatOperation, or for graphs of more than MaxListedOperations
+// operations, the number of operations of each type and device.
+func FormatGraph(g *tf.Graph) (string, error) {
+	var def bytes.Buffer
+	if _, err := g.WriteTo(&def); err != nil {
+		return "", err
+	}
+	var gd pb.GraphDef
+	if err := proto.Unmarshal(def.Bytes(), &gd); err != nil {
+		return "", fmt.Errorf("invalid GraphDef: %v", err)
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "Graph with %d operations\n", len(gd.Node))
+	if len(gd.Node) <= MaxListedOperations {
+		for _, n := range gd.Node {
+			if op := g.Operation(n.Name); op != nil {
+				fmt.Fprintf(&buf, "  %s\n", FormatOperation(op))
+			}
+		}
+		return buf.String(), nil
+	}
+	summary, err := inspect.SummarizeGraphDef(def.Bytes())
+	if err != nil {
+		return "", err
+	}
+	for _, section := range []struct {
+		title  string
+		counts []inspect.Count
+	}{{"Types", summary.OpTypes}, {"Devices", summary.Devices}} {
+		fmt.Fprintf(&buf, "%s:\n", section.title)
+		for _, c := range section.counts {
+			name := c.Name
+			if name == "" {
+				name = "(none)"
+			}
+			fmt.Fprintf(&buf, "  %s: %d\n", name, c.Count)
+		}
+	}
+	return buf.String(), nil
+}
+
+// writeArray writes v, a scalar or nested slice at the given depth, like
+// NumPy does: the elements of the innermost dimension are separated by
+// spaces, and the rows of higher dimensions by as many newlines as they
+// have dimensions. If summarize is set, only the first and last edgeItems
+// elements of long dimensions are written.
+func writeArray(buf *bytes.Buffer, v reflect.Value, depth int, summarize bool, edgeItems int) {
+	if v.Kind() != reflect.Slice {
+		writeScalar(buf, v)
+		return
+	}
+	sep := " "
+	if rank := sliceRank(v.Type()); rank > 1 {
+		sep = strings.Repeat("\n", rank-1) + strings.Repeat(" ", depth+1)
+	}
+	buf.WriteByte('[')
+	n := v.Len()
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			buf.WriteString(sep)
+		}
+		if summarize && n > 2*edgeItems && i == edgeItems {
+			buf.WriteString("...")
+			buf.WriteString(sep)
+			i = n - edgeItems
+		}
+		writeArray(buf, v.Index(i), depth+1, summarize, edgeItems)
+	}
+	buf.WriteByte(']')
+}
+
+func writeScalar(buf *bytes.Buffer, v reflect.Value) {
+	switch v.Kind() {
+	case reflect.String:
+		fmt.Fprintf(buf, "%q", v.String())
+	default:
+		fmt.Fprint(buf, v.Interface())
+	}
+}
+
+func sliceRank(t reflect.Type) int {
+	var rank int
+	for ; t.Kind() == reflect.Slice; t = t.Elem() {
+		rank++
+	}
+	return rank
+}
+
+func numElements(shape []int64) int64 {
+	n := int64(1)
+	for _, d := range shape {
+		n *= d
+	}
+	return n
+}
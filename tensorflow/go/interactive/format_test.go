@@ -0,0 +1,69 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interactive
+
+import (
+	"strings"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+func TestFormatTensor(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		opts  FormatOptions
+		want  string
+	}{
+		{float32(1.5), FormatOptions{}, "Tensor(float32, [])\n1.5"},
+		{[]string{"a", "b"}, FormatOptions{}, "Tensor(string, [2])\n[\"a\" \"b\"]"},
+		{[][]int32{{1, 2}, {3, 4}}, FormatOptions{}, "Tensor(int32, [2, 2])\n[[1 2]\n [3 4]]"},
+		{[][][]int64{{{1, 2}, {3, 4}}, {{5, 6}, {7, 8}}}, FormatOptions{}, "Tensor(int64, [2, 2, 2])\n[[[1 2]\n  [3 4]]\n\n [[5 6]\n  [7 8]]]"},
+		{[]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, FormatOptions{Threshold: 5, EdgeItems: 2}, "Tensor(int32, [10])\n[0 1 ... 8 9]"},
+		{[]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, FormatOptions{}, "Tensor(int32, [10])\n[0 1 2 3 4 5 6 7 8 9]"},
+	}
+	for _, test := range tests {
+		tensor, err := tf.NewTensor(test.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := FormatTensor(tensor, test.opts); got != test.want {
+			t.Errorf("Got:\n%s\nWant:\n%s", got, test.want)
+		}
+	}
+}
+
+func TestFormatOperationAndGraph(t *testing.T) {
+	s := op.NewScope()
+	x := op.Placeholder(s.SubScope("x"), tf.Float, op.PlaceholderShape(tf.MakeShape(-1, 2)))
+	w := op.Const(s.SubScope("w"), [][]float32{{1, 2}, {3, 4}})
+	y := op.MatMul(s.WithDevice("/device:CPU:0"), x, w)
+	graph, err := s.Finalize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "MatMul = MatMul(x/Placeholder:0, w/Const:0) -> (float32 [?, 2]) on /device:CPU:0"
+	if got := FormatOperation(y.Op); got != want {
+		t.Errorf("Got %q, want %q", got, want)
+	}
+	got, err := FormatGraph(graph)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(got, "Graph with 3 operations\n") || !strings.Contains(got, "  "+want+"\n") {
+		t.Errorf("Got:\n%s", got)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interactive
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"reflect"
+	"strconv"
+	"strings"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+// MaxTableElements is the number of elements above which TensorHTML shows
+// the values of a tensor as text instead of a table.
+const MaxTableElements = 1000
+
+// TensorHTML returns an HTML fragment showing the type, shape and values of
+// t. The values of scalars, vectors and matrices of at most
+// MaxTableElements elements are shown in a table, and those of other
+// tensors as formatted by FormatTensor.
+func TensorHTML(t *tf.Tensor, opts FormatOptions) string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "<div><code>Tensor(%s, %s)</code></div>\n", html.EscapeString(t.DataType().String()), ShapeHTML(tf.MakeShape(t.Shape()...)))
+	value, err := t.DecodeValue()
+	shape := t.Shape()
+	if err != nil || len(shape) > 2 || numElements(shape) > MaxTableElements {
+		text := FormatTensor(t, opts)
+		// Without the first line, repeating the type and shape.
+		text = text[strings.IndexByte(text, '\n')+1:]
+		fmt.Fprintf(&buf, "<pre>%s</pre>", html.EscapeString(text))
+		return buf.String()
+	}
+	v := reflect.ValueOf(value)
+	buf.WriteString("<table>\n")
+	switch len(shape) {
+	case 0:
+		writeRow(&buf, []reflect.Value{v})
+	case 1:
+		writeRow(&buf, elements(v))
+	case 2:
+		for _, row := range elements(v) {
+			writeRow(&buf, elements(row))
+		}
+	}
+	buf.WriteString("</table>")
+	return buf.String()
+}
+
+// ShapeHTML returns an HTML fragment showing s, with unknown dimensions in
+// italics.
+func ShapeHTML(s tf.Shape) string {
+	n := s.NumDimensions()
+	if n < 0 {
+		return "<i>?</i>"
+	}
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		if size := s.Size(i); size < 0 {
+			buf.WriteString("<i>?</i>")
+		} else {
+			buf.WriteString(strconv.FormatInt(size, 10))
+		}
+	}
+	buf.WriteByte(']')
+	return buf.String()
+}
+
+func writeRow(buf *bytes.Buffer, values []reflect.Value) {
+	buf.WriteString("<tr>")
+	for _, v := range values {
+		var cell bytes.Buffer
+		writeScalar(&cell, v)
+		fmt.Fprintf(buf, "<td>%s</td>", html.EscapeString(cell.String()))
+	}
+	buf.WriteString("</tr>\n")
+}
+
+func elements(v reflect.Value) []reflect.Value {
+	ret := make([]reflect.Value, v.Len())
+	for i := range ret {
+		ret[i] = v.Index(i)
+	}
+	return ret
+}
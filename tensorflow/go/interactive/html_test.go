@@ -0,0 +1,56 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interactive
+
+import (
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+func TestTensorHTML(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		want  string
+	}{
+		{int32(1), "<div><code>Tensor(int32, [])</code></div>\n<table>\n<tr><td>1</td></tr>\n</table>"},
+		{[]string{"<a>"}, "<div><code>Tensor(string, [1])</code></div>\n<table>\n<tr><td>&#34;&lt;a&gt;&#34;</td></tr>\n</table>"},
+		{[][]float32{{1, 2}, {3, 4}}, "<div><code>Tensor(float32, [2, 2])</code></div>\n<table>\n<tr><td>1</td><td>2</td></tr>\n<tr><td>3</td><td>4</td></tr>\n</table>"},
+		{[][][]int64{{{1}}}, "<div><code>Tensor(int64, [1, 1, 1])</code></div>\n<pre>[[[1]]]</pre>"},
+	}
+	for _, test := range tests {
+		tensor, err := tf.NewTensor(test.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := Show(tensor).HTML(); got != test.want {
+			t.Errorf("Got:\n%s\nWant:\n%s", got, test.want)
+		}
+	}
+}
+
+func TestShapeHTML(t *testing.T) {
+	tests := map[string]tf.Shape{
+		"<i>?</i>":      {},
+		"[]":            tf.ScalarShape(),
+		"[<i>?</i>, 2]": tf.MakeShape(-1, 2),
+		"[1, 2, 3]":     tf.MakeShape(1, 2, 3),
+	}
+	for want, shape := range tests {
+		if got := ShapeHTML(shape); got != want {
+			t.Errorf("ShapeHTML(%v) = %q, want %q", shape, got, want)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package interactive supports exploring TensorFlow from Go REPLs and
+// notebooks, such as gophernotes: it formats tensors, shapes, operations
+// and graphs as text and HTML, and provides a default Context evaluating
+// operations as they are added to its graph.
+//
+// In a notebook, the value of a cell can be displayed with Show:
+//
+//	s := interactive.Default().Scope()
+//	x := op.Const(s, [][]float32{{1, 2}, {3, 4}})
+//	results, _ := interactive.Eval(op.MatMul(s, x, x))
+//	interactive.Show(results[0])
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package interactive
+
+import (
+	"fmt"
+	"html"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+// Value wraps a value for display. Its String and HTML methods are those
+// used by notebook kernels to render the value of a cell.
+type Value struct {
+	// V is a *tf.Tensor, tf.Shape, tf.Output, *tf.Operation, *tf.Graph
+	// or op.OpDoc. Other values are formatted with fmt.
+	V interface{}
+	// Format controls the summarization of large tensors.
+	Format FormatOptions
+}
+
+// Show returns v wrapped for display with the default FormatOptions.
+func Show(v interface{}) Value {
+	return Value{V: v}
+}
+
+// Help returns the documentation of operations of type opType, wrapped
+// for display. See op.Doc.
+func Help(opType string) Value {
+	doc, ok := op.Doc(opType)
+	if !ok {
+		return Value{V: fmt.Sprintf("unknown operation %q", opType)}
+	}
+	return Value{V: doc}
+}
+
+// String returns a plain text representation of the value.
+func (v Value) String() string {
+	switch x := v.V.(type) {
+	case *tf.Tensor:
+		return FormatTensor(x, v.Format)
+	case *tf.Operation:
+		return FormatOperation(x)
+	case tf.Output:
+		return fmt.Sprintf("%s:%d %v %v", x.Op.Name(), x.Index, x.DataType(), x.Shape())
+	case *tf.Graph:
+		s, err := FormatGraph(x)
+		if err != nil {
+			return fmt.Sprintf("<%v>", err)
+		}
+		return s
+	}
+	return fmt.Sprint(v.V)
+}
+
+// HTML returns an HTML fragment representing the value.
+func (v Value) HTML() string {
+	switch x := v.V.(type) {
+	case *tf.Tensor:
+		return TensorHTML(x, v.Format)
+	case tf.Shape:
+		return "<code>" + ShapeHTML(x) + "</code>"
+	case tf.Output:
+		return fmt.Sprintf("<code>%s:%d %s %s</code>", html.EscapeString(x.Op.Name()), x.Index, html.EscapeString(x.DataType().String()), ShapeHTML(x.Shape()))
+	}
+	return "<pre>" + html.EscapeString(v.String()) + "</pre>"
+}
@@ -0,0 +1,104 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+// #include <stdlib.h>
+// #include "tensorflow/c/c_api.h"
+import "C"
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"unsafe"
+)
+
+// Library is a handle to a plugin library loaded into the TensorFlow runtime
+// using LoadLibrary.
+type Library struct {
+	c  *C.TF_Library
+	mu sync.Mutex // Protects c in Close.
+}
+
+// LoadLibrary loads a plugin library (a shared object) into the address space
+// of the TensorFlow runtime.
+//
+// Plugin libraries register operations, kernels and device implementations
+// with the runtime when they are loaded. Once loaded, devices provided by the
+// plugin (for example, "TPU" or a vendor specific accelerator) can be
+// referred to by name in OpSpec.Device just like the builtin "CPU" and "GPU"
+// devices.
+//
+// The rules for locating the library from filename are platform-specific.
+// Note that the library is never unloaded, even if the returned Library is
+// garbage collected.
+func LoadLibrary(filename string) (*Library, error) {
+	if err := requireCapability(CapabilityLoadLibrary); err != nil {
+		return nil, err
+	}
+	cname := C.CString(nativePath(filename))
+	defer C.free(unsafe.Pointer(cname))
+	status := newStatus()
+	clib := C.TF_LoadLibrary(cname, status.c)
+	if err := status.Err(); err != nil {
+		return nil, fmt.Errorf("failed to load %q: %v", filename, err)
+	}
+	lib := &Library{c: clib}
+	runtime.SetFinalizer(lib, (*Library).finalizer)
+	return lib, nil
+}
+
+func (l *Library) finalizer() {
+	finalized("Library")
+	C.TF_DeleteLibraryHandle(l.c)
+}
+
+// Close releases the handle to the library without waiting for it to be
+// garbage collected. The library itself remains loaded. The Library must not
+// be used after Close. Close may be called multiple times.
+func (l *Library) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.c == nil {
+		return nil
+	}
+	runtime.SetFinalizer(l, nil)
+	C.TF_DeleteLibraryHandle(l.c)
+	l.c = nil
+	return nil
+}
+
+// OpList returns the serialized representation of the tensorflow.OpList
+// protocol buffer
+// (https://www.tensorflow.org/code/tensorflow/core/framework/op_def.proto)
+// describing the operations registered by the library.
+func (l *Library) OpList() []byte {
+	buf := C.TF_GetOpList(l.c)
+	return C.GoBytes(buf.data, C.int(buf.length))
+}
+
+// Device types built into the TensorFlow runtime. Plugin libraries can
+// register additional device types.
+const (
+	DeviceCPU = "CPU"
+	DeviceGPU = "GPU"
+)
+
+// DeviceName returns the name of the index-th device of type deviceType
+// (e.g., "CPU", "GPU", "TPU" or any type registered by a plugin) in the
+// local process, suitable for use as OpSpec.Device.
+func DeviceName(deviceType string, index int) string {
+	return fmt.Sprintf("/device:%s:%d", deviceType, index)
+}
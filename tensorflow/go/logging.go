@@ -0,0 +1,93 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// LogSeverity is the severity of a message logged by the TensorFlow runtime.
+type LogSeverity int
+
+// Severities of messages logged by the TensorFlow runtime.
+const (
+	LogInfo LogSeverity = iota
+	LogWarning
+	LogError
+	LogFatal
+)
+
+func (s LogSeverity) String() string {
+	switch s {
+	case LogInfo:
+		return "INFO"
+	case LogWarning:
+		return "WARNING"
+	case LogError:
+		return "ERROR"
+	case LogFatal:
+		return "FATAL"
+	}
+	return fmt.Sprintf("LogSeverity(%d)", int(s))
+}
+
+// LogEntry is a message logged by the TensorFlow runtime.
+type LogEntry struct {
+	Time     time.Time
+	Severity LogSeverity
+	// File and Line identify the source code location (in the
+	// TensorFlow runtime) that logged the message.
+	File    string
+	Line    int
+	Message string
+}
+
+// logLinePattern matches lines written by the TensorFlow runtime's logging
+// library, for example:
+// "2017-03-07 10:15:00.123456: W tensorflow/core/framework/op_kernel.cc:993] Message"
+// See https://www.tensorflow.org/code/tensorflow/core/platform/default/logging.cc
+var logLinePattern = regexp.MustCompile(`^(\d{4}-\d\d-\d\d \d\d:\d\d:\d\d\.\d{6}): ([IWEF]) ([^:]+):(\d+)\] (.*)$`)
+
+// parseLogLine parses a line written by the TensorFlow runtime's logging
+// library, returning false if line is not in that format.
+func parseLogLine(line string) (LogEntry, bool) {
+	m := logLinePattern.FindStringSubmatch(line)
+	if m == nil {
+		return LogEntry{}, false
+	}
+	ts, err := time.ParseInLocation("2006-01-02 15:04:05.000000", m[1], time.Local)
+	if err != nil {
+		return LogEntry{}, false
+	}
+	lineno, err := strconv.Atoi(m[4])
+	if err != nil {
+		return LogEntry{}, false
+	}
+	var severity LogSeverity
+	switch m[2] {
+	case "I":
+		severity = LogInfo
+	case "W":
+		severity = LogWarning
+	case "E":
+		severity = LogError
+	case "F":
+		severity = LogFatal
+	}
+	return LogEntry{Time: ts, Severity: severity, File: m[3], Line: lineno, Message: m[5]}, true
+}
@@ -0,0 +1,72 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestParseLogLine(t *testing.T) {
+	line := "2017-03-07 10:15:00.123456: W tensorflow/core/platform/cpu_feature_guard.cc:45] The TensorFlow library wasn't compiled to use SSE4.1 instructions"
+	e, ok := parseLogLine(line)
+	if !ok {
+		t.Fatalf("Failed to parse %q", line)
+	}
+	want := LogEntry{
+		Time:     time.Date(2017, 3, 7, 10, 15, 0, 123456000, time.Local),
+		Severity: LogWarning,
+		File:     "tensorflow/core/platform/cpu_feature_guard.cc",
+		Line:     45,
+		Message:  "The TensorFlow library wasn't compiled to use SSE4.1 instructions",
+	}
+	if !e.Time.Equal(want.Time) || e.Severity != want.Severity || e.File != want.File || e.Line != want.Line || e.Message != want.Message {
+		t.Errorf("Got %+v, want %+v", e, want)
+	}
+	for _, line := range []string{
+		"",
+		"panic: runtime error",
+		"2017-03-07 10:15:00.123456: X foo.cc:1] bad severity",
+	} {
+		if e, ok := parseLogLine(line); ok {
+			t.Errorf("parseLogLine(%q) = %+v, want failure", line, e)
+		}
+	}
+}
+
+func TestRedirectRuntimeLog(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("RedirectRuntimeLog is not supported on Windows")
+	}
+	var entries []LogEntry
+	restore, err := RedirectRuntimeLog(func(e LogEntry) { entries = append(entries, e) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := RedirectRuntimeLog(func(LogEntry) {}); err == nil {
+		t.Error("Concurrent redirections should not be allowed")
+	}
+	fmt.Fprintln(os.Stderr, "2017-03-07 10:15:00.123456: E tensorflow/core/foo.cc:1] first")
+	fmt.Fprintln(os.Stderr, "2017-03-07 10:15:00.123457: I tensorflow/core/foo.cc:2] second")
+	if err := restore(); err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || entries[0].Message != "first" || entries[1].Severity != LogInfo {
+		t.Errorf("Got %+v", entries)
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+// +build !windows
+
+package tensorflow
+
+// #include <unistd.h>
+import "C"
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// logDrainTimeout is how long the restore function of RedirectRuntimeLog
+// waits for more output on the pipe once the standard error is restored.
+const logDrainTimeout = 100 * time.Millisecond
+
+var logRedirect struct {
+	sync.Mutex
+	active bool
+}
+
+// drainReader reads the pipe the standard error is redirected to. Once
+// drain is called, reads fail after the pipe is idle for logDrainTimeout
+// instead of waiting for the end of the file.
+type drainReader struct {
+	f        *os.File
+	draining atomic.Bool
+}
+
+func (r *drainReader) Read(p []byte) (int, error) {
+	if r.draining.Load() {
+		r.f.SetReadDeadline(time.Now().Add(logDrainTimeout))
+	}
+	return r.f.Read(p)
+}
+
+func (r *drainReader) drain() {
+	r.draining.Store(true)
+	// Interrupt a read already waiting without a deadline.
+	r.f.SetReadDeadline(time.Now().Add(logDrainTimeout))
+}
+
+// RedirectRuntimeLog arranges for messages logged by the TensorFlow runtime,
+// which are otherwise written to the standard error of the process, to be
+// passed to handler instead. This allows them to be filtered by severity,
+// counted and forwarded to the logging system of the application.
+//
+// Everything else written to the standard error of the process (for example,
+// by the Go runtime) is passed through unchanged. Note that since this is
+// done by a goroutine, output written immediately before the process crashes
+// may be lost.
+//
+// handler is called from a single goroutine. The returned restore function
+// stops the redirection, waits for all pending messages to be handled and
+// restores the original standard error. Output written later by child
+// processes that inherited the redirected standard error is discarded.
+// Only one redirection may be active at a time.
+//
+// The minimum severity of messages logged by the runtime is controlled by
+// the TF_CPP_MIN_LOG_LEVEL environment variable, which is read once, when
+// the first message is logged.
+func RedirectRuntimeLog(handler func(LogEntry)) (restore func() error, err error) {
+	logRedirect.Lock()
+	defer logRedirect.Unlock()
+	if logRedirect.active {
+		return nil, fmt.Errorf("the TensorFlow runtime log is already being redirected")
+	}
+	r, w, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	saved, err := C.dup(2)
+	if saved < 0 {
+		r.Close()
+		w.Close()
+		return nil, fmt.Errorf("unable to duplicate stderr: %v", err)
+	}
+	if ret, err := C.dup2(C.int(w.Fd()), 2); ret < 0 {
+		C.close(saved)
+		r.Close()
+		w.Close()
+		return nil, fmt.Errorf("unable to redirect stderr: %v", err)
+	}
+	// File descriptor 2 now refers to the write end of the pipe.
+	w.Close()
+	stderr := os.NewFile(uintptr(saved), "stderr")
+	in := &drainReader{f: r}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		// Unlike a bufio.Scanner, ReadLine does not give up on lines
+		// longer than its buffer, which would leave the pipe to fill up
+		// and block every writer to the standard error.
+		br := bufio.NewReader(in)
+		var line []byte
+		for {
+			frag, isPrefix, err := br.ReadLine()
+			if err != nil {
+				if len(line) > 0 {
+					stderr.Write(append(line, '\n'))
+				}
+				return
+			}
+			line = append(line, frag...)
+			if isPrefix {
+				continue
+			}
+			if e, ok := parseLogLine(string(line)); ok {
+				handler(e)
+			} else {
+				stderr.Write(append(line, '\n'))
+			}
+			line = line[:0]
+		}
+	}()
+	logRedirect.active = true
+	return func() error {
+		logRedirect.Lock()
+		defer logRedirect.Unlock()
+		if !logRedirect.active {
+			return nil
+		}
+		// Replacing file descriptor 2 usually closes the last reference
+		// to the write end of the pipe, terminating the goroutine above.
+		// A child process may have inherited it, though, so the
+		// goroutine also stops once the pipe has been idle for
+		// logDrainTimeout.
+		ret, err := C.dup2(saved, 2)
+		in.drain()
+		<-done
+		r.Close()
+		stderr.Close()
+		logRedirect.active = false
+		if ret < 0 {
+			return fmt.Errorf("unable to restore stderr: %v", err)
+		}
+		return nil
+	}, nil
+}
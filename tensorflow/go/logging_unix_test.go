@@ -0,0 +1,73 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+// +build !windows
+
+package tensorflow
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestRedirectRuntimeLogLongLines(t *testing.T) {
+	var entries []LogEntry
+	restore, err := RedirectRuntimeLog(func(e LogEntry) { entries = append(entries, e) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Lines longer than the buffer of the reader, and in total more than
+	// the capacity of the pipe, must not stop the redirection.
+	long := strings.Repeat("x", 1<<17)
+	for i := 0; i < 4; i++ {
+		fmt.Fprintln(os.Stderr, "2017-03-07 10:15:00.123456: W tensorflow/core/foo.cc:1] "+long)
+	}
+	fmt.Fprintln(os.Stderr, "2017-03-07 10:15:00.123457: I tensorflow/core/foo.cc:2] last")
+	if err := restore(); err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 5 || entries[0].Message != long || entries[4].Message != "last" {
+		t.Errorf("Got %d entries", len(entries))
+	}
+}
+
+func TestRedirectRuntimeLogInherited(t *testing.T) {
+	restore, err := RedirectRuntimeLog(func(LogEntry) {})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A copy of the redirected standard error, as held by a child process,
+	// keeps the pipe open after restore.
+	fd, err := syscall.Dup(2)
+	if err != nil {
+		restore()
+		t.Fatal(err)
+	}
+	defer syscall.Close(fd)
+	errc := make(chan error, 1)
+	go func() { errc <- restore() }()
+	select {
+	case err := <-errc:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("restore blocked on a pipe held open by another descriptor")
+	}
+}
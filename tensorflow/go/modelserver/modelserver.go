@@ -0,0 +1,347 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package modelserver serves many models from one process, routing runs by
+// model name and version and enforcing per-model quotas, so that a busy or
+// misbehaving model cannot starve the others:
+//
+//	s := modelserver.New()
+//	defer s.Close()
+//	err := s.Load("ranker", 3, "/models/ranker/3", modelserver.ModelOptions{
+//		Model:             tf.ResilientModelOptions{Tags: []string{"serve"}},
+//		MaxConcurrentRuns: 8,
+//	})
+//	...
+//	out, err := s.Run("ranker", 0, feeds, []string{"scores:0"}, nil)
+//	...
+//	http.Handle("/models/", http.StripPrefix("/models", s.Handler()))
+//
+// Each model is a tf.ResilientModel, reloaded after fatal errors.
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package modelserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+// ModelOptions configures a model of a Server.
+type ModelOptions struct {
+	// Model configures the loading of the model.
+	Model tf.ResilientModelOptions
+	// MaxConcurrentRuns is the maximum number of runs of the model in
+	// progress. Zero means no limit.
+	MaxConcurrentRuns int
+	// MaxFeedBytes is the maximum total size of the tensors fed to the
+	// runs of the model in progress, which bounds the memory used by the
+	// requests of the model. Zero means no limit.
+	MaxFeedBytes int64
+}
+
+// Server serves models identified by name and version. Its methods are
+// safe for concurrent use.
+type Server struct {
+	mu     sync.RWMutex
+	models map[string]map[int64]*model
+}
+
+type model struct {
+	name    string
+	version int64
+	m       *tf.ResilientModel
+	opts    ModelOptions
+
+	mu        sync.Mutex
+	running   int
+	feedBytes int64
+	runs      int64
+	errors    int64
+	rejected  int64
+	latency   time.Duration
+}
+
+// New returns a Server without models.
+func New() *Server {
+	return &Server{models: make(map[string]map[int64]*model)}
+}
+
+// Load loads the SavedModel in exportDir as the version of the model name.
+// Versions must be positive, and a version cannot be loaded twice without
+// being unloaded.
+func (s *Server) Load(name string, version int64, exportDir string, opts ModelOptions) error {
+	if version <= 0 {
+		return fmt.Errorf("invalid version %d of model %q", version, name)
+	}
+	if s.lookup(name, version) != nil {
+		return fmt.Errorf("version %d of model %q is already loaded", version, name)
+	}
+	m, err := tf.LoadResilientModel(exportDir, opts.Model)
+	if err != nil {
+		return fmt.Errorf("failed to load version %d of model %q: %v", version, name, err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.models[name][version] != nil {
+		m.Close()
+		return fmt.Errorf("version %d of model %q is already loaded", version, name)
+	}
+	if s.models[name] == nil {
+		s.models[name] = make(map[int64]*model)
+	}
+	s.models[name][version] = &model{name: name, version: version, m: m, opts: opts}
+	return nil
+}
+
+// Unload removes the version of the model name from s and closes it,
+// failing the runs of the version in progress.
+func (s *Server) Unload(name string, version int64) error {
+	s.mu.Lock()
+	m := s.models[name][version]
+	if m != nil {
+		delete(s.models[name], version)
+		if len(s.models[name]) == 0 {
+			delete(s.models, name)
+		}
+	}
+	s.mu.Unlock()
+	if m == nil {
+		return notFound(name, version)
+	}
+	return m.m.Close()
+}
+
+// Close unloads all the models.
+func (s *Server) Close() error {
+	s.mu.Lock()
+	models := s.models
+	s.models = make(map[string]map[int64]*model)
+	s.mu.Unlock()
+	var err error
+	for _, versions := range models {
+		for _, m := range versions {
+			if e := m.m.Close(); e != nil && err == nil {
+				err = e
+			}
+		}
+	}
+	return err
+}
+
+// lookup returns the version of the model name, or its latest version if
+// version is 0, or nil.
+func (s *Server) lookup(name string, version int64) *model {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	versions := s.models[name]
+	if version != 0 {
+		return versions[version]
+	}
+	var latest *model
+	for v, m := range versions {
+		if latest == nil || v > latest.version {
+			latest = m
+		}
+	}
+	return latest
+}
+
+func notFound(name string, version int64) error {
+	if version == 0 {
+		return &tf.StatusError{Code: tf.NotFound, Message: fmt.Sprintf("model %q not found", name)}
+	}
+	return &tf.StatusError{Code: tf.NotFound, Message: fmt.Sprintf("version %d of model %q not found", version, name)}
+}
+
+// Run runs the version of the model name, or its latest version if version
+// is 0, identifying feeds, fetches and targets by name as
+// tf.ResilientModel.Run does.
+//
+// Runs of unknown models fail with a NotFound *tf.StatusError, and runs
+// exceeding the quotas of their model fail immediately, without waiting
+// for other runs to complete, with a ResourceExhausted *tf.StatusError.
+func (s *Server) Run(name string, version int64, feeds map[string]*tf.Tensor, fetches []string, targets []string) ([]*tf.Tensor, error) {
+	m := s.lookup(name, version)
+	if m == nil {
+		return nil, notFound(name, version)
+	}
+	bytes := feedBytes(feeds)
+	if err := m.acquire(bytes); err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	out, err := m.m.Run(feeds, fetches, targets)
+	m.release(bytes, time.Since(start), err)
+	return out, err
+}
+
+func (m *model) acquire(bytes int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if max := m.opts.MaxConcurrentRuns; max > 0 && m.running >= max {
+		m.rejected++
+		return &tf.StatusError{Code: tf.ResourceExhausted, Message: fmt.Sprintf("version %d of model %q already has %d runs in progress", m.version, m.name, m.running)}
+	}
+	if max := m.opts.MaxFeedBytes; max > 0 && m.feedBytes+bytes > max {
+		m.rejected++
+		return &tf.StatusError{Code: tf.ResourceExhausted, Message: fmt.Sprintf("version %d of model %q cannot accept %d more bytes of feeds: %d of %d in use", m.version, m.name, bytes, m.feedBytes, max)}
+	}
+	m.running++
+	m.feedBytes += bytes
+	return nil
+}
+
+func (m *model) release(bytes int64, latency time.Duration, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.running--
+	m.feedBytes -= bytes
+	m.runs++
+	m.latency += latency
+	if err != nil {
+		m.errors++
+	}
+}
+
+// feedBytes returns the total size of the contents of feeds.
+func feedBytes(feeds map[string]*tf.Tensor) int64 {
+	var total int64
+	for _, t := range feeds {
+		if t == nil {
+			continue
+		}
+		size := int64(t.DataType().Size())
+		if size == 0 {
+			// Strings have no fixed size.
+			n, _ := t.WriteContentsTo(ioutil.Discard)
+			total += n
+			continue
+		}
+		for _, d := range t.Shape() {
+			size *= d
+		}
+		total += size
+	}
+	return total
+}
+
+// ModelStatus describes a version of a model of a Server.
+type ModelStatus struct {
+	Name    string
+	Version int64
+	// Health is the health of the model and, unless it is Healthy,
+	// Error is why.
+	Health string
+	Error  string `json:",omitempty"`
+	// Reloads is the number of times the model was reloaded.
+	Reloads int64
+	// Running is the number of runs in progress and FeedBytes the size
+	// of their feeds.
+	Running   int
+	FeedBytes int64
+	// Runs is the number of completed runs, Errors the number of those
+	// that failed and Latency their total duration.
+	Runs    int64
+	Errors  int64
+	Latency time.Duration
+	// Rejected is the number of runs rejected by the quotas of the model.
+	Rejected int64
+}
+
+// Models returns the status of the models of s, sorted by name and
+// version.
+func (s *Server) Models() []ModelStatus {
+	s.mu.RLock()
+	var models []*model
+	for _, versions := range s.models {
+		for _, m := range versions {
+			models = append(models, m)
+		}
+	}
+	s.mu.RUnlock()
+	sort.Sort(byNameAndVersion(models))
+	statuses := make([]ModelStatus, len(models))
+	for i, m := range models {
+		health, err := m.m.Health()
+		m.mu.Lock()
+		statuses[i] = ModelStatus{
+			Name:      m.name,
+			Version:   m.version,
+			Health:    health.String(),
+			Reloads:   m.m.Reloads(),
+			Running:   m.running,
+			FeedBytes: m.feedBytes,
+			Runs:      m.runs,
+			Errors:    m.errors,
+			Latency:   m.latency,
+			Rejected:  m.rejected,
+		}
+		m.mu.Unlock()
+		if err != nil {
+			statuses[i].Error = err.Error()
+		}
+	}
+	return statuses
+}
+
+type byNameAndVersion []*model
+
+func (m byNameAndVersion) Len() int      { return len(m) }
+func (m byNameAndVersion) Swap(i, j int) { m[i], m[j] = m[j], m[i] }
+func (m byNameAndVersion) Less(i, j int) bool {
+	if m[i].name != m[j].name {
+		return m[i].name < m[j].name
+	}
+	return m[i].version < m[j].version
+}
+
+// Handler returns an http.Handler serving the health and metrics of the
+// models of s:
+//
+//	/healthz  "ok" if all the models are Healthy, or a 503 Service
+//	          Unavailable error listing those that are not.
+//	/metrics  The Models of s, as JSON.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		var unhealthy []string
+		for _, m := range s.Models() {
+			if m.Health != tf.Healthy.String() {
+				unhealthy = append(unhealthy, fmt.Sprintf("%s/%d: %s: %s", m.Name, m.Version, m.Health, m.Error))
+			}
+		}
+		if len(unhealthy) > 0 {
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			for _, u := range unhealthy {
+				fmt.Fprintln(w, u)
+			}
+			return
+		}
+		fmt.Fprintln(w, "ok")
+	})
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(s.Models())
+	})
+	return mux
+}
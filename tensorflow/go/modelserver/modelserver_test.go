@@ -0,0 +1,154 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modelserver
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+const halfPlusTwo = "../../cc/saved_model/testdata/half_plus_two/00000123"
+
+func TestServer(t *testing.T) {
+	s := New()
+	defer s.Close()
+	opts := ModelOptions{Model: tf.ResilientModelOptions{Tags: []string{"serve"}}}
+	for _, v := range []int64{1, 2} {
+		if err := s.Load("half_plus_two", v, halfPlusTwo, opts); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.Load("half_plus_two", 1, halfPlusTwo, opts); err == nil {
+		t.Error("Loading a version twice succeeded, want an error")
+	}
+
+	x, err := tf.NewTensor([]float32{0, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	feeds := map[string]*tf.Tensor{"x:0": x}
+	for _, v := range []int64{0, 1, 2} {
+		out, err := s.Run("half_plus_two", v, feeds, []string{"y:0"}, nil)
+		if err != nil {
+			t.Fatalf("version %d: %v", v, err)
+		}
+		if got, want := out[0].Value().([]float32), []float32{2, 3}; got[0] != want[0] || got[1] != want[1] {
+			t.Errorf("version %d: got %v, want %v", v, got, want)
+		}
+	}
+	var serr *tf.StatusError
+	if _, err := s.Run("half_plus_two", 3, feeds, []string{"y:0"}, nil); !errors.As(err, &serr) || serr.Code != tf.NotFound {
+		t.Errorf("Got %v, want a NotFound error", err)
+	}
+	if _, err := s.Run("other", 0, feeds, []string{"y:0"}, nil); !errors.As(err, &serr) || serr.Code != tf.NotFound {
+		t.Errorf("Got %v, want a NotFound error", err)
+	}
+
+	models := s.Models()
+	if len(models) != 2 || models[0].Version != 1 || models[1].Version != 2 {
+		t.Fatalf("Got models %+v, want versions 1 and 2", models)
+	}
+	// Version 0 runs the latest version.
+	if got, want := models[1].Runs, int64(2); got != want {
+		t.Errorf("Got %d runs of version 2, want %d", got, want)
+	}
+
+	if err := s.Unload("half_plus_two", 2); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Unload("half_plus_two", 2); err == nil {
+		t.Error("Unloading a version twice succeeded, want an error")
+	}
+	if _, err := s.Run("half_plus_two", 0, feeds, []string{"y:0"}, nil); err != nil {
+		t.Errorf("Run of the remaining version failed: %v", err)
+	}
+}
+
+func TestQuotas(t *testing.T) {
+	m := &model{name: "m", version: 1, opts: ModelOptions{MaxConcurrentRuns: 2, MaxFeedBytes: 100}}
+	if err := m.acquire(60); err != nil {
+		t.Fatal(err)
+	}
+	var serr *tf.StatusError
+	if err := m.acquire(60); !errors.As(err, &serr) || serr.Code != tf.ResourceExhausted {
+		t.Errorf("Got %v, want a ResourceExhausted error for the feeds", err)
+	}
+	if err := m.acquire(40); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.acquire(0); !errors.As(err, &serr) || serr.Code != tf.ResourceExhausted {
+		t.Errorf("Got %v, want a ResourceExhausted error for the runs", err)
+	}
+	m.release(60, 0, nil)
+	if err := m.acquire(60); err != nil {
+		t.Errorf("Got %v after a release, want no error", err)
+	}
+	if m.rejected != 2 {
+		t.Errorf("Got %d rejected runs, want 2", m.rejected)
+	}
+
+	// Feeds larger than the quota are rejected by Run.
+	s := New()
+	defer s.Close()
+	if err := s.Load("m", 1, halfPlusTwo, ModelOptions{Model: tf.ResilientModelOptions{Tags: []string{"serve"}}, MaxFeedBytes: 4}); err != nil {
+		t.Fatal(err)
+	}
+	x, err := tf.NewTensor([]float32{0, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Run("m", 1, map[string]*tf.Tensor{"x:0": x}, []string{"y:0"}, nil); !errors.As(err, &serr) || serr.Code != tf.ResourceExhausted {
+		t.Errorf("Got %v, want a ResourceExhausted error", err)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	s := New()
+	defer s.Close()
+	if err := s.Load("m", 1, halfPlusTwo, ModelOptions{Model: tf.ResilientModelOptions{Tags: []string{"serve"}}}); err != nil {
+		t.Fatal(err)
+	}
+	h := s.Handler()
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/healthz", nil))
+	if w.Code != http.StatusOK || strings.TrimSpace(w.Body.String()) != "ok" {
+		t.Errorf("Got %d %q, want 200 \"ok\"", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	var models []ModelStatus
+	if err := json.NewDecoder(w.Body).Decode(&models); err != nil {
+		t.Fatal(err)
+	}
+	if len(models) != 1 || models[0].Name != "m" || models[0].Health != "Healthy" {
+		t.Errorf("Got %+v, want a Healthy model m", models)
+	}
+
+	// A closed model is Unhealthy.
+	s.lookup("m", 1).m.Close()
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/healthz", nil))
+	if w.Code != http.StatusServiceUnavailable || !strings.Contains(w.Body.String(), "m/1: Unhealthy") {
+		t.Errorf("Got %d %q, want a 503 error for m/1", w.Code, w.Body.String())
+	}
+}
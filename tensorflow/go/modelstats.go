@@ -0,0 +1,191 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+)
+
+// MaxLargestWeights is the number of weights listed in
+// ModelStatistics.Largest.
+const MaxLargestWeights = 10
+
+// WeightStats describes a weight of a graph: the value of a Const
+// operation or a variable.
+type WeightStats struct {
+	// Name is the name of the operation.
+	Name string
+	// Type is the type of the operation, such as "Const" or "VariableV2".
+	Type     string
+	DataType DataType
+	Shape    Shape
+	// Parameters is the number of elements of the weight.
+	Parameters int64
+	// Bytes is the size of the weight. The size of the weights whose
+	// elements do not have a fixed size, such as strings, is that of their
+	// serialized value for constants, and 0 for variables.
+	Bytes int64
+}
+
+// ModelStatistics summarizes the operations and weights of a graph.
+type ModelStatistics struct {
+	// Ops is the number of operations of each type.
+	Ops map[string]int
+	// Parameters is the total number of elements of the weights.
+	Parameters int64
+	// Bytes is the total size of the weights of each DataType.
+	Bytes map[DataType]int64
+	// Largest are the largest weights, by decreasing size, up to
+	// MaxLargestWeights.
+	Largest []WeightStats
+	// Unknown lists the names of the variables whose shape is not fully
+	// known, which are not counted.
+	Unknown []string
+}
+
+// ModelStats returns the number of operations of each type of graph and
+// the sizes of its weights: the values of its Const operations and its
+// variables (VariableV2, Variable and VarHandleOp operations).
+//
+// The values of the constants initializing variables, if any, are counted
+// along with the variables: frozen graphs or graphs using other
+// initializers give the exact number of parameters of a model.
+func ModelStats(graph *Graph) (*ModelStatistics, error) {
+	var buf bytes.Buffer
+	if _, err := graph.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+	stats := &ModelStatistics{
+		Ops:   make(map[string]int),
+		Bytes: make(map[DataType]int64),
+	}
+	var weights []WeightStats
+	err := parseMessage(buf.Bytes(), func(field int, _ uint64, b []byte) error {
+		if field != 1 { // node
+			return nil
+		}
+		var (
+			w     WeightStats
+			value []byte // Serialized TensorProto of a Const.
+		)
+		err := parseMessage(b, func(field int, _ uint64, b []byte) error {
+			switch field {
+			case 1:
+				w.Name = string(b)
+			case 2:
+				w.Type = string(b)
+			case 5: // attr
+				if err := parseTypeShapeAttr(b, &w.DataType, &w.Shape); err != nil {
+					return err
+				}
+				if t, ok, err := parseTensorAttr(b, "value"); ok || err != nil {
+					value = t
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		stats.Ops[w.Type]++
+		switch w.Type {
+		case "Const":
+			if w.DataType, w.Shape, err = parseTensorProtoType(value); err != nil {
+				return err
+			}
+		case "VariableV2", "Variable", "VarHandleOp":
+		default:
+			return nil
+		}
+		if !w.Shape.IsFullySpecified() {
+			stats.Unknown = append(stats.Unknown, w.Name)
+			return nil
+		}
+		w.Parameters = 1
+		for i := 0; i < w.Shape.NumDimensions(); i++ {
+			w.Parameters *= w.Shape.Size(i)
+		}
+		if size := w.DataType.Size(); size > 0 {
+			w.Bytes = w.Parameters * int64(size)
+		} else if w.Type == "Const" {
+			w.Bytes = int64(len(value))
+		}
+		stats.Parameters += w.Parameters
+		stats.Bytes[w.DataType] += w.Bytes
+		weights = append(weights, w)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid GraphDef: %v", err)
+	}
+	sort.Slice(weights, func(i, j int) bool {
+		if weights[i].Bytes != weights[j].Bytes {
+			return weights[i].Bytes > weights[j].Bytes
+		}
+		return weights[i].Name < weights[j].Name
+	})
+	if len(weights) > MaxLargestWeights {
+		weights = weights[:MaxLargestWeights]
+	}
+	stats.Largest = weights
+	return stats, nil
+}
+
+// parseTensorAttr returns the serialized TensorProto of entry, an entry of
+// the attributes of a NodeDef, if it is the attribute key.
+func parseTensorAttr(entry []byte, key string) (tensor []byte, ok bool, err error) {
+	var name string
+	var value []byte
+	err = parseMessage(entry, func(field int, _ uint64, b []byte) error {
+		switch field {
+		case 1:
+			name = string(b)
+		case 2:
+			value = b
+		}
+		return nil
+	})
+	if err != nil || name != key {
+		return nil, false, err
+	}
+	err = parseMessage(value, func(field int, _ uint64, b []byte) error {
+		if field == 8 { // tensor
+			tensor, ok = b, true
+		}
+		return nil
+	})
+	return tensor, ok, err
+}
+
+// parseTensorProtoType returns the type and shape of a serialized
+// TensorProto.
+func parseTensorProtoType(b []byte) (dt DataType, shape Shape, err error) {
+	shape = ScalarShape()
+	err = parseMessage(b, func(field int, x uint64, b []byte) error {
+		switch field {
+		case 1: // dtype
+			dt = DataType(x)
+		case 2: // tensor_shape
+			var err error
+			shape, err = parseTensorShapeProto(b)
+			return err
+		}
+		return nil
+	})
+	return dt, shape, err
+}
@@ -0,0 +1,69 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestModelStats(t *testing.T) {
+	g := NewGraph()
+	x, err := Placeholder(g, "x", Float)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := Const(g, "w", [][]float32{{1, 2, 3}, {4, 5, 6}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Const(g, "b", int64(1)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.AddOperation(OpSpec{Type: "MatMul", Name: "y", Input: []Input{x, w}}); err != nil {
+		t.Fatal(err)
+	}
+	for _, spec := range []OpSpec{
+		{Type: "VariableV2", Name: "v", Attrs: map[string]interface{}{"dtype": Double, "shape": MakeShape(4, 5)}},
+		{Type: "VariableV2", Name: "u", Attrs: map[string]interface{}{"dtype": Float, "shape": MakeShape(-1, 5)}},
+	} {
+		if _, err := g.AddOperation(spec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	stats, err := ModelStats(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]int{"Placeholder": 1, "Const": 2, "MatMul": 1, "VariableV2": 2}; !reflect.DeepEqual(stats.Ops, want) {
+		t.Errorf("Got ops %v, want %v", stats.Ops, want)
+	}
+	if stats.Parameters != 27 {
+		t.Errorf("Got %d parameters, want 27", stats.Parameters)
+	}
+	if want := map[DataType]int64{Float: 24, Int64: 8, Double: 160}; !reflect.DeepEqual(stats.Bytes, want) {
+		t.Errorf("Got bytes %v, want %v", stats.Bytes, want)
+	}
+	var largest []string
+	for _, w := range stats.Largest {
+		largest = append(largest, w.Name)
+	}
+	if want := []string{"v", "w", "b"}; !reflect.DeepEqual(largest, want) {
+		t.Errorf("Got largest weights %v, want %v", largest, want)
+	}
+	if want := []string{"u"}; !reflect.DeepEqual(stats.Unknown, want) {
+		t.Errorf("Got unknown weights %v, want %v", stats.Unknown, want)
+	}
+}
@@ -0,0 +1,263 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"reflect"
+	"sync"
+)
+
+// Tolerance specifies how much the outputs of two models may differ before
+// they are considered divergent. Two elements a and b are considered equal
+// if |a - b| <= Absolute + Relative * |b|, where b is the element produced
+// by the primary model. The zero value requires exact equality.
+//
+// Non-numeric elements (strings and bools) are always compared exactly.
+type Tolerance struct {
+	Absolute float64
+	Relative float64
+}
+
+// DivergenceStats summarizes the comparisons made by a MultiModelRunner.
+type DivergenceStats struct {
+	// Runs is the number of runs for which the primary model succeeded.
+	Runs int64
+	// ShadowErrors is the number of those runs for which the shadow model
+	// failed.
+	ShadowErrors int64
+	// Divergent is the number of runs for which at least one output of the
+	// shadow model differed from that of the primary model by more than
+	// the Tolerance, or had a different type or shape.
+	Divergent int64
+	// MaxAbsDiff is the largest absolute difference observed between two
+	// numeric elements.
+	MaxAbsDiff float64
+}
+
+// Divergence describes a run for which the outputs of the shadow model
+// differed from those of the primary model.
+type Divergence struct {
+	// Fetch is the name of the first divergent output.
+	Fetch string
+	// Err describes the shadow failure or the difference.
+	Err error
+}
+
+// MultiModelRunner runs the same requests against a primary and a shadow
+// model, for example a model and its candidate replacement, comparing their
+// outputs to detect divergences before the replacement is promoted. Only the
+// results of the primary model are returned to the caller: errors from the
+// shadow model are recorded but never returned.
+//
+// Both models are run concurrently, so a run takes as long as the slower of
+// the two models.
+//
+// The methods of MultiModelRunner are safe for concurrent use.
+type MultiModelRunner struct {
+	primary, shadow *SavedModel
+	tolerance       Tolerance
+
+	// OnDivergence, if not nil, is called for every divergent run. It
+	// must be set before the runner is used.
+	OnDivergence func(Divergence)
+
+	mu    sync.Mutex
+	stats DivergenceStats
+}
+
+// NewMultiModelRunner returns a MultiModelRunner comparing the outputs of
+// shadow to those of primary using tolerance.
+//
+// The runner does not take ownership of the models, which must be kept open
+// while it is in use.
+func NewMultiModelRunner(primary, shadow *SavedModel, tolerance Tolerance) *MultiModelRunner {
+	return &MultiModelRunner{primary: primary, shadow: shadow, tolerance: tolerance}
+}
+
+// Run runs both models, returning the results of the primary model.
+//
+// As the graphs of the two models are distinct, feeds, fetches and targets
+// are identified by name: feeds and fetches are keyed by tensor names in the
+// form "operation:index" (e.g. "x:0"; the index defaults to 0), while targets
+// are operation names. Both models must use the same names.
+func (r *MultiModelRunner) Run(feeds map[string]*Tensor, fetches []string, targets []string) ([]*Tensor, error) {
+	var (
+		wg        sync.WaitGroup
+		shadow    []*Tensor
+		shadowErr error
+	)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		shadow, shadowErr = runByName(r.shadow, feeds, fetches, targets)
+	}()
+	primary, err := runByName(r.primary, feeds, fetches, targets)
+	wg.Wait()
+	if err != nil {
+		return nil, err
+	}
+	r.record(fetches, primary, shadow, shadowErr)
+	for _, t := range shadow {
+		t.Release()
+	}
+	return primary, nil
+}
+
+// Stats returns a summary of the comparisons made so far.
+func (r *MultiModelRunner) Stats() DivergenceStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.stats
+}
+
+func (r *MultiModelRunner) record(fetches []string, primary, shadow []*Tensor, shadowErr error) {
+	var (
+		div     *Divergence
+		maxDiff float64
+	)
+	if shadowErr != nil {
+		div = &Divergence{Err: fmt.Errorf("shadow model: %v", shadowErr)}
+	} else {
+		for i := range fetches {
+			d, err := CompareTensors(primary[i], shadow[i], r.tolerance)
+			if d > maxDiff {
+				maxDiff = d
+			}
+			if err != nil && div == nil {
+				div = &Divergence{Fetch: fetches[i], Err: err}
+			}
+		}
+	}
+	r.mu.Lock()
+	r.stats.Runs++
+	if shadowErr != nil {
+		r.stats.ShadowErrors++
+	}
+	if div != nil {
+		r.stats.Divergent++
+	}
+	if maxDiff > r.stats.MaxAbsDiff {
+		r.stats.MaxAbsDiff = maxDiff
+	}
+	r.mu.Unlock()
+	if div != nil && r.OnDivergence != nil {
+		r.OnDivergence(*div)
+	}
+}
+
+func runByName(m *SavedModel, feeds map[string]*Tensor, fetches []string, targets []string) ([]*Tensor, error) {
+	f := make(map[Output]*Tensor, len(feeds))
+	for name, t := range feeds {
+		o, err := m.Graph.OutputByName(name)
+		if err != nil {
+			return nil, err
+		}
+		f[o] = t
+	}
+	o := make([]Output, len(fetches))
+	for i, name := range fetches {
+		var err error
+		if o[i], err = m.Graph.OutputByName(name); err != nil {
+			return nil, err
+		}
+	}
+	ops := make([]*Operation, len(targets))
+	for i, name := range targets {
+		if ops[i] = m.Graph.Operation(name); ops[i] == nil {
+			return nil, fmt.Errorf("operation %q not found in the graph", name)
+		}
+	}
+	return m.Session.Run(f, o, ops)
+}
+
+// CompareTensors returns the largest absolute difference between the
+// numeric elements of want and got, and an error if they differ by more than
+// tol or have a different type or shape. The error identifies the index of
+// the first offending element.
+func CompareTensors(want, got *Tensor, tol Tolerance) (float64, error) {
+	if want.DataType() != got.DataType() {
+		return 0, fmt.Errorf("got a %v Tensor, want %v", got.DataType(), want.DataType())
+	}
+	if !reflect.DeepEqual(want.Shape(), got.Shape()) {
+		return 0, fmt.Errorf("got shape %v, want %v", got.Shape(), want.Shape())
+	}
+	w, err := want.DecodeValue()
+	if err != nil {
+		return 0, err
+	}
+	g, err := got.DecodeValue()
+	if err != nil {
+		return 0, err
+	}
+	c := comparison{tol: tol}
+	c.compare(reflect.ValueOf(w), reflect.ValueOf(g))
+	return c.maxDiff, c.err
+}
+
+type comparison struct {
+	tol     Tolerance
+	maxDiff float64
+	err     error
+	index   []int
+}
+
+func (c *comparison) compare(want, got reflect.Value) {
+	if want.Kind() == reflect.Slice {
+		c.index = append(c.index, 0)
+		for i := 0; i < want.Len(); i++ {
+			c.index[len(c.index)-1] = i
+			c.compare(want.Index(i), got.Index(i))
+		}
+		c.index = c.index[:len(c.index)-1]
+		return
+	}
+	var w, g complex128
+	switch want.Kind() {
+	case reflect.Float32, reflect.Float64:
+		w, g = complex(want.Float(), 0), complex(got.Float(), 0)
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		w, g = complex(float64(want.Int()), 0), complex(float64(got.Int()), 0)
+	case reflect.Uint8, reflect.Uint16:
+		w, g = complex(float64(want.Uint()), 0), complex(float64(got.Uint()), 0)
+	case reflect.Complex64, reflect.Complex128:
+		w, g = want.Complex(), got.Complex()
+	default:
+		if c.err == nil && want.Interface() != got.Interface() {
+			c.err = fmt.Errorf("element %v: got %v, want %v", c.index, got.Interface(), want.Interface())
+		}
+		return
+	}
+	if w == g {
+		return
+	}
+	diff := cmplx.Abs(w - g)
+	if math.IsNaN(diff) {
+		// NaN compares unequal to itself: only a NaN in one of the
+		// tensors is a difference.
+		if cmplx.IsNaN(w) && cmplx.IsNaN(g) {
+			return
+		}
+		diff = math.Inf(1)
+	}
+	if diff > c.maxDiff {
+		c.maxDiff = diff
+	}
+	if c.err == nil && !(diff <= c.tol.Absolute+c.tol.Relative*cmplx.Abs(w)) {
+		c.err = fmt.Errorf("element %v: got %v, want %v (difference %g)", c.index, got.Interface(), want.Interface(), diff)
+	}
+}
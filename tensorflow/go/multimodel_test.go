@@ -0,0 +1,115 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"strings"
+	"testing"
+)
+
+// addModel returns a model computing "y" = "x" + delta.
+func addModel(t *testing.T, delta float32) *SavedModel {
+	g := NewGraph()
+	x, err := Placeholder(g, "x", Float)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := Const(g, "delta", delta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Add(g, "y", x, d); err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewSession(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &SavedModel{Session: s, Graph: g}
+}
+
+func TestMultiModelRunner(t *testing.T) {
+	primary := addModel(t, 1)
+	defer primary.Close()
+	shadow := addModel(t, 1.25)
+	defer shadow.Close()
+	x, err := NewTensor([]float32{1, 2, 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	feeds := map[string]*Tensor{"x": x}
+
+	tests := []struct {
+		tol       Tolerance
+		divergent bool
+	}{
+		{Tolerance{}, true},
+		{Tolerance{Absolute: 0.1}, true},
+		{Tolerance{Absolute: 0.25}, false},
+		{Tolerance{Relative: 0.1}, true},
+		{Tolerance{Relative: 0.125}, false},
+	}
+	for _, test := range tests {
+		var divs []Divergence
+		r := NewMultiModelRunner(primary, shadow, test.tol)
+		r.OnDivergence = func(d Divergence) { divs = append(divs, d) }
+		out, err := r.Run(feeds, []string{"y:0"}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := out[0].Value().([]float32), []float32{2, 3, 5}; got[0] != want[0] || got[1] != want[1] || got[2] != want[2] {
+			t.Errorf("Got %v, want the primary result %v", got, want)
+		}
+		stats := r.Stats()
+		if stats.Runs != 1 || stats.MaxAbsDiff != 0.25 || stats.ShadowErrors != 0 {
+			t.Errorf("%+v: unexpected stats %+v", test.tol, stats)
+		}
+		if got := stats.Divergent == 1; got != test.divergent {
+			t.Errorf("%+v: got divergent %v, want %v", test.tol, got, test.divergent)
+		}
+		if len(divs) != int(stats.Divergent) || (len(divs) > 0 && divs[0].Fetch != "y:0") {
+			t.Errorf("%+v: unexpected divergences %v", test.tol, divs)
+		}
+	}
+}
+
+func TestMultiModelRunnerErrors(t *testing.T) {
+	primary := addModel(t, 1)
+	defer primary.Close()
+	shadow := addModel(t, 1)
+	defer shadow.Close()
+	x, err := NewTensor([]float32{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := NewMultiModelRunner(primary, shadow, Tolerance{})
+	if _, err := r.Run(map[string]*Tensor{"x": x}, []string{"z"}, nil); err == nil || !strings.Contains(err.Error(), `"z" not found`) {
+		t.Errorf("Got error %v for an unknown fetch", err)
+	}
+	if stats := r.Stats(); stats.Runs != 0 {
+		t.Errorf("Failed run was recorded: %+v", stats)
+	}
+
+	// An operation missing from the shadow model only fails the shadow run.
+	if _, err := Neg(primary.Graph, "neg", primary.Graph.Operation("y").Output(0)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Run(map[string]*Tensor{"x": x}, []string{"neg"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if stats := r.Stats(); stats.Runs != 1 || stats.ShadowErrors != 1 || stats.Divergent != 1 {
+		t.Errorf("Unexpected stats %+v", stats)
+	}
+}
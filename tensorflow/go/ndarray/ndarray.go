@@ -0,0 +1,227 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ndarray provides index arithmetic for the row-major layout of
+// tensors, and a few operations built on it, to post-process fetched
+// tensors on the host (e.g., the argmax or top-k of scores).
+//
+// Values are handled as flat slices with a shape, as returned by Float32s:
+// the element at index (i0, ..., in) of a tensor of shape (d0, ..., dn) is
+// at offset i0*s0 + ... + in*sn, where the strides s are those returned by
+// Strides.
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package ndarray
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+// NumElements returns the number of elements of a tensor of the given
+// shape.
+func NumElements(shape []int64) int64 {
+	n := int64(1)
+	for _, d := range shape {
+		n *= d
+	}
+	return n
+}
+
+// Strides returns the number of elements between consecutive indices of
+// each dimension of shape.
+func Strides(shape []int64) []int64 {
+	strides := make([]int64, len(shape))
+	s := int64(1)
+	for i := len(shape) - 1; i >= 0; i-- {
+		strides[i] = s
+		s *= shape[i]
+	}
+	return strides
+}
+
+// Offset returns the offset of the element at index in a tensor of the
+// given shape. It panics if index is out of range.
+func Offset(shape, index []int64) int64 {
+	if len(index) != len(shape) {
+		panic(fmt.Sprintf("ndarray: index %v of rank %d for shape %v", index, len(index), shape))
+	}
+	var offset int64
+	for i, x := range index {
+		if x < 0 || x >= shape[i] {
+			panic(fmt.Sprintf("ndarray: index %v out of range for shape %v", index, shape))
+		}
+		offset = offset*shape[i] + x
+	}
+	return offset
+}
+
+// Unravel returns the index of the element at offset in a tensor of the
+// given shape. It is the inverse of Offset, and panics if offset is out of
+// range.
+func Unravel(shape []int64, offset int64) []int64 {
+	if offset < 0 || offset >= NumElements(shape) {
+		panic(fmt.Sprintf("ndarray: offset %d out of range for shape %v", offset, shape))
+	}
+	index := make([]int64, len(shape))
+	for i := len(shape) - 1; i >= 0; i-- {
+		index[i] = offset % shape[i]
+		offset /= shape[i]
+	}
+	return index
+}
+
+// ForEach calls f with the index and offset of each element of a tensor of
+// the given shape, in row-major order. The index slice is reused between
+// calls, and must not be retained or modified by f.
+func ForEach(shape []int64, f func(index []int64, offset int64)) {
+	n := NumElements(shape)
+	index := make([]int64, len(shape))
+	for offset := int64(0); offset < n; offset++ {
+		f(index, offset)
+		// Increment index, last dimension first.
+		for i := len(index) - 1; i >= 0; i-- {
+			if index[i]++; index[i] < shape[i] {
+				break
+			}
+			index[i] = 0
+		}
+	}
+}
+
+// Lanes calls f for each one-dimensional slice of a tensor of the given
+// shape along axis, in row-major order of the other dimensions: the
+// elements of the slice are at offsets start, start+stride, ...,
+// start+(n-1)*stride. It panics if axis is out of range.
+func Lanes(shape []int64, axis int, f func(start, stride, n int64)) {
+	if axis < 0 || axis >= len(shape) {
+		panic(fmt.Sprintf("ndarray: axis %d out of range for shape %v", axis, shape))
+	}
+	stride := Strides(shape)[axis]
+	outer, n := NumElements(shape[:axis]), shape[axis]
+	for i := int64(0); i < outer; i++ {
+		for j := int64(0); j < stride; j++ {
+			f(i*n*stride+j, stride, n)
+		}
+	}
+}
+
+// ReduceShape returns shape without the dimension axis, the shape of the
+// results of reductions such as ArgMax.
+func ReduceShape(shape []int64, axis int) []int64 {
+	return append(append([]int64(nil), shape[:axis]...), shape[axis+1:]...)
+}
+
+// Map sets dst[i] to f(src[i]) for each element of src, which may be the
+// same slice as dst.
+func Map(dst, src []float32, f func(float32) float32) {
+	for i, v := range src {
+		dst[i] = f(v)
+	}
+}
+
+// ArgMax returns the index along axis of the largest value of each slice of
+// values along axis, as tf.argmax does, in a tensor of shape
+// ReduceShape(shape, axis). The first index is returned for ties, and NaNs
+// are never the largest value unless a slice only has NaNs.
+func ArgMax(values []float32, shape []int64, axis int) []int64 {
+	var result []int64
+	Lanes(shape, axis, func(start, stride, n int64) {
+		best := int64(0)
+		for i := int64(1); i < n; i++ {
+			v, b := values[start+i*stride], values[start+best*stride]
+			if v > b || b != b && v == v {
+				best = i
+			}
+		}
+		result = append(result, best)
+	})
+	return result
+}
+
+// TopK returns the k largest values of each slice of values along the last
+// dimension, and their indices, as tf.nn.top_k does: the results have the
+// shape of values with the last dimension replaced by k, values are sorted
+// in decreasing order, and equal values are ordered by index. k is reduced
+// to the size of the last dimension if larger.
+func TopK(values []float32, shape []int64, k int) (topValues []float32, indices []int64) {
+	if len(shape) == 0 {
+		panic("ndarray: TopK of a scalar")
+	}
+	last := shape[len(shape)-1]
+	if int64(k) > last {
+		k = int(last)
+	}
+	rows := NumElements(shape[:len(shape)-1])
+	topValues = make([]float32, 0, rows*int64(k))
+	indices = make([]int64, 0, rows*int64(k))
+	order := make([]int64, last)
+	for r := int64(0); r < rows; r++ {
+		row := values[r*last : (r+1)*last]
+		for i := range order {
+			order[i] = int64(i)
+		}
+		sort.Stable(byValue{row, order})
+		for _, i := range order[:k] {
+			topValues = append(topValues, row[i])
+			indices = append(indices, i)
+		}
+	}
+	return topValues, indices
+}
+
+// byValue sorts indices of values by decreasing value.
+type byValue struct {
+	values  []float32
+	indices []int64
+}
+
+func (s byValue) Len() int      { return len(s.indices) }
+func (s byValue) Swap(i, j int) { s.indices[i], s.indices[j] = s.indices[j], s.indices[i] }
+func (s byValue) Less(i, j int) bool {
+	return s.values[s.indices[i]] > s.values[s.indices[j]]
+}
+
+// Float32s returns the values of t, a Float tensor, as a flat slice in
+// row-major order, and its shape.
+func Float32s(t *tf.Tensor) ([]float32, []int64, error) {
+	if t.DataType() != tf.Float {
+		return nil, nil, fmt.Errorf("got a %v tensor, want %v", t.DataType(), tf.Float)
+	}
+	v, err := t.DecodeValue()
+	if err != nil {
+		return nil, nil, err
+	}
+	values := make([]float32, 0, NumElements(t.Shape()))
+	var flatten func(v reflect.Value)
+	flatten = func(v reflect.Value) {
+		if v.Kind() != reflect.Slice {
+			values = append(values, float32(v.Float()))
+			return
+		}
+		if v.Type().Elem().Kind() == reflect.Float32 {
+			values = append(values, v.Interface().([]float32)...)
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			flatten(v.Index(i))
+		}
+	}
+	flatten(reflect.ValueOf(v))
+	return values, t.Shape(), nil
+}
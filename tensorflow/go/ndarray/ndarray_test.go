@@ -0,0 +1,135 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ndarray
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+func TestIndexing(t *testing.T) {
+	shape := []int64{2, 3, 4}
+	if got, want := Strides(shape), []int64{12, 4, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Strides: got %v, want %v", got, want)
+	}
+	var visited int64
+	ForEach(shape, func(index []int64, offset int64) {
+		if offset != visited {
+			t.Errorf("Got offset %d, want %d", offset, visited)
+		}
+		visited++
+		if got := Offset(shape, index); got != offset {
+			t.Errorf("Offset(%v): got %d, want %d", index, got, offset)
+		}
+		if got := Unravel(shape, offset); !reflect.DeepEqual(got, index) {
+			t.Errorf("Unravel(%d): got %v, want %v", offset, got, index)
+		}
+	})
+	if visited != NumElements(shape) {
+		t.Errorf("Visited %d elements, want %d", visited, NumElements(shape))
+	}
+	ForEach(nil, func(index []int64, offset int64) {
+		if len(index) != 0 || offset != 0 {
+			t.Errorf("Scalar: got (%v, %d)", index, offset)
+		}
+	})
+}
+
+func TestOffsetPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Offset of an index out of range did not panic")
+		}
+	}()
+	Offset([]int64{2, 2}, []int64{0, 2})
+}
+
+func TestLanes(t *testing.T) {
+	var got [][3]int64
+	Lanes([]int64{2, 3, 2}, 1, func(start, stride, n int64) {
+		got = append(got, [3]int64{start, stride, n})
+	})
+	want := [][3]int64{{0, 2, 3}, {1, 2, 3}, {6, 2, 3}, {7, 2, 3}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Got %v, want %v", got, want)
+	}
+}
+
+func TestArgMax(t *testing.T) {
+	nan := float32(math.NaN())
+	values := []float32{
+		1, 5, 3,
+		7, 5, 7,
+		nan, 2, nan,
+	}
+	shape := []int64{3, 3}
+	if got, want := ArgMax(values, shape, 1), []int64{1, 0, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Axis 1: got %v, want %v", got, want)
+	}
+	if got, want := ArgMax(values, shape, 0), []int64{1, 0, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Axis 0: got %v, want %v", got, want)
+	}
+	if got, want := ReduceShape([]int64{2, 3, 4}, 1), []int64{2, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ReduceShape: got %v, want %v", got, want)
+	}
+}
+
+func TestTopK(t *testing.T) {
+	values := []float32{
+		1, 4, 2, 4,
+		0, -1, 3, 2,
+	}
+	top, indices := TopK(values, []int64{2, 4}, 3)
+	if want := []float32{4, 4, 2, 3, 2, 0}; !reflect.DeepEqual(top, want) {
+		t.Errorf("Got values %v, want %v", top, want)
+	}
+	if want := []int64{1, 3, 2, 2, 3, 0}; !reflect.DeepEqual(indices, want) {
+		t.Errorf("Got indices %v, want %v", indices, want)
+	}
+	if top, _ := TopK(values[:4], []int64{4}, 10); len(top) != 4 {
+		t.Errorf("Got %d values, want 4", len(top))
+	}
+}
+
+func TestFloat32s(t *testing.T) {
+	tensor, err := tf.NewTensor([][]float32{{1, 2}, {3, 4}, {5, 6}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	values, shape, err := Float32s(tensor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []float32{1, 2, 3, 4, 5, 6}; !reflect.DeepEqual(values, want) {
+		t.Errorf("Got %v, want %v", values, want)
+	}
+	if want := []int64{3, 2}; !reflect.DeepEqual(shape, want) {
+		t.Errorf("Got shape %v, want %v", shape, want)
+	}
+	Map(values, values, func(v float32) float32 { return -v })
+	if values[5] != -6 {
+		t.Errorf("Map: got %v", values)
+	}
+	ints, err := tf.NewTensor([]int32{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := Float32s(ints); err == nil {
+		t.Error("Float32s of an Int32 tensor succeeded, want an error")
+	}
+}
@@ -0,0 +1,232 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package numerics detects the operations of TensorFlow graphs producing
+// NaN or infinite values, to debug numeric blowups without tfdbg.
+//
+// Guard adds CheckNumerics operations to a graph, failing runs as soon as
+// an operation produces a NaN or infinite value, and ParseError reports
+// which operation did:
+//
+//	guarded, stats, err := numerics.Guard(graphDef, numerics.Options{Scopes: []string{"model"}})
+//	...
+//	if _, err := session.Run(feeds, fetches, nil); err != nil {
+//		if r, ok := numerics.ParseError(err); ok {
+//			log.Printf("%s:%d produced %s values", r.Op, r.Output, r.Kind)
+//		}
+//	}
+//
+// Graphs constructed with the op package can be guarded by serializing
+// them with Graph.WriteTo and importing the result of Guard.
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package numerics
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+)
+
+// Options configures Guard.
+type Options struct {
+	// Scopes lists the name scopes whose operations are checked, such as
+	// "model/encoder" for the operations named "model/encoder/...". If
+	// empty, all the operations are checked.
+	Scopes []string
+}
+
+// Stats describes the checks added by Guard.
+type Stats struct {
+	// Checked maps the names of the checked outputs, such as "dense/MatMul:0",
+	// to the outputs of their CheckNumerics operations. The original
+	// outputs are only checked when they are used by other operations:
+	// fetch the CheckNumerics outputs instead to check fetched values.
+	Checked map[string]string
+}
+
+// Report describes the first operation of a run that produced NaN or
+// infinite values.
+type Report struct {
+	// Op is the name of the operation, and Output the index of the
+	// output with NaN or infinite values.
+	Op     string
+	Output int
+	// Kind is "NaN", "Inf" or "Inf and NaN".
+	Kind string
+}
+
+// checkedTypes are the types supported by CheckNumerics.
+var checkedTypes = map[tf.DataType]bool{
+	tf.Half:     true,
+	tf.Bfloat16: true,
+	tf.Float:    true,
+	tf.Double:   true,
+}
+
+// unchecked lists the types of operations whose outputs are not checked:
+// those of control flow, which must be connected to each other directly.
+var unchecked = map[string]bool{
+	"Enter":         true,
+	"Exit":          true,
+	"LoopCond":      true,
+	"Merge":         true,
+	"NextIteration": true,
+	"Switch":        true,
+	"RefEnter":      true,
+	"RefExit":       true,
+	"RefMerge":      true,
+	"RefSwitch":     true,
+}
+
+// messagePrefix starts the messages of the CheckNumerics operations, which
+// are followed by the checked output.
+const messagePrefix = "numerics check of "
+
+// Guard returns a graph, given as a serialized tensorflow.GraphDef protocol
+// buffer (https://www.tensorflow.org/code/tensorflow/core/framework/graph.proto),
+// in which the floating point outputs of the operations in the scopes of
+// opts are checked by CheckNumerics operations, and their consumers use the
+// checked values instead. NaN and infinite values thus do not propagate
+// beyond the operation that produced them, which ParseError reports.
+//
+// The outputs of control flow operations are not checked.
+func Guard(graphDef []byte, opts Options) ([]byte, *Stats, error) {
+	var def pb.GraphDef
+	if err := proto.Unmarshal(graphDef, &def); err != nil {
+		return nil, nil, fmt.Errorf("invalid GraphDef: %v", err)
+	}
+	// The types of the outputs are only known once the graph is imported.
+	graph := tf.NewGraph()
+	defer graph.Close()
+	if err := graph.Import(graphDef, ""); err != nil {
+		return nil, nil, err
+	}
+	names := make(map[string]bool, len(def.Node))
+	for _, n := range def.Node {
+		names[n.Name] = true
+	}
+	stats := &Stats{Checked: make(map[string]string)}
+	var checks []*pb.NodeDef
+	for _, n := range def.Node {
+		if unchecked[n.Op] || !inScopes(n.Name, opts.Scopes) {
+			continue
+		}
+		op := graph.Operation(n.Name)
+		for i := 0; i < op.NumOutputs(); i++ {
+			dt := op.Output(i).DataType()
+			if !checkedTypes[dt] {
+				continue
+			}
+			output := n.Name + ":" + strconv.Itoa(i)
+			name := uniqueName(names, n.Name+"/CheckNumerics")
+			checks = append(checks, &pb.NodeDef{
+				Name:   name,
+				Op:     "CheckNumerics",
+				Input:  []string{inputName(n.Name, i)},
+				Device: n.Device,
+				Attr: map[string]*pb.AttrValue{
+					"T":       {Value: &pb.AttrValue_Type{Type: pb.DataType(dt)}},
+					"message": {Value: &pb.AttrValue_S{S: []byte(messagePrefix + output)}},
+				},
+			})
+			stats.Checked[output] = name + ":0"
+		}
+	}
+	for _, n := range def.Node {
+		for i, in := range n.Input {
+			if strings.HasPrefix(in, "^") {
+				continue
+			}
+			if checked, ok := stats.Checked[canonical(in)]; ok {
+				n.Input[i] = strings.TrimSuffix(checked, ":0")
+			}
+		}
+	}
+	def.Node = append(def.Node, checks...)
+	out, err := proto.Marshal(&def)
+	if err != nil {
+		return nil, nil, err
+	}
+	return out, stats, nil
+}
+
+// errorPattern matches the messages of the errors of CheckNumerics
+// operations added by Guard.
+var errorPattern = regexp.MustCompile(regexp.QuoteMeta(messagePrefix) + `(\S+):(\d+) : Tensor had (.+?) values`)
+
+// ParseError returns the Report of err, an error returned by Session.Run
+// for a graph guarded by Guard, and false if err was not caused by a
+// CheckNumerics operation added by Guard.
+func ParseError(err error) (*Report, bool) {
+	if err == nil {
+		return nil, false
+	}
+	m := errorPattern.FindStringSubmatch(err.Error())
+	if m == nil {
+		return nil, false
+	}
+	output, _ := strconv.Atoi(m[2])
+	return &Report{Op: m[1], Output: output, Kind: m[3]}, true
+}
+
+// inScopes returns true if the operation named name is in one of scopes,
+// or if scopes is empty.
+func inScopes(name string, scopes []string) bool {
+	if len(scopes) == 0 {
+		return true
+	}
+	for _, s := range scopes {
+		s = strings.TrimSuffix(s, "/")
+		if name == s || strings.HasPrefix(name, s+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// uniqueName returns name, or name with a suffix if it is already in names,
+// and adds it to names.
+func uniqueName(names map[string]bool, name string) string {
+	unique := name
+	for i := 1; names[unique]; i++ {
+		unique = name + "_" + strconv.Itoa(i)
+	}
+	names[unique] = true
+	return unique
+}
+
+// inputName returns the name of output i of op in the inputs of NodeDefs.
+func inputName(op string, i int) string {
+	if i == 0 {
+		return op
+	}
+	return op + ":" + strconv.Itoa(i)
+}
+
+// canonical returns input, the name of an output in the inputs of NodeDefs,
+// with an explicit index.
+func canonical(input string) string {
+	op, index, err := tf.ParseTensorName(input)
+	if err != nil {
+		return input
+	}
+	return op + ":" + strconv.Itoa(index)
+}
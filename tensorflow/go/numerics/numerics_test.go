@@ -0,0 +1,117 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package numerics
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+// guarded returns a session running the graph built by build, guarded with
+// opts.
+func guarded(t *testing.T, opts Options, build func(s *op.Scope)) (*tf.Session, *tf.Graph, *Stats) {
+	s := op.NewScope()
+	build(s)
+	graph, err := s.Finalize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if _, err := graph.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	def, stats, err := Guard(buf.Bytes(), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := tf.NewGraph()
+	if err := g.Import(def, ""); err != nil {
+		t.Fatal(err)
+	}
+	sess, err := tf.NewSession(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sess, g, stats
+}
+
+func TestGuard(t *testing.T) {
+	sess, g, stats := guarded(t, Options{}, func(s *op.Scope) {
+		x := op.Placeholder(s.SubScope("x"), tf.Float)
+		y := op.Mul(s.SubScope("mul"), x, op.Const(s.SubScope("two"), float32(2)))
+		op.Log(s.SubScope("log"), y)
+		op.Cast(s.SubScope("cast"), x, tf.Int32)
+	})
+	defer sess.Close()
+	want := map[string]string{
+		"x/Placeholder:0": "x/Placeholder/CheckNumerics:0",
+		"log/Log:0":       "log/Log/CheckNumerics:0",
+		"mul/Mul:0":       "mul/Mul/CheckNumerics:0",
+		"two/Const:0":     "two/Const/CheckNumerics:0",
+	}
+	if !reflect.DeepEqual(stats.Checked, want) {
+		t.Errorf("Got checked outputs %v, want %v", stats.Checked, want)
+	}
+	x := g.Operation("x/Placeholder").Output(0)
+	log := g.Operation("log/Log/CheckNumerics").Output(0)
+	tests := []struct {
+		x    float32
+		want *Report
+	}{
+		{1, nil},
+		{-1, &Report{Op: "log/Log", Output: 0, Kind: "NaN"}},
+		{0, &Report{Op: "log/Log", Output: 0, Kind: "Inf"}},
+		{3e38, &Report{Op: "mul/Mul", Output: 0, Kind: "Inf"}},
+	}
+	for _, test := range tests {
+		feed, err := tf.NewTensor(test.x)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = sess.Run(map[tf.Output]*tf.Tensor{x: feed}, []tf.Output{log}, nil)
+		got, ok := ParseError(err)
+		if test.want == nil {
+			if err != nil {
+				t.Errorf("x=%v: %v", test.x, err)
+			}
+			continue
+		}
+		if !ok || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("x=%v: got report %+v for error %v, want %+v", test.x, got, err, test.want)
+		}
+	}
+}
+
+func TestGuardScopes(t *testing.T) {
+	sess, _, stats := guarded(t, Options{Scopes: []string{"b/"}}, func(s *op.Scope) {
+		op.Const(s.SubScope("a"), float32(1))
+		op.Const(s.SubScope("b"), float32(1))
+		op.Const(s.SubScope("bc"), float32(1))
+	})
+	defer sess.Close()
+	if want := map[string]string{"b/Const:0": "b/Const/CheckNumerics:0"}; !reflect.DeepEqual(stats.Checked, want) {
+		t.Errorf("Got checked outputs %v, want %v", stats.Checked, want)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	if r, ok := ParseError(nil); ok {
+		t.Errorf("Got %+v for a nil error", r)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package op
+
+import (
+	"fmt"
+	"strings"
+)
+
+// WithAttrs returns a new Scope which will cause all operations added to
+// the graph to carry attrs, in addition to the attributes of s, for example
+// hints such as "_fused" for the graph rewrites of the runtime or of
+// custom optimizers experimenting with fusion strategies. Attributes set by
+// the operations themselves take precedence.
+//
+// The names of the attributes must start with an underscore: TensorFlow
+// only accepts attributes outside the definition of an operation with
+// such names, and ignores those it does not know about.
+//
+// The returned Scope shares the namespace of s.
+func (s *Scope) WithAttrs(attrs map[string]interface{}) *Scope {
+	merged := withAttrs(attrs, s.attrs)
+	for name := range attrs {
+		if !strings.HasPrefix(name, "_") {
+			s.UpdateErr("WithAttrs", fmt.Errorf("attribute %q does not start with an underscore", name))
+		}
+	}
+	c := s.clone()
+	c.attrs = merged
+	return c
+}
+
+// withAttrs returns a copy of attrs with the attributes of extra that it
+// does not set.
+func withAttrs(attrs, extra map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(attrs)+len(extra))
+	for name, value := range extra {
+		merged[name] = value
+	}
+	for name, value := range attrs {
+		merged[name] = value
+	}
+	return merged
+}
@@ -0,0 +1,53 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package op
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+func TestWithAttrs(t *testing.T) {
+	s, rec := NewRecordingScope()
+	x := Placeholder(s.SubScope("x"), tf.Float)
+	fused := s.WithAttrs(map[string]interface{}{"_fused": "dense", "_group": int64(1)})
+	y := MatMul(fused.SubScope("dense"), x, x)
+	Relu(fused.WithAttrs(map[string]interface{}{"_group": int64(2)}).SubScope("relu"), y)
+	graph, err := s.Finalize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"attr _fused:", "attr _group: 1", "attr _group: 2"} {
+		if !strings.Contains(rec.String(), want) {
+			t.Errorf("%q not found in the recording:\n%s", want, rec)
+		}
+	}
+	var buf bytes.Buffer
+	if _, err := graph.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if n := bytes.Count(buf.Bytes(), []byte("_fused")); n != 2 {
+		t.Errorf("Got %d operations with the _fused attribute, want 2", n)
+	}
+
+	s = NewScope()
+	s.WithAttrs(map[string]interface{}{"fused": true})
+	if s.Err() == nil {
+		t.Error("WithAttrs accepted an attribute without an underscore")
+	}
+}
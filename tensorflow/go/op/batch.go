@@ -0,0 +1,26 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package op
+
+import tf "github.com/tensorflow/tensorflow/tensorflow/go"
+
+// EnsureBatchDim adds a leading batch dimension of size 1 to x, so that a
+// single example can be fed to operations that process batches of examples.
+// See tf.SqueezeBatch for removing the batch dimension from results, and
+// tf.SavedModel.PredictSingle for models loaded from disk.
+func EnsureBatchDim(scope *Scope, x tf.Output) tf.Output {
+	scope = scope.SubScope("EnsureBatchDim")
+	return ExpandDims(scope, x, Const(scope, int32(0)))
+}
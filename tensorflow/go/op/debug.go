@@ -0,0 +1,110 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package op
+
+import (
+	"errors"
+	"fmt"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+// WithoutDebugOps returns a new Scope in which AssertShape and DebugPrint
+// add no operation and return their inputs, for example to build the
+// graph of a model for export without the checks and logging used while
+// developing it.
+//
+// The returned Scope shares the namespace of s.
+func (s *Scope) WithoutDebugOps() *Scope {
+	c := s.clone()
+	c.noDebug = true
+	return c
+}
+
+// AssertShape returns x, checking that its shape is compatible with shape.
+// Incompatible shapes known when the graph is constructed are reported as
+// an error of the scope. Otherwise, the returned Output depends on Assert
+// operations failing the run in which it is computed if the shape of x is
+// not compatible: operations using it instead of x never see a tensor of
+// the wrong shape.
+func AssertShape(scope *Scope, x tf.Output, shape tf.Shape) tf.Output {
+	if scope.Err() != nil {
+		return x
+	}
+	static := x.Shape()
+	if !static.IsCompatibleWith(shape) {
+		scope.UpdateErr("AssertShape", fmt.Errorf("%s:%d has shape %v, which is incompatible with %v", x.Op.Name(), x.Index, static, shape))
+		return x
+	}
+	rank := shape.NumDimensions()
+	if scope.noDebug || rank < 0 {
+		return x
+	}
+	var (
+		indices []int32
+		dims    []int64
+		checked = static.NumDimensions() >= 0
+	)
+	for i := 0; i < rank; i++ {
+		if size := shape.Size(i); size >= 0 {
+			indices = append(indices, int32(i))
+			dims = append(dims, size)
+			if static.Size(i) < 0 {
+				checked = false
+			}
+		}
+	}
+	if checked {
+		// The shape of x is known to be compatible.
+		return x
+	}
+	scope = scope.SubScope("AssertShape")
+	actual := Shape(scope, x, ShapeOutType(tf.Int64))
+	data := []tf.Output{Const(scope.SubScope("message"), fmt.Sprintf("Expected a tensor of shape %v, got", shape)), actual}
+	rankScope := scope.SubScope("rank")
+	asserts := []*tf.Operation{Assert(rankScope, Equal(rankScope, Size(rankScope, actual), Const(rankScope, int32(rank))), data)}
+	if len(indices) > 0 {
+		// The dimensions can only be gathered once the rank is valid.
+		s := scope.SubScope("dims").WithControlDependencies(asserts[0])
+		equal := Equal(s, Gather(s, actual, Const(s.SubScope("indices"), indices)), Const(s.SubScope("expected"), dims))
+		asserts = append(asserts, Assert(s, All(s, equal, Const(s.SubScope("axis"), int32(0))), data))
+	}
+	return Identity(scope.WithControlDependencies(asserts...), x)
+}
+
+// DebugPrint returns tensors, printing msg followed by their first values
+// to the standard error of the process computing them whenever they are
+// computed. The returned Outputs must be used instead of tensors for the
+// values to be printed.
+func DebugPrint(scope *Scope, msg string, tensors ...tf.Output) []tf.Output {
+	if scope.Err() != nil || scope.noDebug {
+		return tensors
+	}
+	if len(tensors) == 0 {
+		scope.UpdateErr("DebugPrint", errors.New("no tensors to print"))
+		return nil
+	}
+	scope = scope.SubScope("DebugPrint")
+	printed := Print(scope, tensors[0], tensors, PrintMessage(msg))
+	if scope.Err() != nil {
+		return tensors
+	}
+	ret := []tf.Output{printed}
+	after := scope.WithControlDependencies(printed.Op)
+	for _, t := range tensors[1:] {
+		ret = append(ret, Identity(after, t))
+	}
+	return ret
+}
@@ -0,0 +1,113 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package op
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+func TestAssertShape(t *testing.T) {
+	s := NewScope()
+	x := Placeholder(s.SubScope("x"), tf.Float)
+	checked := AssertShape(s, x, tf.MakeShape(-1, 2))
+	known := Const(s, [][]float32{{1, 2}})
+	if got := AssertShape(s, known, tf.MakeShape(1, 2)); got != known {
+		t.Errorf("A runtime check was added for a tensor of a known shape")
+	}
+	if got := AssertShape(s.WithoutDebugOps(), x, tf.MakeShape(-1, 2)); got != x {
+		t.Errorf("A runtime check was added without debug operations")
+	}
+	graph, err := s.Finalize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sess, err := tf.NewSession(graph, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sess.Close()
+	tests := []struct {
+		value   interface{}
+		wantErr bool
+	}{
+		{[][]float32{{1, 2}, {3, 4}, {5, 6}}, false},
+		{[][]float32{{1, 2, 3}}, true},
+		{[]float32{1, 2}, true},
+		{[][][]float32{{{1, 2}}}, true},
+	}
+	for _, test := range tests {
+		feed, err := tf.NewTensor(test.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		results, err := sess.Run(map[tf.Output]*tf.Tensor{x: feed}, []tf.Output{checked}, nil)
+		if test.wantErr {
+			if err == nil || !strings.Contains(err.Error(), "Expected a tensor of shape [?, 2]") {
+				t.Errorf("%v: got error %v, want a failed assertion", test.value, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: %v", test.value, err)
+			continue
+		}
+		if got := results[0].Value(); !reflect.DeepEqual(got, test.value) {
+			t.Errorf("Got %v, want %v", got, test.value)
+		}
+	}
+}
+
+func TestAssertShapeStatic(t *testing.T) {
+	s := NewScope()
+	AssertShape(s, Const(s, []float32{1, 2}), tf.MakeShape(3))
+	if err := s.Err(); err == nil || !strings.Contains(err.Error(), "incompatible with [3]") {
+		t.Errorf("Got error %v, want an incompatible shape", err)
+	}
+}
+
+func TestDebugPrint(t *testing.T) {
+	s := NewScope()
+	a, b := Const(s.SubScope("a"), int32(1)), Const(s.SubScope("b"), "b")
+	printed := DebugPrint(s, "values: ", a, b)
+	if got := DebugPrint(s.WithoutDebugOps(), "values: ", a, b); got[0] != a || got[1] != b {
+		t.Errorf("Operations were added without debug operations")
+	}
+	graph, err := s.Finalize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(printed) != 2 {
+		t.Fatalf("Got %d outputs, want 2", len(printed))
+	}
+	if got := printed[1].Op.ControlInputs(); len(got) != 1 || got[0].Name() != printed[0].Op.Name() {
+		t.Errorf("%s does not depend on %s", printed[1].Op.Name(), printed[0].Op.Name())
+	}
+	sess, err := tf.NewSession(graph, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sess.Close()
+	results, err := sess.Run(nil, printed, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := []interface{}{results[0].Value(), results[1].Value()}; !reflect.DeepEqual(got, []interface{}{int32(1), "b"}) {
+		t.Errorf("Got %v, want [1 b]", got)
+	}
+}
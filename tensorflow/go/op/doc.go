@@ -0,0 +1,134 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package op
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+// OpDoc documents a TensorFlow operation, for interactive tools showing
+// help about operations.
+type OpDoc struct {
+	// Summary is a one line description of the operation, and
+	// Description an optional longer one.
+	Summary, Description string
+	Inputs               []ArgDoc
+	Outputs              []ArgDoc
+	Attrs                []ArgDoc
+}
+
+// ArgDoc documents an input, output or attribute of an operation.
+type ArgDoc struct {
+	Name        string
+	Description string
+}
+
+// opDocs is set by the file generated with genop -docs_outfile, which is
+// compiled with the opdocs build tag.
+var opDocs map[string]OpDoc
+
+// registered holds the operations registered with the runtime, for
+// operations missing from opDocs.
+var registered struct {
+	once sync.Once
+	ops  map[string]tf.OpInfo
+}
+
+// Doc returns the documentation of operations of type opType, such as
+// "Conv2D", and false if the type is unknown.
+//
+// The summaries and descriptions of operations are only available in
+// programs built with the opdocs build tag, which embeds them into the
+// binary:
+//
+//	go build -tags opdocs
+//
+// Otherwise, and for operations loaded with tf.LoadLibrary, only the names
+// of the inputs, outputs and attributes are returned. The operations
+// registered with the runtime are listed by the first call, so libraries
+// must be loaded before it.
+func Doc(opType string) (OpDoc, bool) {
+	if doc, ok := opDocs[opType]; ok {
+		return doc, true
+	}
+	registered.once.Do(func() {
+		ops, err := tf.RegisteredOps()
+		if err != nil {
+			return
+		}
+		registered.ops = make(map[string]tf.OpInfo, len(ops))
+		for _, op := range ops {
+			registered.ops[op.Name] = op
+		}
+	})
+	info, ok := registered.ops[opType]
+	if !ok {
+		return OpDoc{}, false
+	}
+	var doc OpDoc
+	for _, a := range info.Inputs {
+		doc.Inputs = append(doc.Inputs, ArgDoc{Name: a.Name})
+	}
+	for _, a := range info.Outputs {
+		doc.Outputs = append(doc.Outputs, ArgDoc{Name: a.Name})
+	}
+	for _, a := range info.Attrs {
+		doc.Attrs = append(doc.Attrs, ArgDoc{Name: a.Name})
+	}
+	return doc, true
+}
+
+// String formats the documentation as plain text help, for example:
+//
+//	Computes numerical negative value element-wise.
+//
+//	I.e., \(y = -x\).
+//
+//	Inputs:
+//	  x
+//	Outputs:
+//	  y
+//	Attrs:
+//	  T
+func (d OpDoc) String() string {
+	var buf bytes.Buffer
+	if d.Summary != "" {
+		fmt.Fprintf(&buf, "%s\n\n", d.Summary)
+	}
+	if d.Description != "" {
+		fmt.Fprintf(&buf, "%s\n\n", d.Description)
+	}
+	for _, section := range []struct {
+		title string
+		args  []ArgDoc
+	}{{"Inputs", d.Inputs}, {"Outputs", d.Outputs}, {"Attrs", d.Attrs}} {
+		if len(section.args) == 0 {
+			continue
+		}
+		fmt.Fprintf(&buf, "%s:\n", section.title)
+		for _, a := range section.args {
+			if a.Description == "" {
+				fmt.Fprintf(&buf, "  %s\n", a.Name)
+			} else {
+				fmt.Fprintf(&buf, "  %s: %s\n", a.Name, a.Description)
+			}
+		}
+	}
+	return buf.String()
+}
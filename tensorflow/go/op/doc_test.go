@@ -0,0 +1,51 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package op
+
+import "testing"
+
+func TestDoc(t *testing.T) {
+	doc, ok := Doc("Neg")
+	if !ok {
+		t.Fatal("Neg is not documented")
+	}
+	if len(doc.Inputs) != 1 || doc.Inputs[0].Name != "x" || len(doc.Outputs) != 1 || doc.Outputs[0].Name != "y" {
+		t.Errorf("Got %+v, want input x and output y", doc)
+	}
+	if doc, ok := Doc("NoSuchOp"); ok {
+		t.Errorf("Got %+v for an unknown operation", doc)
+	}
+}
+
+func TestOpDocString(t *testing.T) {
+	doc := OpDoc{
+		Summary:     "Computes numerical negative value element-wise.",
+		Description: "I.e., \\(y = -x\\).",
+		Inputs:      []ArgDoc{{Name: "x", Description: "The input."}},
+		Outputs:     []ArgDoc{{Name: "y"}},
+	}
+	want := `Computes numerical negative value element-wise.
+
+I.e., \(y = -x\).
+
+Inputs:
+  x: The input.
+Outputs:
+  y
+`
+	if got := doc.String(); got != want {
+		t.Errorf("Got:\n%s\nWant:\n%s", got, want)
+	}
+}
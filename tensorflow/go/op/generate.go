@@ -14,5 +14,7 @@
 
 //go:generate go generate ../genop
 //go:generate go run ../genop/main.go -outfile wrappers.go
+//go:generate go run ../genop/main.go -docs_outfile docs_generated.go
+//go:generate go run ../genop/main.go -gradients_outfile gradients_generated.go -tensorflow_src ../../..
 
 package op
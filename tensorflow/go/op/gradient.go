@@ -0,0 +1,49 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package op
+
+import "fmt"
+
+// opGradients is set by the file generated with genop -gradients_outfile.
+var opGradients map[string]bool
+
+// HasGradient reports whether the TensorFlow runtime registers a gradient
+// for operations of type opType, such as "Conv2D", so that graphs using
+// them can be differentiated. Operations declared not differentiable, such
+// as "Shape", whose gradient is zero, have one.
+//
+// The table of gradients is generated from the TensorFlow sources by genop,
+// as the runtime does not expose its registry: gradients registered by
+// libraries loaded with tf.LoadLibrary are not known.
+func HasGradient(opType string) bool {
+	return opGradients[opType]
+}
+
+// CheckGradients returns an error listing the types of operations without a
+// gradient among opTypes, for training graph builders to fail fast.
+func CheckGradients(opTypes ...string) error {
+	var missing []string
+	seen := make(map[string]bool)
+	for _, t := range opTypes {
+		if !HasGradient(t) && !seen[t] {
+			seen[t] = true
+			missing = append(missing, t)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("no gradient registered for %q", missing)
+	}
+	return nil
+}
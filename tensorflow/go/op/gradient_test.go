@@ -0,0 +1,41 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package op
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHasGradient(t *testing.T) {
+	for op, want := range map[string]bool{
+		"Conv2D":      true,
+		"MatMul":      true,
+		"Shape":       true,
+		"Placeholder": false,
+		"NoSuchOp":    false,
+	} {
+		if got := HasGradient(op); got != want {
+			t.Errorf("HasGradient(%q): got %v, want %v", op, got, want)
+		}
+	}
+	if err := CheckGradients("MatMul", "Conv2D"); err != nil {
+		t.Error(err)
+	}
+	err := CheckGradients("MatMul", "NoSuchOp", "NoSuchOp", "Placeholder")
+	if err == nil || !strings.Contains(err.Error(), `["NoSuchOp" "Placeholder"]`) {
+		t.Errorf("Got error %v, want one listing NoSuchOp and Placeholder", err)
+	}
+}
@@ -0,0 +1,116 @@
+// DO NOT EDIT
+// This file was machine generated by github.com/tensorflow/tensorflow/tensorflow/go/genop/internal
+
+package op
+
+func init() {
+	opGradients = map[string]bool{
+		"Abs":                     true,
+		"Acos":                    true,
+		"Add":                     true,
+		"AddN":                    true,
+		"Asin":                    true,
+		"Atan":                    true,
+		"BatchMatMul":             true,
+		"BatchToSpace":            true,
+		"BatchToSpaceND":          true,
+		"BroadcastGradientArgs":   true,
+		"CheckNumerics":           true,
+		"Complex":                 true,
+		"Concat":                  true,
+		"ConcatOffset":            true,
+		"ConcatV2":                true,
+		"Conj":                    true,
+		"Const":                   true,
+		"Conv2D":                  true,
+		"Cos":                     true,
+		"CrossEntropy":            true,
+		"DepthToSpace":            true,
+		"Diag":                    true,
+		"DiagPart":                true,
+		"Div":                     true,
+		"EditDistance":            true,
+		"Elu":                     true,
+		"Exp":                     true,
+		"ExpandDims":              true,
+		"Expm1":                   true,
+		"Fill":                    true,
+		"GatherNd":                true,
+		"Identity":                true,
+		"Imag":                    true,
+		"Inv":                     true,
+		"InvertPermutation":       true,
+		"Log":                     true,
+		"Log1p":                   true,
+		"MapAccumulate":           true,
+		"MatMul":                  true,
+		"MatrixBandPart":          true,
+		"MatrixDiag":              true,
+		"Max":                     true,
+		"MaxPool":                 true,
+		"Maximum":                 true,
+		"Mean":                    true,
+		"Min":                     true,
+		"Minimum":                 true,
+		"MirrorPad":               true,
+		"MirrorPadGrad":           true,
+		"Mul":                     true,
+		"Neg":                     true,
+		"OneHot":                  true,
+		"Pack":                    true,
+		"Pad":                     true,
+		"Pow":                     true,
+		"Prod":                    true,
+		"QuantizeAndDequantize":   true,
+		"QuantizeAndDequantizeV2": true,
+		"Rank":                    true,
+		"Real":                    true,
+		"RealDiv":                 true,
+		"Reciprocal":              true,
+		"RefIdentity":             true,
+		"Relu":                    true,
+		"Relu6":                   true,
+		"Reshape":                 true,
+		"Reverse":                 true,
+		"ReverseSequence":         true,
+		"ReverseV2":               true,
+		"Rsqrt":                   true,
+		"ScatterNd":               true,
+		"SegmentMax":              true,
+		"SegmentMean":             true,
+		"SegmentMin":              true,
+		"SegmentSum":              true,
+		"Select":                  true,
+		"Shape":                   true,
+		"ShapeN":                  true,
+		"Sigmoid":                 true,
+		"Sign":                    true,
+		"Sin":                     true,
+		"Size":                    true,
+		"Slice":                   true,
+		"Softmax":                 true,
+		"SpaceToBatch":            true,
+		"SpaceToBatchND":          true,
+		"SpaceToDepth":            true,
+		"SparseMatMul":            true,
+		"SparseSegmentMean":       true,
+		"SparseSegmentSqrtN":      true,
+		"SparseSegmentSum":        true,
+		"Split":                   true,
+		"Sqrt":                    true,
+		"Square":                  true,
+		"Squeeze":                 true,
+		"StopGradient":            true,
+		"StridedSlice":            true,
+		"StridedSliceGrad":        true,
+		"Sub":                     true,
+		"Sum":                     true,
+		"Tan":                     true,
+		"Tanh":                    true,
+		"Transpose":               true,
+		"Unpack":                  true,
+		"UnsortedSegmentMax":      true,
+		"UnsortedSegmentSum":      true,
+		"ZerosLike":               true,
+	}
+}
@@ -39,6 +39,8 @@ func Const(scope *Scope, value interface{}) (output tf.Output) {
 			scope.UpdateErr("Const", err)
 			return
 		}
+		// The graph keeps its own copy of the value.
+		defer t.Release()
 	}
 	return scope.AddOperation(tf.OpSpec{
 		Type: "Const",
@@ -17,6 +17,7 @@
 package op
 
 import (
+	"strings"
 	"testing"
 
 	tf "github.com/tensorflow/tensorflow/tensorflow/go"
@@ -31,3 +32,99 @@ func TestPlaceholder(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestEnsureBatchDim(t *testing.T) {
+	s := NewScope()
+	x := Placeholder(s, tf.Float, PlaceholderShape(tf.MakeShape(-1, 10)))
+	y := EnsureBatchDim(s, x)
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := y.Shape().String(), "[1, ?, 10]"; got != want {
+		t.Errorf("Got shape %v, want %v", got, want)
+	}
+}
+
+func TestIsStateful(t *testing.T) {
+	tests := map[string]bool{
+		"RandomUniform": true,
+		"VariableV2":    true,
+		"Add":           false,
+		"NoSuchOp":      false,
+	}
+	for opType, want := range tests {
+		if got := IsStateful(opType); got != want {
+			t.Errorf("IsStateful(%q) = %v, want %v", opType, got, want)
+		}
+	}
+}
+
+func TestGeneratedDTypeValidation(t *testing.T) {
+	s := NewScope()
+	shape := Const(s, []int32{2})
+	RandomUniform(s.SubScope("float"), shape, tf.Float)
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+	RandomUniform(s.SubScope("int"), shape, tf.Int32)
+	if err := s.Err(); err == nil || !strings.Contains(err.Error(), "int32 is not one of the allowed types") {
+		t.Errorf("Got error %v, want an error about the allowed types", err)
+	}
+}
+
+func TestGeneratedAttrsAreNotShared(t *testing.T) {
+	s := NewScope()
+	x := Placeholder(s.SubScope("x"), tf.Float, PlaceholderShape(tf.MakeShape(2, 3)))
+	y := Placeholder(s.SubScope("y"), tf.Int32)
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := x.Shape().NumDimensions(), 2; got != want {
+		t.Errorf("Got %d dimensions for x, want %d", got, want)
+	}
+	// The attribute map of y is recycled from x, it must not keep its shape.
+	if got, want := y.Shape().NumDimensions(), -1; got != want {
+		t.Errorf("Got %d dimensions for y, want %d", got, want)
+	}
+	if got, want := y.DataType(), tf.Int32; got != want {
+		t.Errorf("Got %v, want %v", got, want)
+	}
+}
+
+// attrSink makes the attribute maps of BenchmarkAttrs escape to the heap,
+// as they do in the generated functions by being passed to AddOperation.
+var attrSink map[string]interface{}
+
+// BenchmarkAttrs compares the attribute maps allocated for each operation,
+// as the generated functions did before, with those taken from attrPool.
+func BenchmarkAttrs(b *testing.B) {
+	shape := tf.MakeShape(-1, 10)
+	b.Run("Literal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			attrs := map[string]interface{}{"dtype": tf.Float}
+			PlaceholderShape(shape)(attrs)
+			attrSink = attrs
+		}
+	})
+	b.Run("Pooled", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			attrs := newAttrs()
+			attrs["dtype"] = tf.Float
+			PlaceholderShape(shape)(attrs)
+			attrSink = attrs
+			releaseAttrs(attrs)
+		}
+	})
+	b.Run("Graph", func(b *testing.B) {
+		b.ReportAllocs()
+		s := NewScope()
+		for i := 0; i < b.N; i++ {
+			Placeholder(s.SubScope("x"), tf.Float, PlaceholderShape(shape))
+		}
+		if err := s.Err(); err != nil {
+			b.Fatal(err)
+		}
+	})
+}
@@ -0,0 +1,124 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package op
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+// Raw adds an operation of type opType to the graph, for tools that build
+// graphs from configuration rather than code and cannot use the generated
+// functions. The values of attrs must have the Go types that the generated
+// functions use for the attributes, such as int64 for "int" and
+// tf.DataType for "type".
+//
+// The attributes are checked against the definition of the operation
+// registered with the runtime, including operations of plugin libraries
+// loaded with tf.LoadLibrary: an unknown operation, an unknown attribute
+// or a value of the wrong type makes the scope fail with an error naming
+// the attribute.
+//
+// Outputs of the returned operation can be obtained with its Output method.
+func Raw(scope *Scope, opType string, inputs []tf.Input, attrs map[string]interface{}) (o *tf.Operation) {
+	if scope.Err() != nil {
+		return
+	}
+	if err := checkAttrs(opType, attrs); err != nil {
+		scope.UpdateErr(opType, err)
+		return
+	}
+	return scope.AddOperation(tf.OpSpec{
+		Type:  opType,
+		Input: inputs,
+		Attrs: attrs,
+	})
+}
+
+// attrGoTypes maps the types of attributes to the Go types of their values.
+var attrGoTypes = map[string]reflect.Type{
+	"string":       reflect.TypeOf(""),
+	"list(string)": reflect.TypeOf([]string(nil)),
+	"int":          reflect.TypeOf(int64(0)),
+	"list(int)":    reflect.TypeOf([]int64(nil)),
+	"float":        reflect.TypeOf(float32(0)),
+	"list(float)":  reflect.TypeOf([]float32(nil)),
+	"bool":         reflect.TypeOf(false),
+	"list(bool)":   reflect.TypeOf([]bool(nil)),
+	"type":         reflect.TypeOf(tf.DataType(0)),
+	"list(type)":   reflect.TypeOf([]tf.DataType(nil)),
+	"shape":        reflect.TypeOf(tf.Shape{}),
+	"list(shape)":  reflect.TypeOf([]tf.Shape(nil)),
+	"tensor":       reflect.TypeOf((*tf.Tensor)(nil)),
+	"list(tensor)": reflect.TypeOf([]*tf.Tensor(nil)),
+	"func":         reflect.TypeOf(tf.NameAttrList{}),
+	"list(func)":   reflect.TypeOf([]tf.NameAttrList(nil)),
+}
+
+func checkAttrs(opType string, attrs map[string]interface{}) error {
+	info, err := registeredOp(opType)
+	if err != nil {
+		return err
+	}
+	types := make(map[string]string, len(info.Attrs))
+	for _, a := range info.Attrs {
+		types[a.Name] = a.Type
+	}
+	for name, value := range attrs {
+		typ, ok := types[name]
+		if !ok {
+			return fmt.Errorf("%s has no attribute %q", opType, name)
+		}
+		want, ok := attrGoTypes[typ]
+		if !ok {
+			return fmt.Errorf("attribute %q of type %q is not supported", name, typ)
+		}
+		if got := reflect.TypeOf(value); got != want {
+			return fmt.Errorf("attribute %q of type %q must be a %v, not %v", name, typ, want, got)
+		}
+	}
+	return nil
+}
+
+var registry struct {
+	sync.Mutex
+	ops map[string]*tf.OpInfo
+}
+
+// registeredOp returns the definition of operations of type opType. The
+// registered operations are listed again if opType is unknown, as plugin
+// libraries may have been loaded since they were last listed.
+func registeredOp(opType string) (*tf.OpInfo, error) {
+	registry.Lock()
+	defer registry.Unlock()
+	if info, ok := registry.ops[opType]; ok {
+		return info, nil
+	}
+	ops, err := tf.RegisteredOps()
+	if err != nil {
+		return nil, err
+	}
+	registry.ops = make(map[string]*tf.OpInfo, len(ops))
+	for i := range ops {
+		registry.ops[ops[i].Name] = &ops[i]
+	}
+	if info, ok := registry.ops[opType]; ok {
+		return info, nil
+	}
+	return nil, fmt.Errorf("operation %q is not registered", opType)
+}
@@ -0,0 +1,54 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package op
+
+import (
+	"strings"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+func TestRaw(t *testing.T) {
+	s := NewScope()
+	x := Placeholder(s.SubScope("x"), tf.Float)
+	cast := Raw(s, "Cast", []tf.Input{x}, map[string]interface{}{"DstT": tf.Int32})
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if got := cast.Output(0).DataType(); got != tf.Int32 {
+		t.Errorf("Got %v, want %v", got, tf.Int32)
+	}
+
+	tests := []struct {
+		opType string
+		attrs  map[string]interface{}
+		want   string
+	}{
+		{"NoSuchOp", nil, `operation "NoSuchOp" is not registered`},
+		{"Cast", map[string]interface{}{"DstT": tf.Int32, "Foo": int64(1)}, `Cast has no attribute "Foo"`},
+		{"Cast", map[string]interface{}{"DstT": "int32"}, `attribute "DstT" of type "type" must be a tensorflow.DataType, not string`},
+	}
+	for _, test := range tests {
+		s := NewScope()
+		x := Placeholder(s.SubScope("x"), tf.Float)
+		if op := Raw(s, test.opType, []tf.Input{x}, test.attrs); op != nil {
+			t.Errorf("%s %v: got an operation, want an error", test.opType, test.attrs)
+		}
+		if err := s.Err(); err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%s %v: got error %v, want %q", test.opType, test.attrs, err, test.want)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package op
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+// Recording is a canonical text description of the operations added to a
+// graph through a Scope, intended to be compared against golden files in
+// tests so that changes to the code constructing a graph can be verified not
+// to change the graph itself.
+//
+// Each operation is described by a line containing its type and name,
+// followed by indented lines for each of its inputs (in order), control
+// inputs, attributes (sorted by name) and device, if any. For example:
+//
+//	Add "layer/Add"
+//	  input "x:0"
+//	  input "layer/Const:0"
+//	  device "/device:GPU:0"
+type Recording struct {
+	buf bytes.Buffer
+}
+
+// NewRecordingScope is like NewScope, but also returns a Recording of the
+// operations successfully added through the returned Scope and all the
+// scopes derived from it.
+func NewRecordingScope() (*Scope, *Recording) {
+	s := NewScope()
+	s.rec = new(Recording)
+	return s, s.rec
+}
+
+// String returns the description of the operations recorded so far.
+func (r *Recording) String() string {
+	return r.buf.String()
+}
+
+func (r *Recording) record(args tf.OpSpec) {
+	fmt.Fprintf(&r.buf, "%s %q\n", args.Type, args.Name)
+	for _, in := range args.Input {
+		switch in := in.(type) {
+		case tf.Output:
+			fmt.Fprintf(&r.buf, "  input %s\n", outputName(in))
+		case tf.OutputList:
+			names := make([]string, len(in))
+			for i, o := range in {
+				names[i] = outputName(o)
+			}
+			fmt.Fprintf(&r.buf, "  input [%s]\n", strings.Join(names, ", "))
+		}
+	}
+	for _, op := range args.ControlInputs {
+		fmt.Fprintf(&r.buf, "  control_input %q\n", op.Name())
+	}
+	names := make([]string, 0, len(args.Attrs))
+	for name := range args.Attrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&r.buf, "  attr %s: %s\n", name, attrString(args.Attrs[name]))
+	}
+	if args.Device != "" {
+		fmt.Fprintf(&r.buf, "  device %q\n", args.Device)
+	}
+}
+
+func outputName(o tf.Output) string {
+	return fmt.Sprintf("%q", fmt.Sprintf("%s:%d", o.Op.Name(), o.Index))
+}
+
+func attrString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return fmt.Sprintf("%q", v)
+	case []string:
+		return fmt.Sprintf("%q", v)
+	case *tf.Tensor:
+		val, err := v.DecodeValue()
+		if err != nil {
+			return fmt.Sprintf("Tensor(%v, %v)", v.DataType(), v.Shape())
+		}
+		return fmt.Sprintf("Tensor(%v, %v) %v", v.DataType(), v.Shape(), val)
+	}
+	return fmt.Sprint(value)
+}
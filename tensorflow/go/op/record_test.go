@@ -0,0 +1,53 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package op
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func TestRecordingScope(t *testing.T) {
+	s, rec := NewRecordingScope()
+	x := Placeholder(s.SubScope("x"), tf.Float, PlaceholderShape(tf.MakeShape(-1, 2)))
+	layer := s.SubScope("layer").WithDevice("/device:GPU:0")
+	y := MatMul(layer, x, Const(layer, [][]float32{{1, 2}, {3, 4}}), MatMulTransposeB(true))
+	Concat(s, Const(s, int32(0)), []tf.Output{x, y})
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+	// Failed operations are not recorded.
+	s.AddOperation(tf.OpSpec{Type: "NoSuchOp"})
+
+	golden := filepath.Join("testdata", "recording.golden")
+	if *update {
+		if err := ioutil.WriteFile(golden, []byte(rec.String()), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := rec.String(); got != string(want) {
+		t.Errorf("Got:\n%s\nWant:\n%s", got, want)
+	}
+}
@@ -17,6 +17,9 @@ package op
 import (
 	"fmt"
 	"runtime/debug"
+	"sort"
+	"strings"
+	"sync"
 
 	tf "github.com/tensorflow/tensorflow/tensorflow/go"
 )
@@ -29,31 +32,78 @@ import (
 // to the graph.
 //
 // A Scope object and all its derivates (e.g., obtained from Scope.SubScope)
-// are not safe for concurrent use by multiple goroutines.
+// are safe for concurrent use by multiple goroutines, so that independent
+// parts of a large graph, such as per-feature towers, can be constructed in
+// parallel (see Parallel). TensorFlow still validates the operations and
+// infers their shapes one at a time, so the speedup depends on the share
+// of the time spent preparing them in Go, e.g. converting constants to
+// Tensors.
 type Scope struct {
 	graph     *tf.Graph
 	namemap   map[string]int
 	namespace string
+	device    string
 	err       *scopeErr
+	rec       *Recording
+	tracer    BuildTracer
+	seeds     *randomSeeds
+	opSeed    *scopeSeeds
+	seedNames bool
+	batch     *tf.GraphBatch
+	control   []*tf.Operation
+	attrs     map[string]interface{}
+	noDebug   bool
 }
 
 // scopeErr is used to share errors between all derivatives of a root scope.
+// Its mutex also guards the namemaps, Recording and randomSeeds of the
+// derivatives.
 type scopeErr struct {
+	mu  sync.Mutex
 	err error
 }
 
 // NewScope creates a Scope initialized with an empty Graph.
 func NewScope() *Scope {
-	return &Scope{graph: tf.NewGraph(), namemap: make(map[string]int), err: new(scopeErr)}
+	return NewScopeWithGraph(tf.NewGraph())
+}
+
+// NewScopeWithGraph creates a Scope adding operations to g, which may
+// already contain operations, for example those of a loaded SavedModel. The
+// names of the operations in g are not known to the Scope: use SubScope with
+// a namespace not used by g to avoid collisions.
+func NewScopeWithGraph(g *tf.Graph) *Scope {
+	return &Scope{graph: g, namemap: make(map[string]int), err: new(scopeErr), seeds: new(randomSeeds)}
+}
+
+// NewBatchScope is like NewScope, but the operations are added to the Graph
+// in batches, which is faster for very large graphs (see tf.GraphBatch).
+// Errors in the operations are only detected when the batch is added to the
+// Graph, by Finalize or when an operation is inspected, and are then
+// reported by the Scope like other errors. Unlike other
+// Scopes, the returned Scope and its derivatives are not safe for
+// concurrent use.
+func NewBatchScope() *Scope {
+	s := NewScope()
+	s.batch = s.graph.NewBatch()
+	return s
 }
 
 // Finalize returns the Graph on which this scope operates on and renders s
 // unusable. If there was an error during graph construction, that error is
 // returned instead.
 func (s *Scope) Finalize() (*tf.Graph, error) {
-	if err := s.Err(); err != nil {
+	s.err.mu.Lock()
+	defer s.err.mu.Unlock()
+	if err := s.batchErr(); err != nil {
 		return nil, err
 	}
+	if s.batch != nil {
+		if err := s.batch.Flush(); err != nil {
+			s.err.err = err
+			return nil, err
+		}
+	}
 	s.err.err = fmt.Errorf("Scope has been finalized and is no longer usable")
 	return s.graph, nil
 }
@@ -64,7 +114,10 @@ func (s *Scope) Finalize() (*tf.Graph, error) {
 // by a call to SubScope), then this prefix will be applied to the name
 // of the operation being added. See also Graph.AddOperation.
 func (s *Scope) AddOperation(args tf.OpSpec) *tf.Operation {
-	if s.Err() != nil {
+	s.err.mu.Lock()
+	err, seeded := s.batchErr(), s.seeds.set
+	s.err.mu.Unlock()
+	if err != nil {
 		return nil
 	}
 	if args.Name == "" {
@@ -73,9 +126,30 @@ func (s *Scope) AddOperation(args tf.OpSpec) *tf.Operation {
 	if s.namespace != "" {
 		args.Name = s.namespace + "/" + args.Name
 	}
-	op, err := s.graph.AddOperation(args)
+	if args.Device == "" {
+		args.Device = s.device
+	}
+	if seeded || s.opSeed != nil {
+		s.setRandomSeed(&args)
+	}
+	if len(s.attrs) > 0 {
+		args.Attrs = withAttrs(args.Attrs, s.attrs)
+	}
+	if len(s.control) > 0 {
+		args.ControlInputs = append(append([]*tf.Operation(nil), s.control...), args.ControlInputs...)
+	}
+	var op *tf.Operation
+	if s.batch != nil {
+		op, err = s.batch.AddOperation(args)
+	} else {
+		op, err = s.graph.AddOperation(args)
+	}
 	if err != nil {
-		s.UpdateErr(args.Type, err)
+		s.UpdateErr(args.Type, fmt.Errorf("%v (%s)", err, describeOp(args)))
+	} else if s.rec != nil {
+		s.err.mu.Lock()
+		s.rec.record(args)
+		s.err.mu.Unlock()
 	}
 	return op
 }
@@ -88,12 +162,70 @@ func (s *Scope) SubScope(namespace string) *Scope {
 	if s.namespace != "" {
 		namespace = s.namespace + "/" + namespace
 	}
-	return &Scope{
-		graph:     s.graph,
-		namemap:   make(map[string]int),
-		namespace: namespace,
-		err:       s.err,
+	c := s.clone()
+	c.namemap = make(map[string]int)
+	c.namespace = namespace
+	return c
+}
+
+// clone returns a shallow copy of s, which shares the namespace, graph,
+// errors and other state of s, for SubScope and the With methods to
+// override a field of.
+func (s *Scope) clone() *Scope {
+	c := *s
+	return &c
+}
+
+// Parallel calls build concurrently for each i in [0, n), with the i'th of
+// n scopes obtained by calling s.SubScope(namespace) in turn, and returns
+// once all the calls return. The scopes are created before any call starts,
+// so the names of the operations do not depend on the order in which the
+// calls run.
+//
+// Random operations added by build and seeded by the graph-level seed of s
+// only (see SetRandomSeed) get operation seeds derived from their names,
+// rather than from the order in which they are added, so the graph is
+// reproducible too.
+func (s *Scope) Parallel(n int, namespace string, build func(i int, s *Scope)) {
+	scopes := make([]*Scope, n)
+	for i := range scopes {
+		scopes[i] = s.SubScope(namespace)
+		scopes[i].seedNames = true
+	}
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for i, sub := range scopes {
+		go func(i int, sub *Scope) {
+			defer wg.Done()
+			build(i, sub)
+		}(i, sub)
 	}
+	wg.Wait()
+}
+
+// WithDevice returns a new Scope which will cause all operations added to the
+// graph to be placed on the device named by device, such as "/device:GPU:0"
+// or a device registered by a plugin library (see tf.LoadLibrary and
+// tf.DeviceName).
+//
+// The returned Scope shares the namespace of s.
+func (s *Scope) WithDevice(device string) *Scope {
+	c := s.clone()
+	c.device = device
+	return c
+}
+
+// WithControlDependencies returns a new Scope which will cause all
+// operations added to the graph to be executed after ops, in addition to
+// the control dependencies of s, like tf.control_dependencies in Python.
+// This ensures that operations without outputs used by the rest of the
+// graph, such as Assert, are executed.
+//
+// The returned Scope shares the namespace of s.
+func (s *Scope) WithControlDependencies(ops ...*tf.Operation) *Scope {
+	c := s.clone()
+	c.control = append(append([]*tf.Operation(nil), s.control...), ops...)
+	return c
 }
 
 // Err returns the error, if any, encountered during the construction
@@ -103,18 +235,34 @@ func (s *Scope) SubScope(namespace string) *Scope {
 // indicating that the scope should be discarded as the graph could not
 // be constructed.
 func (s *Scope) Err() error {
+	s.err.mu.Lock()
+	defer s.err.mu.Unlock()
+	return s.batchErr()
+}
+
+// batchErr returns the error of s, first recording the error of a failed
+// flush of its batch, which inspecting an operation can flush. s.err.mu must
+// be held.
+func (s *Scope) batchErr() error {
+	if s.err.err == nil && s.batch != nil {
+		s.err.err = s.batch.Err()
+	}
 	return s.err.err
 }
 
 // UpdateErr is used to notify Scope of any graph construction errors
 // while creating the operation op.
 func (s *Scope) UpdateErr(op string, err error) {
+	s.err.mu.Lock()
+	defer s.err.mu.Unlock()
 	if s.err.err == nil {
 		s.err.err = fmt.Errorf("failed to add operation %q: %v (Stacktrace: %s)", op, err, debug.Stack())
 	}
 }
 
 func (s *Scope) uniqueName(name string) string {
+	s.err.mu.Lock()
+	defer s.err.mu.Unlock()
 	count := s.namemap[name]
 	s.namemap[name]++
 	if count == 0 {
@@ -129,3 +277,47 @@ func (s *Scope) opName(typ string) string {
 	}
 	return s.namespace + "/" + typ
 }
+
+// describeOp summarizes args for error messages: the name of the operation,
+// the types of its inputs and its attributes. The contents of Tensor
+// attributes are redacted since they may be large or sensitive, only their
+// type and shape are included.
+func describeOp(args tf.OpSpec) string {
+	var inputs []string
+	for _, in := range args.Input {
+		switch in := in.(type) {
+		case tf.Output:
+			inputs = append(inputs, in.DataType().String())
+		case tf.OutputList:
+			types := make([]string, len(in))
+			for i, o := range in {
+				types[i] = o.DataType().String()
+			}
+			inputs = append(inputs, "["+strings.Join(types, ", ")+"]")
+		}
+	}
+	names := make([]string, 0, len(args.Attrs))
+	for name := range args.Attrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	attrs := make([]string, len(names))
+	for i, name := range names {
+		attrs[i] = name + ": " + redactedAttrString(args.Attrs[name])
+	}
+	return fmt.Sprintf("name %q, inputs [%s], attrs {%s}", args.Name, strings.Join(inputs, ", "), strings.Join(attrs, ", "))
+}
+
+func redactedAttrString(value interface{}) string {
+	switch v := value.(type) {
+	case *tf.Tensor:
+		return fmt.Sprintf("Tensor(%v, %v)", v.DataType(), v.Shape())
+	case []*tf.Tensor:
+		tensors := make([]string, len(v))
+		for i, t := range v {
+			tensors[i] = redactedAttrString(t)
+		}
+		return "[" + strings.Join(tensors, ", ") + "]"
+	}
+	return attrString(value)
+}
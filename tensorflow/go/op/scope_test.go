@@ -16,6 +16,9 @@ package op
 
 import (
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
 
 	tf "github.com/tensorflow/tensorflow/tensorflow/go"
@@ -67,6 +70,37 @@ func TestScopeSubScopeErrors(t *testing.T) {
 	}
 }
 
+func TestScopeErrorDescribesOperation(t *testing.T) {
+	s := NewScope()
+	Add(s.SubScope("x"), Const(s.SubScope("a"), int32(1)), Const(s.SubScope("b"), float32(1)))
+	if err := s.Err(); err == nil {
+		t.Fatal("Expected error")
+	} else if want := `(name "x/Add", inputs [int32, float32], attrs {})`; !strings.Contains(err.Error(), want) {
+		t.Errorf("Got %q, want it to contain %q", err, want)
+	}
+
+	// The contents of Tensor attributes must not appear in errors.
+	secret, err := tf.NewTensor("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s = NewScope()
+	s.AddOperation(tf.OpSpec{
+		Type:  "Const",
+		Attrs: map[string]interface{}{"dtype": tf.Int32, "value": secret},
+	})
+	err = s.Err()
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+	if want := `attrs {dtype: int32, value: Tensor(string, [])}`; !strings.Contains(err.Error(), want) {
+		t.Errorf("Got %q, want it to contain %q", err, want)
+	}
+	if strings.Contains(err.Error(), "secret") {
+		t.Errorf("Tensor contents were not redacted: %q", err)
+	}
+}
+
 func TestScopeFinalize(t *testing.T) {
 	var (
 		root = NewScope()
@@ -124,3 +158,176 @@ func ExampleScope_SubScope() {
 	fmt.Println(c1.Op.Name(), c2.Op.Name())
 	// Output: x/Const x_1/Const
 }
+
+func TestScopeWithDevice(t *testing.T) {
+	var (
+		root = NewScope()
+		tpu  = root.WithDevice(tf.DeviceName("TPU", 0))
+		c1   = Const(root, int64(1))
+		c2   = Const(tpu.SubScope("x"), int64(2))
+		c3   = Const(tpu.SubScope("x"), int64(3))
+	)
+	if err := root.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if got := c1.Op.Device(); got != "" {
+		t.Errorf("Got device %q, want none", got)
+	}
+	for _, c := range []tf.Output{c2, c3} {
+		if got, want := c.Op.Device(), "/device:TPU:0"; got != want {
+			t.Errorf("%v: got device %q, want %q", c.Op.Name(), got, want)
+		}
+	}
+	// Scopes returned by WithDevice share names with their parent.
+	if got, want := c3.Op.Name(), "x_1/Const"; got != want {
+		t.Errorf("Got name %q, want %q", got, want)
+	}
+}
+
+func TestScopeParallel(t *testing.T) {
+	const towers, depth = 8, 20
+	root, rec := NewRecordingScope()
+	x := Placeholder(root.SubScope("x"), tf.Float)
+	outputs := make([]tf.Output, towers)
+	root.Parallel(towers, "tower", func(i int, s *Scope) {
+		y := x
+		for j := 0; j < depth; j++ {
+			y = Add(s.SubScope("add"), y, Const(s.SubScope("c"), float32(i)))
+		}
+		outputs[i] = y
+	})
+	graph, err := root.Finalize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, o := range outputs {
+		want := "tower/add_19/Add"
+		if i > 0 {
+			want = fmt.Sprintf("tower_%d/add_19/Add", i)
+		}
+		if got := o.Op.Name(); got != want {
+			t.Errorf("Tower %d: got output %q, want %q", i, got, want)
+		}
+	}
+	for i := 1; i < towers; i++ {
+		if name := fmt.Sprintf("tower_%d/c_19/Const", i); graph.Operation(name) == nil {
+			t.Errorf("Operation %q not found", name)
+		}
+	}
+	if got, want := strings.Count(rec.String(), "\nAdd "), towers*depth; got != want {
+		t.Errorf("Recorded %d Add operations, want %d", got, want)
+	}
+}
+
+func TestScopeConcurrentSubScopes(t *testing.T) {
+	root := NewScope()
+	const n = 16
+	names := make(chan string, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			names <- Const(root.SubScope("x"), int32(1)).Op.Name()
+		}()
+	}
+	wg.Wait()
+	close(names)
+	seen := make(map[string]bool)
+	for name := range names {
+		if seen[name] {
+			t.Errorf("Duplicate operation %q", name)
+		}
+		seen[name] = true
+	}
+	if err := root.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(seen) != n {
+		t.Errorf("Got %d operations, want %d", len(seen), n)
+	}
+}
+
+func TestBatchScope(t *testing.T) {
+	s := NewBatchScope()
+	x := Placeholder(s.SubScope("x"), tf.Float)
+	y := EnsureBatchDim(s, Add(s, x, Const(s.SubScope("one"), float32(1))))
+	if got, want := y.Op.Name(), "EnsureBatchDim/ExpandDims"; got != want {
+		t.Errorf("Got name %q, want %q", got, want)
+	}
+	graph, err := s.Finalize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"x/Placeholder", "one/Const", "Add", "EnsureBatchDim/Const", "EnsureBatchDim/ExpandDims"} {
+		if graph.Operation(name) == nil {
+			t.Errorf("Operation %q not found", name)
+		}
+	}
+	if got, want := y.DataType(), tf.Float; got != want {
+		t.Errorf("Got type %v, want %v", got, want)
+	}
+
+	s = NewBatchScope()
+	Add(s, Const(s.SubScope("x"), float32(1)), Const(s.SubScope("y"), int32(1)))
+	if err := s.Err(); err != nil {
+		t.Fatalf("Error reported before the batch is flushed: %v", err)
+	}
+	if _, err := s.Finalize(); err == nil {
+		t.Error("Finalize succeeded with inputs of different types")
+	}
+	// Operations can consume those of an earlier flush, here implicit to
+	// get the size of the list output of ShapeN.
+	s = NewBatchScope()
+	x = Placeholder(s, tf.Float)
+	shapes := ShapeN(s, []tf.Output{x, x})
+	shape := Add(s, shapes[0], shapes[1])
+	if graph, err = s.Finalize(); err != nil {
+		t.Fatal(err)
+	}
+	if graph.Operation(shape.Op.Name()) == nil {
+		t.Errorf("Operation %q not found", shape.Op.Name())
+	}
+
+	// Flushing the batch by inspecting an operation, here to get the
+	// size of the list output of ShapeN, records the error on the Scope.
+	s = NewBatchScope()
+	sum := Add(s, Const(s.SubScope("x"), float32(1)), Const(s.SubScope("y"), int32(1)))
+	if shapes := ShapeN(s, []tf.Output{sum}); shapes != nil {
+		t.Errorf("Got shapes %v of an invalid operation", shapes)
+	}
+	if err := s.Err(); err == nil {
+		t.Error("Failed flush not recorded on the Scope")
+	}
+	s = NewBatchScope()
+	sum = Add(s, Const(s.SubScope("x"), float32(1)), Const(s.SubScope("y"), int32(1)))
+	sum.DataType()
+	if err := s.Err(); err == nil {
+		t.Error("Implicit failed flush not recorded on the Scope")
+	}
+}
+
+func TestScopeWithControlDependencies(t *testing.T) {
+	root := NewScope()
+	a := NoOp(root.SubScope("a"))
+	b := NoOp(root.SubScope("b"))
+	s := root.WithControlDependencies(a).SubScope("x").WithControlDependencies(b)
+	c := Const(s, int32(1))
+	d := Const(root, int32(2))
+	if err := root.Err(); err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		op   *tf.Operation
+		want string
+	}{{c.Op, "a b"}, {d.Op, ""}} {
+		var names []string
+		for _, op := range test.op.ControlInputs() {
+			names = append(names, op.Name())
+		}
+		sort.Strings(names)
+		if got := strings.Join(names, " "); got != test.want {
+			t.Errorf("%s: got control inputs %q, want %q", test.op.Name(), got, test.want)
+		}
+	}
+}
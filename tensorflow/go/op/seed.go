@@ -0,0 +1,117 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package op
+
+import (
+	"hash/fnv"
+	"math"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+// randomSeeds holds the graph-level random seed shared by a root scope and
+// its derivatives.
+type randomSeeds struct {
+	seed int64
+	set  bool
+	// ops counts the random operations seeded by the graph-level seed
+	// only, each of which gets a distinct operation seed.
+	ops int64
+}
+
+// scopeSeeds holds the seeds set by WithRandomSeed.
+type scopeSeeds struct {
+	graph, op int64
+}
+
+// SetRandomSeed sets the graph-level random seed of the graph of s, like
+// tf.random.set_seed in Python: random operations added afterwards by s, or
+// by any Scope derived from the same root, are deterministic. Each random
+// operation without an operation seed (see WithRandomSeed) gets a distinct
+// seed derived from its position among the random operations of the graph,
+// or from its name for the scopes of Parallel, so a graph constructed by the
+// same code always produces the same random values.
+func (s *Scope) SetRandomSeed(seed int64) {
+	s.err.mu.Lock()
+	defer s.err.mu.Unlock()
+	s.seeds.seed, s.seeds.set = seed, true
+}
+
+// WithRandomSeed returns a new Scope which will set the seed attributes of
+// the random operations added to the graph, such as RandomUniform, to
+// graphSeed ("seed") and opSeed ("seed2"), making them deterministic.
+// Operations created with the same seeds produce the same random values.
+// Seeds set explicitly with the optional attributes of an operation take
+// precedence.
+//
+// The returned Scope shares the namespace of s.
+func (s *Scope) WithRandomSeed(graphSeed, opSeed int64) *Scope {
+	c := s.clone()
+	c.opSeed = &scopeSeeds{graph: graphSeed, op: opSeed}
+	return c
+}
+
+// setRandomSeed sets the seed attributes of args, if it is a random
+// operation whose seeds are not set.
+func (s *Scope) setRandomSeed(args *tf.OpSpec) {
+	if _, ok := args.Attrs["seed"]; ok {
+		return
+	}
+	if _, ok := args.Attrs["seed2"]; ok {
+		return
+	}
+	info, err := registeredOp(args.Type)
+	if err != nil {
+		return // Reported when the operation is added.
+	}
+	var seed, seed2 bool
+	for _, a := range info.Attrs {
+		seed = seed || (a.Name == "seed" && a.Type == "int")
+		seed2 = seed2 || (a.Name == "seed2" && a.Type == "int")
+	}
+	if !seed || !seed2 {
+		return
+	}
+	var graph, op int64
+	switch {
+	case s.opSeed != nil:
+		graph, op = s.opSeed.graph, s.opSeed.op
+	default:
+		s.err.mu.Lock()
+		set := s.seeds.set
+		if set {
+			graph, op = s.seeds.seed, s.seeds.ops
+			s.seeds.ops++
+		}
+		s.err.mu.Unlock()
+		if set && s.seedNames {
+			// The position depends on the scheduling of the calls
+			// of Parallel, the name does not.
+			h := fnv.New64a()
+			h.Write([]byte(args.Name))
+			op = int64(h.Sum64() >> 1)
+		}
+	}
+	if graph == 0 && op == 0 {
+		// Zero seeds make the operation nondeterministic.
+		op = math.MaxInt32
+	}
+	attrs := make(map[string]interface{}, len(args.Attrs)+2)
+	for name, value := range args.Attrs {
+		attrs[name] = value
+	}
+	attrs["seed"], attrs["seed2"] = graph, op
+	args.Attrs = attrs
+}
@@ -0,0 +1,105 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package op
+
+import (
+	"reflect"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+// randomValues builds a graph with build and returns the values of the
+// outputs it returns.
+func randomValues(t *testing.T, build func(s *Scope) []tf.Output) []interface{} {
+	s := NewScope()
+	outputs := build(s)
+	graph, err := s.Finalize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sess, err := tf.NewSession(graph, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sess.Close()
+	results, err := sess.Run(nil, outputs, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := make([]interface{}, len(results))
+	for i, r := range results {
+		values[i] = r.Value()
+	}
+	return values
+}
+
+func TestRandomSeed(t *testing.T) {
+	shape := func(s *Scope) tf.Output { return Const(s.SubScope("shape"), []int32{8}) }
+	tests := []struct {
+		name  string
+		build func(s *Scope) []tf.Output
+		// same reports whether the two outputs must be equal.
+		same bool
+	}{
+		{
+			name: "WithRandomSeed",
+			build: func(s *Scope) []tf.Output {
+				a := RandomUniform(s.WithRandomSeed(1, 2), shape(s), tf.Float)
+				b := RandomUniform(s.SubScope("b").WithRandomSeed(1, 2), shape(s), tf.Float)
+				return []tf.Output{a, b}
+			},
+			same: true,
+		},
+		{
+			name: "SetRandomSeed",
+			build: func(s *Scope) []tf.Output {
+				s.SetRandomSeed(42)
+				a := RandomUniform(s, shape(s), tf.Float)
+				b := RandomUniform(s.SubScope("b"), shape(s), tf.Float)
+				return []tf.Output{a, b}
+			},
+		},
+		{
+			name: "Parallel",
+			build: func(s *Scope) []tf.Output {
+				s.SetRandomSeed(42)
+				outputs := make([]tf.Output, 4)
+				s.Parallel(len(outputs), "tower", func(i int, s *Scope) {
+					outputs[i] = RandomUniform(s, shape(s), tf.Float)
+				})
+				return outputs
+			},
+		},
+		{
+			name: "ExplicitSeed",
+			build: func(s *Scope) []tf.Output {
+				s = s.WithRandomSeed(1, 2)
+				a := RandomUniform(s, shape(s), tf.Float, RandomUniformSeed(1), RandomUniformSeed2(3))
+				b := RandomUniform(s.SubScope("b"), shape(s), tf.Float)
+				return []tf.Output{a, b}
+			},
+		},
+	}
+	for _, test := range tests {
+		first, second := randomValues(t, test.build), randomValues(t, test.build)
+		if !reflect.DeepEqual(first, second) {
+			t.Errorf("%s: got %v and %v from the same graph, want deterministic values", test.name, first, second)
+		}
+		if same := reflect.DeepEqual(first[0], first[1]); same != test.same {
+			t.Errorf("%s: got %v and %v, want equal values: %v", test.name, first[0], first[1], test.same)
+		}
+	}
+}
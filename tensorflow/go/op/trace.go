@@ -0,0 +1,118 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package op
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// BuildTracer is notified of the construction of operations by the
+// functions of this package, when they are generated with the -trace flag of
+// genop (the default functions are not), to profile the construction of
+// large graphs.
+type BuildTracer interface {
+	// StartOp is called when the construction of an operation of type
+	// opType in the given namespace starts, and the returned function
+	// when it ends.
+	StartOp(opType, namespace string) (end func())
+}
+
+// WithTracer returns a new Scope which will report the construction of
+// operations to t. The returned Scope shares the namespace of s, and the
+// scopes derived from it report to t too.
+func (s *Scope) WithTracer(t BuildTracer) *Scope {
+	c := s.clone()
+	c.tracer = t
+	return c
+}
+
+func noTrace() {}
+
+// trace is called by the generated functions, see BuildTracer.
+func (s *Scope) trace(opType string) func() {
+	if s.tracer == nil {
+		return noTrace
+	}
+	return s.tracer.StartOp(opType, s.namespace)
+}
+
+// StartTrace reports the construction of an operation of type opType to the
+// BuildTracer of scope, if any, returning the function to call when it
+// ends. It is called by the functions that genop generates outside this
+// package (see its -pkgdir flag).
+func StartTrace(scope *Scope, opType string) func() {
+	return scope.trace(opType)
+}
+
+// BuildProfile is a BuildTracer aggregating the number of operations
+// constructed and the time spent constructing them by operation type. It is
+// safe for concurrent use.
+type BuildProfile struct {
+	mu    sync.Mutex
+	stats map[string]*OpBuildStats
+}
+
+// OpBuildStats are the statistics of a BuildProfile for one operation type.
+type OpBuildStats struct {
+	OpType string
+	Count  int
+	Total  time.Duration
+}
+
+// StartOp implements BuildTracer.
+func (p *BuildProfile) StartOp(opType, namespace string) func() {
+	start := time.Now()
+	return func() {
+		elapsed := time.Since(start)
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		if p.stats == nil {
+			p.stats = make(map[string]*OpBuildStats)
+		}
+		st, ok := p.stats[opType]
+		if !ok {
+			st = &OpBuildStats{OpType: opType}
+			p.stats[opType] = st
+		}
+		st.Count++
+		st.Total += elapsed
+	}
+}
+
+// Stats returns the statistics of each operation type, the most expensive
+// in total first.
+func (p *BuildProfile) Stats() []OpBuildStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	stats := make([]OpBuildStats, 0, len(p.stats))
+	for _, st := range p.stats {
+		stats = append(stats, *st)
+	}
+	sort.Sort(byTotal(stats))
+	return stats
+}
+
+type byTotal []OpBuildStats
+
+func (s byTotal) Len() int      { return len(s) }
+func (s byTotal) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s byTotal) Less(i, j int) bool {
+	if s[i].Total != s[j].Total {
+		return s[i].Total > s[j].Total
+	}
+	return s[i].OpType < s[j].OpType
+}
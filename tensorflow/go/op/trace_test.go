@@ -0,0 +1,60 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package op
+
+import "testing"
+
+type recordingTracer []string
+
+func (r *recordingTracer) StartOp(opType, namespace string) func() {
+	*r = append(*r, "start "+namespace+" "+opType)
+	return func() { *r = append(*r, "end "+namespace+" "+opType) }
+}
+
+func TestScopeWithTracer(t *testing.T) {
+	// The default generated functions do not report to the tracer, so call
+	// trace as the functions generated with genop -trace do.
+	var r recordingTracer
+	s := NewScope().WithTracer(&r)
+	s.SubScope("x").trace("Add")()
+	s.trace("Neg")()
+	NewScope().trace("Sub")()
+	want := []string{"start x Add", "end x Add", "start  Neg", "end  Neg"}
+	if len(r) != len(want) {
+		t.Fatalf("Got %q, want %q", r, want)
+	}
+	for i := range want {
+		if r[i] != want[i] {
+			t.Errorf("Got %q, want %q", r, want)
+			break
+		}
+	}
+}
+
+func TestBuildProfile(t *testing.T) {
+	var p BuildProfile
+	s := NewScope().WithTracer(&p)
+	for i := 0; i < 3; i++ {
+		s.trace("Add")()
+	}
+	s.trace("Neg")()
+	counts := make(map[string]int)
+	for _, st := range p.Stats() {
+		counts[st.OpType] = st.Count
+	}
+	if len(counts) != 2 || counts["Add"] != 3 || counts["Neg"] != 1 {
+		t.Errorf("Got %v, want 3 Add and 1 Neg", counts)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.18
+// +build go1.18
+
+package op
+
+import (
+	"fmt"
+	"reflect"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+// TensorType is the set of Go types of the elements of tensors that can be
+// created with tf.NewTensor.
+type TensorType interface {
+	float32 | float64 | int8 | int16 | int32 | int64 | uint8 | uint16 |
+		complex64 | complex128 | string | bool
+}
+
+// Typed is an Output whose elements are of type T, making it possible for
+// the compiler to verify that the operations of a graph are applied to
+// tensors of consistent types. See the typed package for generic versions
+// of common operations.
+type Typed[T TensorType] struct {
+	tf.Output
+}
+
+// DataTypeOf returns the DataType of tensors whose elements are of type T.
+func DataTypeOf[T TensorType]() tf.DataType {
+	var zero T
+	dt, err := tf.DataTypeOf(reflect.TypeOf(zero))
+	if err != nil {
+		// TensorType only includes types with a DataType.
+		panic(err)
+	}
+	return dt
+}
+
+// AsTyped returns output as a Typed[T], or an error if the type of its
+// elements is not T.
+func AsTyped[T TensorType](output tf.Output) (Typed[T], error) {
+	if want := DataTypeOf[T](); output.DataType() != want {
+		return Typed[T]{}, fmt.Errorf("output of type %v cannot be used as %v", output.DataType(), want)
+	}
+	return Typed[T]{output}, nil
+}
@@ -0,0 +1,68 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.18
+// +build go1.18
+
+// Package typed defines generic functions for adding common TensorFlow
+// operations to a Graph, operating on op.Typed values. Using them, applying
+// an operation to tensors of different types, for example adding a float32
+// tensor to an int32 one, is reported by the compiler instead of when the
+// graph is constructed or run:
+//
+//	s := op.NewScope()
+//	x := typed.Placeholder[float32](s)
+//	y := typed.Mul(s, x, typed.Const[float32](s, float32(2)))
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package typed
+
+//go:generate go run ../../genop/main.go -outfile typed_ops.go -typed Abs,Add,BiasAdd,Div,Exp,Floor,Identity,Log,MatMul,Maximum,Minimum,Mul,Neg,Pow,RealDiv,Relu,Relu6,Rsqrt,Sigmoid,Softmax,Sqrt,Square,SquaredDifference,Sub,Tanh
+
+import (
+	"fmt"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+// Placeholder is like op.Placeholder, for tensors of type T.
+func Placeholder[T op.TensorType](scope *op.Scope, optional ...op.PlaceholderAttr) op.Typed[T] {
+	return op.Typed[T]{Output: op.Placeholder(scope, op.DataTypeOf[T](), optional...)}
+}
+
+// Const is like op.Const, for a value whose elements are of type T, such as
+// a T or a []T.
+func Const[T op.TensorType](scope *op.Scope, value interface{}) (output op.Typed[T]) {
+	if scope.Err() != nil {
+		return
+	}
+	t, ok := value.(*tf.Tensor)
+	if !ok {
+		var err error
+		if t, err = tf.NewTensor(value); err != nil {
+			scope.UpdateErr("Const", err)
+			return
+		}
+		// The graph keeps its own copy of the value.
+		defer t.Release()
+	}
+	if want := op.DataTypeOf[T](); t.DataType() != want {
+		scope.UpdateErr("Const", fmt.Errorf("value of type %v cannot be used as %v", t.DataType(), want))
+		return
+	}
+	output.Output = op.Const(scope, t)
+	return
+}
@@ -0,0 +1,162 @@
+// DO NOT EDIT
+// This file was machine generated by github.com/tensorflow/tensorflow/tensorflow/go/genop/internal
+//
+// WARNING: This generation of wrapper function for TensorFlow ops is in an
+// experimental state. The generated API can change without notice.
+
+//go:build go1.18
+// +build go1.18
+
+package typed
+
+import "github.com/tensorflow/tensorflow/tensorflow/go/op"
+
+// Abs is like op.Abs, for tensors of type T.
+func Abs[T float32 | float64 | int32 | int64](scope *op.Scope, x op.Typed[T]) (y op.Typed[T]) {
+	y.Output = op.Abs(scope, x.Output)
+	return
+}
+
+// Add is like op.Add, for tensors of type T.
+func Add[T float32 | float64 | uint8 | int8 | int16 | int32 | int64 | complex64 | complex128 | string](scope *op.Scope, x op.Typed[T], y op.Typed[T]) (z op.Typed[T]) {
+	z.Output = op.Add(scope, x.Output, y.Output)
+	return
+}
+
+// BiasAdd is like op.BiasAdd, for tensors of type T.
+func BiasAdd[T float32 | float64 | int64 | int32 | uint8 | uint16 | int16 | int8 | complex64 | complex128](scope *op.Scope, value op.Typed[T], bias op.Typed[T], optional ...op.BiasAddAttr) (output op.Typed[T]) {
+	output.Output = op.BiasAdd(scope, value.Output, bias.Output, optional...)
+	return
+}
+
+// Div is like op.Div, for tensors of type T.
+func Div[T float32 | float64 | uint8 | int8 | uint16 | int16 | int32 | int64 | complex64 | complex128](scope *op.Scope, x op.Typed[T], y op.Typed[T]) (z op.Typed[T]) {
+	z.Output = op.Div(scope, x.Output, y.Output)
+	return
+}
+
+// Exp is like op.Exp, for tensors of type T.
+func Exp[T float32 | float64 | complex64 | complex128](scope *op.Scope, x op.Typed[T]) (y op.Typed[T]) {
+	y.Output = op.Exp(scope, x.Output)
+	return
+}
+
+// Floor is like op.Floor, for tensors of type T.
+func Floor[T float32 | float64](scope *op.Scope, x op.Typed[T]) (y op.Typed[T]) {
+	y.Output = op.Floor(scope, x.Output)
+	return
+}
+
+// Identity is like op.Identity, for tensors of type T.
+func Identity[T op.TensorType](scope *op.Scope, input op.Typed[T]) (output op.Typed[T]) {
+	output.Output = op.Identity(scope, input.Output)
+	return
+}
+
+// Log is like op.Log, for tensors of type T.
+func Log[T float32 | float64 | complex64 | complex128](scope *op.Scope, x op.Typed[T]) (y op.Typed[T]) {
+	y.Output = op.Log(scope, x.Output)
+	return
+}
+
+// MatMul is like op.MatMul, for tensors of type T.
+func MatMul[T float32 | float64 | int32 | complex64 | complex128](scope *op.Scope, a op.Typed[T], b op.Typed[T], optional ...op.MatMulAttr) (product op.Typed[T]) {
+	product.Output = op.MatMul(scope, a.Output, b.Output, optional...)
+	return
+}
+
+// Maximum is like op.Maximum, for tensors of type T.
+func Maximum[T float32 | float64 | int32 | int64](scope *op.Scope, x op.Typed[T], y op.Typed[T]) (z op.Typed[T]) {
+	z.Output = op.Maximum(scope, x.Output, y.Output)
+	return
+}
+
+// Minimum is like op.Minimum, for tensors of type T.
+func Minimum[T float32 | float64 | int32 | int64](scope *op.Scope, x op.Typed[T], y op.Typed[T]) (z op.Typed[T]) {
+	z.Output = op.Minimum(scope, x.Output, y.Output)
+	return
+}
+
+// Mul is like op.Mul, for tensors of type T.
+func Mul[T float32 | float64 | uint8 | int8 | uint16 | int16 | int32 | int64 | complex64 | complex128](scope *op.Scope, x op.Typed[T], y op.Typed[T]) (z op.Typed[T]) {
+	z.Output = op.Mul(scope, x.Output, y.Output)
+	return
+}
+
+// Neg is like op.Neg, for tensors of type T.
+func Neg[T float32 | float64 | int32 | int64 | complex64 | complex128](scope *op.Scope, x op.Typed[T]) (y op.Typed[T]) {
+	y.Output = op.Neg(scope, x.Output)
+	return
+}
+
+// Pow is like op.Pow, for tensors of type T.
+func Pow[T float32 | float64 | int32 | int64 | complex64 | complex128](scope *op.Scope, x op.Typed[T], y op.Typed[T]) (z op.Typed[T]) {
+	z.Output = op.Pow(scope, x.Output, y.Output)
+	return
+}
+
+// RealDiv is like op.RealDiv, for tensors of type T.
+func RealDiv[T float32 | float64 | uint8 | int8 | uint16 | int16 | int32 | int64 | complex64 | complex128](scope *op.Scope, x op.Typed[T], y op.Typed[T]) (z op.Typed[T]) {
+	z.Output = op.RealDiv(scope, x.Output, y.Output)
+	return
+}
+
+// Relu is like op.Relu, for tensors of type T.
+func Relu[T float32 | float64 | int32 | int64 | uint8 | int16 | int8 | uint16](scope *op.Scope, features op.Typed[T]) (activations op.Typed[T]) {
+	activations.Output = op.Relu(scope, features.Output)
+	return
+}
+
+// Relu6 is like op.Relu6, for tensors of type T.
+func Relu6[T float32 | float64 | int32 | int64 | uint8 | int16 | int8 | uint16](scope *op.Scope, features op.Typed[T]) (activations op.Typed[T]) {
+	activations.Output = op.Relu6(scope, features.Output)
+	return
+}
+
+// Rsqrt is like op.Rsqrt, for tensors of type T.
+func Rsqrt[T float32 | float64 | complex64 | complex128](scope *op.Scope, x op.Typed[T]) (y op.Typed[T]) {
+	y.Output = op.Rsqrt(scope, x.Output)
+	return
+}
+
+// Sigmoid is like op.Sigmoid, for tensors of type T.
+func Sigmoid[T float32 | float64 | complex64 | complex128](scope *op.Scope, x op.Typed[T]) (y op.Typed[T]) {
+	y.Output = op.Sigmoid(scope, x.Output)
+	return
+}
+
+// Softmax is like op.Softmax, for tensors of type T.
+func Softmax[T float32 | float64](scope *op.Scope, logits op.Typed[T]) (softmax op.Typed[T]) {
+	softmax.Output = op.Softmax(scope, logits.Output)
+	return
+}
+
+// Sqrt is like op.Sqrt, for tensors of type T.
+func Sqrt[T float32 | float64 | complex64 | complex128](scope *op.Scope, x op.Typed[T]) (y op.Typed[T]) {
+	y.Output = op.Sqrt(scope, x.Output)
+	return
+}
+
+// Square is like op.Square, for tensors of type T.
+func Square[T float32 | float64 | int32 | int64 | complex64 | complex128](scope *op.Scope, x op.Typed[T]) (y op.Typed[T]) {
+	y.Output = op.Square(scope, x.Output)
+	return
+}
+
+// SquaredDifference is like op.SquaredDifference, for tensors of type T.
+func SquaredDifference[T float32 | float64 | int32 | int64 | complex64 | complex128](scope *op.Scope, x op.Typed[T], y op.Typed[T]) (z op.Typed[T]) {
+	z.Output = op.SquaredDifference(scope, x.Output, y.Output)
+	return
+}
+
+// Sub is like op.Sub, for tensors of type T.
+func Sub[T float32 | float64 | int32 | int64 | complex64 | complex128](scope *op.Scope, x op.Typed[T], y op.Typed[T]) (z op.Typed[T]) {
+	z.Output = op.Sub(scope, x.Output, y.Output)
+	return
+}
+
+// Tanh is like op.Tanh, for tensors of type T.
+func Tanh[T float32 | float64 | complex64 | complex128](scope *op.Scope, x op.Typed[T]) (y op.Typed[T]) {
+	y.Output = op.Tanh(scope, x.Output)
+	return
+}
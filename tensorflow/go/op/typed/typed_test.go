@@ -0,0 +1,77 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.18
+// +build go1.18
+
+package typed
+
+import (
+	"reflect"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+func TestTypedGraph(t *testing.T) {
+	s := op.NewScope()
+	x := Placeholder[float32](s)
+	w := Const[float32](s.SubScope("w"), [][]float32{{1, 2}, {3, 4}})
+	y := Relu(s, MatMul(s, x, w, op.MatMulTransposeB(true)))
+	z := Add(s, y, Const[float32](s.SubScope("b"), float32(0.5)))
+	graph, err := s.Finalize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sess, err := tf.NewSession(graph, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sess.Close()
+	in, err := tf.NewTensor([][]float32{{1, -1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := sess.Run(map[tf.Output]*tf.Tensor{x.Output: in}, []tf.Output{z.Output}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := out[0].Value(), [][]float32{{0.5, 0.5}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Got %v, want %v", got, want)
+	}
+}
+
+func TestConstTypeMismatch(t *testing.T) {
+	s := op.NewScope()
+	Const[float32](s, []int32{1})
+	if _, err := s.Finalize(); err == nil {
+		t.Error("Const[float32] accepted an int32 value")
+	}
+}
+
+func TestAsTyped(t *testing.T) {
+	s := op.NewScope()
+	x := op.Placeholder(s, tf.Int32)
+	if _, err := op.AsTyped[float32](x); err == nil {
+		t.Error("AsTyped[float32] accepted an int32 Output")
+	}
+	typed, err := op.AsTyped[int32](x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if neg := Neg(s, typed); neg.DataType() != tf.Int32 {
+		t.Errorf("Got %v, want %v", neg.DataType(), tf.Int32)
+	}
+}
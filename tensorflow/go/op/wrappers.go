@@ -20,12 +20,38 @@
 
 package op
 
-import tf "github.com/tensorflow/tensorflow/tensorflow/go"
+import (
+	"fmt"
+	"sync"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
 
 // optionalAttr is an intentionally un-exported type to hide
 // details of how optional attributes to operations are implemented.
 type optionalAttr map[string]interface{}
 
+// attrPool recycles the attribute maps of the generated functions. A map is
+// only read while its operation is added to the graph, so building large
+// graphs does not need to allocate a new map for every operation.
+var attrPool = sync.Pool{
+	New: func() interface{} { return make(map[string]interface{}, 8) },
+}
+
+// newAttrs returns an empty attribute map, to be passed to releaseAttrs once
+// the operation has been added.
+func newAttrs() map[string]interface{} {
+	return attrPool.Get().(map[string]interface{})
+}
+
+// releaseAttrs clears attrs and returns it to attrPool.
+func releaseAttrs(attrs map[string]interface{}) {
+	for name := range attrs {
+		delete(attrs, name)
+	}
+	attrPool.Put(attrs)
+}
+
 func makeOutputList(op *tf.Operation, start int, output string) ([]tf.Output, int, error) {
 	size, err := op.OutputListSize(output)
 	if err != nil {
@@ -38,6 +64,21 @@ func makeOutputList(op *tf.Operation, start int, output string) ([]tf.Output, in
 	return list, start + size, nil
 }
 
+// validateDType returns an error if the attribute name is set in attrs to a
+// DataType other than those allowed.
+func validateDType(attrs map[string]interface{}, name string, allowed ...tf.DataType) error {
+	dt, ok := attrs[name].(tf.DataType)
+	if !ok {
+		return nil
+	}
+	for _, a := range allowed {
+		if dt == a {
+			return nil
+		}
+	}
+	return fmt.Errorf("attribute %s: %v is not one of the allowed types %v", name, dt, allowed)
+}
+
 // Adds sparse updates to the variable referenced by `resource`.
 //
 // This operation computes
@@ -208,7 +249,10 @@ func VarHandleOp(scope *Scope, dtype tf.DataType, shape tf.Shape, optional ...Va
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"dtype": dtype, "shape": shape}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["dtype"] = dtype
+	attrs["shape"] = shape
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -318,7 +362,8 @@ func QuantizedInstanceNorm(scope *Scope, x tf.Output, x_min tf.Output, x_max tf.
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -413,7 +458,8 @@ func DebugNumericSummary(scope *Scope, input tf.Output, optional ...DebugNumeric
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -487,7 +533,8 @@ func DebugNanCount(scope *Scope, input tf.Output, optional ...DebugNanCountAttr)
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -538,7 +585,8 @@ func DebugIdentity(scope *Scope, input tf.Output, optional ...DebugIdentityAttr)
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -582,7 +630,8 @@ func Copy(scope *Scope, input tf.Output, optional ...CopyAttr) (output tf.Output
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -647,7 +696,8 @@ func QuantizeAndDequantize(scope *Scope, input tf.Output, optional ...QuantizeAn
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -777,7 +827,8 @@ func OneHot(scope *Scope, indices tf.Output, depth tf.Output, on_value tf.Output
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -806,11 +857,19 @@ func OneHot(scope *Scope, indices tf.Output, depth tf.Output, on_value tf.Output
 //
 // *NOTE*: Bitcast is implemented as a low-level cast, so machines with different
 // endian orderings will give different results.
+//
+// REQUIRES: type_ is one of tf.Float, tf.Double, tf.Int64, tf.Int32, tf.Uint8, tf.Uint16, tf.Int16, tf.Int8, tf.Complex64, tf.Complex128, tf.Qint8, tf.Quint8, tf.Qint32, tf.Half
 func Bitcast(scope *Scope, input tf.Output, type_ tf.DataType) (output tf.Output) {
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"type": type_}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["type"] = type_
+	if err := validateDType(attrs, "type", tf.Float, tf.Double, tf.Int64, tf.Int32, tf.Uint8, tf.Uint16, tf.Int16, tf.Int8, tf.Complex64, tf.Complex128, tf.Qint8, tf.Quint8, tf.Qint32, tf.Half); err != nil {
+		scope.UpdateErr("Bitcast", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "Bitcast",
 		Input: []tf.Input{
@@ -851,7 +910,12 @@ func ExtractImagePatches(scope *Scope, images tf.Output, ksizes []int64, strides
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"ksizes": ksizes, "strides": strides, "rates": rates, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["ksizes"] = ksizes
+	attrs["strides"] = strides
+	attrs["rates"] = rates
+	attrs["padding"] = padding
 	opspec := tf.OpSpec{
 		Type: "ExtractImagePatches",
 		Input: []tf.Input{
@@ -951,7 +1015,9 @@ func DepthToSpace(scope *Scope, input tf.Output, block_size int64) (output tf.Ou
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"block_size": block_size}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["block_size"] = block_size
 	opspec := tf.OpSpec{
 		Type: "DepthToSpace",
 		Input: []tf.Input{
@@ -1201,7 +1267,9 @@ func SpaceToBatch(scope *Scope, input tf.Output, paddings tf.Output, block_size
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"block_size": block_size}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["block_size"] = block_size
 	opspec := tf.OpSpec{
 		Type: "SpaceToBatch",
 		Input: []tf.Input{
@@ -1308,7 +1376,8 @@ func QuantizeAndDequantizeV2(scope *Scope, input tf.Output, input_min tf.Output,
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -1465,6 +1534,8 @@ type ListDiffAttr func(optionalAttr)
 
 // ListDiffOutIdx sets the optional out_idx attribute to value.
 // If not specified, defaults to type:DT_INT32
+//
+// REQUIRES: value is one of tf.Int32, tf.Int64
 func ListDiffOutIdx(value tf.DataType) ListDiffAttr {
 	return func(m optionalAttr) {
 		m["out_idx"] = value
@@ -1504,10 +1575,15 @@ func ListDiff(scope *Scope, x tf.Output, y tf.Output, optional ...ListDiffAttr)
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
+	if err := validateDType(attrs, "out_idx", tf.Int32, tf.Int64); err != nil {
+		scope.UpdateErr("ListDiff", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "ListDiff",
 		Input: []tf.Input{
@@ -1565,7 +1641,8 @@ func Squeeze(scope *Scope, input tf.Output, optional ...SqueezeAttr) (output tf.
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -1596,7 +1673,10 @@ func PlaceholderV2(scope *Scope, dtype tf.DataType, shape tf.Shape) (output tf.O
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"dtype": dtype, "shape": shape}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["dtype"] = dtype
+	attrs["shape"] = shape
 	opspec := tf.OpSpec{
 		Type: "PlaceholderV2",
 
@@ -1634,7 +1714,9 @@ func Placeholder(scope *Scope, dtype tf.DataType, optional ...PlaceholderAttr) (
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"dtype": dtype}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["dtype"] = dtype
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -1690,7 +1772,9 @@ func MirrorPad(scope *Scope, input tf.Output, paddings tf.Output, mode string) (
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"mode": mode}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["mode"] = mode
 	opspec := tf.OpSpec{
 		Type: "MirrorPad",
 		Input: []tf.Input{
@@ -1862,7 +1946,8 @@ func StridedSliceGrad(scope *Scope, shape tf.Output, begin tf.Output, end tf.Out
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -1913,6 +1998,8 @@ type ShapeNAttr func(optionalAttr)
 
 // ShapeNOutType sets the optional out_type attribute to value.
 // If not specified, defaults to type:DT_INT32
+//
+// REQUIRES: value is one of tf.Int32, tf.Int64
 func ShapeNOutType(value tf.DataType) ShapeNAttr {
 	return func(m optionalAttr) {
 		m["out_type"] = value
@@ -1926,10 +2013,15 @@ func ShapeN(scope *Scope, input []tf.Output, optional ...ShapeNAttr) (output []t
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
+	if err := validateDType(attrs, "out_type", tf.Int32, tf.Int64); err != nil {
+		scope.UpdateErr("ShapeN", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "ShapeN",
 		Input: []tf.Input{
@@ -1955,6 +2047,8 @@ type UniqueAttr func(optionalAttr)
 
 // UniqueOutIdx sets the optional out_idx attribute to value.
 // If not specified, defaults to type:DT_INT32
+//
+// REQUIRES: value is one of tf.Int32, tf.Int64
 func UniqueOutIdx(value tf.DataType) UniqueAttr {
 	return func(m optionalAttr) {
 		m["out_idx"] = value
@@ -1987,10 +2081,15 @@ func Unique(scope *Scope, x tf.Output, optional ...UniqueAttr) (y tf.Output, idx
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
+	if err := validateDType(attrs, "out_idx", tf.Int32, tf.Int64); err != nil {
+		scope.UpdateErr("Unique", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "Unique",
 		Input: []tf.Input{
@@ -2090,7 +2189,9 @@ func CheckNumerics(scope *Scope, tensor tf.Output, message string) (output tf.Ou
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"message": message}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["message"] = message
 	opspec := tf.OpSpec{
 		Type: "CheckNumerics",
 		Input: []tf.Input{
@@ -2134,7 +2235,8 @@ func PreventGradient(scope *Scope, input tf.Output, optional ...PreventGradientA
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -2384,7 +2486,8 @@ func EditDistance(scope *Scope, hypothesis_indices tf.Output, hypothesis_values
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -2526,7 +2629,8 @@ func Dequantize(scope *Scope, input tf.Output, min_range tf.Output, max_range tf
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -2579,7 +2683,9 @@ func SplitV(scope *Scope, value tf.Output, size_splits tf.Output, split_dim tf.O
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"num_split": num_split}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["num_split"] = num_split
 	opspec := tf.OpSpec{
 		Type: "SplitV",
 		Input: []tf.Input{
@@ -2616,7 +2722,9 @@ func Split(scope *Scope, split_dim tf.Output, value tf.Output, num_split int64)
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"num_split": num_split}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["num_split"] = num_split
 	opspec := tf.OpSpec{
 		Type: "Split",
 		Input: []tf.Input{
@@ -2734,7 +2842,9 @@ func ParallelConcat(scope *Scope, values []tf.Output, shape tf.Shape) (output tf
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"shape": shape}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["shape"] = shape
 	opspec := tf.OpSpec{
 		Type: "ParallelConcat",
 		Input: []tf.Input{
@@ -2803,7 +2913,11 @@ func AllCandidateSampler(scope *Scope, true_classes tf.Output, num_true int64, n
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"num_true": num_true, "num_sampled": num_sampled, "unique": unique}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["num_true"] = num_true
+	attrs["num_sampled"] = num_sampled
+	attrs["unique"] = unique
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -2962,7 +3076,12 @@ func FixedUnigramCandidateSampler(scope *Scope, true_classes tf.Output, num_true
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"num_true": num_true, "num_sampled": num_sampled, "unique": unique, "range_max": range_max}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["num_true"] = num_true
+	attrs["num_sampled"] = num_sampled
+	attrs["unique"] = unique
+	attrs["range_max"] = range_max
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -3035,7 +3154,12 @@ func UniformCandidateSampler(scope *Scope, true_classes tf.Output, num_true int6
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"num_true": num_true, "num_sampled": num_sampled, "unique": unique, "range_max": range_max}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["num_true"] = num_true
+	attrs["num_sampled"] = num_sampled
+	attrs["unique"] = unique
+	attrs["range_max"] = range_max
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -3080,7 +3204,8 @@ func Abort(scope *Scope, optional ...AbortAttr) (o *tf.Operation) {
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -3188,7 +3313,9 @@ func SpaceToDepth(scope *Scope, input tf.Output, block_size int64) (output tf.Ou
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"block_size": block_size}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["block_size"] = block_size
 	opspec := tf.OpSpec{
 		Type: "SpaceToDepth",
 		Input: []tf.Input{
@@ -3358,7 +3485,9 @@ func Enter(scope *Scope, data tf.Output, frame_name string, optional ...EnterAtt
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"frame_name": frame_name}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["frame_name"] = frame_name
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -3463,7 +3592,8 @@ func CTCGreedyDecoder(scope *Scope, inputs tf.Output, sequence_length tf.Output,
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -3523,7 +3653,8 @@ func CTCLoss(scope *Scope, inputs tf.Output, labels_indices tf.Output, labels_va
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -3575,7 +3706,8 @@ func Stage(scope *Scope, values []tf.Output, optional ...StageAttr) (o *tf.Opera
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -3619,7 +3751,8 @@ func FakeQuantWithMinMaxArgs(scope *Scope, inputs tf.Output, optional ...FakeQua
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -3684,7 +3817,9 @@ func TensorArrayGradV2(scope *Scope, handle tf.Output, flow_in tf.Output, source
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"source": source}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["source"] = source
 	opspec := tf.OpSpec{
 		Type: "TensorArrayGradV2",
 		Input: []tf.Input{
@@ -3726,7 +3861,9 @@ func ResourceGather(scope *Scope, resource tf.Output, indices tf.Output, dtype t
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"dtype": dtype}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["dtype"] = dtype
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -3841,7 +3978,12 @@ func LearnedUnigramCandidateSampler(scope *Scope, true_classes tf.Output, num_tr
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"num_true": num_true, "num_sampled": num_sampled, "unique": unique, "range_max": range_max}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["num_true"] = num_true
+	attrs["num_sampled"] = num_sampled
+	attrs["unique"] = unique
+	attrs["range_max"] = range_max
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -3977,7 +4119,9 @@ func TensorArrayConcatV3(scope *Scope, handle tf.Output, flow_in tf.Output, dtyp
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"dtype": dtype}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["dtype"] = dtype
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -4088,7 +4232,9 @@ func TensorArrayGradV3(scope *Scope, handle tf.Output, flow_in tf.Output, source
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"source": source}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["source"] = source
 	opspec := tf.OpSpec{
 		Type: "TensorArrayGradV3",
 		Input: []tf.Input{
@@ -4116,7 +4262,9 @@ func TensorArrayConcatV2(scope *Scope, handle tf.Output, flow_in tf.Output, dtyp
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"dtype": dtype}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["dtype"] = dtype
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -4211,7 +4359,8 @@ func QueueCloseV2(scope *Scope, handle tf.Output, optional ...QueueCloseV2Attr)
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -4295,7 +4444,9 @@ func QueueDequeueUpToV2(scope *Scope, handle tf.Output, n tf.Output, component_t
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"component_types": component_types}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["component_types"] = component_types
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -4376,7 +4527,9 @@ func QueueDequeueManyV2(scope *Scope, handle tf.Output, n tf.Output, component_t
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"component_types": component_types}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["component_types"] = component_types
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -4432,7 +4585,8 @@ func QueueEnqueueV2(scope *Scope, handle tf.Output, components []tf.Output, opti
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -4473,7 +4627,9 @@ func Unstage(scope *Scope, dtypes []tf.DataType, optional ...UnstageAttr) (value
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"dtypes": dtypes}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["dtypes"] = dtypes
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -4562,7 +4718,9 @@ func PriorityQueueV2(scope *Scope, shapes []tf.Shape, optional ...PriorityQueueV
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"shapes": shapes}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["shapes"] = shapes
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -4644,7 +4802,9 @@ func PaddingFIFOQueueV2(scope *Scope, component_types []tf.DataType, optional ..
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"component_types": component_types}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["component_types"] = component_types
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -4718,7 +4878,9 @@ func FIFOQueueV2(scope *Scope, component_types []tf.DataType, optional ...FIFOQu
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"component_types": component_types}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["component_types"] = component_types
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -4779,7 +4941,8 @@ func Cumsum(scope *Scope, x tf.Output, axis tf.Output, optional ...CumsumAttr) (
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -4811,7 +4974,11 @@ func QuantizedMaxPool(scope *Scope, input tf.Output, min_input tf.Output, max_in
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"ksize": ksize, "strides": strides, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["ksize"] = ksize
+	attrs["strides"] = strides
+	attrs["padding"] = padding
 	opspec := tf.OpSpec{
 		Type: "QuantizedMaxPool",
 		Input: []tf.Input{
@@ -4838,7 +5005,11 @@ func AvgPool3D(scope *Scope, input tf.Output, ksize []int64, strides []int64, pa
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"ksize": ksize, "strides": strides, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["ksize"] = ksize
+	attrs["strides"] = strides
+	attrs["padding"] = padding
 	opspec := tf.OpSpec{
 		Type: "AvgPool3D",
 		Input: []tf.Input{
@@ -4855,6 +5026,8 @@ type QuantizedConv2DAttr func(optionalAttr)
 
 // QuantizedConv2DOutType sets the optional out_type attribute to value.
 // If not specified, defaults to type:DT_QINT32
+//
+// REQUIRES: value is one of tf.Qint8, tf.Quint8, tf.Qint16, tf.Quint16, tf.Qint32
 func QuantizedConv2DOutType(value tf.DataType) QuantizedConv2DAttr {
 	return func(m optionalAttr) {
 		m["out_type"] = value
@@ -4884,10 +5057,17 @@ func QuantizedConv2D(scope *Scope, input tf.Output, filter tf.Output, min_input
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"strides": strides, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["strides"] = strides
+	attrs["padding"] = padding
 	for _, a := range optional {
 		a(attrs)
 	}
+	if err := validateDType(attrs, "out_type", tf.Qint8, tf.Quint8, tf.Qint16, tf.Quint16, tf.Qint32); err != nil {
+		scope.UpdateErr("QuantizedConv2D", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "QuantizedConv2D",
 		Input: []tf.Input{
@@ -4916,7 +5096,11 @@ func QuantizedAvgPool(scope *Scope, input tf.Output, min_input tf.Output, max_in
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"ksize": ksize, "strides": strides, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["ksize"] = ksize
+	attrs["strides"] = strides
+	attrs["padding"] = padding
 	opspec := tf.OpSpec{
 		Type: "QuantizedAvgPool",
 		Input: []tf.Input{
@@ -4998,7 +5182,8 @@ func TensorSummary(scope *Scope, tensor tf.Output, optional ...TensorSummaryAttr
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -5102,7 +5287,8 @@ func BatchMatMul(scope *Scope, x tf.Output, y tf.Output, optional ...BatchMatMul
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -5163,7 +5349,8 @@ func SparseTensorDenseMatMul(scope *Scope, a_indices tf.Output, a_values tf.Outp
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -5210,7 +5397,11 @@ func Dilation2DBackpropInput(scope *Scope, input tf.Output, filter tf.Output, ou
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"strides": strides, "rates": rates, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["strides"] = strides
+	attrs["rates"] = rates
+	attrs["padding"] = padding
 	opspec := tf.OpSpec{
 		Type: "Dilation2DBackpropInput",
 		Input: []tf.Input{
@@ -5277,7 +5468,8 @@ func FusedBatchNormGrad(scope *Scope, y_backprop tf.Output, x tf.Output, scale t
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -5339,7 +5531,8 @@ func Max(scope *Scope, input tf.Output, reduction_indices tf.Output, optional ..
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -5385,7 +5578,8 @@ func FakeQuantWithMinMaxArgsGradient(scope *Scope, gradients tf.Output, inputs t
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -5417,7 +5611,11 @@ func MaxPoolGradWithArgmax(scope *Scope, input tf.Output, grad tf.Output, argmax
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"ksize": ksize, "strides": strides, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["ksize"] = ksize
+	attrs["strides"] = strides
+	attrs["padding"] = padding
 	opspec := tf.OpSpec{
 		Type: "MaxPoolGradWithArgmax",
 		Input: []tf.Input{
@@ -5446,7 +5644,11 @@ func Dilation2DBackpropFilter(scope *Scope, input tf.Output, filter tf.Output, o
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"strides": strides, "rates": rates, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["strides"] = strides
+	attrs["rates"] = rates
+	attrs["padding"] = padding
 	opspec := tf.OpSpec{
 		Type: "Dilation2DBackpropFilter",
 		Input: []tf.Input{
@@ -5493,7 +5695,10 @@ func Conv3DBackpropInputV2(scope *Scope, input_sizes tf.Output, filter tf.Output
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"strides": strides, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["strides"] = strides
+	attrs["padding"] = padding
 	opspec := tf.OpSpec{
 		Type: "Conv3DBackpropInputV2",
 		Input: []tf.Input{
@@ -5540,7 +5745,10 @@ func Conv3DBackpropFilter(scope *Scope, input tf.Output, filter tf.Output, out_b
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"strides": strides, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["strides"] = strides
+	attrs["padding"] = padding
 	opspec := tf.OpSpec{
 		Type: "Conv3DBackpropFilter",
 		Input: []tf.Input{
@@ -5569,7 +5777,10 @@ func Conv3DBackpropInput(scope *Scope, input tf.Output, filter tf.Output, out_ba
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"strides": strides, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["strides"] = strides
+	attrs["padding"] = padding
 	opspec := tf.OpSpec{
 		Type: "Conv3DBackpropInput",
 		Input: []tf.Input{
@@ -5662,7 +5873,9 @@ func ReverseSequence(scope *Scope, input tf.Output, seq_lengths tf.Output, seq_d
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"seq_dim": seq_dim}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["seq_dim"] = seq_dim
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -5704,7 +5917,10 @@ func RemoteFusedGraphExecute(scope *Scope, values []tf.Output, N int64, serializ
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"N": N, "serialized_graph_transfer_info": serialized_graph_transfer_info}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["N"] = N
+	attrs["serialized_graph_transfer_info"] = serialized_graph_transfer_info
 	opspec := tf.OpSpec{
 		Type: "RemoteFusedGraphExecute",
 		Input: []tf.Input{
@@ -5742,7 +5958,10 @@ func Conv3DBackpropFilterV2(scope *Scope, input tf.Output, filter_sizes tf.Outpu
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"strides": strides, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["strides"] = strides
+	attrs["padding"] = padding
 	opspec := tf.OpSpec{
 		Type: "Conv3DBackpropFilterV2",
 		Input: []tf.Input{
@@ -5794,7 +6013,9 @@ func TensorArrayV2(scope *Scope, size tf.Output, dtype tf.DataType, optional ...
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"dtype": dtype}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["dtype"] = dtype
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -5914,7 +6135,10 @@ func DepthwiseConv2dNative(scope *Scope, input tf.Output, filter tf.Output, stri
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"strides": strides, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["strides"] = strides
+	attrs["padding"] = padding
 	opspec := tf.OpSpec{
 		Type: "DepthwiseConv2dNative",
 		Input: []tf.Input{
@@ -5970,7 +6194,11 @@ func FusedResizeAndPadConv2D(scope *Scope, input tf.Output, size tf.Output, padd
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"mode": mode, "strides": strides, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["mode"] = mode
+	attrs["strides"] = strides
+	attrs["padding"] = padding
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -6054,7 +6282,8 @@ func FractionalAvgPoolGrad(scope *Scope, orig_input_tensor_shape tf.Output, out_
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -6114,7 +6343,10 @@ func Conv2DBackpropInput(scope *Scope, input_sizes tf.Output, filter tf.Output,
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"strides": strides, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["strides"] = strides
+	attrs["padding"] = padding
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -6186,7 +6418,8 @@ func FusedBatchNorm(scope *Scope, x tf.Output, scale tf.Output, offset tf.Output
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -6235,14 +6468,22 @@ func RandomStandardNormalSeed2(value int64) RandomStandardNormalAttr {
 //	dtype: The type of the output.
 //
 // Returns A tensor of the specified shape filled with random normal values.
+//
+// REQUIRES: dtype is one of tf.Half, tf.Float, tf.Double
 func RandomStandardNormal(scope *Scope, shape tf.Output, dtype tf.DataType, optional ...RandomStandardNormalAttr) (output tf.Output) {
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"dtype": dtype}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["dtype"] = dtype
 	for _, a := range optional {
 		a(attrs)
 	}
+	if err := validateDType(attrs, "dtype", tf.Half, tf.Float, tf.Double); err != nil {
+		scope.UpdateErr("RandomStandardNormal", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "RandomStandardNormal",
 		Input: []tf.Input{
@@ -6309,7 +6550,8 @@ func FractionalMaxPoolGrad(scope *Scope, orig_input tf.Output, orig_output tf.Ou
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -6355,7 +6597,8 @@ func ResourceApplyAdagradDA(scope *Scope, var_ tf.Output, gradient_accumulator t
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -6413,7 +6656,9 @@ func ComputeAccidentalHits(scope *Scope, true_classes tf.Output, sampled_candida
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"num_true": num_true}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["num_true"] = num_true
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -6460,7 +6705,11 @@ func AvgPoolGrad(scope *Scope, orig_input_shape tf.Output, grad tf.Output, ksize
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"ksize": ksize, "strides": strides, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["ksize"] = ksize
+	attrs["strides"] = strides
+	attrs["padding"] = padding
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -6573,7 +6822,9 @@ func TensorArrayGatherV2(scope *Scope, handle tf.Output, indices tf.Output, flow
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"dtype": dtype}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["dtype"] = dtype
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -6706,7 +6957,8 @@ func DecodeCSV(scope *Scope, records tf.Output, record_defaults []tf.Output, opt
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -6763,7 +7015,8 @@ func BiasAddGrad(scope *Scope, out_backprop tf.Output, optional ...BiasAddGradAt
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -6819,7 +7072,9 @@ func ParseTensor(scope *Scope, serialized tf.Output, out_type tf.DataType) (outp
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"out_type": out_type}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["out_type"] = out_type
 	opspec := tf.OpSpec{
 		Type: "ParseTensor",
 		Input: []tf.Input{
@@ -6934,14 +7189,22 @@ func DecodeRawLittleEndian(value bool) DecodeRawAttr {
 // Returns A Tensor with one more dimension than the input `bytes`.  The
 // added dimension will have size equal to the length of the elements
 // of `bytes` divided by the number of bytes to represent `out_type`.
+//
+// REQUIRES: out_type is one of tf.Half, tf.Float, tf.Double, tf.Int32, tf.Uint8, tf.Int16, tf.Int8, tf.Int64
 func DecodeRaw(scope *Scope, bytes tf.Output, out_type tf.DataType, optional ...DecodeRawAttr) (output tf.Output) {
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"out_type": out_type}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["out_type"] = out_type
 	for _, a := range optional {
 		a(attrs)
 	}
+	if err := validateDType(attrs, "out_type", tf.Half, tf.Float, tf.Double, tf.Int32, tf.Uint8, tf.Int16, tf.Int8, tf.Int64); err != nil {
+		scope.UpdateErr("DecodeRaw", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "DecodeRaw",
 		Input: []tf.Input{
@@ -6986,7 +7249,9 @@ func QueueDequeueV2(scope *Scope, handle tf.Output, component_types []tf.DataTyp
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"component_types": component_types}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["component_types"] = component_types
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -7122,7 +7387,8 @@ func ParseSingleSequenceExample(scope *Scope, serialized tf.Output, feature_list
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -7218,7 +7484,8 @@ func RandomGamma(scope *Scope, shape tf.Output, alpha tf.Output, optional ...Ran
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -7278,7 +7545,11 @@ func FusedPadConv2D(scope *Scope, input tf.Output, paddings tf.Output, filter tf
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"mode": mode, "strides": strides, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["mode"] = mode
+	attrs["strides"] = strides
+	attrs["padding"] = padding
 	opspec := tf.OpSpec{
 		Type: "FusedPadConv2D",
 		Input: []tf.Input{
@@ -7336,7 +7607,8 @@ func RandomShuffle(scope *Scope, value tf.Output, optional ...RandomShuffleAttr)
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -7412,14 +7684,22 @@ func TruncatedNormalSeed2(value int64) TruncatedNormalAttr {
 //
 // Returns A tensor of the specified shape filled with random truncated normal
 // values.
+//
+// REQUIRES: dtype is one of tf.Half, tf.Float, tf.Double
 func TruncatedNormal(scope *Scope, shape tf.Output, dtype tf.DataType, optional ...TruncatedNormalAttr) (output tf.Output) {
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"dtype": dtype}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["dtype"] = dtype
 	for _, a := range optional {
 		a(attrs)
 	}
+	if err := validateDType(attrs, "dtype", tf.Half, tf.Float, tf.Double); err != nil {
+		scope.UpdateErr("TruncatedNormal", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "TruncatedNormal",
 		Input: []tf.Input{
@@ -7479,7 +7759,10 @@ func Skipgram(scope *Scope, filename string, batch_size int64, optional ...Skipg
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"filename": filename, "batch_size": batch_size}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["filename"] = filename
+	attrs["batch_size"] = batch_size
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -7536,7 +7819,8 @@ func ParameterizedTruncatedNormal(scope *Scope, shape tf.Output, means tf.Output
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -7622,7 +7906,8 @@ func RandomUniformInt(scope *Scope, shape tf.Output, minval tf.Output, maxval tf
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -7681,7 +7966,8 @@ func Pack(scope *Scope, values []tf.Output, optional ...PackAttr) (output tf.Out
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -7716,6 +8002,8 @@ type QuantizedReluAttr func(optionalAttr)
 
 // QuantizedReluOutType sets the optional out_type attribute to value.
 // If not specified, defaults to type:DT_QUINT8
+//
+// REQUIRES: value is one of tf.Qint8, tf.Quint8, tf.Qint16, tf.Quint16, tf.Qint32
 func QuantizedReluOutType(value tf.DataType) QuantizedReluAttr {
 	return func(m optionalAttr) {
 		m["out_type"] = value
@@ -7734,10 +8022,15 @@ func QuantizedRelu(scope *Scope, features tf.Output, min_features tf.Output, max
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
+	if err := validateDType(attrs, "out_type", tf.Qint8, tf.Quint8, tf.Qint16, tf.Quint16, tf.Qint32); err != nil {
+		scope.UpdateErr("QuantizedRelu", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "QuantizedRelu",
 		Input: []tf.Input{
@@ -7827,7 +8120,8 @@ func SparseMatMul(scope *Scope, a tf.Output, b tf.Output, optional ...SparseMatM
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -7908,7 +8202,13 @@ func SdcaOptimizer(scope *Scope, sparse_example_indices []tf.Output, sparse_feat
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"loss_type": loss_type, "l1": l1, "l2": l2, "num_loss_partitions": num_loss_partitions, "num_inner_iterations": num_inner_iterations}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["loss_type"] = loss_type
+	attrs["l1"] = l1
+	attrs["l2"] = l2
+	attrs["num_loss_partitions"] = num_loss_partitions
+	attrs["num_inner_iterations"] = num_inner_iterations
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -8019,7 +8319,9 @@ func Restore(scope *Scope, file_pattern tf.Output, tensor_name tf.Output, dt tf.
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"dt": dt}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["dt"] = dt
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -8060,7 +8362,10 @@ func BatchNormWithGlobalNormalization(scope *Scope, t tf.Output, m tf.Output, v
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"variance_epsilon": variance_epsilon, "scale_after_normalization": scale_after_normalization}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["variance_epsilon"] = variance_epsilon
+	attrs["scale_after_normalization"] = scale_after_normalization
 	opspec := tf.OpSpec{
 		Type: "BatchNormWithGlobalNormalization",
 		Input: []tf.Input{
@@ -8103,7 +8408,11 @@ func MaxPool(scope *Scope, input tf.Output, ksize []int64, strides []int64, padd
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"ksize": ksize, "strides": strides, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["ksize"] = ksize
+	attrs["strides"] = strides
+	attrs["padding"] = padding
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -8203,7 +8512,10 @@ func StringToHashBucketStrong(scope *Scope, input tf.Output, num_buckets int64,
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"num_buckets": num_buckets, "key": key}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["num_buckets"] = num_buckets
+	attrs["key"] = key
 	opspec := tf.OpSpec{
 		Type: "StringToHashBucketStrong",
 		Input: []tf.Input{
@@ -8259,7 +8571,8 @@ func DestroyResourceOp(scope *Scope, resource tf.Output, optional ...DestroyReso
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -8319,7 +8632,8 @@ func ResourceApplyMomentum(scope *Scope, var_ tf.Output, accum tf.Output, lr tf.
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -8469,6 +8783,8 @@ type MaxPoolWithArgmaxAttr func(optionalAttr)
 
 // MaxPoolWithArgmaxTargmax sets the optional Targmax attribute to value.
 // If not specified, defaults to type:DT_INT64
+//
+// REQUIRES: value is one of tf.Int32, tf.Int64
 func MaxPoolWithArgmaxTargmax(value tf.DataType) MaxPoolWithArgmaxAttr {
 	return func(m optionalAttr) {
 		m["Targmax"] = value
@@ -8493,10 +8809,18 @@ func MaxPoolWithArgmax(scope *Scope, input tf.Output, ksize []int64, strides []i
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"ksize": ksize, "strides": strides, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["ksize"] = ksize
+	attrs["strides"] = strides
+	attrs["padding"] = padding
 	for _, a := range optional {
 		a(attrs)
 	}
+	if err := validateDType(attrs, "Targmax", tf.Int32, tf.Int64); err != nil {
+		scope.UpdateErr("MaxPoolWithArgmax", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "MaxPoolWithArgmax",
 		Input: []tf.Input{
@@ -8596,7 +8920,9 @@ func TopK(scope *Scope, input tf.Output, k int64, optional ...TopKAttr) (values
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"k": k}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["k"] = k
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -8626,7 +8952,11 @@ func MaxPool3D(scope *Scope, input tf.Output, ksize []int64, strides []int64, pa
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"ksize": ksize, "strides": strides, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["ksize"] = ksize
+	attrs["strides"] = strides
+	attrs["padding"] = padding
 	opspec := tf.OpSpec{
 		Type: "MaxPool3D",
 		Input: []tf.Input{
@@ -8798,7 +9128,9 @@ func FractionalMaxPool(scope *Scope, value tf.Output, pooling_ratio []float32, o
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"pooling_ratio": pooling_ratio}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["pooling_ratio"] = pooling_ratio
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -9018,7 +9350,8 @@ func ResourceApplyCenteredRMSProp(scope *Scope, var_ tf.Output, mg tf.Output, ms
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -9077,7 +9410,10 @@ func DepthwiseConv2dNativeBackpropInput(scope *Scope, input_sizes tf.Output, fil
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"strides": strides, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["strides"] = strides
+	attrs["padding"] = padding
 	opspec := tf.OpSpec{
 		Type: "DepthwiseConv2dNativeBackpropInput",
 		Input: []tf.Input{
@@ -9107,7 +9443,9 @@ func StringToHashBucket(scope *Scope, string_tensor tf.Output, num_buckets int64
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"num_buckets": num_buckets}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["num_buckets"] = num_buckets
 	opspec := tf.OpSpec{
 		Type: "StringToHashBucket",
 		Input: []tf.Input{
@@ -9152,7 +9490,9 @@ func GetSessionTensor(scope *Scope, handle tf.Output, dtype tf.DataType) (value
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"dtype": dtype}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["dtype"] = dtype
 	opspec := tf.OpSpec{
 		Type: "GetSessionTensor",
 		Input: []tf.Input{
@@ -9195,7 +9535,8 @@ func ResourceApplyProximalGradientDescent(scope *Scope, var_ tf.Output, alpha tf
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -9242,7 +9583,8 @@ func ResourceApplyProximalAdagrad(scope *Scope, var_ tf.Output, accum tf.Output,
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -9270,11 +9612,19 @@ func ResourceApplyProximalAdagrad(scope *Scope, var_ tf.Output, accum tf.Output,
 //
 //
 // Returns The float value that the lowest quantized output value represents.The float value that the highest quantized output value represents.
+//
+// REQUIRES: out_type is one of tf.Qint8, tf.Quint8, tf.Qint16, tf.Quint16, tf.Qint32
 func QuantizedBiasAdd(scope *Scope, input tf.Output, bias tf.Output, min_input tf.Output, max_input tf.Output, min_bias tf.Output, max_bias tf.Output, out_type tf.DataType) (output tf.Output, min_out tf.Output, max_out tf.Output) {
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"out_type": out_type}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["out_type"] = out_type
+	if err := validateDType(attrs, "out_type", tf.Qint8, tf.Quint8, tf.Qint16, tf.Quint16, tf.Qint32); err != nil {
+		scope.UpdateErr("QuantizedBiasAdd", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "QuantizedBiasAdd",
 		Input: []tf.Input{
@@ -9349,7 +9699,9 @@ func TensorArrayV3(scope *Scope, size tf.Output, dtype tf.DataType, optional ...
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"dtype": dtype}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["dtype"] = dtype
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -9390,7 +9742,8 @@ func ResourceApplyGradientDescent(scope *Scope, var_ tf.Output, alpha tf.Output,
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -9441,7 +9794,8 @@ func Multinomial(scope *Scope, logits tf.Output, num_samples tf.Output, optional
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -9488,7 +9842,8 @@ func ResourceSparseApplyAdagradDA(scope *Scope, var_ tf.Output, gradient_accumul
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -9563,7 +9918,9 @@ func SparseToSparseSetOperation(scope *Scope, set1_indices tf.Output, set1_value
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"set_operation": set_operation}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["set_operation"] = set_operation
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -9594,7 +9951,11 @@ func AvgPool3DGrad(scope *Scope, orig_input_shape tf.Output, grad tf.Output, ksi
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"ksize": ksize, "strides": strides, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["ksize"] = ksize
+	attrs["strides"] = strides
+	attrs["padding"] = padding
 	opspec := tf.OpSpec{
 		Type: "AvgPool3DGrad",
 		Input: []tf.Input{
@@ -9611,6 +9972,8 @@ type QuantizedRelu6Attr func(optionalAttr)
 
 // QuantizedRelu6OutType sets the optional out_type attribute to value.
 // If not specified, defaults to type:DT_QUINT8
+//
+// REQUIRES: value is one of tf.Qint8, tf.Quint8, tf.Qint16, tf.Quint16, tf.Qint32
 func QuantizedRelu6OutType(value tf.DataType) QuantizedRelu6Attr {
 	return func(m optionalAttr) {
 		m["out_type"] = value
@@ -9629,10 +9992,15 @@ func QuantizedRelu6(scope *Scope, features tf.Output, min_features tf.Output, ma
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
+	if err := validateDType(attrs, "out_type", tf.Qint8, tf.Quint8, tf.Qint16, tf.Quint16, tf.Qint32); err != nil {
+		scope.UpdateErr("QuantizedRelu6", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "QuantizedRelu6",
 		Input: []tf.Input{
@@ -9692,7 +10060,9 @@ func FixedLengthRecordReaderV2(scope *Scope, record_bytes int64, optional ...Fix
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"record_bytes": record_bytes}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["record_bytes"] = record_bytes
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -9756,7 +10126,8 @@ func ResourceSparseApplyCenteredRMSProp(scope *Scope, var_ tf.Output, mg tf.Outp
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -9856,7 +10227,8 @@ func Cumprod(scope *Scope, x tf.Output, axis tf.Output, optional ...CumprodAttr)
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -9958,7 +10330,9 @@ func DenseToSparseSetOperation(scope *Scope, set1 tf.Output, set2_indices tf.Out
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"set_operation": set_operation}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["set_operation"] = set_operation
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -10005,7 +10379,9 @@ func MirrorPadGrad(scope *Scope, input tf.Output, paddings tf.Output, mode strin
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"mode": mode}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["mode"] = mode
 	opspec := tf.OpSpec{
 		Type: "MirrorPadGrad",
 		Input: []tf.Input{
@@ -10184,7 +10560,10 @@ func Conv2D(scope *Scope, input tf.Output, filter tf.Output, strides []int64, pa
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"strides": strides, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["strides"] = strides
+	attrs["padding"] = padding
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -10224,7 +10603,8 @@ func StringJoin(scope *Scope, inputs []tf.Output, optional ...StringJoinAttr) (o
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -10285,7 +10665,10 @@ func ParseExample(scope *Scope, serialized tf.Output, names tf.Output, sparse_ke
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"sparse_types": sparse_types, "dense_shapes": dense_shapes}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["sparse_types"] = sparse_types
+	attrs["dense_shapes"] = dense_shapes
 	opspec := tf.OpSpec{
 		Type: "ParseExample",
 		Input: []tf.Input{
@@ -10374,7 +10757,8 @@ func ResourceApplyAdagrad(scope *Scope, var_ tf.Output, accum tf.Output, lr tf.O
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -10406,7 +10790,9 @@ func StringToHashBucketFast(scope *Scope, input tf.Output, num_buckets int64) (o
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"num_buckets": num_buckets}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["num_buckets"] = num_buckets
 	opspec := tf.OpSpec{
 		Type: "StringToHashBucketFast",
 		Input: []tf.Input{
@@ -10480,7 +10866,8 @@ func AddManySparseToTensorsMap(scope *Scope, sparse_indices tf.Output, sparse_va
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -10633,7 +11020,8 @@ func SampleDistortedBoundingBox(scope *Scope, image_size tf.Output, bounding_box
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -10710,7 +11098,8 @@ func ResourceSparseApplyRMSProp(scope *Scope, var_ tf.Output, ms tf.Output, mom
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -10794,14 +11183,22 @@ func QuantizeV2Mode(value string) QuantizeV2Attr {
 //
 //
 // Returns The quantized data produced from the float input.The actual minimum scalar value used for the output.The actual maximum scalar value used for the output.
+//
+// REQUIRES: T is one of tf.Qint8, tf.Quint8, tf.Qint16, tf.Quint16, tf.Qint32
 func QuantizeV2(scope *Scope, input tf.Output, min_range tf.Output, max_range tf.Output, T tf.DataType, optional ...QuantizeV2Attr) (output tf.Output, output_min tf.Output, output_max tf.Output) {
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"T": T}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["T"] = T
 	for _, a := range optional {
 		a(attrs)
 	}
+	if err := validateDType(attrs, "T", tf.Qint8, tf.Quint8, tf.Qint16, tf.Quint16, tf.Qint32); err != nil {
+		scope.UpdateErr("QuantizeV2", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "QuantizeV2",
 		Input: []tf.Input{
@@ -10833,7 +11230,10 @@ func DepthwiseConv2dNativeBackpropFilter(scope *Scope, input tf.Output, filter_s
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"strides": strides, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["strides"] = strides
+	attrs["padding"] = padding
 	opspec := tf.OpSpec{
 		Type: "DepthwiseConv2dNativeBackpropFilter",
 		Input: []tf.Input{
@@ -10904,7 +11304,8 @@ func ResourceSparseApplyFtrl(scope *Scope, var_ tf.Output, accum tf.Output, line
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -10937,7 +11338,10 @@ func Conv3D(scope *Scope, input tf.Output, filter tf.Output, strides []int64, pa
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"strides": strides, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["strides"] = strides
+	attrs["padding"] = padding
 	opspec := tf.OpSpec{
 		Type: "Conv3D",
 		Input: []tf.Input{
@@ -10962,7 +11366,9 @@ func TensorArrayReadV3(scope *Scope, handle tf.Output, index tf.Output, flow_in
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"dtype": dtype}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["dtype"] = dtype
 	opspec := tf.OpSpec{
 		Type: "TensorArrayReadV3",
 		Input: []tf.Input{
@@ -11094,7 +11500,8 @@ func ResizeArea(scope *Scope, images tf.Output, size tf.Output, optional ...Resi
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -11114,6 +11521,8 @@ type RealAttr func(optionalAttr)
 
 // RealTout sets the optional Tout attribute to value.
 // If not specified, defaults to type:DT_FLOAT
+//
+// REQUIRES: value is one of tf.Float, tf.Double
 func RealTout(value tf.DataType) RealAttr {
 	return func(m optionalAttr) {
 		m["Tout"] = value
@@ -11137,10 +11546,15 @@ func Real(scope *Scope, input tf.Output, optional ...RealAttr) (output tf.Output
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
+	if err := validateDType(attrs, "Tout", tf.Float, tf.Double); err != nil {
+		scope.UpdateErr("Real", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "Real",
 		Input: []tf.Input{
@@ -11210,7 +11624,12 @@ func ThreadUnsafeUnigramCandidateSampler(scope *Scope, true_classes tf.Output, n
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"num_true": num_true, "num_sampled": num_sampled, "unique": unique, "range_max": range_max}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["num_true"] = num_true
+	attrs["num_sampled"] = num_sampled
+	attrs["unique"] = unique
+	attrs["range_max"] = range_max
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -11261,7 +11680,8 @@ func ResourceSparseApplyProximalAdagrad(scope *Scope, var_ tf.Output, accum tf.O
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -11375,7 +11795,8 @@ func TextLineReaderV2(scope *Scope, optional ...TextLineReaderV2Attr) (reader_ha
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -11428,7 +11849,8 @@ func SparseReduceSum(scope *Scope, input_indices tf.Output, input_values tf.Outp
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -11458,7 +11880,11 @@ func MaxPool3DGrad(scope *Scope, orig_input tf.Output, orig_output tf.Output, gr
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"ksize": ksize, "strides": strides, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["ksize"] = ksize
+	attrs["strides"] = strides
+	attrs["padding"] = padding
 	opspec := tf.OpSpec{
 		Type: "MaxPool3DGrad",
 		Input: []tf.Input{
@@ -11502,7 +11928,8 @@ func SetSize(scope *Scope, set_indices tf.Output, set_values tf.Output, set_shap
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -11522,6 +11949,8 @@ type SizeAttr func(optionalAttr)
 
 // SizeOutType sets the optional out_type attribute to value.
 // If not specified, defaults to type:DT_INT32
+//
+// REQUIRES: value is one of tf.Int32, tf.Int64
 func SizeOutType(value tf.DataType) SizeAttr {
 	return func(m optionalAttr) {
 		m["out_type"] = value
@@ -11543,10 +11972,15 @@ func Size(scope *Scope, input tf.Output, optional ...SizeAttr) (output tf.Output
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
+	if err := validateDType(attrs, "out_type", tf.Int32, tf.Int64); err != nil {
+		scope.UpdateErr("Size", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "Size",
 		Input: []tf.Input{
@@ -11601,7 +12035,8 @@ func ResourceApplyRMSProp(scope *Scope, var_ tf.Output, ms tf.Output, mom tf.Out
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -11673,7 +12108,8 @@ func Print(scope *Scope, input tf.Output, data []tf.Output, optional ...PrintAtt
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -11751,7 +12187,8 @@ func LRN(scope *Scope, input tf.Output, optional ...LRNAttr) (output tf.Output)
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -11799,7 +12236,8 @@ func ResourceSparseApplyAdagrad(scope *Scope, var_ tf.Output, accum tf.Output, l
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -11868,7 +12306,8 @@ func LRNGrad(scope *Scope, input_grads tf.Output, input_image tf.Output, output_
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -11890,6 +12329,8 @@ type StringToNumberAttr func(optionalAttr)
 //
 // value: The numeric type to interpret each string in `string_tensor` as.
 // If not specified, defaults to type:DT_FLOAT
+//
+// REQUIRES: value is one of tf.Float, tf.Int32
 func StringToNumberOutType(value tf.DataType) StringToNumberAttr {
 	return func(m optionalAttr) {
 		m["out_type"] = value
@@ -11906,10 +12347,15 @@ func StringToNumber(scope *Scope, string_tensor tf.Output, optional ...StringToN
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
+	if err := validateDType(attrs, "out_type", tf.Float, tf.Int32); err != nil {
+		scope.UpdateErr("StringToNumber", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "StringToNumber",
 		Input: []tf.Input{
@@ -12001,7 +12447,8 @@ func AsString(scope *Scope, input tf.Output, optional ...AsStringAttr) (output t
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -12043,7 +12490,9 @@ func InTopK(scope *Scope, predictions tf.Output, targets tf.Output, k int64) (pr
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"k": k}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["k"] = k
 	opspec := tf.OpSpec{
 		Type: "InTopK",
 		Input: []tf.Input{
@@ -12092,7 +12541,8 @@ func Gather(scope *Scope, params tf.Output, indices tf.Output, optional ...Gathe
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -12221,7 +12671,11 @@ func Dilation2D(scope *Scope, input tf.Output, filter tf.Output, strides []int64
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"strides": strides, "rates": rates, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["strides"] = strides
+	attrs["rates"] = rates
+	attrs["padding"] = padding
 	opspec := tf.OpSpec{
 		Type: "Dilation2D",
 		Input: []tf.Input{
@@ -12288,7 +12742,8 @@ func EncodeBase64(scope *Scope, input tf.Output, optional ...EncodeBase64Attr) (
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -12447,7 +12902,8 @@ func CopyHost(scope *Scope, input tf.Output, optional ...CopyHostAttr) (output t
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -12496,6 +12952,8 @@ type QuantizedReluXAttr func(optionalAttr)
 
 // QuantizedReluXOutType sets the optional out_type attribute to value.
 // If not specified, defaults to type:DT_QUINT8
+//
+// REQUIRES: value is one of tf.Qint8, tf.Quint8, tf.Qint16, tf.Quint16, tf.Qint32
 func QuantizedReluXOutType(value tf.DataType) QuantizedReluXAttr {
 	return func(m optionalAttr) {
 		m["out_type"] = value
@@ -12515,10 +12973,15 @@ func QuantizedReluX(scope *Scope, features tf.Output, max_value tf.Output, min_f
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
+	if err := validateDType(attrs, "out_type", tf.Qint8, tf.Quint8, tf.Qint16, tf.Quint16, tf.Qint32); err != nil {
+		scope.UpdateErr("QuantizedReluX", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "QuantizedReluX",
 		Input: []tf.Input{
@@ -12564,7 +13027,8 @@ func MergeV2Checkpoints(scope *Scope, checkpoint_prefixes tf.Output, destination
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -12594,7 +13058,9 @@ func ReadVariableOp(scope *Scope, resource tf.Output, dtype tf.DataType) (value
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"dtype": dtype}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["dtype"] = dtype
 	opspec := tf.OpSpec{
 		Type: "ReadVariableOp",
 		Input: []tf.Input{
@@ -12635,7 +13101,8 @@ func Prod(scope *Scope, input tf.Output, reduction_indices tf.Output, optional .
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -12680,7 +13147,8 @@ func ResizeBilinear(scope *Scope, images tf.Output, size tf.Output, optional ...
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -12833,7 +13301,8 @@ func ResourceSparseApplyProximalGradientDescent(scope *Scope, var_ tf.Output, al
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -12885,7 +13354,9 @@ func Unpack(scope *Scope, value tf.Output, num int64, optional ...UnpackAttr) (o
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"num": num}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["num"] = num
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -12946,7 +13417,9 @@ func SparseSplit(scope *Scope, split_dim tf.Output, indices tf.Output, values tf
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"num_split": num_split}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["num_split"] = num_split
 	opspec := tf.OpSpec{
 		Type: "SparseSplit",
 		Input: []tf.Input{
@@ -13033,7 +13506,8 @@ func ReduceJoin(scope *Scope, inputs tf.Output, reduction_indices tf.Output, opt
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -13102,7 +13576,8 @@ func Svd(scope *Scope, input tf.Output, optional ...SvdAttr) (s tf.Output, u tf.
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -13152,14 +13627,22 @@ func RandomUniformSeed2(value int64) RandomUniformAttr {
 //	dtype: The type of the output.
 //
 // Returns A tensor of the specified shape filled with uniform random values.
+//
+// REQUIRES: dtype is one of tf.Half, tf.Float, tf.Double
 func RandomUniform(scope *Scope, shape tf.Output, dtype tf.DataType, optional ...RandomUniformAttr) (output tf.Output) {
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"dtype": dtype}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["dtype"] = dtype
 	for _, a := range optional {
 		a(attrs)
 	}
+	if err := validateDType(attrs, "dtype", tf.Half, tf.Float, tf.Double); err != nil {
+		scope.UpdateErr("RandomUniform", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "RandomUniform",
 		Input: []tf.Input{
@@ -13209,7 +13692,8 @@ func ResourceApplyFtrl(scope *Scope, var_ tf.Output, accum tf.Output, linear tf.
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -13252,7 +13736,8 @@ func Any(scope *Scope, input tf.Output, reduction_indices tf.Output, optional ..
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -13387,7 +13872,8 @@ func TopKV2(scope *Scope, input tf.Output, k tf.Output, optional ...TopKV2Attr)
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -13456,7 +13942,9 @@ func DenseToDenseSetOperation(scope *Scope, set1 tf.Output, set2 tf.Output, set_
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"set_operation": set_operation}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["set_operation"] = set_operation
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -13484,7 +13972,11 @@ func ImmutableConst(scope *Scope, dtype tf.DataType, shape tf.Shape, memory_regi
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"dtype": dtype, "shape": shape, "memory_region_name": memory_region_name}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["dtype"] = dtype
+	attrs["shape"] = shape
+	attrs["memory_region_name"] = memory_region_name
 	opspec := tf.OpSpec{
 		Type: "ImmutableConst",
 
@@ -13546,7 +14038,9 @@ func DeserializeManySparse(scope *Scope, serialized_sparse tf.Output, dtype tf.D
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"dtype": dtype}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["dtype"] = dtype
 	opspec := tf.OpSpec{
 		Type: "DeserializeManySparse",
 		Input: []tf.Input{
@@ -13609,7 +14103,8 @@ func RandomPoisson(scope *Scope, shape tf.Output, rate tf.Output, optional ...Ra
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -13771,7 +14266,9 @@ func SparseConcat(scope *Scope, indices []tf.Output, values []tf.Output, shapes
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"concat_dim": concat_dim}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["concat_dim"] = concat_dim
 	opspec := tf.OpSpec{
 		Type: "SparseConcat",
 		Input: []tf.Input{
@@ -13818,7 +14315,8 @@ func IdentityReaderV2(scope *Scope, optional ...IdentityReaderV2Attr) (reader_ha
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -13879,7 +14377,8 @@ func NonMaxSuppression(scope *Scope, boxes tf.Output, scores tf.Output, max_outp
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -13929,7 +14428,8 @@ func ResourceApplyAdadelta(scope *Scope, var_ tf.Output, accum tf.Output, accum_
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -14082,7 +14582,8 @@ func AddSparseToTensorsMap(scope *Scope, sparse_indices tf.Output, sparse_values
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -14129,11 +14630,21 @@ func AddSparseToTensorsMap(scope *Scope, sparse_indices tf.Output, sparse_values
 //	variance_epsilon: A small float number to avoid dividing by 0.
 //	scale_after_normalization: A bool indicating whether the resulted tensor
 // needs to be multiplied with gamma.
+//
+// REQUIRES: out_type is one of tf.Qint8, tf.Quint8, tf.Qint16, tf.Quint16, tf.Qint32
 func QuantizedBatchNormWithGlobalNormalization(scope *Scope, t tf.Output, t_min tf.Output, t_max tf.Output, m tf.Output, m_min tf.Output, m_max tf.Output, v tf.Output, v_min tf.Output, v_max tf.Output, beta tf.Output, beta_min tf.Output, beta_max tf.Output, gamma tf.Output, gamma_min tf.Output, gamma_max tf.Output, out_type tf.DataType, variance_epsilon float32, scale_after_normalization bool) (result tf.Output, result_min tf.Output, result_max tf.Output) {
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"out_type": out_type, "variance_epsilon": variance_epsilon, "scale_after_normalization": scale_after_normalization}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["out_type"] = out_type
+	attrs["variance_epsilon"] = variance_epsilon
+	attrs["scale_after_normalization"] = scale_after_normalization
+	if err := validateDType(attrs, "out_type", tf.Qint8, tf.Quint8, tf.Qint16, tf.Quint16, tf.Qint32); err != nil {
+		scope.UpdateErr("QuantizedBatchNormWithGlobalNormalization", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "QuantizedBatchNormWithGlobalNormalization",
 		Input: []tf.Input{
@@ -14277,7 +14788,8 @@ func ResourceApplyAdam(scope *Scope, var_ tf.Output, m tf.Output, v tf.Output, b
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -14454,7 +14966,9 @@ func TakeManySparseFromTensorsMap(scope *Scope, sparse_handles tf.Output, dtype
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"dtype": dtype}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["dtype"] = dtype
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -14625,7 +15139,8 @@ func All(scope *Scope, input tf.Output, reduction_indices tf.Output, optional ..
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -14645,7 +15160,9 @@ func Cast(scope *Scope, x tf.Output, DstT tf.DataType) (y tf.Output) {
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"DstT": DstT}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["DstT"] = DstT
 	opspec := tf.OpSpec{
 		Type: "Cast",
 		Input: []tf.Input{
@@ -14696,7 +15213,10 @@ func CTCBeamSearchDecoder(scope *Scope, inputs tf.Output, sequence_length tf.Out
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"beam_width": beam_width, "top_paths": top_paths}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["beam_width"] = beam_width
+	attrs["top_paths"] = top_paths
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -14810,7 +15330,9 @@ func RecordInput(scope *Scope, file_pattern string, optional ...RecordInputAttr)
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"file_pattern": file_pattern}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["file_pattern"] = file_pattern
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -14998,7 +15520,8 @@ func ResizeBicubic(scope *Scope, images tf.Output, size tf.Output, optional ...R
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -15135,7 +15658,10 @@ func BatchNormWithGlobalNormalizationGrad(scope *Scope, t tf.Output, m tf.Output
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"variance_epsilon": variance_epsilon, "scale_after_normalization": scale_after_normalization}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["variance_epsilon"] = variance_epsilon
+	attrs["scale_after_normalization"] = scale_after_normalization
 	opspec := tf.OpSpec{
 		Type: "BatchNormWithGlobalNormalizationGrad",
 		Input: []tf.Input{
@@ -15268,7 +15794,8 @@ func EncodeJpeg(scope *Scope, image tf.Output, optional ...EncodeJpegAttr) (cont
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -15401,7 +15928,9 @@ func RandomShuffleQueueV2(scope *Scope, component_types []tf.DataType, optional
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"component_types": component_types}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["component_types"] = component_types
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -15576,7 +16105,9 @@ func BatchToSpace(scope *Scope, input tf.Output, crops tf.Output, block_size int
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"block_size": block_size}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["block_size"] = block_size
 	opspec := tf.OpSpec{
 		Type: "BatchToSpace",
 		Input: []tf.Input{
@@ -15638,7 +16169,8 @@ func SparseToDense(scope *Scope, sparse_indices tf.Output, output_shape tf.Outpu
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -15686,11 +16218,19 @@ func Asin(scope *Scope, x tf.Output) (y tf.Output) {
 //	out_type: The type of the output. Should be a lower bit depth than Tinput.
 //
 // Returns The requested_output_min value is copied into this output.The requested_output_max value is copied into this output.
+//
+// REQUIRES: out_type is one of tf.Qint8, tf.Quint8, tf.Qint16, tf.Quint16, tf.Qint32
 func Requantize(scope *Scope, input tf.Output, input_min tf.Output, input_max tf.Output, requested_output_min tf.Output, requested_output_max tf.Output, out_type tf.DataType) (output tf.Output, output_min tf.Output, output_max tf.Output) {
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"out_type": out_type}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["out_type"] = out_type
+	if err := validateDType(attrs, "out_type", tf.Qint8, tf.Quint8, tf.Qint16, tf.Quint16, tf.Qint32); err != nil {
+		scope.UpdateErr("Requantize", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "Requantize",
 		Input: []tf.Input{
@@ -15768,7 +16308,8 @@ func ResourceSparseApplyAdadelta(scope *Scope, var_ tf.Output, accum tf.Output,
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -15943,7 +16484,8 @@ func BiasAdd(scope *Scope, value tf.Output, bias tf.Output, optional ...BiasAddA
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -15996,7 +16538,8 @@ func SparseReduceSumSparse(scope *Scope, input_indices tf.Output, input_values t
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -16123,7 +16666,12 @@ func LogUniformCandidateSampler(scope *Scope, true_classes tf.Output, num_true i
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"num_true": num_true, "num_sampled": num_sampled, "unique": unique, "range_max": range_max}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["num_true"] = num_true
+	attrs["num_sampled"] = num_sampled
+	attrs["unique"] = unique
+	attrs["range_max"] = range_max
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -16249,7 +16797,8 @@ func Assert(scope *Scope, condition tf.Output, data []tf.Output, optional ...Ass
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -16594,7 +17143,8 @@ func StridedSlice(scope *Scope, input tf.Output, begin tf.Output, end tf.Output,
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -16614,6 +17164,8 @@ type ComplexAbsAttr func(optionalAttr)
 
 // ComplexAbsTout sets the optional Tout attribute to value.
 // If not specified, defaults to type:DT_FLOAT
+//
+// REQUIRES: value is one of tf.Float, tf.Double
 func ComplexAbsTout(value tf.DataType) ComplexAbsAttr {
 	return func(m optionalAttr) {
 		m["Tout"] = value
@@ -16630,10 +17182,15 @@ func ComplexAbs(scope *Scope, x tf.Output, optional ...ComplexAbsAttr) (y tf.Out
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
+	if err := validateDType(attrs, "Tout", tf.Float, tf.Double); err != nil {
+		scope.UpdateErr("ComplexAbs", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "ComplexAbs",
 		Input: []tf.Input{
@@ -16781,7 +17338,8 @@ func MatMul(scope *Scope, a tf.Output, b tf.Output, optional ...MatMulAttr) (pro
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -16825,7 +17383,8 @@ func Mean(scope *Scope, input tf.Output, reduction_indices tf.Output, optional .
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -16934,7 +17493,7 @@ func ImageSummaryMaxImages(value int64) ImageSummaryAttr {
 //
 // value: Color to use for pixels with non-finite values.
 // If not specified, defaults to tensor:<dtype:DT_UINT8 tensor_shape:<dim:<size:4 > > int_val:255 int_val:0 int_val:0 int_val:255 >
-func ImageSummaryBadColor(value tf.Tensor) ImageSummaryAttr {
+func ImageSummaryBadColor(value *tf.Tensor) ImageSummaryAttr {
 	return func(m optionalAttr) {
 		m["bad_color"] = value
 	}
@@ -16986,7 +17545,8 @@ func ImageSummary(scope *Scope, tag tf.Output, tensor tf.Output, optional ...Ima
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -17213,7 +17773,8 @@ func CropAndResizeGradBoxes(scope *Scope, grads tf.Output, image tf.Output, boxe
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -17316,6 +17877,8 @@ type ComplexAttr func(optionalAttr)
 
 // ComplexTout sets the optional Tout attribute to value.
 // If not specified, defaults to type:DT_COMPLEX64
+//
+// REQUIRES: value is one of tf.Complex64, tf.Complex128
 func ComplexTout(value tf.DataType) ComplexAttr {
 	return func(m optionalAttr) {
 		m["Tout"] = value
@@ -17342,10 +17905,15 @@ func Complex(scope *Scope, real tf.Output, imag tf.Output, optional ...ComplexAt
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
+	if err := validateDType(attrs, "Tout", tf.Complex64, tf.Complex128); err != nil {
+		scope.UpdateErr("Complex", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "Complex",
 		Input: []tf.Input{
@@ -17362,6 +17930,8 @@ type ImagAttr func(optionalAttr)
 
 // ImagTout sets the optional Tout attribute to value.
 // If not specified, defaults to type:DT_FLOAT
+//
+// REQUIRES: value is one of tf.Float, tf.Double
 func ImagTout(value tf.DataType) ImagAttr {
 	return func(m optionalAttr) {
 		m["Tout"] = value
@@ -17385,10 +17955,15 @@ func Imag(scope *Scope, input tf.Output, optional ...ImagAttr) (output tf.Output
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
+	if err := validateDType(attrs, "Tout", tf.Float, tf.Double); err != nil {
+		scope.UpdateErr("Imag", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "Imag",
 		Input: []tf.Input{
@@ -17405,6 +17980,8 @@ type UniqueWithCountsAttr func(optionalAttr)
 
 // UniqueWithCountsOutIdx sets the optional out_idx attribute to value.
 // If not specified, defaults to type:DT_INT32
+//
+// REQUIRES: value is one of tf.Int32, tf.Int64
 func UniqueWithCountsOutIdx(value tf.DataType) UniqueWithCountsAttr {
 	return func(m optionalAttr) {
 		m["out_idx"] = value
@@ -17439,10 +18016,15 @@ func UniqueWithCounts(scope *Scope, x tf.Output, optional ...UniqueWithCountsAtt
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
+	if err := validateDType(attrs, "out_idx", tf.Int32, tf.Int64); err != nil {
+		scope.UpdateErr("UniqueWithCounts", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "UniqueWithCounts",
 		Input: []tf.Input{
@@ -17524,7 +18106,8 @@ func ResourceSparseApplyMomentum(scope *Scope, var_ tf.Output, accum tf.Output,
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -17604,7 +18187,9 @@ func RestoreSlice(scope *Scope, file_pattern tf.Output, tensor_name tf.Output, s
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"dt": dt}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["dt"] = dt
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -17767,7 +18352,9 @@ func PlaceholderWithDefault(scope *Scope, input tf.Output, shape tf.Shape) (outp
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"shape": shape}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["shape"] = shape
 	opspec := tf.OpSpec{
 		Type: "PlaceholderWithDefault",
 		Input: []tf.Input{
@@ -17784,7 +18371,9 @@ func TensorArrayReadV2(scope *Scope, handle tf.Output, index tf.Output, flow_in
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"dtype": dtype}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["dtype"] = dtype
 	opspec := tf.OpSpec{
 		Type: "TensorArrayReadV2",
 		Input: []tf.Input{
@@ -17801,6 +18390,8 @@ type QuantizedMatMulAttr func(optionalAttr)
 
 // QuantizedMatMulToutput sets the optional Toutput attribute to value.
 // If not specified, defaults to type:DT_QINT32
+//
+// REQUIRES: value is one of tf.Qint8, tf.Quint8, tf.Qint16, tf.Quint16, tf.Qint32
 func QuantizedMatMulToutput(value tf.DataType) QuantizedMatMulAttr {
 	return func(m optionalAttr) {
 		m["Toutput"] = value
@@ -17832,6 +18423,8 @@ func QuantizedMatMulTransposeB(value bool) QuantizedMatMulAttr {
 // value: The type of output produced by activation function
 // following this operation.
 // If not specified, defaults to type:DT_QUINT8
+//
+// REQUIRES: value is one of tf.Qint8, tf.Quint8, tf.Qint16, tf.Quint16, tf.Qint32
 func QuantizedMatMulTactivation(value tf.DataType) QuantizedMatMulAttr {
 	return func(m optionalAttr) {
 		m["Tactivation"] = value
@@ -17858,10 +18451,19 @@ func QuantizedMatMul(scope *Scope, a tf.Output, b tf.Output, min_a tf.Output, ma
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
+	if err := validateDType(attrs, "Toutput", tf.Qint8, tf.Quint8, tf.Qint16, tf.Quint16, tf.Qint32); err != nil {
+		scope.UpdateErr("QuantizedMatMul", err)
+		return
+	}
+	if err := validateDType(attrs, "Tactivation", tf.Qint8, tf.Quint8, tf.Qint16, tf.Quint16, tf.Qint32); err != nil {
+		scope.UpdateErr("QuantizedMatMul", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "QuantizedMatMul",
 		Input: []tf.Input{
@@ -17878,6 +18480,8 @@ type QuantizedMulAttr func(optionalAttr)
 
 // QuantizedMulToutput sets the optional Toutput attribute to value.
 // If not specified, defaults to type:DT_QINT32
+//
+// REQUIRES: value is one of tf.Qint8, tf.Quint8, tf.Qint16, tf.Quint16, tf.Qint32
 func QuantizedMulToutput(value tf.DataType) QuantizedMulAttr {
 	return func(m optionalAttr) {
 		m["Toutput"] = value
@@ -17902,10 +18506,15 @@ func QuantizedMul(scope *Scope, x tf.Output, y tf.Output, min_x tf.Output, max_x
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
+	if err := validateDType(attrs, "Toutput", tf.Qint8, tf.Quint8, tf.Qint16, tf.Quint16, tf.Qint32); err != nil {
+		scope.UpdateErr("QuantizedMul", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "QuantizedMul",
 		Input: []tf.Input{
@@ -17954,7 +18563,8 @@ func QueueEnqueueManyV2(scope *Scope, handle tf.Output, components []tf.Output,
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -18041,11 +18651,19 @@ func SquaredDifference(scope *Scope, x tf.Output, y tf.Output) (z tf.Output) {
 //	out_type: The type of the output. Should be a lower bit depth than Tinput.
 //
 // Returns The float value that the minimum quantized output value represents.The float value that the maximum quantized output value represents.
+//
+// REQUIRES: out_type is one of tf.Qint8, tf.Quint8, tf.Qint16, tf.Quint16, tf.Qint32
 func QuantizeDownAndShrinkRange(scope *Scope, input tf.Output, input_min tf.Output, input_max tf.Output, out_type tf.DataType) (output tf.Output, output_min tf.Output, output_max tf.Output) {
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"out_type": out_type}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["out_type"] = out_type
+	if err := validateDType(attrs, "out_type", tf.Qint8, tf.Quint8, tf.Qint16, tf.Quint16, tf.Qint32); err != nil {
+		scope.UpdateErr("QuantizeDownAndShrinkRange", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "QuantizeDownAndShrinkRange",
 		Input: []tf.Input{
@@ -18072,6 +18690,8 @@ func DecodePngChannels(value int64) DecodePngAttr {
 
 // DecodePngDtype sets the optional dtype attribute to value.
 // If not specified, defaults to type:DT_UINT8
+//
+// REQUIRES: value is one of tf.Uint8, tf.Uint16
 func DecodePngDtype(value tf.DataType) DecodePngAttr {
 	return func(m optionalAttr) {
 		m["dtype"] = value
@@ -18101,10 +18721,15 @@ func DecodePng(scope *Scope, contents tf.Output, optional ...DecodePngAttr) (ima
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
+	if err := validateDType(attrs, "dtype", tf.Uint8, tf.Uint16); err != nil {
+		scope.UpdateErr("DecodePng", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "DecodePng",
 		Input: []tf.Input{
@@ -18240,7 +18865,8 @@ func AudioSummaryV2(scope *Scope, tag tf.Output, tensor tf.Output, sample_rate t
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -18294,7 +18920,8 @@ func Qr(scope *Scope, input tf.Output, optional ...QrAttr) (q tf.Output, r tf.Ou
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -18350,7 +18977,9 @@ func AudioSummary(scope *Scope, tag tf.Output, tensor tf.Output, sample_rate flo
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"sample_rate": sample_rate}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["sample_rate"] = sample_rate
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -18393,7 +19022,8 @@ func ResizeNearestNeighbor(scope *Scope, images tf.Output, size tf.Output, optio
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -18463,7 +19093,8 @@ func MatrixInverse(scope *Scope, input tf.Output, optional ...MatrixInverseAttr)
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -18518,7 +19149,8 @@ func TFRecordReaderV2(scope *Scope, optional ...TFRecordReaderV2Attr) (reader_ha
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -18563,7 +19195,8 @@ func MatrixSolve(scope *Scope, matrix tf.Output, rhs tf.Output, optional ...Matr
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -18662,7 +19295,8 @@ func MatrixTriangularSolve(scope *Scope, matrix tf.Output, rhs tf.Output, option
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -18766,7 +19400,9 @@ func FractionalAvgPool(scope *Scope, value tf.Output, pooling_ratio []float32, o
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"pooling_ratio": pooling_ratio}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["pooling_ratio"] = pooling_ratio
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -18826,7 +19462,8 @@ func RandomCrop(scope *Scope, image tf.Output, size tf.Output, optional ...Rando
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -18870,7 +19507,8 @@ func Sum(scope *Scope, input tf.Output, reduction_indices tf.Output, optional ..
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -18931,7 +19569,9 @@ func DynamicPartition(scope *Scope, data tf.Output, partitions tf.Output, num_pa
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"num_partitions": num_partitions}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["num_partitions"] = num_partitions
 	opspec := tf.OpSpec{
 		Type: "DynamicPartition",
 		Input: []tf.Input{
@@ -19024,7 +19664,11 @@ func AvgPool(scope *Scope, value tf.Output, ksize []int64, strides []int64, padd
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"ksize": ksize, "strides": strides, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["ksize"] = ksize
+	attrs["strides"] = strides
+	attrs["padding"] = padding
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -19104,7 +19748,8 @@ func WholeFileReaderV2(scope *Scope, optional ...WholeFileReaderV2Attr) (reader_
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -19215,7 +19860,10 @@ func Conv2DBackpropFilter(scope *Scope, input tf.Output, filter_sizes tf.Output,
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"strides": strides, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["strides"] = strides
+	attrs["padding"] = padding
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -19276,7 +19924,8 @@ func Min(scope *Scope, input tf.Output, reduction_indices tf.Output, optional ..
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -19365,14 +20014,22 @@ func CropAndResizeGradImageMethod(value string) CropAndResizeGradImageAttr {
 //
 //
 // Returns A 4-D tensor of shape `[batch, image_height, image_width, depth]`.
+//
+// REQUIRES: T is one of tf.Float, tf.Half, tf.Double
 func CropAndResizeGradImage(scope *Scope, grads tf.Output, boxes tf.Output, box_ind tf.Output, image_size tf.Output, T tf.DataType, optional ...CropAndResizeGradImageAttr) (output tf.Output) {
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"T": T}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["T"] = T
 	for _, a := range optional {
 		a(attrs)
 	}
+	if err := validateDType(attrs, "T", tf.Float, tf.Half, tf.Double); err != nil {
+		scope.UpdateErr("CropAndResizeGradImage", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "CropAndResizeGradImage",
 		Input: []tf.Input{
@@ -19389,6 +20046,8 @@ type ShapeAttr func(optionalAttr)
 
 // ShapeOutType sets the optional out_type attribute to value.
 // If not specified, defaults to type:DT_INT32
+//
+// REQUIRES: value is one of tf.Int32, tf.Int64
 func ShapeOutType(value tf.DataType) ShapeAttr {
 	return func(m optionalAttr) {
 		m["out_type"] = value
@@ -19409,10 +20068,15 @@ func Shape(scope *Scope, input tf.Output, optional ...ShapeAttr) (output tf.Outp
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
+	if err := validateDType(attrs, "out_type", tf.Int32, tf.Int64); err != nil {
+		scope.UpdateErr("Shape", err)
+		return
+	}
 	opspec := tf.OpSpec{
 		Type: "Shape",
 		Input: []tf.Input{
@@ -19478,7 +20142,8 @@ func ResizeBilinearGrad(scope *Scope, grads tf.Output, original_image tf.Output,
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -19521,7 +20186,8 @@ func ResizeNearestNeighborGrad(scope *Scope, grads tf.Output, size tf.Output, op
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -19632,7 +20298,8 @@ func DecodeJpeg(scope *Scope, contents tf.Output, optional ...DecodeJpegAttr) (i
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -19678,7 +20345,9 @@ func TensorArrayGatherV3(scope *Scope, handle tf.Output, indices tf.Output, flow
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"dtype": dtype}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["dtype"] = dtype
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -19792,7 +20461,8 @@ func SelfAdjointEigV2(scope *Scope, input tf.Output, optional ...SelfAdjointEigV
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -19870,7 +20540,8 @@ func EncodePng(scope *Scope, image tf.Output, optional ...EncodePngAttr) (conten
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -19944,7 +20615,8 @@ func MatrixSolveLs(scope *Scope, matrix tf.Output, rhs tf.Output, l2_regularizer
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -20062,7 +20734,8 @@ func ExtractGlimpse(scope *Scope, input tf.Output, size tf.Output, offsets tf.Ou
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -20110,7 +20783,11 @@ func MaxPoolGrad(scope *Scope, orig_input tf.Output, orig_output tf.Output, grad
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"ksize": ksize, "strides": strides, "padding": padding}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["ksize"] = ksize
+	attrs["strides"] = strides
+	attrs["padding"] = padding
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -20185,7 +20862,8 @@ func CropAndResize(scope *Scope, image tf.Output, boxes tf.Output, box_ind tf.Ou
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
 	for _, a := range optional {
 		a(attrs)
 	}
@@ -20253,7 +20931,9 @@ func RestoreV2(scope *Scope, prefix tf.Output, tensor_names tf.Output, shape_and
 	if scope.Err() != nil {
 		return
 	}
-	attrs := map[string]interface{}{"dtypes": dtypes}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["dtypes"] = dtypes
 	opspec := tf.OpSpec{
 		Type: "RestoreV2",
 		Input: []tf.Input{
@@ -20273,3 +20953,171 @@ func RestoreV2(scope *Scope, prefix tf.Output, tensor_names tf.Output, shape_and
 	}
 	return tensors
 }
+
+// Computes the gradient function for function f via backpropagation.
+//
+// Arguments:
+//	input: a list of input tensors of size N + M;
+//	Tout: the type list for the input list.
+//	f: The function we want to compute the gradient for.
+//
+// The function 'f' must be a numerical function which takes N inputs and
+// produces M outputs. Its gradient function 'g', which is computed by
+// this SymbolicGradient op is a function taking N + M inputs and
+// produces N outputs.
+//
+// I.e. if we have
+//    (y1, y2, ..., y_M) = f(x1, x2, ..., x_N),
+// then, g is
+//    (dL/dx1, dL/dx2, ..., dL/dx_N) = g(x1, x2, ..., x_N,
+//                                      dL/dy1, dL/dy2, ..., dL/dy_M),
+//
+// where L is a scalar-value function of (x1, x2, ..., xN) (e.g., the
+// loss function). dL/dx_i is the partial derivative of L with respect
+// to x_i.
+//
+// (Needs some math expert to say the comment above better.)
+//
+// Returns a list of output tensors of size N;
+func SymbolicGradient(scope *Scope, input []tf.Output, Tout []tf.DataType, f tf.NameAttrList) (output []tf.Output) {
+	if scope.Err() != nil {
+		return
+	}
+	attrs := newAttrs()
+	defer releaseAttrs(attrs)
+	attrs["Tout"] = Tout
+	attrs["f"] = f
+	opspec := tf.OpSpec{
+		Type: "SymbolicGradient",
+		Input: []tf.Input{
+			tf.OutputList(input),
+		},
+		Attrs: attrs,
+	}
+	op := scope.AddOperation(opspec)
+	if scope.Err() != nil {
+		return
+	}
+	var idx int
+	var err error
+	if output, idx, err = makeOutputList(op, idx, "output"); err != nil {
+		scope.UpdateErr("SymbolicGradient", err)
+		return
+	}
+	return output
+}
+
+// statefulOps is the set of operations with OpDef.is_stateful set.
+var statefulOps = map[string]bool{
+	"AddManySparseToTensorsMap":                  true,
+	"AddSparseToTensorsMap":                      true,
+	"Assert":                                     true,
+	"Barrier":                                    true,
+	"ConditionalAccumulator":                     true,
+	"FIFOQueue":                                  true,
+	"FIFOQueueV2":                                true,
+	"FakeQueue":                                  true,
+	"FixedLengthRecordReader":                    true,
+	"FixedLengthRecordReaderV2":                  true,
+	"HashTable":                                  true,
+	"IdentityReader":                             true,
+	"IdentityReaderV2":                           true,
+	"Multinomial":                                true,
+	"MutableDenseHashTable":                      true,
+	"MutableHashTable":                           true,
+	"MutableHashTableOfTensors":                  true,
+	"NegTrain":                                   true,
+	"PaddingFIFOQueue":                           true,
+	"PaddingFIFOQueueV2":                         true,
+	"ParameterizedTruncatedNormal":               true,
+	"Print":                                      true,
+	"PriorityQueue":                              true,
+	"PriorityQueueV2":                            true,
+	"PyFunc":                                     true,
+	"QueueCloseV2":                               true,
+	"QueueDequeueManyV2":                         true,
+	"QueueDequeueUpToV2":                         true,
+	"QueueDequeueV2":                             true,
+	"QueueEnqueueManyV2":                         true,
+	"QueueEnqueueV2":                             true,
+	"QueueSizeV2":                                true,
+	"RandomCrop":                                 true,
+	"RandomGamma":                                true,
+	"RandomPoisson":                              true,
+	"RandomShuffle":                              true,
+	"RandomShuffleQueue":                         true,
+	"RandomShuffleQueueV2":                       true,
+	"RandomStandardNormal":                       true,
+	"RandomUniform":                              true,
+	"RandomUniformInt":                           true,
+	"ReaderNumRecordsProducedV2":                 true,
+	"ReaderNumWorkUnitsCompletedV2":              true,
+	"ReaderReadUpToV2":                           true,
+	"ReaderReadV2":                               true,
+	"ReaderResetV2":                              true,
+	"ReaderRestoreStateV2":                       true,
+	"ReaderSerializeStateV2":                     true,
+	"RecordInput":                                true,
+	"ResourceApplyAdadelta":                      true,
+	"ResourceApplyAdagrad":                       true,
+	"ResourceApplyAdagradDA":                     true,
+	"ResourceApplyAdam":                          true,
+	"ResourceApplyCenteredRMSProp":               true,
+	"ResourceApplyFtrl":                          true,
+	"ResourceApplyGradientDescent":               true,
+	"ResourceApplyMomentum":                      true,
+	"ResourceApplyProximalAdagrad":               true,
+	"ResourceApplyProximalGradientDescent":       true,
+	"ResourceApplyRMSProp":                       true,
+	"ResourceSparseApplyAdadelta":                true,
+	"ResourceSparseApplyAdagrad":                 true,
+	"ResourceSparseApplyAdagradDA":               true,
+	"ResourceSparseApplyCenteredRMSProp":         true,
+	"ResourceSparseApplyFtrl":                    true,
+	"ResourceSparseApplyMomentum":                true,
+	"ResourceSparseApplyProximalAdagrad":         true,
+	"ResourceSparseApplyProximalGradientDescent": true,
+	"ResourceSparseApplyRMSProp":                 true,
+	"SampleDistortedBoundingBox":                 true,
+	"Skipgram":                                   true,
+	"SparseConditionalAccumulator":               true,
+	"Stack":                                      true,
+	"Stage":                                      true,
+	"TFRecordReader":                             true,
+	"TFRecordReaderV2":                           true,
+	"TakeManySparseFromTensorsMap":               true,
+	"TemporaryVariable":                          true,
+	"TensorArray":                                true,
+	"TensorArrayCloseV3":                         true,
+	"TensorArrayConcatV3":                        true,
+	"TensorArrayGatherV3":                        true,
+	"TensorArrayGrad":                            true,
+	"TensorArrayGradV2":                          true,
+	"TensorArrayGradV3":                          true,
+	"TensorArrayReadV3":                          true,
+	"TensorArrayScatterV3":                       true,
+	"TensorArraySizeV3":                          true,
+	"TensorArraySplitV3":                         true,
+	"TensorArrayV2":                              true,
+	"TensorArrayV3":                              true,
+	"TensorArrayWriteV3":                         true,
+	"TextLineReader":                             true,
+	"TextLineReaderV2":                           true,
+	"TruncatedNormal":                            true,
+	"Unstage":                                    true,
+	"Variable":                                   true,
+	"VariableV2":                                 true,
+	"WholeFileReader":                            true,
+	"WholeFileReaderV2":                          true,
+}
+
+// IsStateful reports whether operations of type opType are stateful: they
+// may produce different outputs for the same inputs or have side effects,
+// such as updating variables or queues. Stateful operations must not be
+// deduplicated or have their results cached, and their relative order
+// may need to be enforced with control dependencies.
+//
+// IsStateful returns false for unknown operation types.
+func IsStateful(opType string) bool {
+	return statefulOps[opType]
+}
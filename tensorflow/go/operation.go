@@ -18,7 +18,12 @@ package tensorflow
 // #include "tensorflow/c/c_api.h"
 import "C"
 
-import "unsafe"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unsafe"
+)
 
 // Operation that has been added to the graph.
 type Operation struct {
@@ -26,21 +31,53 @@ type Operation struct {
 	// A reference to the Graph to prevent it from
 	// being GCed while the Operation is still alive.
 	g *Graph
+	// p is set while the operation is pending in a GraphBatch, in which
+	// case c is nil. See cop.
+	p *pendingOp
+}
+
+// cop returns the TF_Operation of op, first adding the pending operations
+// of its GraphBatch to the graph if needed, or nil if they could not be
+// added. The accessors of op then return zero values.
+func (op *Operation) cop() *C.TF_Operation {
+	op.resolve()
+	return op.c
 }
 
 // Name returns the name of the operation.
 func (op *Operation) Name() string {
+	if op.p != nil {
+		return op.p.name
+	}
 	return C.GoString(C.TF_OperationName(op.c))
 }
 
 // Type returns the name of the operator used by this operation.
 func (op *Operation) Type() string {
-	return C.GoString(C.TF_OperationOpType(op.c))
+	c := op.cop()
+	if c == nil {
+		return ""
+	}
+	return C.GoString(C.TF_OperationOpType(c))
+}
+
+// Device returns the device requested for op, or the empty string if no
+// device was specified.
+func (op *Operation) Device() string {
+	c := op.cop()
+	if c == nil {
+		return ""
+	}
+	return C.GoString(C.TF_OperationDevice(c))
 }
 
 // NumOutputs returns the number of outputs of op.
 func (op *Operation) NumOutputs() int {
-	return int(C.TF_OperationNumOutputs(op.c))
+	c := op.cop()
+	if c == nil {
+		return 0
+	}
+	return int(C.TF_OperationNumOutputs(c))
 }
 
 // OutputListSize returns the size of the list of Outputs that is produced by a
@@ -51,6 +88,9 @@ func (op *Operation) NumOutputs() int {
 // the list of tensors for a specific output of the operation, identified
 // by its name.
 func (op *Operation) OutputListSize(output string) (int, error) {
+	if err := op.resolve(); err != nil {
+		return 0, err
+	}
 	cname := C.CString(output)
 	defer C.free(unsafe.Pointer(cname))
 	status := newStatus()
@@ -63,6 +103,91 @@ func (op *Operation) Output(i int) Output {
 	return Output{op, i}
 }
 
+// NumInputs returns the number of inputs of op, counting each tensor of an
+// input list separately.
+func (op *Operation) NumInputs() int {
+	c := op.cop()
+	if c == nil {
+		return 0
+	}
+	return int(C.TF_OperationNumInputs(c))
+}
+
+// Input returns the Output feeding the i-th input of op, or the zero Output,
+// with a nil Op, if i is not less than NumInputs. Together with
+// Output.DataType, this allows the types of the operations of any graph,
+// including imported graphs, to be inspected.
+func (op *Operation) Input(i int) Output {
+	if i < 0 || i >= op.NumInputs() {
+		return Output{}
+	}
+	out := C.TF_OperationInput(C.TF_Input{oper: op.cop(), index: C.int(i)})
+	return Output{&Operation{c: out.oper, g: op.g}, int(out.index)}
+}
+
+// ControlInputs returns the operations that must be executed before op, as
+// set by OpSpec.ControlInputs.
+func (op *Operation) ControlInputs() []*Operation {
+	c := op.cop()
+	if c == nil {
+		return nil
+	}
+	n := int(C.TF_OperationNumControlInputs(c))
+	if n == 0 {
+		return nil
+	}
+	cops := make([]*C.TF_Operation, n)
+	n = int(C.TF_OperationGetControlInputs(c, &cops[0], C.int(n)))
+	ret := make([]*Operation, n)
+	for i := range ret {
+		ret[i] = &Operation{c: cops[i], g: op.g}
+	}
+	return ret
+}
+
+// Attrs returns the attributes of op, including those set by default and
+// those unknown to the definition of its operation (e.g., those starting
+// with an underscore, such as "_class"), as serialized AttrValue protocol
+// buffers. They can be used as is in the OpSpec of another operation, to
+// copy op without losing attributes whose values this package cannot
+// represent.
+func (op *Operation) Attrs() (map[string]AttrValueProto, error) {
+	if err := op.resolve(); err != nil {
+		return nil, err
+	}
+	buf := C.TF_NewBuffer()
+	defer C.TF_DeleteBuffer(buf)
+	status := newStatus()
+	C.TF_OperationToNodeDef(op.c, buf, status.c)
+	if err := status.Err(); err != nil {
+		return nil, err
+	}
+	def := C.GoBytes(buf.data, C.int(buf.length))
+	attrs := make(map[string]AttrValueProto)
+	err := parseMessage(def, func(field int, v uint64, b []byte) error {
+		if field != 5 { // attr
+			return nil
+		}
+		var name string
+		var value []byte
+		err := parseMessage(b, func(field int, v uint64, b []byte) error {
+			switch field {
+			case 1:
+				name = string(b)
+			case 2:
+				value = b
+			}
+			return nil
+		})
+		attrs[name] = AttrValueProto(value)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid NodeDef of operation %q: %v", op.Name(), err)
+	}
+	return attrs, nil
+}
+
 // Output represents one of the outputs of an operation in the graph. Has a
 // DataType (and eventually a Shape).  May be passed as an input argument to a
 // function for adding operations to a graph, or to a Session's Run() method to
@@ -77,13 +202,20 @@ type Output struct {
 
 // DataType returns the type of elements in the tensor produced by p.
 func (p Output) DataType() DataType {
-	return DataType(C.TF_OperationOutputType(p.c()))
+	port := p.c()
+	if port.oper == nil {
+		return 0
+	}
+	return DataType(C.TF_OperationOutputType(port))
 }
 
 // Shape returns the (possibly incomplete) shape of the tensor produced p.
 func (p Output) Shape() Shape {
 	status := newStatus()
 	port := p.c()
+	if port.oper == nil {
+		return Shape{}
+	}
 	ndims := C.TF_GraphGetTensorNumDims(p.Op.g.c, port, status.c)
 	if err := status.Err(); err != nil {
 		// This should not be possible since an error only occurs if
@@ -111,11 +243,34 @@ func (p Output) Shape() Shape {
 }
 
 func (p Output) c() C.TF_Output {
-	return C.TF_Output{oper: p.Op.c, index: C.int(p.Index)}
+	return C.TF_Output{oper: p.Op.cop(), index: C.int(p.Index)}
 }
 
 func (p Output) canBeAnInput() {}
 
+// ParseTensorName returns the name of the operation and the index of the
+// output named by name, in the form "operation:index", or "operation" for
+// output 0, as in the inputs of NodeDefs and the TensorInfos of
+// SignatureDefs. For control inputs, in the form "^operation", the index is
+// -1.
+func ParseTensorName(name string) (op string, index int, err error) {
+	if strings.HasPrefix(name, "^") {
+		op, index = name[1:], -1
+	} else if i := strings.LastIndex(name, ":"); i >= 0 {
+		n, err := strconv.ParseUint(name[i+1:], 10, 31)
+		if err != nil {
+			return "", 0, fmt.Errorf("invalid tensor name %q", name)
+		}
+		op, index = name[:i], int(n)
+	} else {
+		op = name
+	}
+	if op == "" {
+		return "", 0, fmt.Errorf("invalid tensor name %q", name)
+	}
+	return op, index, nil
+}
+
 // Input is the interface for specifying inputs to an operation being added to
 // a Graph.
 //
@@ -135,3 +290,12 @@ type Input interface {
 type OutputList []Output
 
 func (l OutputList) canBeAnInput() {}
+
+// DataTypes returns the types of the elements of the tensors produced by l.
+func (l OutputList) DataTypes() []DataType {
+	ret := make([]DataType, len(l))
+	for i, o := range l {
+		ret[i] = o.DataType()
+	}
+	return ret
+}
@@ -15,9 +15,12 @@
 package tensorflow
 
 import (
+	"bytes"
 	"fmt"
+	"reflect"
 	"runtime"
 	"runtime/debug"
+	"sort"
 	"testing"
 )
 
@@ -172,3 +175,158 @@ func forceGC() {
 	runtime.GC()
 	debug.FreeOSMemory()
 }
+
+func TestOperationInputs(t *testing.T) {
+	g := NewGraph()
+	x, err := Placeholder(g, "x", Float)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := Const(g, "c", float32(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Add(g, "y", x, c); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if _, err := g.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	imported := NewGraph()
+	if err := imported.Import(buf.Bytes(), "imported"); err != nil {
+		t.Fatal(err)
+	}
+	y := imported.Operation("imported/y")
+	if got := y.NumInputs(); got != 2 {
+		t.Fatalf("Got %d inputs, want 2", got)
+	}
+	inputs := OutputList{y.Input(0), y.Input(1)}
+	for i, want := range []string{"imported/x", "imported/c"} {
+		if in := inputs[i]; in.Op.Name() != want || in.Index != 0 {
+			t.Errorf("Got input %d from %s:%d, want %s:0", i, in.Op.Name(), in.Index, want)
+		}
+	}
+	if got := inputs.DataTypes(); len(got) != 2 || got[0] != Float || got[1] != Float {
+		t.Errorf("Got types %v, want [float32 float32]", got)
+	}
+	if in := y.Input(2); in.Op != nil {
+		t.Errorf("Got input 2 from %s, want none", in.Op.Name())
+	}
+}
+
+func TestOperationControlInputs(t *testing.T) {
+	g := NewGraph()
+	initOp, err := g.AddOperation(OpSpec{Type: "NoOp", Name: "init"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	x, err := g.AddOperation(OpSpec{Type: "NoOp", Name: "x", ControlInputs: []*Operation{initOp}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := g.NewBatch()
+	y, err := b.AddOperation(OpSpec{Type: "NoOp", Name: "y", ControlInputs: []*Operation{initOp, x}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		op   *Operation
+		want []string
+	}{
+		{initOp, nil},
+		{x, []string{"init"}},
+		{y, []string{"init", "x"}},
+	}
+	for _, test := range tests {
+		var got []string
+		for _, c := range test.op.ControlInputs() {
+			got = append(got, c.Name())
+		}
+		sort.Strings(got)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got control inputs %v, want %v", test.op.Name(), got, test.want)
+		}
+	}
+}
+
+func TestOperationAttrs(t *testing.T) {
+	g := NewGraph()
+	x, err := g.AddOperation(OpSpec{
+		Type:  "Placeholder",
+		Name:  "x",
+		Attrs: map[string]interface{}{"dtype": Float, "_class": []string{"loc:@y"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	attrs, err := x.Attrs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"dtype", "shape", "_class"} {
+		if _, ok := attrs[name]; !ok {
+			t.Errorf("Got attributes %v, want %q", attrs, name)
+		}
+	}
+	if got, want := attrs["dtype"], (AttrValueProto{0x30, 0x01}); !bytes.Equal(got, want) {
+		t.Errorf("Got dtype %x, want %x", got, want)
+	}
+	// Copy x to a new graph with its attributes as is.
+	copied := make(map[string]interface{}, len(attrs))
+	for name, value := range attrs {
+		copied[name] = value
+	}
+	y, err := NewGraph().AddOperation(OpSpec{Type: "Placeholder", Name: "x", Attrs: copied})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := y.Attrs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, attrs) {
+		t.Errorf("Got attributes %v, want %v", got, attrs)
+	}
+	if got := y.Output(0).DataType(); got != Float {
+		t.Errorf("Got type %v, want %v", got, Float)
+	}
+	// Only the attributes starting with an underscore may be unknown to
+	// the definition of the operation.
+	copied["_newer"] = AttrValueProto{0x28, 0x01}
+	if _, err := NewGraph().AddOperation(OpSpec{Type: "Placeholder", Name: "x", Attrs: copied}); err != nil {
+		t.Error(err)
+	}
+	copied["newer"] = AttrValueProto{0x28, 0x01}
+	if _, err := NewGraph().AddOperation(OpSpec{Type: "Placeholder", Name: "x", Attrs: copied}); err == nil {
+		t.Error("Unknown attribute accepted")
+	}
+}
+
+func TestParseTensorName(t *testing.T) {
+	tests := []struct {
+		name  string
+		op    string
+		index int
+	}{
+		{"x", "x", 0},
+		{"x:0", "x", 0},
+		{"x:10", "x", 10},
+		{"scope/x:1", "scope/x", 1},
+		{"^init", "init", -1},
+	}
+	for _, test := range tests {
+		op, index, err := ParseTensorName(test.name)
+		if err != nil || op != test.op || index != test.index {
+			t.Errorf("ParseTensorName(%q) = %q, %d, %v, want %q, %d", test.name, op, index, err, test.op, test.index)
+		}
+	}
+	for _, name := range []string{"", "^", ":0", "x:", "x:y", "x:-1", "x:1.5"} {
+		if op, index, err := ParseTensorName(name); err == nil {
+			t.Errorf("ParseTensorName(%q) = %q, %d, want an error", name, op, index)
+		}
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+// #include "tensorflow/c/c_api.h"
+import "C"
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// OpInfo describes an operation registered with the TensorFlow runtime.
+type OpInfo struct {
+	// Name is the type of the operation, as used in OpSpec.Type.
+	Name    string
+	Inputs  []OpArg
+	Outputs []OpArg
+	Attrs   []OpAttr
+	// IsStateful is true for operations that may produce different
+	// results given the same inputs, or have side effects.
+	IsStateful bool
+}
+
+// OpArg describes an input or output of an operation.
+type OpArg struct {
+	Name string
+	// Type is the type of the tensors of the argument, if fixed by the
+	// operation. Otherwise, it is determined by the attribute named by
+	// TypeAttr (or TypeListAttr, for a list of tensors of different
+	// types).
+	Type         DataType
+	TypeAttr     string
+	TypeListAttr string
+	// NumberAttr, if not empty, is the name of the attribute holding
+	// the number of tensors in the argument, which is a list.
+	NumberAttr string
+	IsRef      bool
+}
+
+// OpAttr describes an attribute of an operation.
+type OpAttr struct {
+	Name string
+	// Type is the type of the attribute, such as "int", "type", "shape"
+	// or "list(float)".
+	Type string
+}
+
+// RegisteredOps returns the operations registered with the TensorFlow runtime,
+// sorted by name. They include the operations provided by the runtime and
+// by the plugin libraries loaded with LoadLibrary.
+//
+// This can be used to verify that the runtime supports the operations needed
+// by a model before loading it.
+func RegisteredOps() ([]OpInfo, error) {
+	buf := C.TF_GetAllOpList()
+	defer C.TF_DeleteBuffer(buf)
+	return parseOpList(C.GoBytes(buf.data, C.int(buf.length)))
+}
+
+// UnregisteredOps returns the types in opTypes that are not registered with
+// the TensorFlow runtime, or nil if all of them are.
+func UnregisteredOps(opTypes ...string) ([]string, error) {
+	ops, err := RegisteredOps()
+	if err != nil {
+		return nil, err
+	}
+	var missing []string
+	for _, t := range opTypes {
+		i := sort.Search(len(ops), func(i int) bool { return ops[i].Name >= t })
+		if i == len(ops) || ops[i].Name != t {
+			missing = append(missing, t)
+		}
+	}
+	return missing, nil
+}
+
+// The Go API does not depend on the generated protocol buffers, so the
+// tensorflow.OpList protocol buffer
+// (https://www.tensorflow.org/code/tensorflow/core/framework/op_def.proto)
+// is decoded by hand below.
+
+func parseOpList(b []byte) ([]OpInfo, error) {
+	var ops []OpInfo
+	err := parseMessage(b, func(field int, v uint64, b []byte) error {
+		if field != 1 { // op
+			return nil
+		}
+		op, err := parseOpDef(b)
+		ops = append(ops, op)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid OpList: %v", err)
+	}
+	sort.Sort(opsByName(ops))
+	return ops, nil
+}
+
+type opsByName []OpInfo
+
+func (o opsByName) Len() int           { return len(o) }
+func (o opsByName) Less(i, j int) bool { return o[i].Name < o[j].Name }
+func (o opsByName) Swap(i, j int)      { o[i], o[j] = o[j], o[i] }
+
+func parseOpDef(b []byte) (OpInfo, error) {
+	var op OpInfo
+	err := parseMessage(b, func(field int, v uint64, b []byte) error {
+		switch field {
+		case 1:
+			op.Name = string(b)
+		case 2, 3:
+			arg, err := parseArgDef(b)
+			if field == 2 {
+				op.Inputs = append(op.Inputs, arg)
+			} else {
+				op.Outputs = append(op.Outputs, arg)
+			}
+			return err
+		case 4:
+			var attr OpAttr
+			op.Attrs = append(op.Attrs, attr)
+			return parseMessage(b, func(field int, v uint64, b []byte) error {
+				switch field {
+				case 1:
+					op.Attrs[len(op.Attrs)-1].Name = string(b)
+				case 2:
+					op.Attrs[len(op.Attrs)-1].Type = string(b)
+				}
+				return nil
+			})
+		case 17:
+			op.IsStateful = v != 0
+		}
+		return nil
+	})
+	return op, err
+}
+
+func parseArgDef(b []byte) (OpArg, error) {
+	var arg OpArg
+	err := parseMessage(b, func(field int, v uint64, b []byte) error {
+		switch field {
+		case 1:
+			arg.Name = string(b)
+		case 3:
+			arg.Type = DataType(v)
+		case 4:
+			arg.TypeAttr = string(b)
+		case 5:
+			arg.NumberAttr = string(b)
+		case 6:
+			arg.TypeListAttr = string(b)
+		case 16:
+			arg.IsRef = v != 0
+		}
+		return nil
+	})
+	return arg, err
+}
+
+var errTruncated = errors.New("truncated message")
+
+// parseMessage calls f for each field of the serialized protocol buffer
+// message b, with the value of varint fields in v and the contents of
+// length-delimited fields in b. Fields of other wire types are skipped.
+func parseMessage(b []byte, f func(field int, v uint64, b []byte) error) error {
+	return parseFields(b, func(field, wire int, v uint64, b []byte) error {
+		if wire != 0 {
+			v = 0
+		}
+		return f(field, v, b)
+	})
+}
+
+// parseFields is like parseMessage, but also passes the wire type of each
+// field to f, and the value of 64-bit and 32-bit fields in v.
+func parseFields(b []byte, f func(field, wire int, v uint64, b []byte) error) error {
+	for len(b) > 0 {
+		key, n := binary.Uvarint(b)
+		if n <= 0 {
+			return errTruncated
+		}
+		b = b[n:]
+		var (
+			v    uint64
+			data []byte
+		)
+		switch key & 7 {
+		case 0: // varint
+			if v, n = binary.Uvarint(b); n <= 0 {
+				return errTruncated
+			}
+			b = b[n:]
+		case 1: // 64-bit
+			if len(b) < 8 {
+				return errTruncated
+			}
+			v, b = binary.LittleEndian.Uint64(b), b[8:]
+		case 2: // length-delimited
+			l, n := binary.Uvarint(b)
+			if n <= 0 || uint64(len(b)-n) < l {
+				return errTruncated
+			}
+			data, b = b[n:n+int(l)], b[n+int(l):]
+		case 5: // 32-bit
+			if len(b) < 4 {
+				return errTruncated
+			}
+			v, b = uint64(binary.LittleEndian.Uint32(b)), b[4:]
+		default:
+			return fmt.Errorf("unsupported wire type %d", key&7)
+		}
+		if err := f(int(key>>3), int(key&7), v, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
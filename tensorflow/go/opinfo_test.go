@@ -0,0 +1,72 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRegisteredOps(t *testing.T) {
+	ops, err := RegisteredOps()
+	if err != nil {
+		t.Fatal(err)
+	}
+	byName := make(map[string]OpInfo)
+	for _, op := range ops {
+		byName[op.Name] = op
+	}
+	add, ok := byName["Add"]
+	if !ok {
+		t.Fatal("Add is not registered")
+	}
+	want := OpInfo{
+		Name:    "Add",
+		Inputs:  []OpArg{{Name: "x", TypeAttr: "T"}, {Name: "y", TypeAttr: "T"}},
+		Outputs: []OpArg{{Name: "z", TypeAttr: "T"}},
+		Attrs:   []OpAttr{{Name: "T", Type: "type"}},
+	}
+	if !reflect.DeepEqual(add, want) {
+		t.Errorf("Got %+v, want %+v", add, want)
+	}
+	if !byName["VarHandleOp"].IsStateful {
+		t.Error("VarHandleOp is not stateful")
+	}
+	if shape := byName["Shape"]; len(shape.Outputs) != 1 || shape.Outputs[0].TypeAttr != "out_type" {
+		t.Errorf("Unexpected outputs of Shape: %+v", shape.Outputs)
+	}
+}
+
+func TestUnregisteredOps(t *testing.T) {
+	missing, err := UnregisteredOps("Add", "NoSuchOp", "Placeholder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"NoSuchOp"}; !reflect.DeepEqual(missing, want) {
+		t.Errorf("Got %v, want %v", missing, want)
+	}
+}
+
+func TestParseOpListErrors(t *testing.T) {
+	for _, b := range [][]byte{
+		{0x0a},             // Missing length.
+		{0x0a, 0x05, 0x0a}, // Truncated OpDef.
+		{0x0b},             // Unsupported wire type.
+	} {
+		if _, err := parseOpList(b); err == nil {
+			t.Errorf("parseOpList(%x) succeeded", b)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"path/filepath"
+	"strings"
+	"syscall"
+)
+
+// maxPath is the length from which paths passed to the TensorFlow runtime
+// must be shortened. The runtime appends the names of files to the directories
+// it is given, and fails to open paths longer than MAX_PATH (260) characters.
+const maxPath = 200
+
+// nativePath returns a form of path that the TensorFlow runtime can open.
+//
+// On Windows, directories with long paths are replaced by their short (8.3)
+// equivalent, if the file system provides one. Prefixing the path with \\?\
+// instead would not work, since the runtime uses forward slashes when joining
+// paths.
+func nativePath(path string) string {
+	if len(path) < maxPath {
+		return path
+	}
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return path
+	}
+	// GetShortPathName itself only accepts long paths with the \\?\ prefix.
+	prefix, unprefixed := `\\?\`, ""
+	if strings.HasPrefix(abs, `\\`) {
+		// UNC path: \\server\share\...
+		prefix, unprefixed, abs = `\\?\UNC\`, `\\`, abs[2:]
+	}
+	long, err := syscall.UTF16PtrFromString(prefix + abs)
+	if err != nil {
+		return path
+	}
+	buf := make([]uint16, len(prefix)+len(abs)+1)
+	for {
+		n, err := syscall.GetShortPathName(long, &buf[0], uint32(len(buf)))
+		if err != nil || n == 0 {
+			return path
+		}
+		if int(n) < len(buf) {
+			short := syscall.UTF16ToString(buf[:n])
+			if strings.HasPrefix(short, prefix) {
+				short = unprefixed + short[len(prefix):]
+			}
+			return short
+		}
+		// buf was too small; n is the required size.
+		buf = make([]uint16, n)
+	}
+}
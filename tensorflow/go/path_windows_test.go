@@ -0,0 +1,56 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNativePathLong(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "TestNativePathLong")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+	dir := filepath.Join(tmp, strings.Repeat("a", 100), strings.Repeat("b", 100), strings.Repeat("c", 100))
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	short := nativePath(dir)
+	if short == dir {
+		t.Skip("short file names are not supported by the file system")
+	}
+	if len(short) >= maxPath {
+		t.Errorf("nativePath(%q) = %q, want fewer than %d characters", dir, short, maxPath)
+	}
+	if err := ioutil.WriteFile(short+"/saved_model.pb", nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "saved_model.pb")); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNativePathShort(t *testing.T) {
+	for _, path := range []string{"", `C:\models\half_plus_two`, "relative/path"} {
+		if got := nativePath(path); got != path {
+			t.Errorf("nativePath(%q) = %q", path, got)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+// #include <stddef.h>
+// #include "tensorflow/c/c_api.h"
+// extern void goReleasePinned(void* data, size_t len, void* arg);
+import "C"
+
+import (
+	"errors"
+	"sync"
+	"unsafe"
+)
+
+// minPinnedBytes is the size of the smallest buffers of a PinnedPool.
+const minPinnedBytes = 4096
+
+// PinnedPool allocates the buffers of Tensors in page-locked ("pinned")
+// host memory, from which GPUs copy with DMA: feeding such Tensors to
+// operations placed on a GPU is faster than feeding Tensors in pageable
+// memory, which the driver first copies to a staging buffer.
+//
+// Pinning memory is expensive, so the buffers of released Tensors are kept
+// for reuse by later Tensors of similar size, with sizes rounded up to a
+// power of two. Pinned memory cannot be swapped out: keep the Tensors of
+// the pool for as short a time as possible, such as the duration of a
+// Session.Run call, and release them with Release.
+//
+// Pinning requires building with the cuda tag and linking the CUDA
+// runtime. The methods of PinnedPool are safe for concurrent use.
+type PinnedPool struct {
+	mu      sync.Mutex
+	free    map[uintptr][]unsafe.Pointer // Idle buffers by size.
+	idle    int64
+	maxIdle int64
+	closed  bool
+}
+
+// pinnedBuffers maps the buffers allocated by PinnedPools, in use or idle,
+// to their size and pool.
+var pinnedBuffers = struct {
+	sync.Mutex
+	owner map[unsafe.Pointer]pinnedBuffer
+}{owner: make(map[unsafe.Pointer]pinnedBuffer)}
+
+type pinnedBuffer struct {
+	size uintptr
+	pool *PinnedPool
+}
+
+// NewPinnedPool returns a PinnedPool keeping up to maxIdleBytes of pinned
+// memory in idle buffers. It fails if pinned memory is not supported.
+func NewPinnedPool(maxIdleBytes int64) (*PinnedPool, error) {
+	if !pinnedSupported {
+		return nil, errors.New("pinned host memory requires building with the cuda tag")
+	}
+	return &PinnedPool{free: make(map[uintptr][]unsafe.Pointer), maxIdle: maxIdleBytes}, nil
+}
+
+// NewTensor is like NewTensor, allocating the Tensor in pinned memory.
+func (p *PinnedPool) NewTensor(value interface{}) (*Tensor, error) {
+	return newTensor(value, p.allocate)
+}
+
+func (p *PinnedPool) allocate(dataType DataType, shape []int64, nbytes uintptr) (*C.TF_Tensor, error) {
+	if nbytes == 0 {
+		return allocateTensor(dataType, shape, nbytes)
+	}
+	size := uintptr(minPinnedBytes)
+	for size < nbytes {
+		size *= 2
+	}
+	data, err := p.get(size)
+	if err != nil {
+		return nil, err
+	}
+	var shapePtr *C.int64_t
+	if len(shape) > 0 {
+		shapePtr = (*C.int64_t)(unsafe.Pointer(&shape[0]))
+	}
+	// Buffers are page aligned, so TF_NewTensor uses them without copying.
+	return C.TF_NewTensor(C.TF_DataType(dataType), shapePtr, C.int(len(shape)), data, C.size_t(nbytes), (*[0]byte)(C.goReleasePinned), nil), nil
+}
+
+// get returns an idle buffer of the given size, or a new one.
+func (p *PinnedPool) get(size uintptr) (unsafe.Pointer, error) {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil, errors.New("PinnedPool is closed")
+	}
+	if free := p.free[size]; len(free) > 0 {
+		data := free[len(free)-1]
+		p.free[size] = free[:len(free)-1]
+		p.idle -= int64(size)
+		p.mu.Unlock()
+		return data, nil
+	}
+	p.mu.Unlock()
+	data, err := pinnedAlloc(size)
+	if err != nil {
+		return nil, err
+	}
+	pinnedBuffers.Lock()
+	pinnedBuffers.owner[data] = pinnedBuffer{size, p}
+	pinnedBuffers.Unlock()
+	return data, nil
+}
+
+// put keeps data, a buffer of the given size, for reuse, or frees it if the
+// pool is closed or has enough idle buffers.
+func (p *PinnedPool) put(data unsafe.Pointer, size uintptr) {
+	p.mu.Lock()
+	keep := !p.closed && p.idle+int64(size) <= p.maxIdle
+	if keep {
+		p.free[size] = append(p.free[size], data)
+		p.idle += int64(size)
+	}
+	p.mu.Unlock()
+	if !keep {
+		freePinned(data)
+	}
+}
+
+// Close frees the idle buffers of p. The buffers of the Tensors allocated
+// by p are freed when the Tensors are released. Tensors cannot be
+// allocated once p is closed.
+func (p *PinnedPool) Close() error {
+	p.mu.Lock()
+	free := p.free
+	p.free, p.idle, p.closed = nil, 0, true
+	p.mu.Unlock()
+	for _, buffers := range free {
+		for _, data := range buffers {
+			freePinned(data)
+		}
+	}
+	return nil
+}
+
+func freePinned(data unsafe.Pointer) {
+	pinnedBuffers.Lock()
+	delete(pinnedBuffers.owner, data)
+	pinnedBuffers.Unlock()
+	pinnedFree(data)
+}
+
+// goReleasePinned is the deallocator of the Tensors of PinnedPools, called
+// by the runtime once a Tensor is no longer used by the Go program nor by
+// any run.
+//
+//export goReleasePinned
+func goReleasePinned(data unsafe.Pointer, n C.size_t, arg unsafe.Pointer) {
+	pinnedBuffers.Lock()
+	b := pinnedBuffers.owner[data]
+	pinnedBuffers.Unlock()
+	b.pool.put(data, b.size)
+}
@@ -0,0 +1,43 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build cuda
+// +build cuda
+
+package tensorflow
+
+// #cgo LDFLAGS: -lcudart
+// #include <cuda_runtime_api.h>
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+const pinnedSupported = true
+
+// pinnedAlloc allocates size bytes of page-locked memory, usable by all the
+// GPUs of the process.
+func pinnedAlloc(size uintptr) (unsafe.Pointer, error) {
+	var data unsafe.Pointer
+	if err := C.cudaHostAlloc(&data, C.size_t(size), C.cudaHostAllocPortable); err != C.cudaSuccess {
+		return nil, fmt.Errorf("cannot allocate %d bytes of pinned memory: %s", size, C.GoString(C.cudaGetErrorString(err)))
+	}
+	return data, nil
+}
+
+func pinnedFree(data unsafe.Pointer) {
+	C.cudaFreeHost(data)
+}
@@ -0,0 +1,58 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPinnedPool(t *testing.T) {
+	p, err := NewPinnedPool(1 << 20)
+	if !pinnedSupported {
+		if err == nil {
+			t.Errorf("NewPinnedPool succeeded without pinned memory support")
+		}
+		t.Skip("pinned memory requires the cuda tag")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+	want := [][]float32{{1, 2, 3}, {4, 5, 6}}
+	for i := 0; i < 2; i++ {
+		tensor, err := p.NewTensor(want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := tensor.Value(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Got %v, want %v", got, want)
+		}
+		tensor.Release()
+		// The buffer of the released Tensor is reused.
+		if got := p.idle; got != minPinnedBytes {
+			t.Errorf("Got %d idle bytes, want %d", got, minPinnedBytes)
+		}
+	}
+	empty, err := p.NewTensor([]float32{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	empty.Release()
+	p.Close()
+	if _, err := p.NewTensor(want); err == nil {
+		t.Errorf("NewTensor succeeded on a closed pool")
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// PlacementViolation is an operation whose requested device cannot be
+// satisfied.
+type PlacementViolation struct {
+	// Op is the name of the operation.
+	Op string
+	// Device is the device requested by the operation.
+	Device string
+	// Reason describes the violation.
+	Reason string
+	// Soft is true if the session places the operation on another device
+	// instead of failing when it allows soft placement (see the
+	// allow_soft_placement option of ConfigProto), that is if a device of
+	// the same job, replica and task is available.
+	Soft bool
+}
+
+func (v PlacementViolation) Error() string {
+	return fmt.Sprintf("operation %s placed on %q: %s", v.Op, v.Device, v.Reason)
+}
+
+// PlacementError lists the violations found by Graph.ValidatePlacement.
+type PlacementError []PlacementViolation
+
+func (e PlacementError) Error() string {
+	msgs := make([]string, len(e))
+	for i, v := range e {
+		msgs[i] = v.Error()
+	}
+	return fmt.Sprintf("%d invalid placements: %s", len(e), strings.Join(msgs, "; "))
+}
+
+// ValidatePlacement checks the devices requested by the operations of g
+// against devices, the names of the devices available to the session
+// (e.g., "/job:localhost/replica:0/task:0/device:GPU:0" or "/cpu:0"), and
+// returns a PlacementError listing all the violations, or nil if there are
+// none. Creating a session only reports the first of them.
+//
+// The requested devices must be valid device names matching one of
+// devices, where unspecified parts and an index of "*" match any value,
+// and the operations colocated with the "_class" attribute must request
+// compatible devices. ValidatePlacement does not check that the kernels
+// of the operations are registered for the requested devices.
+func (g *Graph) ValidatePlacement(devices []string) error {
+	var available []deviceName
+	for _, name := range devices {
+		d, ok := parseDeviceName(name)
+		if !ok {
+			return fmt.Errorf("invalid device %q", name)
+		}
+		available = append(available, d)
+	}
+	var buf bytes.Buffer
+	if _, err := g.WriteTo(&buf); err != nil {
+		return err
+	}
+	nodes, err := placementNodes(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	byName := make(map[string]*placementNode, len(nodes))
+	for _, n := range nodes {
+		byName[n.name] = n
+	}
+	var violations PlacementError
+	for _, n := range nodes {
+		if n.device == "" {
+			continue
+		}
+		requested, ok := parseDeviceName(n.device)
+		if !ok {
+			violations = append(violations, PlacementViolation{Op: n.name, Device: n.device, Reason: "invalid device name"})
+			continue
+		}
+		if !requested.matchesAny(available) {
+			soft := requested
+			soft.typ, soft.index = "", ""
+			violations = append(violations, PlacementViolation{
+				Op:     n.name,
+				Device: n.device,
+				Reason: "no such device is available",
+				Soft:   soft.matchesAny(available),
+			})
+			continue
+		}
+		for _, loc := range n.colocate {
+			c, ok := byName[loc]
+			if !ok {
+				continue
+			}
+			// Both devices are requested, so they are compatible if
+			// they match.
+			if d, ok := parseDeviceName(c.device); ok && !requested.matches(d) {
+				violations = append(violations, PlacementViolation{
+					Op:     n.name,
+					Device: n.device,
+					Reason: fmt.Sprintf("colocated with %s, which is placed on %q", c.name, c.device),
+				})
+			}
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	return violations
+}
+
+// placementNode is the placement of a NodeDef.
+type placementNode struct {
+	name, device string
+	colocate     []string // Names of the nodes in the "_class" attribute.
+}
+
+func placementNodes(graphDef []byte) ([]*placementNode, error) {
+	var nodes []*placementNode
+	err := parseMessage(graphDef, func(field int, v uint64, b []byte) error {
+		if field != 1 { // node
+			return nil
+		}
+		n := new(placementNode)
+		nodes = append(nodes, n)
+		return parseMessage(b, func(field int, v uint64, b []byte) error {
+			switch field {
+			case 1:
+				n.name = string(b)
+			case 4:
+				n.device = string(b)
+			case 5:
+				var name string
+				var value []byte
+				err := parseMessage(b, func(field int, v uint64, b []byte) error {
+					switch field {
+					case 1:
+						name = string(b)
+					case 2:
+						value = b
+					}
+					return nil
+				})
+				if err != nil || name != "_class" {
+					return err
+				}
+				return parseMessage(value, func(field int, v uint64, b []byte) error {
+					if field != 1 { // list
+						return nil
+					}
+					return parseMessage(b, func(field int, v uint64, b []byte) error {
+						if s := string(b); field == 2 && strings.HasPrefix(s, "loc:@") {
+							n.colocate = append(n.colocate, strings.TrimPrefix(s, "loc:@"))
+						}
+						return nil
+					})
+				})
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid GraphDef: %v", err)
+	}
+	return nodes, nil
+}
+
+// deviceName is a parsed device name, in which unspecified parts are empty.
+type deviceName struct {
+	job, replica, task string
+	typ, index         string // typ is in upper case.
+}
+
+// parseDeviceName parses the device names accepted by TensorFlow, such as
+// "/job:worker/replica:0/task:1/device:GPU:0" or "/cpu:0".
+func parseDeviceName(name string) (d deviceName, ok bool) {
+	if !validDevice.MatchString(name) {
+		return d, false
+	}
+	for _, part := range strings.Split(name, "/") {
+		fields := strings.Split(strings.TrimPrefix(part, "device:"), ":")
+		switch {
+		case part == "":
+		case strings.HasPrefix(part, "job:"):
+			d.job = fields[1]
+		case strings.HasPrefix(part, "replica:"):
+			d.replica = fields[1]
+		case strings.HasPrefix(part, "task:"):
+			d.task = fields[1]
+		default:
+			d.typ = strings.ToUpper(fields[0])
+			if len(fields) > 1 {
+				d.index = fields[1]
+			}
+		}
+	}
+	return d, true
+}
+
+// matches reports whether the device d, as requested by an operation, can
+// be the available device a.
+func (d deviceName) matches(a deviceName) bool {
+	return matchPart(d.job, a.job) && matchPart(d.replica, a.replica) && matchPart(d.task, a.task) &&
+		matchPart(d.typ, a.typ) && matchPart(d.index, a.index)
+}
+
+func (d deviceName) matchesAny(available []deviceName) bool {
+	for _, a := range available {
+		if d.matches(a) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPart reports whether the parts of two device names match, empty
+// parts and "*" matching any value.
+func matchPart(a, b string) bool {
+	return a == "" || b == "" || a == "*" || b == "*" || a == b
+}
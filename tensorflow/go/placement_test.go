@@ -0,0 +1,61 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidatePlacement(t *testing.T) {
+	g := NewGraph()
+	for _, spec := range []OpSpec{
+		{Type: "NoOp", Name: "any"},
+		{Type: "NoOp", Name: "cpu", Device: "/cpu:0"},
+		{Type: "NoOp", Name: "gpu", Device: "/job:localhost/device:GPU:*"},
+		{Type: "NoOp", Name: "gpu1", Device: "/device:GPU:1"},
+		{Type: "NoOp", Name: "worker", Device: "/job:worker/task:0/device:CPU:0"},
+		{Type: "NoOp", Name: "invalid", Device: "/device:GPU:x"},
+		{Type: "NoOp", Name: "colocated", Device: "/device:CPU:0", Attrs: map[string]interface{}{"_class": []string{"loc:@gpu"}}},
+	} {
+		if _, err := g.AddOperation(spec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	devices := []string{
+		"/job:localhost/replica:0/task:0/device:CPU:0",
+		"/job:localhost/replica:0/task:0/gpu:0",
+	}
+	err := g.ValidatePlacement(devices)
+	want := PlacementError{
+		{Op: "gpu1", Device: "/device:GPU:1", Reason: "no such device is available", Soft: true},
+		{Op: "worker", Device: "/job:worker/task:0/device:CPU:0", Reason: "no such device is available"},
+		{Op: "invalid", Device: "/device:GPU:x", Reason: "invalid device name"},
+		{Op: "colocated", Device: "/device:CPU:0", Reason: `colocated with gpu, which is placed on "/job:localhost/device:GPU:*"`},
+	}
+	if !reflect.DeepEqual(err, want) {
+		t.Errorf("Got %v, want %v", err, want)
+	}
+	if err := g.ValidatePlacement([]string{"/job:localhost/device:bogus:"}); err == nil {
+		t.Error("Invalid available device accepted")
+	}
+	g = NewGraph()
+	if _, err := g.AddOperation(OpSpec{Type: "NoOp", Name: "x", Device: "/gpu:0"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.ValidatePlacement(devices); err != nil {
+		t.Error(err)
+	}
+}
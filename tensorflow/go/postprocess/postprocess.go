@@ -0,0 +1,155 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package postprocess implements on the host the usual post-processing
+// steps of classification and detection models, to apply them to fetched
+// tensors without another session run. The results match those of the
+// corresponding TensorFlow operations.
+//
+// The functions operate on flat slices of values with a shape, as returned
+// by ndarray.Float32s; see also ndarray.ArgMax and ndarray.TopK.
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package postprocess
+
+import (
+	"math"
+	"sort"
+)
+
+// Softmax sets dst to the softmax of logits along their last dimension, as
+// tf.nn.softmax does. dst may be the same slice as logits.
+func Softmax(dst, logits []float32, shape []int64) {
+	if len(shape) == 0 {
+		panic("postprocess: Softmax of a scalar")
+	}
+	n := int(shape[len(shape)-1])
+	if n == 0 {
+		return
+	}
+	for start := 0; start < len(logits); start += n {
+		row, out := logits[start:start+n], dst[start:start+n]
+		largest := row[0]
+		for _, v := range row[1:] {
+			if v > largest {
+				largest = v
+			}
+		}
+		var sum float32
+		for i, v := range row {
+			out[i] = float32(math.Exp(float64(v - largest)))
+			sum += out[i]
+		}
+		for i := range out {
+			out[i] /= sum
+		}
+	}
+}
+
+// NonMaxSuppression greedily selects up to maxOutputSize boxes in
+// decreasing order of score, discarding the boxes that overlap a selected
+// box with an intersection over union (IOU) larger than iouThreshold, as
+// tf.image.non_max_suppression does.
+//
+// boxes holds 4 coordinates per box, [y1, x1, y2, x2], where either pair
+// of opposite corners may be given, and scores one score per box. The
+// indices of the selected boxes are returned in the order of selection.
+// Boxes with equal scores are considered in the order of their indices.
+func NonMaxSuppression(boxes, scores []float32, maxOutputSize int, iouThreshold float32) []int32 {
+	if len(boxes) != 4*len(scores) {
+		panic("postprocess: NonMaxSuppression needs 4 coordinates per score")
+	}
+	order := make([]int32, len(scores))
+	for i := range order {
+		order[i] = int32(i)
+	}
+	sort.Stable(byScore{scores, order})
+	norm := make([]box, len(scores))
+	for i := range norm {
+		norm[i] = newBox(boxes[4*i : 4*i+4])
+	}
+	var selected []int32
+	active := make([]bool, len(order))
+	for i := range active {
+		active[i] = true
+	}
+	for i, candidate := range order {
+		if len(selected) >= maxOutputSize {
+			break
+		}
+		if !active[i] {
+			continue
+		}
+		selected = append(selected, candidate)
+		for j := i + 1; j < len(order); j++ {
+			if active[j] && iou(norm[candidate], norm[order[j]]) > iouThreshold {
+				active[j] = false
+			}
+		}
+	}
+	return selected
+}
+
+// box is a box with ordered corners.
+type box struct {
+	ymin, xmin, ymax, xmax, area float32
+}
+
+func newBox(c []float32) box {
+	b := box{
+		ymin: min(c[0], c[2]),
+		xmin: min(c[1], c[3]),
+		ymax: max(c[0], c[2]),
+		xmax: max(c[1], c[3]),
+	}
+	b.area = (b.ymax - b.ymin) * (b.xmax - b.xmin)
+	return b
+}
+
+func iou(a, b box) float32 {
+	if a.area <= 0 || b.area <= 0 {
+		return 0
+	}
+	h := max(min(a.ymax, b.ymax)-max(a.ymin, b.ymin), 0)
+	w := max(min(a.xmax, b.xmax)-max(a.xmin, b.xmin), 0)
+	intersection := h * w
+	return intersection / (a.area + b.area - intersection)
+}
+
+func min(a, b float32) float32 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b float32) float32 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// byScore sorts indices of boxes by decreasing score.
+type byScore struct {
+	scores  []float32
+	indices []int32
+}
+
+func (s byScore) Len() int      { return len(s.indices) }
+func (s byScore) Swap(i, j int) { s.indices[i], s.indices[j] = s.indices[j], s.indices[i] }
+func (s byScore) Less(i, j int) bool {
+	return s.scores[s.indices[i]] > s.scores[s.indices[j]]
+}
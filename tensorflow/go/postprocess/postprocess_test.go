@@ -0,0 +1,266 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postprocess
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/ndarray"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+// graphRunner runs a graph computing the outputs of a post-processing
+// operation of the value fed to in.
+type graphRunner struct {
+	session *tf.Session
+	in      tf.Output
+	outs    []tf.Output
+}
+
+func newGraphRunner(b testing.TB, build func(s *op.Scope, in tf.Output) []tf.Output) *graphRunner {
+	s := op.NewScope()
+	in := op.Placeholder(s, tf.Float)
+	outs := build(s, in)
+	g, err := s.Finalize()
+	if err != nil {
+		b.Fatal(err)
+	}
+	sess, err := tf.NewSession(g, nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+	return &graphRunner{sess, in, outs}
+}
+
+func (r *graphRunner) run(b testing.TB, value *tf.Tensor) []*tf.Tensor {
+	out, err := r.session.Run(map[tf.Output]*tf.Tensor{r.in: value}, r.outs, nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+	return out
+}
+
+func randomScores(rows, cols int) *tf.Tensor {
+	r := rand.New(rand.NewSource(1))
+	scores := make([][]float32, rows)
+	for i := range scores {
+		scores[i] = make([]float32, cols)
+		for j := range scores[i] {
+			scores[i][j] = float32(r.NormFloat64())
+		}
+	}
+	t, err := tf.NewTensor(scores)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// randomBoxes returns n boxes as a [n, 4] tensor, and scores for them.
+func randomBoxes(n int) (*tf.Tensor, []float32) {
+	r := rand.New(rand.NewSource(1))
+	boxes := make([][]float32, n)
+	scores := make([]float32, n)
+	for i := range boxes {
+		y, x := r.Float32(), r.Float32()
+		boxes[i] = []float32{y, x, y + 0.1 + 0.2*r.Float32(), x + 0.1 + 0.2*r.Float32()}
+		scores[i] = r.Float32()
+	}
+	t, err := tf.NewTensor(boxes)
+	if err != nil {
+		panic(err)
+	}
+	return t, scores
+}
+
+func values(t testing.TB, tensor *tf.Tensor) ([]float32, []int64) {
+	v, shape, err := ndarray.Float32s(tensor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v, shape
+}
+
+func TestSoftmax(t *testing.T) {
+	scores := randomScores(8, 100)
+	r := newGraphRunner(t, func(s *op.Scope, in tf.Output) []tf.Output {
+		return []tf.Output{op.Softmax(s, in)}
+	})
+	want, _ := values(t, r.run(t, scores)[0])
+	logits, shape := values(t, scores)
+	got := make([]float32, len(logits))
+	Softmax(got, logits, shape)
+	for i := range got {
+		if math.Abs(float64(got[i]-want[i])) > 1e-6 {
+			t.Fatalf("Element %d: got %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestArgMaxAndTopK(t *testing.T) {
+	scores := randomScores(8, 100)
+	r := newGraphRunner(t, func(s *op.Scope, in tf.Output) []tf.Output {
+		values, indices := op.TopKV2(s, in, op.Const(s, int32(5)))
+		return []tf.Output{op.ArgMax(s, in, op.Const(s, int32(1))), values, indices}
+	})
+	out := r.run(t, scores)
+	v, shape := values(t, scores)
+	argmax := ndarray.ArgMax(v, shape, 1)
+	if want := out[0].Value().([]int64); !reflect.DeepEqual(argmax, want) {
+		t.Errorf("ArgMax: got %v, want %v", argmax, want)
+	}
+	top, indices := ndarray.TopK(v, shape, 5)
+	if want, _ := values(t, out[1]); !reflect.DeepEqual(top, want) {
+		t.Errorf("TopK values: got %v, want %v", top, want)
+	}
+	var want []int64
+	for _, row := range out[2].Value().([][]int32) {
+		for _, i := range row {
+			want = append(want, int64(i))
+		}
+	}
+	if !reflect.DeepEqual(indices, want) {
+		t.Errorf("TopK indices: got %v, want %v", indices, want)
+	}
+}
+
+func TestNonMaxSuppression(t *testing.T) {
+	boxes, scores := randomBoxes(200)
+	scoresTensor, err := tf.NewTensor(scores)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := op.NewScope()
+	b := op.Placeholder(s, tf.Float)
+	sc := op.Placeholder(s, tf.Float)
+	selected := op.NonMaxSuppression(s, b, sc, op.Const(s, int32(20)), op.NonMaxSuppressionIouThreshold(0.3))
+	g, err := s.Finalize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sess, err := tf.NewSession(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sess.Close()
+	out, err := sess.Run(map[tf.Output]*tf.Tensor{b: boxes, sc: scoresTensor}, []tf.Output{selected}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	flat, _ := values(t, boxes)
+	if got, want := NonMaxSuppression(flat, scores, 20, 0.3), out[0].Value().([]int32); !reflect.DeepEqual(got, want) {
+		t.Errorf("Got %v, want %v", got, want)
+	}
+}
+
+func TestNonMaxSuppressionCorners(t *testing.T) {
+	boxes := []float32{
+		0, 0, 1, 1,
+		1, 1, 0, 0.1, // Corners swapped, IOU 0.9 with the first box.
+		0, 0, 0, 1, // Empty.
+		2, 2, 3, 3,
+	}
+	scores := []float32{0.5, 0.9, 0.8, 0.5}
+	if got, want := NonMaxSuppression(boxes, scores, 10, 0.5), []int32{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Got %v, want %v", got, want)
+	}
+}
+
+func BenchmarkSoftmax(b *testing.B) {
+	scores := randomScores(32, 1000)
+	b.Run("Host", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			logits, shape := values(b, scores)
+			Softmax(logits, logits, shape)
+		}
+	})
+	b.Run("Graph", func(b *testing.B) {
+		r := newGraphRunner(b, func(s *op.Scope, in tf.Output) []tf.Output {
+			return []tf.Output{op.Softmax(s, in)}
+		})
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			values(b, r.run(b, scores)[0])
+		}
+	})
+}
+
+func BenchmarkTopK(b *testing.B) {
+	scores := randomScores(32, 1000)
+	b.Run("Host", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			v, shape := values(b, scores)
+			ndarray.TopK(v, shape, 5)
+		}
+	})
+	b.Run("Graph", func(b *testing.B) {
+		r := newGraphRunner(b, func(s *op.Scope, in tf.Output) []tf.Output {
+			values, indices := op.TopKV2(s, in, op.Const(s, int32(5)))
+			return []tf.Output{values, indices}
+		})
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			out := r.run(b, scores)
+			values(b, out[0])
+			out[1].Value()
+		}
+	})
+}
+
+func BenchmarkArgMax(b *testing.B) {
+	scores := randomScores(32, 1000)
+	b.Run("Host", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			v, shape := values(b, scores)
+			ndarray.ArgMax(v, shape, 1)
+		}
+	})
+	b.Run("Graph", func(b *testing.B) {
+		r := newGraphRunner(b, func(s *op.Scope, in tf.Output) []tf.Output {
+			return []tf.Output{op.ArgMax(s, in, op.Const(s, int32(1)))}
+		})
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			r.run(b, scores)[0].Value()
+		}
+	})
+}
+
+func BenchmarkNonMaxSuppression(b *testing.B) {
+	boxes, scores := randomBoxes(1000)
+	b.Run("Host", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			flat, _ := values(b, boxes)
+			NonMaxSuppression(flat, scores, 100, 0.5)
+		}
+	})
+	b.Run("Graph", func(b *testing.B) {
+		scoresTensor, err := tf.NewTensor(scores)
+		if err != nil {
+			b.Fatal(err)
+		}
+		r := newGraphRunner(b, func(s *op.Scope, in tf.Output) []tf.Output {
+			return []tf.Output{op.NonMaxSuppression(s, in, op.Const(s, scoresTensor), op.Const(s, int32(100)))}
+		})
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			r.run(b, boxes)[0].Value()
+		}
+	})
+}
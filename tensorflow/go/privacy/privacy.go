@@ -0,0 +1,173 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package privacy adds to graphs the operations of differentially private
+// aggregations: clipping the contribution of each record, such as a model
+// update of a federated learning client, then adding noise calibrated to
+// the clipping bound to their sum.
+//
+//	s := op.NewScope()
+//	updates := op.Placeholder(s, tf.Float) // [clients, parameters]
+//	sum := privacy.GaussianSum(s, updates, privacy.Gaussian{
+//		L2Clip:          1,
+//		NoiseMultiplier: privacy.GaussianSigma(1, 1e-5, 1),
+//	})
+//
+// Records are Float Tensors whose first dimension indexes records. The
+// guarantees hold only if every record comes from a different individual
+// and the noise is not seeded with known values.
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package privacy
+
+import (
+	"fmt"
+	"math"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+// Gaussian configures the Gaussian mechanism.
+type Gaussian struct {
+	// L2Clip bounds the L2 norm of each record.
+	L2Clip float32
+	// NoiseMultiplier is the standard deviation of the noise relative to
+	// L2Clip. See GaussianSigma.
+	NoiseMultiplier float32
+	// Seed, if not zero, makes the noise deterministic, for tests.
+	Seed int64
+}
+
+// Laplace configures the Laplace mechanism.
+type Laplace struct {
+	// L1Clip bounds the L1 norm of each record.
+	L1Clip float32
+	// Epsilon is the privacy budget: the noise has scale L1Clip/Epsilon.
+	Epsilon float32
+	// Seed, if not zero, makes the noise deterministic, for tests.
+	Seed int64
+}
+
+// GaussianSigma returns the standard deviation of Gaussian noise making
+// (epsilon, delta)-differentially private a query of the given L2
+// sensitivity, using the classic bound sqrt(2 ln(1.25/delta)) *
+// sensitivity / epsilon, valid for epsilon < 1. With sensitivity 1, it is
+// the NoiseMultiplier of a Gaussian mechanism.
+func GaussianSigma(epsilon, delta, sensitivity float64) float32 {
+	return float32(math.Sqrt(2*math.Log(1.25/delta)) * sensitivity / epsilon)
+}
+
+// ClipL2 scales down each record whose L2 norm exceeds clip to have norm
+// clip, leaving the other records unchanged.
+func ClipL2(scope *op.Scope, records tf.Output, clip float32) tf.Output {
+	return clipNorm(scope.SubScope("clip_l2"), records, clip, true)
+}
+
+// ClipL1 scales down each record whose L1 norm exceeds clip to have norm
+// clip, leaving the other records unchanged.
+func ClipL1(scope *op.Scope, records tf.Output, clip float32) tf.Output {
+	return clipNorm(scope.SubScope("clip_l1"), records, clip, false)
+}
+
+// clipNorm clips records by their L2 norm if l2 is set, or L1 norm.
+func clipNorm(s *op.Scope, records tf.Output, clip float32, l2 bool) tf.Output {
+	if !(clip > 0) {
+		s.UpdateErr("Clip", fmt.Errorf("clipping bound must be positive, got %v", clip))
+		return tf.Output{}
+	}
+	var terms tf.Output
+	if l2 {
+		terms = op.Square(s, records)
+	} else {
+		terms = op.Abs(s, records)
+	}
+	// The norms of the records, reduced over all but their first dimension.
+	axes := op.Range(s, op.Const(s.SubScope("start"), int32(1)), op.Rank(s, records), op.Const(s.SubScope("delta"), int32(1)))
+	norms := op.Sum(s, terms, axes, op.SumKeepDims(true))
+	if l2 {
+		norms = op.Sqrt(s, norms)
+	}
+	bound := op.Const(s.SubScope("clip"), clip)
+	// Scale by clip/max(norm, clip), which is 1 for records within bounds
+	// and avoids dividing by zero norms.
+	scale := op.RealDiv(s, bound, op.Maximum(s, norms, bound))
+	return op.Mul(s, records, scale)
+}
+
+// AddGaussianNoise adds to x Gaussian noise of standard deviation stddev.
+// A non-zero seed makes the noise deterministic.
+func AddGaussianNoise(scope *op.Scope, x tf.Output, stddev float32, seed int64) tf.Output {
+	s := scope.SubScope("gaussian_noise")
+	if !(stddev >= 0) {
+		s.UpdateErr("AddGaussianNoise", fmt.Errorf("standard deviation must not be negative, got %v", stddev))
+		return tf.Output{}
+	}
+	normal := op.RandomStandardNormal(s, op.Shape(s, x), tf.Float, op.RandomStandardNormalSeed(seed), op.RandomStandardNormalSeed2(seed))
+	return op.Add(s, x, op.Mul(s, normal, op.Const(s.SubScope("stddev"), stddev)))
+}
+
+// AddLaplaceNoise adds to x Laplace noise of the given scale, whose
+// standard deviation is sqrt(2) * scale. A non-zero seed makes the noise
+// deterministic.
+func AddLaplaceNoise(scope *op.Scope, x tf.Output, scale float32, seed int64) tf.Output {
+	s := scope.SubScope("laplace_noise")
+	if !(scale >= 0) {
+		s.UpdateErr("AddLaplaceNoise", fmt.Errorf("scale must not be negative, got %v", scale))
+		return tf.Output{}
+	}
+	// The difference of two exponential variables, each -log(1-u) for u
+	// uniform in [0, 1), which is finite.
+	shape := op.Shape(s, x)
+	exponential := func(seed2 int64) tf.Output {
+		u := op.RandomUniform(s, shape, tf.Float, op.RandomUniformSeed(seed), op.RandomUniformSeed2(seed2))
+		return op.Neg(s, op.Log1p(s, op.Neg(s, u)))
+	}
+	var seed2 int64
+	if seed != 0 {
+		seed2 = seed + 1
+	}
+	noise := op.Sub(s, exponential(seed), exponential(seed2))
+	return op.Add(s, x, op.Mul(s, noise, op.Const(s.SubScope("scale"), scale)))
+}
+
+// GaussianSum returns the sum of records, clipped by L2 norm, with Gaussian
+// noise of standard deviation g.L2Clip * g.NoiseMultiplier.
+func GaussianSum(scope *op.Scope, records tf.Output, g Gaussian) tf.Output {
+	s := scope.SubScope("gaussian_sum")
+	clipped := ClipL2(s, records, g.L2Clip)
+	if s.Err() != nil {
+		return tf.Output{}
+	}
+	sum := op.Sum(s, clipped, op.Const(s.SubScope("axis"), int32(0)))
+	return AddGaussianNoise(s, sum, g.L2Clip*g.NoiseMultiplier, g.Seed)
+}
+
+// LaplaceSum returns the sum of records, clipped by L1 norm, with Laplace
+// noise of scale l.L1Clip / l.Epsilon, which is epsilon-differentially
+// private.
+func LaplaceSum(scope *op.Scope, records tf.Output, l Laplace) tf.Output {
+	s := scope.SubScope("laplace_sum")
+	if !(l.Epsilon > 0) {
+		s.UpdateErr("LaplaceSum", fmt.Errorf("epsilon must be positive, got %v", l.Epsilon))
+		return tf.Output{}
+	}
+	clipped := ClipL1(s, records, l.L1Clip)
+	if s.Err() != nil {
+		return tf.Output{}
+	}
+	sum := op.Sum(s, clipped, op.Const(s.SubScope("axis"), int32(0)))
+	return AddLaplaceNoise(s, sum, l.L1Clip/l.Epsilon, l.Seed)
+}
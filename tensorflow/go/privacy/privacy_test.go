@@ -0,0 +1,135 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package privacy
+
+import (
+	"math"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+// eval builds outputs with build and returns their values.
+func eval(t *testing.T, build func(s *op.Scope) []tf.Output) []interface{} {
+	s := op.NewScope()
+	outputs := build(s)
+	graph, err := s.Finalize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sess, err := tf.NewSession(graph, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sess.Close()
+	results, err := sess.Run(nil, outputs, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := make([]interface{}, len(results))
+	for i, r := range results {
+		values[i] = r.Value()
+	}
+	return values
+}
+
+func near(a, b []float32, tolerance float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if math.Abs(float64(a[i]-b[i])) > tolerance {
+			return false
+		}
+	}
+	return true
+}
+
+func TestClip(t *testing.T) {
+	records := [][]float32{{3, 4}, {0.3, 0.4}, {0, 0}, {-2, 1}}
+	values := eval(t, func(s *op.Scope) []tf.Output {
+		r := op.Const(s, records)
+		return []tf.Output{
+			ClipL2(s, r, 1),
+			ClipL1(s, r, 1),
+			GaussianSum(s, r, Gaussian{L2Clip: 1}),
+		}
+	})
+	l2 := values[0].([][]float32)
+	l1 := values[1].([][]float32)
+	wantL2 := [][]float32{{0.6, 0.8}, {0.3, 0.4}, {0, 0}, {-2 / float32(math.Sqrt(5)), 1 / float32(math.Sqrt(5))}}
+	wantL1 := [][]float32{{3. / 7, 4. / 7}, {0.3, 0.4}, {0, 0}, {-2. / 3, 1. / 3}}
+	for i := range records {
+		if !near(l2[i], wantL2[i], 1e-6) {
+			t.Errorf("ClipL2: got %v, want %v", l2[i], wantL2[i])
+		}
+		if !near(l1[i], wantL1[i], 1e-6) {
+			t.Errorf("ClipL1: got %v, want %v", l1[i], wantL1[i])
+		}
+	}
+	// Without noise, GaussianSum is the sum of the clipped records.
+	var want [2]float32
+	for _, r := range wantL2 {
+		want[0] += r[0]
+		want[1] += r[1]
+	}
+	if got := values[2].([]float32); !near(got, want[:], 1e-6) {
+		t.Errorf("GaussianSum: got %v, want %v", got, want)
+	}
+}
+
+func TestNoise(t *testing.T) {
+	const n = 100000
+	values := eval(t, func(s *op.Scope) []tf.Output {
+		zeros := op.Const(s, make([]float32, n))
+		return []tf.Output{
+			AddGaussianNoise(s, zeros, 2, 1),
+			AddLaplaceNoise(s, zeros, 2, 1),
+		}
+	})
+	for i, want := range []float64{2, 2 * math.Sqrt2} {
+		var sum, squares float64
+		for _, v := range values[i].([]float32) {
+			sum += float64(v)
+			squares += float64(v) * float64(v)
+		}
+		mean := sum / n
+		stddev := math.Sqrt(squares/n - mean*mean)
+		if math.Abs(mean) > 0.05 || math.Abs(stddev-want) > 0.05 {
+			t.Errorf("noise %d: got mean %v and standard deviation %v, want 0 and %v", i, mean, stddev, want)
+		}
+	}
+}
+
+func TestInvalid(t *testing.T) {
+	for _, build := range []func(s *op.Scope, r tf.Output){
+		func(s *op.Scope, r tf.Output) { ClipL2(s, r, 0) },
+		func(s *op.Scope, r tf.Output) { AddGaussianNoise(s, r, -1, 0) },
+		func(s *op.Scope, r tf.Output) { LaplaceSum(s, r, Laplace{L1Clip: 1}) },
+	} {
+		s := op.NewScope()
+		build(s, op.Const(s, [][]float32{{1}}))
+		if _, err := s.Finalize(); err == nil {
+			t.Error("Finalize succeeded, want an error")
+		}
+	}
+}
+
+func TestGaussianSigma(t *testing.T) {
+	if got, want := GaussianSigma(0.5, 1e-5, 1), float32(9.6896); math.Abs(float64(got-want)) > 1e-3 {
+		t.Errorf("Got %v, want %v", got, want)
+	}
+}
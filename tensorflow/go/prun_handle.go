@@ -0,0 +1,30 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !libtensorflow_1_1
+// +build !libtensorflow_1_1
+
+package tensorflow
+
+// The TensorFlow C library releases before 1.1 do not contain the
+// TF_DeletePRunHandle symbol. We work around that by implementing the
+// equivalent in session.cpp. Build with the libtensorflow_1_1 tag to use the
+// function provided by the library instead.
+
+// extern void tfDeletePRunHandle(const char*);
+import "C"
+
+func deletePRunHandle(h *C.char) {
+	C.tfDeletePRunHandle(h)
+}
@@ -0,0 +1,255 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package quantize converts frozen TensorFlow graphs to be smaller.
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package quantize
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+)
+
+// Options configures DynamicRange.
+type Options struct {
+	// MinElements is the minimum number of elements of the weights to
+	// quantize. Small weights contribute little to the size of a model
+	// and are more sensitive to quantization errors. Defaults to 1024.
+	MinElements int
+	// Ops lists the types of the operations whose weights (their second
+	// input) are quantized. Defaults to MatMul, Conv2D and
+	// DepthwiseConv2dNative.
+	Ops []string
+}
+
+// Stats describes the changes made by DynamicRange.
+type Stats struct {
+	// Quantized lists the names of the quantized weights.
+	Quantized []string
+	// BytesBefore and BytesAfter are the sizes of the quantized weights
+	// before and after quantization, including their scales.
+	BytesBefore, BytesAfter int64
+}
+
+// DynamicRange applies post-training dynamic range quantization to the
+// weights of a frozen graph, given as a serialized tensorflow.GraphDef
+// protocol buffer
+// (https://www.tensorflow.org/code/tensorflow/core/framework/graph.proto),
+// returning the serialized converted graph.
+//
+// Each float32 constant used as the weights of one of opts.Ops, directly or
+// through Identity operations such as the "w/read" kept by freeze_graph, is
+// converted to int8 values with one scale per output channel (the last
+// dimension), reducing its size by 4x. Every operation of the original
+// graph keeps its name, so the feeds and fetches of the graph are
+// unchanged.
+//
+// Only the size of the graph is reduced, to store and load it: the
+// operations still compute in float32, and the weights are dequantized
+// with Cast and Mul operations, since the Dequantize operation only
+// supports a single range per tensor. The runtime may fold them back into
+// float32 constants when it optimizes the graph, and otherwise runs them
+// on every run, so the converted graph does not run faster than the
+// original one.
+func DynamicRange(graphDef []byte, opts Options) ([]byte, *Stats, error) {
+	if opts.MinElements == 0 {
+		opts.MinElements = 1024
+	}
+	if opts.Ops == nil {
+		opts.Ops = []string{"MatMul", "Conv2D", "DepthwiseConv2dNative"}
+	}
+	var def pb.GraphDef
+	if err := proto.Unmarshal(graphDef, &def); err != nil {
+		return nil, nil, fmt.Errorf("invalid GraphDef: %v", err)
+	}
+	ops := make(map[string]bool)
+	for _, op := range opts.Ops {
+		ops[op] = true
+	}
+	nodes := make(map[string]*pb.NodeDef)
+	for _, n := range def.Node {
+		nodes[n.Name] = n
+	}
+	stats := new(Stats)
+	done := make(map[string]bool)
+	var added []*pb.NodeDef
+	for _, n := range def.Node {
+		if !ops[n.Op] || len(n.Input) < 2 {
+			continue
+		}
+		name := n.Input[1]
+		if strings.HasPrefix(name, "^") {
+			continue
+		}
+		name = strings.TrimSuffix(name, ":0")
+		w, ok := nodes[name]
+		for ok && w.Op == "Identity" && len(w.Input) > 0 && !strings.HasPrefix(w.Input[0], "^") {
+			name = strings.TrimSuffix(w.Input[0], ":0")
+			w, ok = nodes[name]
+		}
+		if !ok || done[name] || w.Op != "Const" {
+			continue
+		}
+		t := w.Attr["value"].GetTensor()
+		if t == nil || t.Dtype != pb.DataType_DT_FLOAT {
+			continue
+		}
+		values, shape, err := floats(t)
+		if err != nil {
+			return nil, nil, fmt.Errorf("weights %q: %v", name, err)
+		}
+		if len(values) < opts.MinElements || len(shape) == 0 {
+			continue
+		}
+		q, scales := quantize(values, int(shape[len(shape)-1]))
+		added = append(added, replace(w, shape, q, scales)...)
+		done[name] = true
+		stats.Quantized = append(stats.Quantized, name)
+		stats.BytesBefore += int64(4 * len(values))
+		stats.BytesAfter += int64(len(q) + 4*len(scales))
+	}
+	def.Node = append(def.Node, added...)
+	out, err := proto.Marshal(&def)
+	if err != nil {
+		return nil, nil, err
+	}
+	return out, stats, nil
+}
+
+// floats returns the values and shape of a DT_FLOAT tensor.
+func floats(t *pb.TensorProto) ([]float32, []int64, error) {
+	var shape []int64
+	n := 1
+	for _, d := range t.TensorShape.GetDim() {
+		shape = append(shape, d.Size)
+		n *= int(d.Size)
+	}
+	values := make([]float32, n)
+	switch {
+	case len(t.TensorContent) > 0:
+		if len(t.TensorContent) != 4*n {
+			return nil, nil, fmt.Errorf("got %d bytes of content for %d values", len(t.TensorContent), n)
+		}
+		for i := range values {
+			values[i] = math.Float32frombits(binary.LittleEndian.Uint32(t.TensorContent[4*i:]))
+		}
+	case len(t.FloatVal) > 0:
+		// The last value is repeated to fill the tensor.
+		for i := range values {
+			if i < len(t.FloatVal) {
+				values[i] = t.FloatVal[i]
+			} else {
+				values[i] = t.FloatVal[len(t.FloatVal)-1]
+			}
+		}
+	}
+	return values, shape, nil
+}
+
+// quantize converts values, whose last dimension is of size channels, to
+// int8 with a scale per channel, such that values[i] ~= q[i] *
+// scales[i%channels].
+func quantize(values []float32, channels int) (q []int8, scales []float32) {
+	scales = make([]float32, channels)
+	for i, v := range values {
+		if a := float32(math.Abs(float64(v))); a > scales[i%channels] {
+			scales[i%channels] = a
+		}
+	}
+	for c := range scales {
+		if scales[c] == 0 {
+			scales[c] = 1
+		} else {
+			scales[c] /= 127
+		}
+	}
+	q = make([]int8, len(values))
+	for i, v := range values {
+		r := math.Round(float64(v / scales[i%channels]))
+		q[i] = int8(math.Max(-127, math.Min(127, r)))
+	}
+	return q, scales
+}
+
+// replace turns the Const node w into the dequantization of q and scales,
+// returning the nodes that must be added to the graph for it.
+func replace(w *pb.NodeDef, shape []int64, q []int8, scales []float32) []*pb.NodeDef {
+	content := make([]byte, len(q))
+	for i, v := range q {
+		content[i] = byte(v)
+	}
+	scaleContent := make([]byte, 4*len(scales))
+	for i, s := range scales {
+		binary.LittleEndian.PutUint32(scaleContent[4*i:], math.Float32bits(s))
+	}
+	quantized := constNode(w.Name+"/quantized", w.Device, pb.DataType_DT_INT8, shape, content)
+	scale := constNode(w.Name+"/scale", w.Device, pb.DataType_DT_FLOAT, []int64{int64(len(scales))}, scaleContent)
+	cast := &pb.NodeDef{
+		Name:   w.Name + "/dequantize",
+		Op:     "Cast",
+		Input:  []string{quantized.Name},
+		Device: w.Device,
+		Attr: map[string]*pb.AttrValue{
+			"SrcT": typeAttr(pb.DataType_DT_INT8),
+			"DstT": typeAttr(pb.DataType_DT_FLOAT),
+		},
+	}
+	// Keep the name (and control inputs) of w, so that its consumers are
+	// unchanged.
+	inputs := []string{cast.Name, scale.Name}
+	for _, in := range w.Input {
+		if strings.HasPrefix(in, "^") {
+			inputs = append(inputs, in)
+		}
+	}
+	*w = pb.NodeDef{
+		Name:   w.Name,
+		Op:     "Mul",
+		Input:  inputs,
+		Device: w.Device,
+		Attr:   map[string]*pb.AttrValue{"T": typeAttr(pb.DataType_DT_FLOAT)},
+	}
+	return []*pb.NodeDef{quantized, scale, cast}
+}
+
+func constNode(name, device string, dtype pb.DataType, shape []int64, content []byte) *pb.NodeDef {
+	dims := make([]*pb.TensorShapeProto_Dim, len(shape))
+	for i, d := range shape {
+		dims[i] = &pb.TensorShapeProto_Dim{Size: d}
+	}
+	return &pb.NodeDef{
+		Name:   name,
+		Op:     "Const",
+		Device: device,
+		Attr: map[string]*pb.AttrValue{
+			"dtype": typeAttr(dtype),
+			"value": {Value: &pb.AttrValue_Tensor{Tensor: &pb.TensorProto{
+				Dtype:         dtype,
+				TensorShape:   &pb.TensorShapeProto{Dim: dims},
+				TensorContent: content,
+			}}},
+		},
+	}
+}
+
+func typeAttr(dt pb.DataType) *pb.AttrValue {
+	return &pb.AttrValue{Value: &pb.AttrValue_Type{Type: dt}}
+}
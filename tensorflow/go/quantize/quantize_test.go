@@ -0,0 +1,152 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quantize
+
+import (
+	"bytes"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+func run(t *testing.T, graphDef []byte, x []float32) []float32 {
+	g := tf.NewGraph()
+	if err := g.Import(graphDef, ""); err != nil {
+		t.Fatal(err)
+	}
+	sess, err := tf.NewSession(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sess.Close()
+	in, err := tf.NewTensor([][]float32{x})
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := sess.Run(
+		map[tf.Output]*tf.Tensor{g.Operation("x/Placeholder").Output(0): in},
+		[]tf.Output{g.Operation("y/MatMul").Output(0)},
+		nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return out[0].Value().([][]float32)[0]
+}
+
+func TestDynamicRange(t *testing.T) {
+	const in, out = 32, 64
+	r := rand.New(rand.NewSource(1))
+	weights := make([][]float32, in)
+	for i := range weights {
+		weights[i] = make([]float32, out)
+		for j := range weights[i] {
+			// Channels of very different magnitudes.
+			weights[i][j] = float32(r.NormFloat64()) * float32(j+1)
+		}
+	}
+	x := make([]float32, in)
+	for i := range x {
+		x[i] = float32(r.NormFloat64())
+	}
+	s := op.NewScope()
+	op.MatMul(s.SubScope("y"),
+		op.Placeholder(s.SubScope("x"), tf.Float, op.PlaceholderShape(tf.MakeShape(-1, in))),
+		op.Const(s.SubScope("w"), weights))
+	graph, err := s.Finalize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if _, err := graph.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	quantized, stats, err := DynamicRange(buf.Bytes(), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Stats{Quantized: []string{"w/Const"}, BytesBefore: 4 * in * out, BytesAfter: in*out + 4*out}
+	if !reflect.DeepEqual(stats, want) {
+		t.Errorf("Got %+v, want %+v", stats, want)
+	}
+	expected, got := run(t, buf.Bytes(), x), run(t, quantized, x)
+	for j := range expected {
+		// Each product is off by at most half a quantization step.
+		tolerance := 0.5 * float64(in) * maxAbs(weights, j) / 127 * maxAbs([][]float32{x}, -1)
+		if d := math.Abs(float64(expected[j] - got[j])); d > tolerance {
+			t.Errorf("Output %d: got %v, want %v (tolerance %v)", j, got[j], expected[j], tolerance)
+		}
+	}
+
+	// Weights that are too small are left unchanged.
+	if _, stats, err := DynamicRange(buf.Bytes(), Options{MinElements: in*out + 1}); err != nil || len(stats.Quantized) != 0 {
+		t.Errorf("Got %+v, %v; want no quantized weights", stats, err)
+	}
+}
+
+func TestDynamicRangeIdentity(t *testing.T) {
+	weights := &pb.TensorProto{
+		Dtype:       pb.DataType_DT_FLOAT,
+		TensorShape: &pb.TensorShapeProto{Dim: []*pb.TensorShapeProto_Dim{{Size: 2}, {Size: 2}}},
+		FloatVal:    []float32{1, 2, 3, 4},
+	}
+	def := &pb.GraphDef{Node: []*pb.NodeDef{
+		{Name: "x", Op: "Placeholder"},
+		{Name: "w", Op: "Const", Attr: map[string]*pb.AttrValue{"value": {Value: &pb.AttrValue_Tensor{Tensor: weights}}}},
+		{Name: "w/read", Op: "Identity", Input: []string{"w"}},
+		{Name: "y", Op: "MatMul", Input: []string{"x", "w/read:0"}},
+	}}
+	b, err := proto.Marshal(def)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, stats, err := DynamicRange(b, Options{MinElements: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"w"}; !reflect.DeepEqual(stats.Quantized, want) {
+		t.Errorf("Got quantized weights %v, want %v", stats.Quantized, want)
+	}
+}
+
+// maxAbs returns the largest absolute value in column j of m, or in all of
+// m if j is negative.
+func maxAbs(m [][]float32, j int) float64 {
+	var max float64
+	for _, row := range m {
+		for k, v := range row {
+			if j < 0 || k == j {
+				max = math.Max(max, math.Abs(float64(v)))
+			}
+		}
+	}
+	return max
+}
+
+func TestQuantize(t *testing.T) {
+	q, scales := quantize([]float32{1, 0, -0.5, 0, 0.25, 0}, 2)
+	if want := []float32{1.0 / 127, 1}; !reflect.DeepEqual(scales, want) {
+		t.Errorf("Got scales %v, want %v", scales, want)
+	}
+	if want := []int8{127, 0, -64, 0, 32, 0}; !reflect.DeepEqual(q, want) {
+		t.Errorf("Got %v, want %v", q, want)
+	}
+}
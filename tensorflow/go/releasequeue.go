@@ -0,0 +1,206 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// ReleaseQueueOptions bounds the resources waiting in a ReleaseQueue.
+type ReleaseQueueOptions struct {
+	// MaxPendingBytes is the maximum total size of the Tensors waiting to
+	// be released. Defaults to 1 GiB. A Tensor larger than the bound is
+	// accepted when nothing else is pending.
+	MaxPendingBytes int64
+	// MaxPending is the maximum number of Tensors and Closers waiting to
+	// be released. Defaults to 1024.
+	MaxPending int
+	// OnError, if not nil, is called on the background goroutine with the
+	// errors returned by Closers.
+	OnError func(error)
+}
+
+// ReleaseQueueStats describes the work of a ReleaseQueue.
+type ReleaseQueueStats struct {
+	// Pending and PendingBytes are the number of resources, and the size
+	// of the Tensors, waiting to be released.
+	Pending      int
+	PendingBytes int64
+	// Released and ReleasedBytes count the resources released so far.
+	Released      int64
+	ReleasedBytes int64
+	// Blocked counts the calls that waited for pending resources to be
+	// released, and BlockedTime the total time they waited.
+	Blocked     int64
+	BlockedTime time.Duration
+	// Errors counts the errors returned by Closers.
+	Errors int64
+}
+
+// ReleaseQueue releases Tensors and closes Sessions and other resources on
+// a background goroutine, so that freeing large amounts of memory, which
+// can take milliseconds, does not add to the latency of the goroutines
+// serving requests. Calls block while the queue is full, bounding the
+// memory waiting to be freed.
+//
+// The methods of ReleaseQueue are safe for concurrent use.
+type ReleaseQueue struct {
+	opts ReleaseQueueOptions
+
+	mu      sync.Mutex
+	cond    sync.Cond // Signaled when the queue changes.
+	pending []releaseItem
+	stats   ReleaseQueueStats
+	stopped bool
+	done    chan struct{}
+}
+
+type releaseItem struct {
+	tensor *Tensor
+	closer io.Closer
+	bytes  int64
+}
+
+// NewReleaseQueue returns a ReleaseQueue and starts its goroutine, which
+// runs until Stop is called.
+func NewReleaseQueue(opts ReleaseQueueOptions) *ReleaseQueue {
+	if opts.MaxPendingBytes <= 0 {
+		opts.MaxPendingBytes = 1 << 30
+	}
+	if opts.MaxPending <= 0 {
+		opts.MaxPending = 1024
+	}
+	q := &ReleaseQueue{opts: opts, done: make(chan struct{})}
+	q.cond.L = &q.mu
+	go q.run()
+	return q
+}
+
+// Release queues t to be released. t must not be used afterwards.
+func (q *ReleaseQueue) Release(t *Tensor) {
+	t.mu.Lock()
+	var bytes int64
+	if t.c != nil {
+		bytes = int64(len(tensorData(t.c)))
+	}
+	t.mu.Unlock()
+	if bytes == 0 {
+		// Released already, or with nothing to free.
+		t.Release()
+		return
+	}
+	q.push(releaseItem{tensor: t, bytes: bytes})
+}
+
+// Close queues c, such as a Session, to be closed. c must not be used
+// afterwards.
+func (q *ReleaseQueue) Close(c io.Closer) {
+	q.push(releaseItem{closer: c})
+}
+
+func (q *ReleaseQueue) push(item releaseItem) {
+	q.mu.Lock()
+	if q.full(item.bytes) && !q.stopped {
+		start := time.Now()
+		for q.full(item.bytes) && !q.stopped {
+			q.cond.Wait()
+		}
+		q.stats.Blocked++
+		q.stats.BlockedTime += time.Since(start)
+	}
+	if q.stopped {
+		q.mu.Unlock()
+		q.release(item)
+		q.mu.Lock()
+		q.stats.Released++
+		q.stats.ReleasedBytes += item.bytes
+		q.mu.Unlock()
+		return
+	}
+	q.pending = append(q.pending, item)
+	q.stats.Pending++
+	q.stats.PendingBytes += item.bytes
+	q.cond.Broadcast()
+	q.mu.Unlock()
+}
+
+// full reports whether an item of the given size must wait.
+func (q *ReleaseQueue) full(bytes int64) bool {
+	if q.stats.Pending == 0 {
+		return false
+	}
+	return q.stats.Pending >= q.opts.MaxPending || q.stats.PendingBytes+bytes > q.opts.MaxPendingBytes
+}
+
+func (q *ReleaseQueue) run() {
+	defer close(q.done)
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for {
+		for len(q.pending) == 0 && !q.stopped {
+			q.cond.Wait()
+		}
+		if len(q.pending) == 0 {
+			return
+		}
+		item := q.pending[0]
+		q.pending[0] = releaseItem{}
+		q.pending = q.pending[1:]
+		q.mu.Unlock()
+		q.release(item)
+		q.mu.Lock()
+		q.stats.Pending--
+		q.stats.PendingBytes -= item.bytes
+		q.stats.Released++
+		q.stats.ReleasedBytes += item.bytes
+		q.cond.Broadcast()
+	}
+}
+
+// release releases item, without q.mu held.
+func (q *ReleaseQueue) release(item releaseItem) {
+	if item.tensor != nil {
+		item.tensor.Release()
+		return
+	}
+	if err := item.closer.Close(); err != nil {
+		q.mu.Lock()
+		q.stats.Errors++
+		q.mu.Unlock()
+		if q.opts.OnError != nil {
+			q.opts.OnError(fmt.Errorf("closing %T: %v", item.closer, err))
+		}
+	}
+}
+
+// Stats returns the state of the queue and what it has done so far.
+func (q *ReleaseQueue) Stats() ReleaseQueueStats {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.stats
+}
+
+// Stop releases the pending resources and stops the goroutine of the
+// queue. Resources queued afterwards are released synchronously.
+func (q *ReleaseQueue) Stop() {
+	q.mu.Lock()
+	q.stopped = true
+	q.cond.Broadcast()
+	q.mu.Unlock()
+	<-q.done
+}
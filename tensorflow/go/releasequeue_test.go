@@ -0,0 +1,98 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+type testCloser struct {
+	closing chan struct{}
+	err     error
+}
+
+func (c *testCloser) Close() error {
+	if c.closing != nil {
+		<-c.closing
+	}
+	return c.err
+}
+
+func TestReleaseQueue(t *testing.T) {
+	q := NewReleaseQueue(ReleaseQueueOptions{})
+	var tensors []*Tensor
+	for i := 0; i < 10; i++ {
+		tensor, err := NewTensor(make([]float32, 256))
+		if err != nil {
+			t.Fatal(err)
+		}
+		tensors = append(tensors, tensor)
+		q.Release(tensor)
+	}
+	q.Stop()
+	for i, tensor := range tensors {
+		if tensor.c != nil {
+			t.Errorf("Tensor %d was not released", i)
+		}
+	}
+	stats := q.Stats()
+	if stats.Pending != 0 || stats.PendingBytes != 0 || stats.Released != 10 || stats.ReleasedBytes != 10*1024 {
+		t.Errorf("Got %+v, want 10 tensors of 1024 bytes released", stats)
+	}
+	// After Stop, resources are released synchronously.
+	tensor, err := NewTensor(int64(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	q.Release(tensor)
+	if tensor.c != nil {
+		t.Error("Tensor released after Stop was not released")
+	}
+}
+
+func TestReleaseQueueBackpressure(t *testing.T) {
+	var reported []error
+	q := NewReleaseQueue(ReleaseQueueOptions{
+		MaxPending: 1,
+		OnError:    func(err error) { reported = append(reported, err) },
+	})
+	slow := &testCloser{closing: make(chan struct{}), err: errors.New("failed")}
+	q.Close(slow)
+	queued := make(chan struct{})
+	go func() {
+		q.Close(&testCloser{})
+		close(queued)
+	}()
+	select {
+	case <-queued:
+		t.Fatal("Close did not wait for the pending closer")
+	case <-time.After(50 * time.Millisecond):
+	}
+	if got := q.Stats().Pending; got != 1 {
+		t.Errorf("Got %d pending, want 1", got)
+	}
+	close(slow.closing)
+	<-queued
+	q.Stop()
+	stats := q.Stats()
+	if stats.Released != 2 || stats.Blocked != 1 || stats.BlockedTime <= 0 || stats.Errors != 1 {
+		t.Errorf("Got %+v, want 2 released, 1 blocked call and 1 error", stats)
+	}
+	if len(reported) != 1 {
+		t.Errorf("Got errors %v, want 1", reported)
+	}
+}
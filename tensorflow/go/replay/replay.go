@@ -0,0 +1,380 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package replay records the feeds of production Session runs to TFRecord
+// files and runs them again offline, so that problems that only occur with
+// production inputs can be reproduced against any build of the model:
+//
+//	rec := replay.NewRecorder(m.Session, f, replay.RecorderOptions{
+//		SampleRate: 0.01,
+//		Scrub:      replay.Omit("user_id:0"),
+//	})
+//	outputs, err := rec.Run(feeds, fetches, nil)
+//
+// and later:
+//
+//	r := replay.NewReader(f)
+//	for {
+//		e, err := r.Read()
+//		...
+//		outputs, err := replay.Replay(candidate.Session, candidate.Graph, e)
+//	}
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package replay
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/codec"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+	savedmodelpb "github.com/tensorflow/tensorflow/tensorflow/go/core/protobuf"
+	"github.com/tensorflow/tensorflow/tensorflow/go/signature"
+	"github.com/tensorflow/tensorflow/tensorflow/go/tfrecord"
+)
+
+// Scrubber removes sensitive data from the feeds of recorded runs. It is
+// called with the name of each feed (e.g., "x:0") and its value, and
+// returns the value to record instead, or nil to omit the feed. If it
+// returns an error the run is not recorded.
+type Scrubber func(name string, t *tf.Tensor) (*tf.Tensor, error)
+
+// Omit returns a Scrubber omitting the named feeds from the records.
+// Runs recorded without a feed can only be replayed if the graph provides
+// a default for it (e.g., a PlaceholderWithDefault).
+func Omit(names ...string) Scrubber {
+	omitted := set(names)
+	return func(name string, t *tf.Tensor) (*tf.Tensor, error) {
+		if omitted[name] {
+			return nil, nil
+		}
+		return t, nil
+	}
+}
+
+// Zero returns a Scrubber replacing the values of the named feeds by zeros
+// (or empty strings) of the same type and shape, so that the recorded runs
+// can still be replayed.
+func Zero(names ...string) Scrubber {
+	zeroed := set(names)
+	return func(name string, t *tf.Tensor) (*tf.Tensor, error) {
+		if !zeroed[name] {
+			return t, nil
+		}
+		p := &pb.TensorProto{Dtype: pb.DataType(t.DataType()), TensorShape: &pb.TensorShapeProto{}}
+		for _, d := range t.Shape() {
+			p.TensorShape.Dim = append(p.TensorShape.Dim, &pb.TensorShapeProto_Dim{Size: d})
+		}
+		return decodeTensorProto(p)
+	}
+}
+
+// Chain returns a Scrubber applying each of scrubbers in turn.
+func Chain(scrubbers ...Scrubber) Scrubber {
+	return func(name string, t *tf.Tensor) (*tf.Tensor, error) {
+		for _, s := range scrubbers {
+			var err error
+			if t, err = s(name, t); t == nil || err != nil {
+				return nil, err
+			}
+		}
+		return t, nil
+	}
+}
+
+func set(names []string) map[string]bool {
+	s := make(map[string]bool, len(names))
+	for _, n := range names {
+		s[n] = true
+	}
+	return s
+}
+
+// RecorderOptions configures a Recorder.
+type RecorderOptions struct {
+	// SampleRate is the fraction of runs that are recorded, between 0
+	// and 1.
+	SampleRate float64
+	// Scrub, if not nil, is applied to the feeds of the sampled runs
+	// before they are recorded.
+	Scrub Scrubber
+	// RecordOutputs indicates that the fetched outputs are recorded along
+	// with the feeds, to compare with the outputs of replays.
+	RecordOutputs bool
+}
+
+// RecorderStats counts the runs made through a Recorder.
+type RecorderStats struct {
+	// Runs is the number of calls to Run.
+	Runs int64
+	// Recorded is the number of runs that were recorded.
+	Recorded int64
+	// Dropped is the number of sampled runs that could not be recorded,
+	// because they could not be encoded or scrubbed, or because writing
+	// a previous record failed.
+	Dropped int64
+}
+
+// Recorder runs a Session, recording a sample of the runs to a TFRecord
+// file. Recording never fails a run: see Err and Stats.
+//
+// The methods of Recorder are safe for concurrent use.
+type Recorder struct {
+	session *tf.Session
+	opts    RecorderOptions
+	now     func() time.Time
+
+	mu    sync.Mutex
+	w     *tfrecord.Writer
+	rand  *rand.Rand
+	stats RecorderStats
+	err   error
+}
+
+// NewRecorder returns a Recorder running session and writing the records
+// of the sampled runs to w.
+func NewRecorder(session *tf.Session, w io.Writer, opts RecorderOptions) *Recorder {
+	return &Recorder{
+		session: session,
+		opts:    opts,
+		now:     time.Now,
+		w:       tfrecord.NewWriter(w),
+		rand:    rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Run is like Session.Run, recording the run if it is sampled. Runs are
+// recorded whether or not they succeed, once they complete.
+func (r *Recorder) Run(feeds map[tf.Output]*tf.Tensor, fetches []tf.Output, targets []*tf.Operation) ([]*tf.Tensor, error) {
+	start := r.now()
+	outputs, err := r.session.Run(feeds, fetches, targets)
+	if r.sample() {
+		r.record(start, feeds, fetches, targets, outputs, err)
+	}
+	return outputs, err
+}
+
+func (r *Recorder) sample() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.stats.Runs++
+	return r.rand.Float64() < r.opts.SampleRate
+}
+
+func (r *Recorder) record(start time.Time, feeds map[tf.Output]*tf.Tensor, fetches []tf.Output, targets []*tf.Operation, outputs []*tf.Tensor, runErr error) {
+	b, err := r.encode(start, feeds, fetches, targets, outputs, runErr)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err != nil || r.err != nil {
+		r.stats.Dropped++
+		return
+	}
+	if r.err = r.w.Write(b); r.err != nil {
+		r.stats.Dropped++
+		return
+	}
+	r.stats.Recorded++
+}
+
+func (r *Recorder) encode(start time.Time, feeds map[tf.Output]*tf.Tensor, fetches []tf.Output, targets []*tf.Operation, outputs []*tf.Tensor, runErr error) ([]byte, error) {
+	rec := &runRecord{TimestampMicros: start.UnixNano() / int64(time.Microsecond)}
+	for o, t := range feeds {
+		name := tensorName(o)
+		if r.opts.Scrub != nil {
+			var err error
+			if t, err = r.opts.Scrub(name, t); err != nil {
+				return nil, err
+			}
+			if t == nil {
+				continue
+			}
+		}
+		p, err := encodeTensorProto(t)
+		if err != nil {
+			return nil, fmt.Errorf("feed %q: %v", name, err)
+		}
+		rec.Feeds = append(rec.Feeds, &savedmodelpb.NamedTensorProto{Name: name, Tensor: p})
+	}
+	for _, o := range fetches {
+		rec.Fetches = append(rec.Fetches, tensorName(o))
+	}
+	for _, op := range targets {
+		rec.Targets = append(rec.Targets, op.Name())
+	}
+	if runErr != nil {
+		rec.Error = runErr.Error()
+	} else if r.opts.RecordOutputs {
+		for i, t := range outputs {
+			p, err := encodeTensorProto(t)
+			if err != nil {
+				return nil, fmt.Errorf("output %q: %v", rec.Fetches[i], err)
+			}
+			rec.Outputs = append(rec.Outputs, p)
+		}
+	}
+	return proto.Marshal(rec)
+}
+
+// Stats returns the number of runs made and recorded so far.
+func (r *Recorder) Stats() RecorderStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.stats
+}
+
+// Err returns the error that stopped the recording, if writing a record
+// failed.
+func (r *Recorder) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
+// Entry is a recorded run.
+type Entry struct {
+	// Time is when the run started.
+	Time time.Time
+	// Feeds are the recorded feeds, keyed by tensor name (e.g., "x:0").
+	Feeds map[string]*tf.Tensor
+	// Fetches and Targets are the names of the fetched tensors and of the
+	// target operations.
+	Fetches []string
+	Targets []string
+	// Outputs are the recorded outputs, if the Recorder recorded them and
+	// the run succeeded.
+	Outputs []*tf.Tensor
+	// Error is the error message of the run, if it failed.
+	Error string
+}
+
+// Reader reads the entries written by a Recorder.
+type Reader struct {
+	r *tfrecord.Reader
+}
+
+// NewReader returns a Reader reading entries from r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: tfrecord.NewReader(r)}
+}
+
+// Read returns the next entry, or io.EOF if there are no more entries.
+func (r *Reader) Read() (*Entry, error) {
+	b, err := r.r.Read()
+	if err != nil {
+		return nil, err
+	}
+	var rec runRecord
+	if err := proto.Unmarshal(b, &rec); err != nil {
+		return nil, fmt.Errorf("invalid record: %v", err)
+	}
+	e := &Entry{
+		Time:    time.Unix(0, rec.TimestampMicros*int64(time.Microsecond)),
+		Feeds:   make(map[string]*tf.Tensor, len(rec.Feeds)),
+		Fetches: rec.Fetches,
+		Targets: rec.Targets,
+		Error:   rec.Error,
+	}
+	for _, f := range rec.Feeds {
+		if e.Feeds[f.Name], err = decodeTensorProto(f.Tensor); err != nil {
+			return nil, fmt.Errorf("feed %q: %v", f.Name, err)
+		}
+	}
+	for i, p := range rec.Outputs {
+		t, err := decodeTensorProto(p)
+		if err != nil {
+			return nil, fmt.Errorf("output %d: %v", i, err)
+		}
+		e.Outputs = append(e.Outputs, t)
+	}
+	return e, nil
+}
+
+// Replay runs the feeds, fetches and targets of e in session, looking up
+// the tensors and operations by name in graph. The graph need not be the
+// one that was recorded, only have the same names.
+func Replay(session *tf.Session, graph *tf.Graph, e *Entry) ([]*tf.Tensor, error) {
+	feeds := make(map[tf.Output]*tf.Tensor, len(e.Feeds))
+	for name, t := range e.Feeds {
+		o, err := signature.TensorInfo{Name: name}.Output(graph)
+		if err != nil {
+			return nil, err
+		}
+		feeds[o] = t
+	}
+	fetches := make([]tf.Output, len(e.Fetches))
+	for i, name := range e.Fetches {
+		var err error
+		if fetches[i], err = (signature.TensorInfo{Name: name}).Output(graph); err != nil {
+			return nil, err
+		}
+	}
+	var targets []*tf.Operation
+	for _, name := range e.Targets {
+		op := graph.Operation(name)
+		if op == nil {
+			return nil, fmt.Errorf("operation %q not found in the graph", name)
+		}
+		targets = append(targets, op)
+	}
+	return session.Run(feeds, fetches, targets)
+}
+
+func tensorName(o tf.Output) string {
+	return fmt.Sprintf("%s:%d", o.Op.Name(), o.Index)
+}
+
+func encodeTensorProto(t *tf.Tensor) (*pb.TensorProto, error) {
+	b, err := codec.Proto.Encode(t)
+	if err != nil {
+		return nil, err
+	}
+	p := new(pb.TensorProto)
+	if err := proto.Unmarshal(b, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func decodeTensorProto(p *pb.TensorProto) (*tf.Tensor, error) {
+	b, err := proto.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return codec.Proto.Decode(b)
+}
+
+// runRecord is the record of a run. Tensors are stored as
+// tensorflow.TensorProto protocol buffers.
+type runRecord struct {
+	TimestampMicros      int64                            `protobuf:"varint,1,opt,name=timestamp_micros,json=timestampMicros,proto3"`
+	Feeds                []*savedmodelpb.NamedTensorProto `protobuf:"bytes,2,rep,name=feeds,proto3"`
+	Fetches              []string                         `protobuf:"bytes,3,rep,name=fetches,proto3"`
+	Targets              []string                         `protobuf:"bytes,4,rep,name=targets,proto3"`
+	Outputs              []*pb.TensorProto                `protobuf:"bytes,5,rep,name=outputs,proto3"`
+	Error                string                           `protobuf:"bytes,6,opt,name=error,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *runRecord) Reset()         { *m = runRecord{} }
+func (m *runRecord) String() string { return proto.CompactTextString(m) }
+func (*runRecord) ProtoMessage()    {}
@@ -0,0 +1,165 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replay
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+const halfPlusTwo = "../../cc/saved_model/testdata/half_plus_two/00000123"
+
+func loadHalfPlusTwo(t *testing.T) *tf.SavedModel {
+	m, err := tf.LoadSavedModel(halfPlusTwo, []string{"serve"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func tensor(t *testing.T, v interface{}) *tf.Tensor {
+	tensor, err := tf.NewTensor(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tensor
+}
+
+func TestRecordReplay(t *testing.T) {
+	m := loadHalfPlusTwo(t)
+	defer m.Session.Close()
+	x, y := m.Graph.Operation("x").Output(0), m.Graph.Operation("y").Output(0)
+
+	var buf bytes.Buffer
+	rec := NewRecorder(m.Session, &buf, RecorderOptions{SampleRate: 1, RecordOutputs: true})
+	inputs := [][][]float32{{{1}}, {{2}, {3}}, {{-4}}}
+	for _, in := range inputs {
+		if _, err := rec.Run(map[tf.Output]*tf.Tensor{x: tensor(t, in)}, []tf.Output{y}, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := rec.Run(map[tf.Output]*tf.Tensor{x: tensor(t, []int32{1})}, []tf.Output{y}, nil); err == nil {
+		t.Fatal("Run succeeded with an int32 feed")
+	}
+	if got, want := rec.Stats(), (RecorderStats{Runs: 4, Recorded: 4}); got != want {
+		t.Errorf("Got stats %+v, want %+v", got, want)
+	}
+	if err := rec.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Replay against a separately loaded copy of the model.
+	replica := loadHalfPlusTwo(t)
+	defer replica.Session.Close()
+	r := NewReader(&buf)
+	for i, in := range inputs {
+		e, err := r.Read()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := e.Feeds["x:0"]; got == nil || !reflect.DeepEqual(got.Value(), in) {
+			t.Errorf("Entry %d: got feeds %v, want x:0 = %v", i, e.Feeds, in)
+		}
+		if !reflect.DeepEqual(e.Fetches, []string{"y:0"}) || e.Error != "" || len(e.Outputs) != 1 {
+			t.Fatalf("Entry %d: got %+v", i, e)
+		}
+		outputs, err := Replay(replica.Session, replica.Graph, e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tf.CompareTensors(e.Outputs[0], outputs[0], tf.Tolerance{}); err != nil {
+			t.Errorf("Entry %d: %v", i, err)
+		}
+	}
+	e, err := r.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Error == "" || e.Outputs != nil {
+		t.Errorf("Got %+v for the failed run, want an error and no outputs", e)
+	}
+	if _, err := Replay(replica.Session, replica.Graph, e); err == nil {
+		t.Error("Replay of the failed run succeeded")
+	}
+	if _, err := r.Read(); err != io.EOF {
+		t.Errorf("Got %v after the last entry, want io.EOF", err)
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("disk full") }
+
+func TestRecorderSampling(t *testing.T) {
+	m := loadHalfPlusTwo(t)
+	defer m.Session.Close()
+	feeds := map[tf.Output]*tf.Tensor{m.Graph.Operation("x").Output(0): tensor(t, [][]float32{{1}})}
+	fetches := []tf.Output{m.Graph.Operation("y").Output(0)}
+
+	var buf bytes.Buffer
+	rec := NewRecorder(m.Session, &buf, RecorderOptions{})
+	for i := 0; i < 3; i++ {
+		if _, err := rec.Run(feeds, fetches, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got, want := rec.Stats(), (RecorderStats{Runs: 3}); got != want || buf.Len() != 0 {
+		t.Errorf("Got stats %+v and %d bytes, want %+v and none", got, buf.Len(), want)
+	}
+
+	rec = NewRecorder(m.Session, failingWriter{}, RecorderOptions{SampleRate: 1})
+	for i := 0; i < 2; i++ {
+		if _, err := rec.Run(feeds, fetches, nil); err != nil {
+			t.Fatalf("Run failed with a failing recording: %v", err)
+		}
+	}
+	if got, want := rec.Stats(), (RecorderStats{Runs: 2, Dropped: 2}); got != want {
+		t.Errorf("Got stats %+v, want %+v", got, want)
+	}
+	if err := rec.Err(); err == nil || !strings.Contains(err.Error(), "disk full") {
+		t.Errorf("Got error %v, want the error of the writer", err)
+	}
+}
+
+func TestScrubbers(t *testing.T) {
+	s := Chain(Omit("id:0"), Zero("x:0", "query:0"))
+	if got, err := s("id:0", tensor(t, "1234")); got != nil || err != nil {
+		t.Errorf("Got (%v, %v) for an omitted feed", got, err)
+	}
+	tests := []struct {
+		name        string
+		value, want interface{}
+	}{
+		{"x:0", [][]float32{{1, 2}}, [][]float32{{0, 0}}},
+		{"query:0", []string{"secret", "data"}, []string{"", ""}},
+		{"y:0", []int64{3}, []int64{3}},
+	}
+	for _, test := range tests {
+		got, err := s(test.name, tensor(t, test.value))
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got.Value(), test.want) {
+			t.Errorf("%s: got %v, want %v", test.name, got.Value(), test.want)
+		}
+	}
+}
@@ -0,0 +1,337 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package replica serves a SavedModel from several devices of a host, such
+// as its GPUs, by loading the model once and replicating its graph, with
+// the values of its variables, onto each device:
+//
+//	pool, err := replica.Load(exportDir, replica.Options{Tags: []string{"serve"}})
+//	if err != nil {
+//		...
+//	}
+//	defer pool.Close()
+//	out, err := pool.Run(feeds, []string{"scores:0"}, nil)
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package replica
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+	configpb "github.com/tensorflow/tensorflow/tensorflow/go/core/protobuf"
+)
+
+// Policy selects the replica running each call to Pool.Run.
+type Policy int
+
+const (
+	// LeastLoaded selects the replica with the fewest runs in progress,
+	// taking turns between equally loaded replicas.
+	LeastLoaded Policy = iota
+	// RoundRobin selects the replicas in turn.
+	RoundRobin
+)
+
+// Options configures Load.
+type Options struct {
+	// Tags identify the graph of the SavedModel to load.
+	Tags []string
+	// Devices lists the devices to replicate the graph onto, e.g.
+	// "/device:GPU:1". A device may be listed more than once to run
+	// several replicas on it. Defaults to VisibleGPUs.
+	Devices []string
+	// SessionOptions configures the sessions of the replicas. Soft
+	// placement is always enabled, so that operations without a kernel
+	// for the device of their replica run on the CPU.
+	SessionOptions *tf.SessionOptions
+	// Policy selects the replica running each call to Run.
+	Policy Policy
+}
+
+// Pool runs a model replicated on several devices. It is safe for
+// concurrent use.
+type Pool struct {
+	policy   Policy
+	next     uint64 // Accessed atomically.
+	replicas []*replica
+}
+
+type replica struct {
+	device   string
+	graph    *tf.Graph
+	session  *tf.Session
+	inFlight int64 // Accessed atomically.
+}
+
+// Load loads the SavedModel in exportDir and replicates its graph onto each
+// of opts.Devices. The device of every operation of the graph is replaced by
+// that of its replica, except for operations placed on a CPU (such as
+// input pipelines), which keep their placement.
+//
+// The values of the variables of the replicas are copied from those
+// restored from the SavedModel. Models initializing other state, such as
+// lookup tables, or using resource variables are not supported.
+func Load(exportDir string, opts Options) (*Pool, error) {
+	devices := opts.Devices
+	if devices == nil {
+		devices = VisibleGPUs()
+	}
+	if len(devices) == 0 {
+		return nil, fmt.Errorf("no devices to replicate the model onto")
+	}
+	sessionOptions, err := softPlacement(opts.SessionOptions)
+	if err != nil {
+		return nil, err
+	}
+	m, err := tf.LoadSavedModel(exportDir, opts.Tags, opts.SessionOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer m.Close()
+	var buf bytes.Buffer
+	if _, err := m.Graph.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+	var def pb.GraphDef
+	if err := proto.Unmarshal(buf.Bytes(), &def); err != nil {
+		return nil, fmt.Errorf("invalid GraphDef: %v", err)
+	}
+	var variables []string
+	var outputs []tf.Output
+	for _, n := range def.Node {
+		switch n.Op {
+		case "Variable", "VariableV2":
+			variables = append(variables, n.Name)
+			outputs = append(outputs, m.Graph.Operation(n.Name).Output(0))
+		case "VarHandleOp":
+			return nil, fmt.Errorf("resource variable %q is not supported", n.Name)
+		}
+	}
+	var values []*tf.Tensor
+	if len(outputs) > 0 {
+		if values, err = m.Session.Run(nil, outputs, nil); err != nil {
+			return nil, fmt.Errorf("failed to read the variables of the model: %v", err)
+		}
+	}
+
+	p := &Pool{policy: opts.Policy}
+	for _, device := range devices {
+		r, err := newReplica(&def, device, sessionOptions, variables, values)
+		if err != nil {
+			p.Close()
+			return nil, fmt.Errorf("device %s: %v", device, err)
+		}
+		p.replicas = append(p.replicas, r)
+	}
+	return p, nil
+}
+
+// softPlacement returns a copy of opts with soft placement enabled.
+func softPlacement(opts *tf.SessionOptions) (*tf.SessionOptions, error) {
+	var o tf.SessionOptions
+	if opts != nil {
+		o = *opts
+	}
+	var config configpb.ConfigProto
+	if err := proto.Unmarshal(o.Config, &config); err != nil {
+		return nil, fmt.Errorf("invalid SessionOptions.Config: %v", err)
+	}
+	config.AllowSoftPlacement = true
+	var err error
+	if o.Config, err = proto.Marshal(&config); err != nil {
+		return nil, err
+	}
+	return &o, nil
+}
+
+// newReplica imports def placed on device into a new graph and session,
+// initializing the variables with the given values.
+func newReplica(def *pb.GraphDef, device string, opts *tf.SessionOptions, variables []string, values []*tf.Tensor) (*replica, error) {
+	def = proto.Clone(def).(*pb.GraphDef)
+	for _, n := range def.Node {
+		if !isCPU(n.Device) {
+			n.Device = device
+		}
+	}
+	b, err := proto.Marshal(def)
+	if err != nil {
+		return nil, err
+	}
+	g := tf.NewGraph()
+	if err := g.Import(b, ""); err != nil {
+		return nil, err
+	}
+	feeds := make(map[tf.Output]*tf.Tensor, len(variables))
+	assigns := make([]*tf.Operation, len(variables))
+	for i, name := range variables {
+		value, err := g.AddOperation(tf.OpSpec{
+			Type:  "Placeholder",
+			Name:  fmt.Sprintf("replica/value_%d", i),
+			Attrs: map[string]interface{}{"dtype": values[i].DataType()},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if assigns[i], err = g.AddOperation(tf.OpSpec{
+			Type:  "Assign",
+			Name:  fmt.Sprintf("replica/assign_%d", i),
+			Input: []tf.Input{g.Operation(name).Output(0), value.Output(0)},
+		}); err != nil {
+			return nil, err
+		}
+		feeds[value.Output(0)] = values[i]
+	}
+	s, err := tf.NewSession(g, opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(assigns) > 0 {
+		if _, err := s.Run(feeds, nil, assigns); err != nil {
+			s.Close()
+			return nil, fmt.Errorf("failed to initialize the variables: %v", err)
+		}
+	}
+	return &replica{device: device, graph: g, session: s}, nil
+}
+
+// isCPU reports whether the device specification spec names a CPU.
+func isCPU(spec string) bool {
+	for _, part := range strings.Split(spec, "/") {
+		part = strings.ToUpper(strings.TrimPrefix(part, "device:"))
+		if part == "CPU" || strings.HasPrefix(part, "CPU:") {
+			return true
+		}
+	}
+	return false
+}
+
+// Run runs the model on one of the replicas, selected according to the
+// Policy of the pool. Feeds, fetches and targets are identified by name, as
+// in "operation:index", and are the same for all replicas.
+func (p *Pool) Run(feeds map[string]*tf.Tensor, fetches []string, targets []string) ([]*tf.Tensor, error) {
+	r := p.pick()
+	atomic.AddInt64(&r.inFlight, 1)
+	defer atomic.AddInt64(&r.inFlight, -1)
+	return r.run(feeds, fetches, targets)
+}
+
+// pick returns the replica to run the next call to Run.
+func (p *Pool) pick() *replica {
+	start := int(atomic.AddUint64(&p.next, 1) % uint64(len(p.replicas)))
+	if p.policy == RoundRobin {
+		return p.replicas[start]
+	}
+	best := p.replicas[start]
+	for i := 1; i < len(p.replicas); i++ {
+		r := p.replicas[(start+i)%len(p.replicas)]
+		if atomic.LoadInt64(&r.inFlight) < atomic.LoadInt64(&best.inFlight) {
+			best = r
+		}
+	}
+	return best
+}
+
+func (r *replica) run(feeds map[string]*tf.Tensor, fetches []string, targets []string) ([]*tf.Tensor, error) {
+	f := make(map[tf.Output]*tf.Tensor, len(feeds))
+	for name, t := range feeds {
+		o, err := r.graph.OutputByName(name)
+		if err != nil {
+			return nil, err
+		}
+		f[o] = t
+	}
+	o := make([]tf.Output, len(fetches))
+	for i, name := range fetches {
+		var err error
+		if o[i], err = r.graph.OutputByName(name); err != nil {
+			return nil, err
+		}
+	}
+	ops := make([]*tf.Operation, len(targets))
+	for i, name := range targets {
+		if ops[i] = r.graph.Operation(name); ops[i] == nil {
+			return nil, fmt.Errorf("operation %q not found in the graph", name)
+		}
+	}
+	return r.session.Run(f, o, ops)
+}
+
+// Devices returns the devices of the replicas, in the order of
+// Options.Devices.
+func (p *Pool) Devices() []string {
+	devices := make([]string, len(p.replicas))
+	for i, r := range p.replicas {
+		devices[i] = r.device
+	}
+	return devices
+}
+
+// InFlight returns the number of runs in progress on each replica, in the
+// order of Devices.
+func (p *Pool) InFlight() []int {
+	n := make([]int, len(p.replicas))
+	for i, r := range p.replicas {
+		n[i] = int(atomic.LoadInt64(&r.inFlight))
+	}
+	return n
+}
+
+// Close closes the sessions and graphs of all replicas, returning the first
+// error encountered.
+func (p *Pool) Close() error {
+	var err error
+	for _, r := range p.replicas {
+		if serr := r.session.Close(); err == nil {
+			err = serr
+		}
+		if gerr := r.graph.Close(); err == nil {
+			err = gerr
+		}
+	}
+	return err
+}
+
+// VisibleGPUs returns the names of the GPUs visible to the process, as
+// restricted by the CUDA_VISIBLE_DEVICES environment variable (TensorFlow
+// numbers the visible GPUs from 0). The C API does not enumerate devices,
+// so nil is returned if the variable is not set.
+func VisibleGPUs() []string {
+	visible, ok := os.LookupEnv("CUDA_VISIBLE_DEVICES")
+	if !ok {
+		return nil
+	}
+	var gpus []string
+	for _, id := range strings.Split(visible, ",") {
+		id = strings.TrimSpace(id)
+		// CUDA ignores the devices following an invalid one.
+		if n, err := strconv.Atoi(id); err != nil {
+			if !strings.HasPrefix(id, "GPU-") {
+				break
+			}
+		} else if n < 0 {
+			break
+		}
+		gpus = append(gpus, fmt.Sprintf("/device:GPU:%d", len(gpus)))
+	}
+	return gpus
+}
@@ -0,0 +1,126 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replica
+
+import (
+	"os"
+	"reflect"
+	"sync"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+const halfPlusTwo = "../../cc/saved_model/testdata/half_plus_two/00000123"
+
+func TestPool(t *testing.T) {
+	for _, policy := range []Policy{LeastLoaded, RoundRobin} {
+		pool, err := Load(halfPlusTwo, Options{
+			Tags:    []string{"serve"},
+			Devices: []string{"/device:CPU:0", "/device:CPU:0", "/device:CPU:0"},
+			Policy:  policy,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		x, err := tf.NewTensor([][]float32{{0}, {2}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				out, err := pool.Run(map[string]*tf.Tensor{"x": x}, []string{"y:0"}, nil)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				// The variables of every replica hold the values restored
+				// from the model: y = 0.5x + 2.
+				if got, want := out[0].Value(), [][]float32{{2}, {3}}; !reflect.DeepEqual(got, want) {
+					t.Errorf("Got %v, want %v", got, want)
+				}
+			}()
+		}
+		wg.Wait()
+		if got, want := pool.InFlight(), []int{0, 0, 0}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Got %v runs in flight, want %v", got, want)
+		}
+		if _, err := pool.Run(nil, []string{"missing:0"}, nil); err == nil {
+			t.Error("Run of a missing output succeeded, want an error")
+		}
+		if err := pool.Close(); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestPick(t *testing.T) {
+	p := &Pool{replicas: []*replica{{inFlight: 2}, {inFlight: 1}, {inFlight: 1}}}
+	for i := 0; i < 4; i++ {
+		if r := p.pick(); r.inFlight != 1 {
+			t.Errorf("Picked a replica with %d runs in flight", r.inFlight)
+		}
+	}
+	p.policy = RoundRobin
+	seen := make(map[*replica]bool)
+	for range p.replicas {
+		seen[p.pick()] = true
+	}
+	if len(seen) != len(p.replicas) {
+		t.Errorf("Picked %d distinct replicas, want %d", len(seen), len(p.replicas))
+	}
+}
+
+func TestIsCPU(t *testing.T) {
+	for spec, want := range map[string]bool{
+		"":                           false,
+		"/cpu:0":                     true,
+		"/device:CPU:0":              true,
+		"/job:worker/device:CPU:*":   true,
+		"/device:GPU:1":              false,
+		"/job:cpu/replica:0/task:0":  false,
+		"/job:worker/device:XLA_CPU": false,
+	} {
+		if got := isCPU(spec); got != want {
+			t.Errorf("isCPU(%q): got %v, want %v", spec, got, want)
+		}
+	}
+}
+
+func TestVisibleGPUs(t *testing.T) {
+	old, ok := os.LookupEnv("CUDA_VISIBLE_DEVICES")
+	defer func() {
+		if ok {
+			os.Setenv("CUDA_VISIBLE_DEVICES", old)
+		} else {
+			os.Unsetenv("CUDA_VISIBLE_DEVICES")
+		}
+	}()
+	tests := map[string][]string{
+		"":             nil,
+		"3,1":          {"/device:GPU:0", "/device:GPU:1"},
+		"0,-1,2":       {"/device:GPU:0"},
+		"GPU-8f2a1b3c": {"/device:GPU:0"},
+	}
+	for env, want := range tests {
+		os.Setenv("CUDA_VISIBLE_DEVICES", env)
+		if got := VisibleGPUs(); !reflect.DeepEqual(got, want) {
+			t.Errorf("CUDA_VISIBLE_DEVICES=%q: got %v, want %v", env, got, want)
+		}
+	}
+}
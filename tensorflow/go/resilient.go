@@ -0,0 +1,261 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Health is the state of a ResilientModel.
+type Health int
+
+const (
+	// Healthy models serve runs.
+	Healthy Health = iota
+	// Recovering models are being reloaded after a fatal error, and fail
+	// runs with an Unavailable error until the reload is done.
+	Recovering
+	// Unhealthy models failed to reload, or were closed, and fail runs
+	// with an Unavailable error until a successful Reload.
+	Unhealthy
+)
+
+func (h Health) String() string {
+	switch h {
+	case Healthy:
+		return "Healthy"
+	case Recovering:
+		return "Recovering"
+	case Unhealthy:
+		return "Unhealthy"
+	}
+	return fmt.Sprintf("Health(%d)", int(h))
+}
+
+// ResilientModelOptions configures a ResilientModel.
+type ResilientModelOptions struct {
+	// Tags and SessionOptions are passed to LoadSavedModel.
+	Tags           []string
+	SessionOptions *SessionOptions
+	// Warmup, if not nil, is called with every newly loaded model before
+	// it serves runs, for example to run representative requests so that
+	// the first runs are not slow. A model whose warmup fails is
+	// discarded.
+	Warmup func(*SavedModel) error
+	// IsFatal reports whether an error returned by a run leaves the
+	// session unusable, so that the model must be reloaded. Defaults to
+	// IsFatalError.
+	IsFatal func(error) bool
+}
+
+// IsFatalError reports whether err is a *StatusError with a code that
+// usually reports a corrupted runtime state, such as a failed CUDA
+// context: Internal or DataLoss.
+func IsFatalError(err error) bool {
+	var serr *StatusError
+	return errors.As(err, &serr) && (serr.Code == Internal || serr.Code == DataLoss)
+}
+
+// ResilientModel serves a SavedModel, reloading it from its export
+// directory when a run fails with a fatal error, rather than requiring the
+// process to be restarted.
+//
+// The run that failed returns its error right away, and the model is
+// reloaded in the background: runs are not retried, as they may not be
+// idempotent. While the model is reloaded, and after a reload failed, runs
+// fail with an Unavailable *StatusError; Health reports the state of the
+// model, for example for health checks.
+//
+// The methods of ResilientModel are safe for concurrent use.
+type ResilientModel struct {
+	exportDir string
+	opts      ResilientModelOptions
+
+	reloading sync.Mutex     // Held while a model is loaded.
+	recovery  sync.WaitGroup // Reloads in the background.
+
+	mu      sync.RWMutex
+	model   *SavedModel // nil unless Healthy.
+	health  Health
+	err     error // Why the model is not Healthy.
+	reloads int64
+	closed  bool
+}
+
+// LoadResilientModel loads the SavedModel in exportDir, returning an error
+// if it cannot be loaded or warmed up.
+func LoadResilientModel(exportDir string, opts ResilientModelOptions) (*ResilientModel, error) {
+	if opts.IsFatal == nil {
+		opts.IsFatal = IsFatalError
+	}
+	m := &ResilientModel{exportDir: exportDir, opts: opts}
+	model, err := m.load()
+	if err != nil {
+		return nil, err
+	}
+	m.model = model
+	return m, nil
+}
+
+func (m *ResilientModel) load() (*SavedModel, error) {
+	model, err := LoadSavedModel(m.exportDir, m.opts.Tags, m.opts.SessionOptions)
+	if err != nil {
+		return nil, err
+	}
+	if m.opts.Warmup != nil {
+		if err := m.opts.Warmup(model); err != nil {
+			model.Close()
+			return nil, fmt.Errorf("warmup failed: %v", err)
+		}
+	}
+	return model, nil
+}
+
+// Run runs the model. As the graph changes when the model is reloaded,
+// feeds, fetches and targets are identified by name, like in
+// MultiModelRunner.Run. A fatal error starts the reload of the model, but
+// does not wait for it.
+func (m *ResilientModel) Run(feeds map[string]*Tensor, fetches []string, targets []string) ([]*Tensor, error) {
+	m.mu.RLock()
+	model := m.model
+	if model == nil {
+		err := m.unavailable()
+		m.mu.RUnlock()
+		return nil, err
+	}
+	// The read lock is held during the run so that the model is not
+	// closed by a concurrent reload.
+	out, err := runByName(model, feeds, fetches, targets)
+	m.mu.RUnlock()
+	if err != nil && m.opts.IsFatal(err) {
+		m.recover(model, err)
+	}
+	return out, err
+}
+
+func (m *ResilientModel) unavailable() error {
+	return &StatusError{Code: Unavailable, Message: fmt.Sprintf("model is %v: %v", m.health, m.err)}
+}
+
+// recover starts reloading the model in the background after it failed
+// with the fatal error cause, unless it was already reloaded.
+func (m *ResilientModel) recover(failed *SavedModel, cause error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.model != failed {
+		return
+	}
+	m.model, m.health, m.err = nil, Recovering, fmt.Errorf("reloading after %v", cause)
+	m.recovery.Add(1)
+	go func() {
+		defer m.recovery.Done()
+		// No run uses the failed model any more, as runs hold the read
+		// lock of m.mu while they use it.
+		failed.Close()
+		m.reload(true)
+	}()
+}
+
+// reload loads and warms up the model, and then replaces the current model,
+// if any, with it. If recovering is set, the model is only reloaded if it
+// is still Recovering.
+func (m *ResilientModel) reload(recovering bool) error {
+	m.reloading.Lock()
+	defer m.reloading.Unlock()
+	if recovering {
+		if h, _ := m.Health(); h != Recovering {
+			return nil
+		}
+	}
+	model, err := m.load()
+	m.mu.Lock()
+	m.reloads++
+	if m.closed {
+		m.mu.Unlock()
+		if err == nil {
+			model.Close()
+		}
+		return errors.New("model is closed")
+	}
+	if err != nil {
+		if m.model == nil {
+			m.health, m.err = Unhealthy, err
+		}
+		m.mu.Unlock()
+		return err
+	}
+	old := m.model
+	m.model, m.health, m.err = model, Healthy, nil
+	m.mu.Unlock()
+	if old != nil {
+		// Runs using old held the read lock, so they are done.
+		old.Close()
+	}
+	return nil
+}
+
+// Reload reloads the model from its export directory, replacing the
+// current model, for example to recover an Unhealthy model once the
+// condition that prevented its reload is fixed, or to serve a new version
+// of the model. The current model, if any, serves runs until the new one
+// is loaded and warmed up, and keeps serving them if the reload fails.
+func (m *ResilientModel) Reload() error {
+	m.mu.RLock()
+	closed, health := m.closed, m.health
+	m.mu.RUnlock()
+	if closed {
+		return errors.New("model is closed")
+	}
+	if health == Recovering {
+		return errors.New("model is already being reloaded")
+	}
+	return m.reload(false)
+}
+
+// Health returns the state of the model and, unless it is Healthy, why.
+func (m *ResilientModel) Health() (Health, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.health, m.err
+}
+
+// Reloads returns the number of times the model was reloaded, successfully
+// or not.
+func (m *ResilientModel) Reloads() int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.reloads
+}
+
+// Close closes the model, which then fails all runs. It waits for a reload
+// in the background to be done.
+func (m *ResilientModel) Close() error {
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return nil
+	}
+	m.closed = true
+	model := m.model
+	m.model, m.health, m.err = nil, Unhealthy, errors.New("closed")
+	m.mu.Unlock()
+	m.recovery.Wait()
+	if model == nil {
+		return nil
+	}
+	return model.Close()
+}
@@ -0,0 +1,104 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestResilientModel(t *testing.T) {
+	var (
+		warmups    int
+		failWarmup bool
+	)
+	m, err := LoadResilientModel("../cc/saved_model/testdata/half_plus_two/00000123", ResilientModelOptions{
+		Tags: []string{"serve"},
+		Warmup: func(*SavedModel) error {
+			warmups++
+			if failWarmup {
+				return errors.New("failed")
+			}
+			return nil
+		},
+		// Simulate fatal errors with invalid feeds.
+		IsFatal: func(err error) bool { return errors.Is(err, &StatusError{Code: InvalidArgument}) },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+	x, err := NewTensor([][]float32{{0}, {2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bad, err := NewTensor([]int32{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	check := func(wantHealth Health, wantReloads int64) {
+		t.Helper()
+		if h, err := m.Health(); h != wantHealth {
+			t.Errorf("Got health %v (%v), want %v", h, err, wantHealth)
+		}
+		if got := m.Reloads(); got != wantReloads {
+			t.Errorf("Got %d reloads, want %d", got, wantReloads)
+		}
+		out, err := m.Run(map[string]*Tensor{"x": x}, []string{"y"}, nil)
+		if wantHealth != Healthy {
+			if !errors.Is(err, &StatusError{Code: Unavailable}) {
+				t.Errorf("Got error %v, want Unavailable", err)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := out[0].Value(), [][]float32{{2}, {3}}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Got %v, want %v", got, want)
+		}
+	}
+	check(Healthy, 0)
+
+	if _, err := m.Run(map[string]*Tensor{"x": bad}, []string{"y"}, nil); err == nil {
+		t.Fatal("Run succeeded with an invalid feed")
+	}
+	m.recovery.Wait()
+	check(Healthy, 1)
+	if warmups != 2 {
+		t.Errorf("Got %d warmups, want 2", warmups)
+	}
+
+	// A failed Reload keeps the current model.
+	failWarmup = true
+	if err := m.Reload(); err == nil {
+		t.Error("Reload succeeded with a failing warmup")
+	}
+	check(Healthy, 2)
+
+	m.Run(map[string]*Tensor{"x": bad}, []string{"y"}, nil)
+	m.recovery.Wait()
+	check(Unhealthy, 3)
+
+	failWarmup = false
+	if err := m.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	check(Healthy, 4)
+
+	m.Close()
+	check(Unhealthy, 4)
+}
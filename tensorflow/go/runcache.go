@@ -0,0 +1,224 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// RunCacheOptions bounds the size of a RunCache and the age of its entries.
+// A zero value for any of the fields means no bound.
+type RunCacheOptions struct {
+	// MaxEntries is the maximum number of cached results.
+	MaxEntries int
+	// MaxBytes is the maximum total size of the cached tensors.
+	MaxBytes int64
+	// TTL is the duration for which a result is reused.
+	TTL time.Duration
+}
+
+// RunCacheStats counts the lookups made in a RunCache.
+type RunCacheStats struct {
+	Hits, Misses int64
+}
+
+// RunCache memoizes the results of Session.Run, keyed by fingerprints of
+// the contents of the feeds and by the set of fetches. It is intended for
+// workloads where the same inputs are frequently run again, such as scoring
+// the same context against many candidates, and is only correct for graphs
+// whose fetched outputs depend only on the feeds (e.g., graphs without
+// random or stateful operations).
+//
+// When the cache is full, the least recently used results are evicted.
+//
+// The methods of RunCache are safe for concurrent use.
+type RunCache struct {
+	session *Session
+	opts    RunCacheOptions
+	now     func() time.Time
+
+	mu      sync.Mutex
+	entries map[[sha256.Size]byte]*list.Element
+	lru     list.List // Of *runCacheEntry, most recently used first.
+	bytes   int64
+	stats   RunCacheStats
+}
+
+type runCacheEntry struct {
+	key     [sha256.Size]byte
+	results []*Tensor
+	bytes   int64
+	expires time.Time
+}
+
+// NewRunCache returns a RunCache for the results of session.
+func NewRunCache(session *Session, opts RunCacheOptions) *RunCache {
+	return &RunCache{
+		session: session,
+		opts:    opts,
+		now:     time.Now,
+		entries: make(map[[sha256.Size]byte]*list.Element),
+	}
+}
+
+// Run is like Session.Run, without targets, returning cached results when
+// the contents of feeds and the fetches match those of a previous call.
+//
+// The returned Tensors are copies owned by the caller.
+func (c *RunCache) Run(feeds map[Output]*Tensor, fetches []Output) ([]*Tensor, error) {
+	key, err := runCacheKey(feeds, fetches)
+	if err != nil {
+		return nil, err
+	}
+	if results, ok, err := c.lookup(key); ok {
+		return results, err
+	}
+	results, err := c.session.Run(feeds, fetches, nil)
+	if err != nil {
+		return nil, err
+	}
+	cached, err := copyTensors(results)
+	if err != nil {
+		return nil, err
+	}
+	c.add(key, cached)
+	return results, nil
+}
+
+// Stats returns the number of hits and misses of the cache so far.
+func (c *RunCache) Stats() RunCacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// Clear removes all the cached results.
+func (c *RunCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for c.lru.Len() > 0 {
+		c.evictLocked(c.lru.Back())
+	}
+}
+
+// lookup returns copies of the results cached for key, if any.
+func (c *RunCache) lookup(key [sha256.Size]byte) ([]*Tensor, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if ok && c.opts.TTL > 0 && !c.now().Before(e.Value.(*runCacheEntry).expires) {
+		c.evictLocked(e)
+		ok = false
+	}
+	if !ok {
+		c.stats.Misses++
+		return nil, false, nil
+	}
+	c.stats.Hits++
+	c.lru.MoveToFront(e)
+	results, err := copyTensors(e.Value.(*runCacheEntry).results)
+	return results, true, err
+}
+
+func (c *RunCache) add(key [sha256.Size]byte, results []*Tensor) {
+	entry := &runCacheEntry{key: key, results: results, expires: c.now().Add(c.opts.TTL)}
+	for _, t := range results {
+		entry.bytes += int64(len(tensorData(t.c)))
+	}
+	if c.opts.MaxBytes > 0 && entry.bytes > c.opts.MaxBytes {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[key]; ok {
+		// Added concurrently by another call.
+		c.evictLocked(e)
+	}
+	c.entries[key] = c.lru.PushFront(entry)
+	c.bytes += entry.bytes
+	for (c.opts.MaxEntries > 0 && c.lru.Len() > c.opts.MaxEntries) || (c.opts.MaxBytes > 0 && c.bytes > c.opts.MaxBytes) {
+		c.evictLocked(c.lru.Back())
+	}
+}
+
+func (c *RunCache) evictLocked(e *list.Element) {
+	entry := c.lru.Remove(e).(*runCacheEntry)
+	delete(c.entries, entry.key)
+	c.bytes -= entry.bytes
+	// lookup copies results before releasing the lock, so they can be
+	// freed immediately.
+	for _, t := range entry.results {
+		t.Release()
+	}
+}
+
+// runCacheKey fingerprints the type, shape and contents of feeds (in a
+// canonical order) and the fetches.
+func runCacheKey(feeds map[Output]*Tensor, fetches []Output) ([sha256.Size]byte, error) {
+	names := make([]string, 0, len(feeds))
+	byName := make(map[string]*Tensor, len(feeds))
+	for o, t := range feeds {
+		name := fmt.Sprintf("%s:%d", o.Op.Name(), o.Index)
+		if t == nil || t.c == nil {
+			return [sha256.Size]byte{}, fmt.Errorf("feed %s is nil or has been released", name)
+		}
+		if t.DataType() == Resource {
+			// The contents of handles do not identify the state of the
+			// resources.
+			return [sha256.Size]byte{}, fmt.Errorf("feed %s is a resource handle, which cannot be cached", name)
+		}
+		names = append(names, name)
+		byName[name] = t
+	}
+	sort.Strings(names)
+	h := sha256.New()
+	writeString := func(s string) {
+		binary.Write(h, binary.LittleEndian, int64(len(s)))
+		h.Write([]byte(s))
+	}
+	for _, name := range names {
+		t := byName[name]
+		writeString(name)
+		binary.Write(h, binary.LittleEndian, int64(t.DataType()))
+		binary.Write(h, binary.LittleEndian, int64(len(t.Shape())))
+		binary.Write(h, binary.LittleEndian, t.Shape())
+		data := tensorData(t.c)
+		binary.Write(h, binary.LittleEndian, int64(len(data)))
+		h.Write(data)
+	}
+	for _, o := range fetches {
+		writeString(fmt.Sprintf("%s:%d", o.Op.Name(), o.Index))
+	}
+	var key [sha256.Size]byte
+	copy(key[:], h.Sum(nil))
+	return key, nil
+}
+
+func copyTensors(tensors []*Tensor) ([]*Tensor, error) {
+	ret := make([]*Tensor, len(tensors))
+	for i, t := range tensors {
+		var err error
+		if ret[i], err = reshape(t, t.Shape()); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
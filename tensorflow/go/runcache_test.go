@@ -0,0 +1,120 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRunCache(t *testing.T) {
+	g := NewGraph()
+	x, err := Placeholder(g, "x", Int64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	y, err := Neg(g, "y", x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewSession(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	now := time.Unix(0, 0)
+	c := NewRunCache(s, RunCacheOptions{MaxEntries: 2, TTL: time.Minute})
+	c.now = func() time.Time { return now }
+	run := func(v int64) {
+		in, err := NewTensor([]int64{v})
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, err := c.Run(map[Output]*Tensor{x: in}, []Output{y})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := out[0].Value(), []int64{-v}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Run(%d): got %v, want %v", v, got, want)
+		}
+		// The cached results must not be affected.
+		out[0].Release()
+	}
+	checkStats := func(hits, misses int64) {
+		if got, want := c.Stats(), (RunCacheStats{Hits: hits, Misses: misses}); got != want {
+			t.Errorf("Got %+v, want %+v", got, want)
+		}
+	}
+
+	run(1)
+	run(1)
+	checkStats(1, 1)
+	run(2)
+	run(3) // Evicts 1.
+	run(2)
+	checkStats(2, 3)
+	run(1)
+	checkStats(2, 4)
+
+	now = now.Add(time.Minute)
+	run(1)
+	checkStats(2, 5)
+
+	c.Clear()
+	run(1)
+	checkStats(2, 6)
+}
+
+func TestRunCacheKey(t *testing.T) {
+	g := NewGraph()
+	x, err := Placeholder(g, "x", String)
+	if err != nil {
+		t.Fatal(err)
+	}
+	y, err := Placeholder(g, "y", String)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tensor := func(v interface{}) *Tensor {
+		t1, err := NewTensor(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return t1
+	}
+	key := func(feeds map[Output]*Tensor, fetches ...Output) [32]byte {
+		k, err := runCacheKey(feeds, fetches)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return k
+	}
+	a := key(map[Output]*Tensor{x: tensor("a"), y: tensor([]string{"b"})}, x)
+	if b := key(map[Output]*Tensor{x: tensor("a"), y: tensor([]string{"b"})}, x); a != b {
+		t.Error("Equal feeds have different keys")
+	}
+	for _, other := range [][32]byte{
+		key(map[Output]*Tensor{x: tensor("a"), y: tensor("b")}, x),
+		key(map[Output]*Tensor{y: tensor("a"), x: tensor([]string{"b"})}, x),
+		key(map[Output]*Tensor{x: tensor("a"), y: tensor([]string{"b"})}, y),
+		key(map[Output]*Tensor{x: tensor("a"), y: tensor([]string{"b"})}),
+	} {
+		if other == a {
+			t.Error("Different runs have the same key")
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"fmt"
+	"time"
+)
+
+// TraceLevel is the level of detail of the statistics collected during a
+// run. See RunOptions.TraceLevel.
+type TraceLevel int
+
+// Trace levels, as in the tensorflow.RunOptions protocol message.
+const (
+	// NoTrace collects no statistics.
+	NoTrace TraceLevel = iota
+	// SoftwareTrace collects the execution time of each operation.
+	SoftwareTrace
+	// HardwareTrace additionally collects statistics from devices, such
+	// as the kernels run on GPUs.
+	HardwareTrace
+	// FullTrace collects all the available statistics.
+	FullTrace
+)
+
+// RunMetadata is the metadata returned by a run of a Session.
+type RunMetadata struct {
+	// TraceID is the RunOptions.TraceID of the run.
+	TraceID string
+	// Proto is the binary-serialized tensorflow.RunMetadata protocol
+	// message (https://www.tensorflow.org/code/tensorflow/core/protobuf/config.proto)
+	// returned by the runtime, whose step statistics are only collected
+	// if RunOptions.TraceLevel is not NoTrace.
+	Proto []byte
+}
+
+// NodeStats describes an execution of an operation during a run, as
+// recorded in the step statistics of RunMetadata.
+type NodeStats struct {
+	// Device is the device the operation ran on.
+	Device string
+	// Node is the name of the operation.
+	Node string
+	// Start is when the operation was scheduled to run, and Duration the
+	// time from then until its outputs were available.
+	Start    time.Time
+	Duration time.Duration
+	// Label describes the execution, for example with the shapes of the
+	// inputs. It may be empty.
+	Label string
+}
+
+// NodeStats returns the executions of operations recorded in the step
+// statistics of m, in the order of the runtime, which is the order of the
+// devices and then of the executions on each device.
+func (m *RunMetadata) NodeStats() ([]NodeStats, error) {
+	var stats []NodeStats
+	err := parseMessage(m.Proto, func(field int, v uint64, b []byte) error {
+		if field != 1 { // step_stats
+			return nil
+		}
+		return parseMessage(b, func(field int, v uint64, b []byte) error {
+			if field != 1 { // dev_stats
+				return nil
+			}
+			var device string
+			return parseMessage(b, func(field int, v uint64, b []byte) error {
+				switch field {
+				case 1: // device
+					device = string(b)
+				case 2: // node_stats
+					s := NodeStats{Device: device}
+					var start, end int64
+					err := parseMessage(b, func(field int, v uint64, b []byte) error {
+						switch field {
+						case 1: // node_name
+							s.Node = string(b)
+						case 2: // all_start_micros
+							start = int64(v)
+						case 5: // all_end_rel_micros
+							end = int64(v)
+						case 8: // timeline_label
+							s.Label = string(b)
+						}
+						return nil
+					})
+					if err != nil {
+						return err
+					}
+					s.Start = time.Unix(0, start*int64(time.Microsecond))
+					s.Duration = time.Duration(end) * time.Microsecond
+					stats = append(stats, s)
+				}
+				return nil
+			})
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid RunMetadata: %v", err)
+	}
+	return stats, nil
+}
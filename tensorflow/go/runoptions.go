@@ -0,0 +1,204 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+// #include "tensorflow/c/c_api.h"
+import "C"
+
+import (
+	"encoding/binary"
+	"fmt"
+	"unsafe"
+)
+
+// ThreadPoolOptions configures an inter-op thread pool of a Session. See
+// SessionOptions.InterOpThreadPools.
+type ThreadPoolOptions struct {
+	// NumThreads is the number of threads in the pool. 0 lets the runtime
+	// pick a value based on the number of CPUs.
+	NumThreads int
+}
+
+// RunOptions contains options for a single call to Session.RunWithOptions.
+type RunOptions struct {
+	// InterOpThreadPool is the index, in SessionOptions.InterOpThreadPools,
+	// of the thread pool used to run the operations. Running latency
+	// sensitive requests and large batch requests in different pools
+	// prevents them from contending for the same threads.
+	InterOpThreadPool int
+
+	// TraceLevel is the level of detail of the statistics collected in the
+	// RunMetadata of the run.
+	TraceLevel TraceLevel
+
+	// TraceID identifies the request served by the run in a distributed
+	// tracing system, for example a trace or span ID. It is not
+	// interpreted, but recorded in the RunMetadata of the run, so that a
+	// slow request can be correlated with its per-operation timeline.
+	TraceID string
+
+	// MetadataHook, if not nil, is called with the RunMetadata of each run
+	// using these options, successful or not, for example to export it to
+	// a tracing system.
+	MetadataHook func(*RunMetadata)
+
+	// MemoryLimit, if positive, is a best-effort pre-check of the memory
+	// used by the run, not an enforced limit: the runtime offers no way to
+	// bound the memory of a run. Before running, each run with these
+	// options does a DryRun estimating the largest number of bytes of
+	// tensors kept alive at once from the fed tensors and the fetches, and
+	// fails with a ResourceExhausted StatusError if the estimate is over
+	// MemoryLimit. Tensors whose size cannot be inferred and the
+	// operations only run as targets are not counted, and the runtime may
+	// allocate more memory than estimated, so the check only rejects
+	// requests that are known to be too large, such as a single huge
+	// request to a serving process. The graph of the DryRun is cached by
+	// the Session for the fed outputs and the shapes of the fed tensors,
+	// so that the check costs a graph import only for new shapes.
+	MemoryLimit int64
+
+	// Config is a binary-serialized representation of the
+	// tensorflow.RunOptions protocol message
+	// (https://www.tensorflow.org/code/tensorflow/core/protobuf/config.proto),
+	// for options without a corresponding field in RunOptions. Fields of
+	// RunOptions take precedence over Config.
+	Config []byte
+}
+
+// RunWithOptions is like Run, using options for this call. options may be
+// nil to use the default options.
+func (s *Session) RunWithOptions(options *RunOptions, feeds map[Output]*Tensor, fetches []Output, targets []*Operation) ([]*Tensor, error) {
+	if options != nil && options.MetadataHook != nil {
+		out, _, err := s.RunWithMetadata(options, feeds, fetches, targets)
+		return out, err
+	}
+	cOpt, err := options.c()
+	if err != nil {
+		return nil, err
+	}
+	if cOpt != nil {
+		defer C.TF_DeleteBuffer(cOpt)
+	}
+	if err := s.checkMemoryLimit(options, feeds, fetches); err != nil {
+		return nil, err
+	}
+	return s.run(cOpt, nil, feeds, fetches, targets)
+}
+
+// RunWithMetadata is like RunWithOptions, additionally returning the
+// RunMetadata of the run, which is also returned, with the error, if the
+// run fails.
+func (s *Session) RunWithMetadata(options *RunOptions, feeds map[Output]*Tensor, fetches []Output, targets []*Operation) ([]*Tensor, *RunMetadata, error) {
+	cOpt, err := options.c()
+	if err != nil {
+		return nil, nil, err
+	}
+	if cOpt != nil {
+		defer C.TF_DeleteBuffer(cOpt)
+	}
+	buf := C.TF_NewBuffer()
+	defer C.TF_DeleteBuffer(buf)
+	var out []*Tensor
+	if err = s.checkMemoryLimit(options, feeds, fetches); err == nil {
+		out, err = s.run(cOpt, buf, feeds, fetches, targets)
+	}
+	md := &RunMetadata{Proto: C.GoBytes(buf.data, C.int(buf.length))}
+	if options != nil {
+		md.TraceID = options.TraceID
+		if options.MetadataHook != nil {
+			options.MetadataHook(md)
+		}
+	}
+	return out, md, err
+}
+
+// checkMemoryLimit returns a ResourceExhausted StatusError if running
+// fetches with feeds is estimated to need more than the MemoryLimit of
+// options.
+func (s *Session) checkMemoryLimit(options *RunOptions, feeds map[Output]*Tensor, fetches []Output) error {
+	if options == nil || options.MemoryLimit <= 0 {
+		return nil
+	}
+	res, err := s.DryRun(feeds, fetches)
+	if err != nil {
+		return err
+	}
+	if res.PeakBytes > options.MemoryLimit {
+		return &StatusError{Code: ResourceExhausted, Message: fmt.Sprintf("run needs an estimated %d bytes of tensors, over the limit of %d bytes", res.PeakBytes, options.MemoryLimit)}
+	}
+	return nil
+}
+
+// c converts o to a serialized tensorflow.RunOptions protocol message, which
+// the caller must delete.
+func (o *RunOptions) c() (*C.TF_Buffer, error) {
+	if o == nil {
+		return nil, nil
+	}
+	if o.InterOpThreadPool < 0 {
+		return nil, fmt.Errorf("invalid RunOptions.InterOpThreadPool %d", o.InterOpThreadPool)
+	}
+	if o.TraceLevel < NoTrace || o.TraceLevel > FullTrace {
+		return nil, fmt.Errorf("invalid RunOptions.TraceLevel %d", o.TraceLevel)
+	}
+	if o.MemoryLimit < 0 {
+		return nil, fmt.Errorf("invalid RunOptions.MemoryLimit %d", o.MemoryLimit)
+	}
+	// Fields appearing more than once in a serialized message take the
+	// last value, so appending fields to Config overrides them.
+	config := append([]byte(nil), o.Config...)
+	if o.TraceLevel != NoTrace {
+		config = appendVarintField(config, 1, uint64(o.TraceLevel)) // trace_level
+	}
+	if o.InterOpThreadPool != 0 {
+		config = appendVarintField(config, 3, uint64(o.InterOpThreadPool)) // inter_op_thread_pool
+	}
+	if len(config) == 0 {
+		return nil, nil
+	}
+	return C.TF_NewBufferFromString(unsafe.Pointer(&config[0]), C.size_t(len(config))), nil
+}
+
+// config returns o.Config, with the fields of o that are not part of it
+// appended.
+func (o *SessionOptions) config() []byte {
+	if len(o.InterOpThreadPools) == 0 {
+		return o.Config
+	}
+	// Repeated fields appearing in a serialized message are concatenated.
+	config := append([]byte(nil), o.Config...)
+	for _, p := range o.InterOpThreadPools {
+		var pool []byte
+		if p.NumThreads != 0 {
+			pool = appendVarintField(pool, 1, uint64(p.NumThreads)) // num_threads
+		}
+		config = appendBytesField(config, 12, pool) // session_inter_op_thread_pool
+	}
+	return config
+}
+
+func appendVarint(b []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(b, buf[:binary.PutUvarint(buf[:], v)]...)
+}
+
+func appendVarintField(b []byte, field int, v uint64) []byte {
+	return appendVarint(appendVarint(b, uint64(field)<<3), v)
+}
+
+func appendBytesField(b []byte, field int, v []byte) []byte {
+	b = appendVarint(appendVarint(b, uint64(field)<<3|2), uint64(len(v)))
+	return append(b, v...)
+}
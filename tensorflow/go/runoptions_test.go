@@ -0,0 +1,206 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRunWithOptions(t *testing.T) {
+	g := NewGraph()
+	x, err := Placeholder(g, "x", Int64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	y, err := Neg(g, "y", x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewSession(g, &SessionOptions{
+		InterOpThreadPools: []ThreadPoolOptions{{NumThreads: 2}, {}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	in, err := NewTensor(int64(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	feeds := map[Output]*Tensor{x: in}
+	for _, options := range []*RunOptions{nil, {}, {InterOpThreadPool: 1}} {
+		out, err := s.RunWithOptions(options, feeds, []Output{y}, nil)
+		if err != nil {
+			t.Fatalf("%+v: %v", options, err)
+		}
+		if got := out[0].Value().(int64); got != -1 {
+			t.Errorf("%+v: got %v, want -1", options, got)
+		}
+	}
+	for _, options := range []*RunOptions{{InterOpThreadPool: 2}, {InterOpThreadPool: -1}} {
+		if _, err := s.RunWithOptions(options, feeds, []Output{y}, nil); err == nil {
+			t.Errorf("%+v: run succeeded with an invalid thread pool", options)
+		}
+	}
+}
+
+func TestRunMemoryLimit(t *testing.T) {
+	g := NewGraph()
+	x, err := Placeholder(g, "x", Int64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	y, err := Neg(g, "y", x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewSession(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	in, err := NewTensor(make([]int64, 100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	feeds := map[Output]*Tensor{x: in}
+	// x and y take 800 bytes each.
+	t.Run("UnderLimit", func(t *testing.T) {
+		out, err := s.RunWithOptions(&RunOptions{MemoryLimit: 1600}, feeds, []Output{y}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := out[0].Value().([]int64); len(got) != 100 {
+			t.Errorf("Got %d values, want 100", len(got))
+		}
+	})
+	t.Run("OverLimit", func(t *testing.T) {
+		hooked := false
+		options := &RunOptions{MemoryLimit: 1599, MetadataHook: func(*RunMetadata) { hooked = true }}
+		if _, err := s.RunWithOptions(options, feeds, []Output{y}, nil); !errors.Is(err, &StatusError{Code: ResourceExhausted}) {
+			t.Errorf("Got error %v, want ResourceExhausted", err)
+		}
+		if !hooked {
+			t.Errorf("MetadataHook not called for a run over the memory limit")
+		}
+		if _, _, err := s.RunWithMetadata(&RunOptions{MemoryLimit: 1599}, feeds, []Output{y}, nil); !errors.Is(err, &StatusError{Code: ResourceExhausted}) {
+			t.Errorf("Got error %v from RunWithMetadata, want ResourceExhausted", err)
+		}
+	})
+	if _, err := s.RunWithOptions(&RunOptions{MemoryLimit: -1}, feeds, []Output{y}, nil); err == nil {
+		t.Errorf("Run succeeded with a negative memory limit")
+	}
+}
+
+func TestSessionOptionsConfig(t *testing.T) {
+	o := &SessionOptions{
+		Config:             []byte{0x28, 0x04}, // inter_op_parallelism_threads: 4
+		InterOpThreadPools: []ThreadPoolOptions{{NumThreads: 300}, {}},
+	}
+	want := []byte{0x28, 0x04, 0x62, 0x03, 0x08, 0xac, 0x02, 0x62, 0x00}
+	if got := o.config(); !bytes.Equal(got, want) {
+		t.Errorf("Got %x, want %x", got, want)
+	}
+}
+
+func TestRunWithMetadata(t *testing.T) {
+	g := NewGraph()
+	x, err := Placeholder(g, "x", Int64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	y, err := Neg(g, "y", x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewSession(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	in, err := NewTensor(int64(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	feeds := map[Output]*Tensor{x: in}
+
+	var hooked *RunMetadata
+	options := &RunOptions{
+		TraceLevel:   SoftwareTrace,
+		TraceID:      "trace-1",
+		MetadataHook: func(md *RunMetadata) { hooked = md },
+	}
+	out, md, err := s.RunWithMetadata(options, feeds, []Output{y}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := out[0].Value().(int64); got != -1 {
+		t.Errorf("Got %v, want -1", got)
+	}
+	if md.TraceID != "trace-1" || hooked != md {
+		t.Errorf("Got metadata %+v and %+v passed to the hook, want the trace ID and the same metadata", md, hooked)
+	}
+	stats, err := md.NodeStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, s := range stats {
+		if s.Node == "y" {
+			found = true
+			if s.Device == "" || s.Start.IsZero() {
+				t.Errorf("Got stats %+v for y, want a device and a start time", s)
+			}
+		}
+	}
+	if !found {
+		t.Errorf("Got stats %+v, want stats for y", stats)
+	}
+
+	// RunWithOptions calls the hook too.
+	hooked = nil
+	options.TraceID = "trace-2"
+	if _, err := s.RunWithOptions(options, feeds, []Output{y}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if hooked == nil || hooked.TraceID != "trace-2" {
+		t.Errorf("Got %+v passed to the hook, want metadata for trace-2", hooked)
+	}
+
+	if _, _, err := s.RunWithMetadata(&RunOptions{TraceLevel: FullTrace + 1}, feeds, []Output{y}, nil); err == nil {
+		t.Error("Run succeeded with an invalid TraceLevel")
+	}
+}
+
+func TestNodeStats(t *testing.T) {
+	node := appendBytesField(nil, 1, []byte("y"))
+	node = appendVarintField(node, 2, 1500000) // all_start_micros
+	node = appendVarintField(node, 5, 20)      // all_end_rel_micros
+	node = appendBytesField(node, 8, []byte("y = Neg(x)"))
+	dev := appendBytesField(nil, 1, []byte("/cpu:0"))
+	dev = appendBytesField(dev, 2, node)
+	md := &RunMetadata{Proto: appendBytesField(nil, 1, appendBytesField(nil, 1, dev))}
+	stats, err := md.NodeStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := NodeStats{Device: "/cpu:0", Node: "y", Start: time.Unix(1, 500000000), Duration: 20 * time.Microsecond, Label: "y = Neg(x)"}
+	if len(stats) != 1 || stats[0] != want {
+		t.Errorf("Got %+v, want [%+v]", stats, want)
+	}
+}
@@ -0,0 +1,455 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build tensorflow_dynamic
+
+// Definitions of the TensorFlow C API functions used by the Go API that
+// forward to the TensorFlow C library loaded at run time. This allows
+// programs built with the tensorflow_dynamic tag to be linked without the
+// library. See runtime_dynamic.go.
+
+#include <stdio.h>
+#include <stdlib.h>
+#include <string.h>
+
+#ifdef _WIN32
+#include <windows.h>
+#else
+#include <dlfcn.h>
+#endif
+
+#include "tensorflow/c/c_api.h"
+
+#if defined(_WIN32)
+#define TF_DEFAULT_LIBRARY "tensorflow.dll"
+#elif defined(__APPLE__)
+#define TF_DEFAULT_LIBRARY "libtensorflow.dylib"
+#else
+#define TF_DEFAULT_LIBRARY "libtensorflow.so"
+#endif
+
+// Handle of the loaded library, or NULL. Accessed atomically since the first
+// calls may come from several threads at once.
+static void* tf_library;
+
+static void* tfOpen(const char* path, char* err, size_t errlen) {
+#ifdef _WIN32
+  // Paths are UTF-8 encoded. When path names a directory, DLLs that
+  // tensorflow.dll depends on (e.g., for CUDA) are also searched for there.
+  void* lib = NULL;
+  DWORD flags = 0;
+  wchar_t* wpath;
+  int n = MultiByteToWideChar(CP_UTF8, 0, path, -1, NULL, 0);
+  if (n == 0) {
+    snprintf(err, errlen, "invalid UTF-8 in path");
+    return NULL;
+  }
+  wpath = (wchar_t*)malloc(n * sizeof(wchar_t));
+  MultiByteToWideChar(CP_UTF8, 0, path, -1, wpath, n);
+  if (strchr(path, '\\') != NULL || strchr(path, '/') != NULL) {
+    flags = LOAD_WITH_ALTERED_SEARCH_PATH;
+  }
+  lib = (void*)LoadLibraryExW(wpath, NULL, flags);
+  if (lib == NULL) {
+    snprintf(err, errlen, "LoadLibrary failed with error %lu",
+             (unsigned long)GetLastError());
+  }
+  free(wpath);
+#else
+  void* lib = dlopen(path, RTLD_NOW | RTLD_LOCAL);
+  if (lib == NULL) {
+    snprintf(err, errlen, "%s", dlerror());
+  }
+#endif
+  return lib;
+}
+
+static void tfClose(void* lib) {
+#ifdef _WIN32
+  FreeLibrary((HMODULE)lib);
+#else
+  dlclose(lib);
+#endif
+}
+
+static void* tfLookup(void* lib, const char* name) {
+#ifdef _WIN32
+  return (void*)GetProcAddress((HMODULE)lib, name);
+#else
+  return dlsym(lib, name);
+#endif
+}
+
+// Loads the TensorFlow C library from path. Returns 0 on success, otherwise
+// stores a description of the failure in err.
+int tfDynamicOpen(const char* path, char* err, size_t errlen) {
+  void* expected = NULL;
+  void* lib = tfOpen(path, err, errlen);
+  if (lib == NULL) {
+    return -1;
+  }
+  if (!__atomic_compare_exchange_n(&tf_library, &expected, lib, 0,
+                                   __ATOMIC_ACQ_REL, __ATOMIC_ACQUIRE)) {
+    tfClose(lib);
+    snprintf(err, errlen, "the TensorFlow C library has already been loaded");
+    return -1;
+  }
+  return 0;
+}
+
+// Returns the loaded library, loading it from the default location if no
+// library has been loaded yet. Returns NULL if that fails.
+static void* tfLibrary(char* err, size_t errlen) {
+  void* lib = __atomic_load_n(&tf_library, __ATOMIC_ACQUIRE);
+  if (lib == NULL) {
+    tfDynamicOpen(TF_DEFAULT_LIBRARY, err, errlen);
+    // Another thread may have won the race to load the library.
+    lib = __atomic_load_n(&tf_library, __ATOMIC_ACQUIRE);
+  }
+  return lib;
+}
+
+int tfDynamicHasSymbol(const char* name) {
+  char err[256];
+  void* lib = tfLibrary(err, sizeof(err));
+  return lib != NULL && tfLookup(lib, name) != NULL;
+}
+
+// Returns the address of the function name, caching it in *cache. There is
+// no way to report errors to the caller, so the process is aborted if the
+// function cannot be found.
+static void* tfSymbol(void** cache, const char* name) {
+  char err[256] = "";
+  void* lib;
+  void* sym = __atomic_load_n(cache, __ATOMIC_ACQUIRE);
+  if (sym != NULL) {
+    return sym;
+  }
+  lib = tfLibrary(err, sizeof(err));
+  if (lib == NULL) {
+    fprintf(stderr, "unable to load the TensorFlow C library %s: %s\n",
+            TF_DEFAULT_LIBRARY, err);
+    abort();
+  }
+  sym = tfLookup(lib, name);
+  if (sym == NULL) {
+    fprintf(stderr, "%s not found in the TensorFlow C library\n", name);
+    abort();
+  }
+  __atomic_store_n(cache, sym, __ATOMIC_RELEASE);
+  return sym;
+}
+
+// Every function of the C API used by the Go API must be defined below.
+#define TF_FUNC(ret, name, params, args)          \
+  ret name params {                               \
+    typedef ret(*fn_t) params;                    \
+    static void* sym;                             \
+    return ((fn_t)tfSymbol(&sym, #name))args;     \
+  }
+
+#define TF_VOID_FUNC(name, params, args)          \
+  void name params {                              \
+    typedef void(*fn_t) params;                   \
+    static void* sym;                             \
+    ((fn_t)tfSymbol(&sym, #name))args;            \
+  }
+
+TF_VOID_FUNC(TF_AddControlInput,
+             (TF_OperationDescription* desc, TF_Operation* input),
+             (desc, input))
+TF_VOID_FUNC(TF_AddInput,
+             (TF_OperationDescription* desc, TF_Output input),
+             (desc, input))
+TF_VOID_FUNC(TF_AddInputList,
+             (TF_OperationDescription* desc, const TF_Output* inputs,
+              int num_inputs),
+             (desc, inputs, num_inputs))
+TF_FUNC(TF_Tensor*, TF_AllocateTensor,
+        (TF_DataType arg0, const int64_t* dims, int num_dims, size_t len),
+        (arg0, dims, num_dims, len))
+TF_VOID_FUNC(TF_CloseSession,
+             (TF_Session* arg0, TF_Status* status),
+             (arg0, status))
+TF_FUNC(size_t, TF_DataTypeSize,
+        (TF_DataType dt),
+        (dt))
+TF_VOID_FUNC(TF_DeleteBuffer,
+             (TF_Buffer* arg0),
+             (arg0))
+TF_VOID_FUNC(TF_DeleteGraph,
+             (TF_Graph* arg0),
+             (arg0))
+TF_VOID_FUNC(TF_DeleteImportGraphDefOptions,
+             (TF_ImportGraphDefOptions* opts),
+             (opts))
+TF_VOID_FUNC(TF_DeleteLibraryHandle,
+             (TF_Library* lib_handle),
+             (lib_handle))
+TF_VOID_FUNC(TF_DeletePRunHandle,
+             (const char* handle),
+             (handle))
+TF_VOID_FUNC(TF_DeleteSession,
+             (TF_Session* arg0, TF_Status* status),
+             (arg0, status))
+TF_VOID_FUNC(TF_DeleteSessionOptions,
+             (TF_SessionOptions* arg0),
+             (arg0))
+TF_VOID_FUNC(TF_DeleteStatus,
+             (TF_Status* arg0),
+             (arg0))
+TF_VOID_FUNC(TF_DeleteTensor,
+             (TF_Tensor* arg0),
+             (arg0))
+TF_FUNC(int64_t, TF_Dim,
+        (const TF_Tensor* tensor, int dim_index),
+        (tensor, dim_index))
+TF_FUNC(TF_Operation*, TF_FinishOperation,
+        (TF_OperationDescription* desc, TF_Status* status),
+        (desc, status))
+TF_FUNC(TF_Buffer*, TF_GetAllOpList,
+        (void),
+        ())
+TF_FUNC(TF_Code, TF_GetCode,
+        (const TF_Status* s),
+        (s))
+TF_FUNC(TF_Buffer, TF_GetOpList,
+        (TF_Library* lib_handle),
+        (lib_handle))
+TF_FUNC(int, TF_GraphGetTensorNumDims,
+        (TF_Graph* graph, TF_Output output, TF_Status* status),
+        (graph, output, status))
+TF_VOID_FUNC(TF_GraphGetTensorShape,
+             (TF_Graph* graph, TF_Output output, int64_t* dims, int num_dims,
+              TF_Status* status),
+             (graph, output, dims, num_dims, status))
+TF_VOID_FUNC(TF_GraphImportGraphDef,
+             (TF_Graph* graph, const TF_Buffer* graph_def,
+              const TF_ImportGraphDefOptions* options, TF_Status* status),
+             (graph, graph_def, options, status))
+TF_FUNC(TF_Operation*, TF_GraphOperationByName,
+        (TF_Graph* graph, const char* oper_name),
+        (graph, oper_name))
+TF_VOID_FUNC(TF_GraphToGraphDef,
+             (TF_Graph* graph, TF_Buffer* output_graph_def, TF_Status* status),
+             (graph, output_graph_def, status))
+TF_VOID_FUNC(TF_ImportGraphDefOptionsAddInputMapping,
+             (TF_ImportGraphDefOptions* opts, const char* src_name,
+              int src_index, TF_Output dst),
+             (opts, src_name, src_index, dst))
+// Declared in c_api.h as TF_GraphImportGraphDefOptionsRemapControlDependency,
+// but defined by the library under this name.
+TF_VOID_FUNC(TF_ImportGraphDefOptionsRemapControlDependency,
+             (TF_ImportGraphDefOptions* opts, const char* src_name,
+              TF_Operation* dst),
+             (opts, src_name, dst))
+TF_VOID_FUNC(TF_ImportGraphDefOptionsSetPrefix,
+             (TF_ImportGraphDefOptions* opts, const char* prefix),
+             (opts, prefix))
+TF_FUNC(TF_Library*, TF_LoadLibrary,
+        (const char* library_filename, TF_Status* status),
+        (library_filename, status))
+TF_FUNC(TF_Session*, TF_LoadSessionFromSavedModel,
+        (const TF_SessionOptions* session_options, const TF_Buffer* run_options,
+         const char* export_dir, const char* const* tags, int tags_len,
+         TF_Graph* graph, TF_Buffer* meta_graph_def, TF_Status* status),
+        (session_options, run_options, export_dir, tags, tags_len, graph,
+         meta_graph_def, status))
+TF_FUNC(const char*, TF_Message,
+        (const TF_Status* s),
+        (s))
+TF_FUNC(TF_Buffer*, TF_NewBuffer,
+        (void),
+        ())
+TF_FUNC(TF_Buffer*, TF_NewBufferFromString,
+        (const void* proto, size_t proto_len),
+        (proto, proto_len))
+TF_FUNC(TF_Graph*, TF_NewGraph,
+        (void),
+        ())
+TF_FUNC(TF_ImportGraphDefOptions*, TF_NewImportGraphDefOptions,
+        (void),
+        ())
+TF_FUNC(TF_OperationDescription*, TF_NewOperation,
+        (TF_Graph* graph, const char* op_type, const char* oper_name),
+        (graph, op_type, oper_name))
+TF_FUNC(TF_Session*, TF_NewSession,
+        (TF_Graph* graph, const TF_SessionOptions* opts, TF_Status* status),
+        (graph, opts, status))
+TF_FUNC(TF_SessionOptions*, TF_NewSessionOptions,
+        (void),
+        ())
+TF_FUNC(TF_Status*, TF_NewStatus,
+        (void),
+        ())
+TF_FUNC(TF_Tensor*, TF_NewTensor,
+        (TF_DataType arg0, const int64_t* dims, int num_dims, void* data,
+         size_t len,
+         void (*deallocator)(void* data, size_t len, void* arg),
+         void* deallocator_arg),
+        (arg0, dims, num_dims, data, len, deallocator, deallocator_arg))
+TF_FUNC(int, TF_NumDims,
+        (const TF_Tensor* arg0),
+        (arg0))
+TF_FUNC(const char*, TF_OperationDevice,
+        (TF_Operation* oper),
+        (oper))
+TF_FUNC(TF_Output, TF_OperationInput,
+        (TF_Input oper_in),
+        (oper_in))
+TF_FUNC(int, TF_OperationGetControlInputs,
+        (TF_Operation* oper, TF_Operation** control_inputs,
+         int max_control_inputs),
+        (oper, control_inputs, max_control_inputs))
+TF_FUNC(const char*, TF_OperationName,
+        (TF_Operation* oper),
+        (oper))
+TF_FUNC(int, TF_OperationNumControlInputs,
+        (TF_Operation* oper),
+        (oper))
+TF_FUNC(int, TF_OperationNumInputs,
+        (TF_Operation* oper),
+        (oper))
+TF_FUNC(int, TF_OperationNumOutputs,
+        (TF_Operation* oper),
+        (oper))
+TF_FUNC(const char*, TF_OperationOpType,
+        (TF_Operation* oper),
+        (oper))
+TF_FUNC(int, TF_OperationOutputListLength,
+        (TF_Operation* oper, const char* arg_name, TF_Status* status),
+        (oper, arg_name, status))
+TF_FUNC(TF_DataType, TF_OperationOutputType,
+        (TF_Output oper_out),
+        (oper_out))
+TF_VOID_FUNC(TF_OperationToNodeDef,
+             (TF_Operation* oper, TF_Buffer* output_node_def,
+              TF_Status* status),
+             (oper, output_node_def, status))
+TF_VOID_FUNC(TF_SessionPRun,
+             (TF_Session* arg0, const char* handle, const TF_Output* inputs,
+              TF_Tensor* const* input_values, int ninputs,
+              const TF_Output* outputs, TF_Tensor** output_values, int noutputs,
+              const TF_Operation* const* target_opers, int ntargets,
+              TF_Status* arg10),
+             (arg0, handle, inputs, input_values, ninputs, outputs,
+              output_values, noutputs, target_opers, ntargets, arg10))
+TF_VOID_FUNC(TF_SessionPRunSetup,
+             (TF_Session* arg0, const TF_Output* inputs, int ninputs,
+              const TF_Output* outputs, int noutputs,
+              const TF_Operation* const* target_opers, int ntargets,
+              const char** handle, TF_Status* arg8),
+             (arg0, inputs, ninputs, outputs, noutputs, target_opers, ntargets,
+              handle, arg8))
+TF_VOID_FUNC(TF_SessionRun,
+             (TF_Session* session, const TF_Buffer* run_options,
+              const TF_Output* inputs, TF_Tensor* const* input_values,
+              int ninputs, const TF_Output* outputs, TF_Tensor** output_values,
+              int noutputs, const TF_Operation* const* target_opers,
+              int ntargets, TF_Buffer* run_metadata, TF_Status* arg11),
+             (session, run_options, inputs, input_values, ninputs, outputs,
+              output_values, noutputs, target_opers, ntargets, run_metadata,
+              arg11))
+TF_VOID_FUNC(TF_SetAttrBool,
+             (TF_OperationDescription* desc, const char* attr_name,
+              unsigned char value),
+             (desc, attr_name, value))
+TF_VOID_FUNC(TF_SetAttrBoolList,
+             (TF_OperationDescription* desc, const char* attr_name,
+              const unsigned char* values, int num_values),
+             (desc, attr_name, values, num_values))
+TF_VOID_FUNC(TF_SetAttrFloat,
+             (TF_OperationDescription* desc, const char* attr_name, float value),
+             (desc, attr_name, value))
+TF_VOID_FUNC(TF_SetAttrFloatList,
+             (TF_OperationDescription* desc, const char* attr_name,
+              const float* values, int num_values),
+             (desc, attr_name, values, num_values))
+TF_VOID_FUNC(TF_SetAttrInt,
+             (TF_OperationDescription* desc, const char* attr_name,
+              int64_t value),
+             (desc, attr_name, value))
+TF_VOID_FUNC(TF_SetAttrIntList,
+             (TF_OperationDescription* desc, const char* attr_name,
+              const int64_t* values, int num_values),
+             (desc, attr_name, values, num_values))
+TF_VOID_FUNC(TF_SetAttrShape,
+             (TF_OperationDescription* desc, const char* attr_name,
+              const int64_t* dims, int num_dims),
+             (desc, attr_name, dims, num_dims))
+TF_VOID_FUNC(TF_SetAttrShapeList,
+             (TF_OperationDescription* desc, const char* attr_name,
+              const int64_t* const* dims, const int* num_dims, int num_shapes),
+             (desc, attr_name, dims, num_dims, num_shapes))
+TF_VOID_FUNC(TF_SetAttrString,
+             (TF_OperationDescription* desc, const char* attr_name,
+              const void* value, size_t length),
+             (desc, attr_name, value, length))
+TF_VOID_FUNC(TF_SetAttrStringList,
+             (TF_OperationDescription* desc, const char* attr_name,
+              const void* const* values, const size_t* lengths, int num_values),
+             (desc, attr_name, values, lengths, num_values))
+TF_VOID_FUNC(TF_SetAttrTensor,
+             (TF_OperationDescription* desc, const char* attr_name,
+              TF_Tensor* value, TF_Status* status),
+             (desc, attr_name, value, status))
+TF_VOID_FUNC(TF_SetAttrTensorList,
+             (TF_OperationDescription* desc, const char* attr_name,
+              TF_Tensor* const* values, int num_values, TF_Status* status),
+             (desc, attr_name, values, num_values, status))
+TF_VOID_FUNC(TF_SetAttrType,
+             (TF_OperationDescription* desc, const char* attr_name,
+              TF_DataType value),
+             (desc, attr_name, value))
+TF_VOID_FUNC(TF_SetAttrTypeList,
+             (TF_OperationDescription* desc, const char* attr_name,
+              const TF_DataType* values, int num_values),
+             (desc, attr_name, values, num_values))
+TF_VOID_FUNC(TF_SetAttrValueProto,
+             (TF_OperationDescription* desc, const char* attr_name,
+              const void* proto, size_t proto_len, TF_Status* status),
+             (desc, attr_name, proto, proto_len, status))
+TF_VOID_FUNC(TF_SetConfig,
+             (TF_SessionOptions* options, const void* proto, size_t proto_len,
+              TF_Status* status),
+             (options, proto, proto_len, status))
+TF_VOID_FUNC(TF_SetDevice,
+             (TF_OperationDescription* desc, const char* device),
+             (desc, device))
+TF_VOID_FUNC(TF_SetTarget,
+             (TF_SessionOptions* options, const char* target),
+             (options, target))
+TF_FUNC(size_t, TF_StringDecode,
+        (const char* src, size_t src_len, const char** dst, size_t* dst_len,
+         TF_Status* status),
+        (src, src_len, dst, dst_len, status))
+TF_FUNC(size_t, TF_StringEncode,
+        (const char* src, size_t src_len, char* dst, size_t dst_len,
+         TF_Status* status),
+        (src, src_len, dst, dst_len, status))
+TF_FUNC(size_t, TF_StringEncodedSize,
+        (size_t len),
+        (len))
+TF_FUNC(size_t, TF_TensorByteSize,
+        (const TF_Tensor* arg0),
+        (arg0))
+TF_FUNC(void*, TF_TensorData,
+        (const TF_Tensor* arg0),
+        (arg0))
+TF_FUNC(TF_DataType, TF_TensorType,
+        (const TF_Tensor* arg0),
+        (arg0))
+TF_FUNC(const char*, TF_Version,
+        (void),
+        ())
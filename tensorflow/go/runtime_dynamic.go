@@ -0,0 +1,89 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build tensorflow_dynamic
+// +build tensorflow_dynamic
+
+package tensorflow
+
+// #cgo linux LDFLAGS: -ldl
+// #include <stdlib.h>
+// extern int tfDynamicOpen(const char* path, char* err, size_t errlen);
+// extern int tfDynamicHasSymbol(const char* name);
+import "C"
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"unsafe"
+)
+
+// LoadRuntime loads the TensorFlow C library from path (for example,
+// "/opt/tensorflow/lib/libtensorflow.so"), allowing the location of the
+// library to be chosen at run time. It is only supported by programs built
+// with the tensorflow_dynamic tag, which do not need the library at build
+// time.
+//
+// LoadRuntime must be called before any other function in this package. If
+// it is not, the library is loaded from the default search path of the
+// system (libtensorflow.so, libtensorflow.dylib or tensorflow.dll, depending
+// on the platform) when it is first needed, and the process is aborted if it
+// cannot be found. On Windows, the search path includes the directory of the
+// executable and the directories listed in the PATH environment variable.
+func LoadRuntime(path string) error {
+	if filepath.Base(path) != path {
+		// Relative paths would be resolved differently by the dynamic
+		// loaders of different platforms.
+		abs, err := filepath.Abs(path)
+		if err != nil {
+			return err
+		}
+		path = abs
+	}
+	cpath := C.CString(path)
+	defer C.free(unsafe.Pointer(cpath))
+	var buf [256]C.char
+	if C.tfDynamicOpen(cpath, &buf[0], C.size_t(len(buf))) != 0 {
+		return fmt.Errorf("unable to load the TensorFlow C library from %q: %s", path, C.GoString(&buf[0]))
+	}
+	return nil
+}
+
+// SetLibraryPath loads the TensorFlow C library from the directory dir. On
+// Windows, the DLLs that tensorflow.dll depends on are also searched for in
+// dir. See LoadRuntime.
+func SetLibraryPath(dir string) error {
+	return LoadRuntime(filepath.Join(dir, libraryName()))
+}
+
+// libraryName returns the file name of the TensorFlow C library on the
+// current platform.
+func libraryName() string {
+	switch runtime.GOOS {
+	case "windows":
+		return "tensorflow.dll"
+	case "darwin":
+		return "libtensorflow.dylib"
+	}
+	return "libtensorflow.so"
+}
+
+// hasSymbol returns true if the C library function name is provided by the
+// loaded TensorFlow C library.
+func hasSymbol(name string) bool {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	return C.tfDynamicHasSymbol(cname) != 0
+}
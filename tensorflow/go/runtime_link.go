@@ -0,0 +1,39 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !tensorflow_dynamic
+// +build !tensorflow_dynamic
+
+package tensorflow
+
+// #cgo LDFLAGS: -ltensorflow
+import "C"
+
+import "fmt"
+
+// LoadRuntime loads the TensorFlow C library from path. It is only supported
+// by programs built with the tensorflow_dynamic tag; otherwise the library is
+// linked into the program at build time and an error is returned.
+func LoadRuntime(path string) error {
+	return fmt.Errorf("the TensorFlow C library is linked at build time; build with the tensorflow_dynamic tag to load %q at run time", path)
+}
+
+// SetLibraryPath loads the TensorFlow C library from the directory dir. Like
+// LoadRuntime, it is only supported by programs built with the
+// tensorflow_dynamic tag. Otherwise, the library must be in the search path
+// of the system when the program starts: LD_LIBRARY_PATH on Linux,
+// DYLD_LIBRARY_PATH on OS X and PATH on Windows.
+func SetLibraryPath(dir string) error {
+	return fmt.Errorf("the TensorFlow C library is linked at build time; build with the tensorflow_dynamic tag to load it from %q at run time", dir)
+}
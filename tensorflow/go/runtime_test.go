@@ -0,0 +1,74 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestLoadRuntimeAfterUse(t *testing.T) {
+	Version()
+	if err := LoadRuntime("libtensorflow.so"); err == nil {
+		t.Error("LoadRuntime() after the library has been used should fail")
+	}
+}
+
+// TestDynamicRuntimeFunctions checks that every C API function used by this
+// package can be resolved at run time in the tensorflow_dynamic build mode.
+func TestDynamicRuntimeFunctions(t *testing.T) {
+	dynamic, err := ioutil.ReadFile("runtime_dynamic.c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defined := make(map[string]bool)
+	for _, m := range regexp.MustCompile(`TF_(?:VOID_)?FUNC\((?:[^,]+, )?(TF_\w+),`).FindAllStringSubmatch(string(dynamic), -1) {
+		defined[m[1]] = true
+	}
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	call := regexp.MustCompile(`C\.(TF_\w+)\(`)
+	for _, f := range files {
+		if strings.HasSuffix(f, "_test.go") {
+			continue
+		}
+		src, err := ioutil.ReadFile(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, m := range call.FindAllStringSubmatch(string(src), -1) {
+			// TF_DataType conversions are not function calls.
+			if m[1] != "TF_DataType" && !defined[m[1]] {
+				t.Errorf("%s: %s is not defined in runtime_dynamic.c", f, m[1])
+			}
+		}
+	}
+	// The declarations of c_api.h do not always match the definitions of
+	// the library, whose names are those the functions are linked with.
+	lib, err := ioutil.ReadFile("../c/c_api.cc")
+	if err != nil {
+		t.Skip(err)
+	}
+	for name := range defined {
+		if !regexp.MustCompile(`\b` + name + `\(`).Match(lib) {
+			t.Errorf("%s is not defined in c_api.cc", name)
+		}
+	}
+}
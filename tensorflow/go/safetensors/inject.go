@@ -0,0 +1,123 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package safetensors
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+// InjectOptions configures Inject.
+type InjectOptions struct {
+	// Rename returns the name of the variable that the tensor name is
+	// assigned to, for example to convert "dense.kernel" to
+	// "dense/kernel". Nil means that tensors have the names of their
+	// variables.
+	Rename func(name string) string
+	// AllowUnused, if true, ignores the tensors without a variable instead
+	// of failing.
+	AllowUnused bool
+	// AllowMissing, if true, keeps the values of the variables without a
+	// tensor instead of failing.
+	AllowMissing bool
+}
+
+// Inject assigns tensors to the variables of graph, which session runs,
+// returning the sorted names of the variables assigned. Both reference
+// variables (Variable and VariableV2 operations) and resource variables
+// (VarHandleOp operations) are supported. The type and shape of each
+// tensor must match those of its variable.
+//
+// No variable is assigned if any tensor does not match its variable, or,
+// unless allowed by opts, if a tensor has no variable or a variable has no
+// tensor. The operations assigning the variables are added to graph by
+// the first call assigning them, and reused by later calls (see
+// tf.AssignVariables).
+func Inject(session *tf.Session, graph *tf.Graph, tensors map[string]*tf.Tensor, opts InjectOptions) ([]string, error) {
+	vars, err := graph.Variables()
+	if err != nil {
+		return nil, err
+	}
+	variables := make(map[string]tf.Variable, len(vars))
+	for _, v := range vars {
+		variables[v.Op.Name()] = v
+	}
+
+	values := make(map[string]*tf.Tensor, len(tensors))
+	var unused []string
+	for name, t := range tensors {
+		v := name
+		if opts.Rename != nil {
+			v = opts.Rename(name)
+		}
+		variable, ok := variables[v]
+		if !ok {
+			unused = append(unused, name)
+			continue
+		}
+		if _, ok := values[v]; ok {
+			return nil, fmt.Errorf("several tensors are assigned to variable %q", v)
+		}
+		if err := check(variable, t); err != nil {
+			return nil, fmt.Errorf("tensor %q: %v", name, err)
+		}
+		values[v] = t
+	}
+	if len(unused) > 0 && !opts.AllowUnused {
+		sort.Strings(unused)
+		return nil, fmt.Errorf("no variable for tensors %s", strings.Join(unused, ", "))
+	}
+	if !opts.AllowMissing {
+		var missing []string
+		for v := range variables {
+			if _, ok := values[v]; !ok {
+				missing = append(missing, v)
+			}
+		}
+		if len(missing) > 0 {
+			sort.Strings(missing)
+			return nil, fmt.Errorf("no tensor for variables %s", strings.Join(missing, ", "))
+		}
+	}
+
+	names := make([]string, 0, len(values))
+	for v := range values {
+		names = append(names, v)
+	}
+	sort.Strings(names)
+	if err := tf.AssignVariables(session, graph, values); err != nil {
+		return nil, fmt.Errorf("failed to assign the variables: %v", err)
+	}
+	return names, nil
+}
+
+// InjectSavedModel is like Inject for the variables of m.
+func InjectSavedModel(m *tf.SavedModel, tensors map[string]*tf.Tensor, opts InjectOptions) ([]string, error) {
+	return Inject(m.Session, m.Graph, tensors, opts)
+}
+
+// check returns an error if t does not have the type and shape of v.
+func check(v tf.Variable, t *tf.Tensor) error {
+	if v.DataType != t.DataType() {
+		return fmt.Errorf("%v tensor for %v variable %q", t.DataType(), v.DataType, v.Op.Name())
+	}
+	if shape := tf.MakeShape(t.Shape()...); !shape.IsCompatibleWith(v.Shape) {
+		return fmt.Errorf("tensor of shape %v for variable %q of shape %v", t.Shape(), v.Op.Name(), v.Shape)
+	}
+	return nil
+}
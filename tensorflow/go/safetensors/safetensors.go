@@ -0,0 +1,277 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package safetensors reads and writes the safetensors format of model
+// weights (https://github.com/huggingface/safetensors) and injects weights
+// into the variables of a graph, so that the weights of a deployed model
+// can be inspected or swapped without exporting the model again:
+//
+//	tensors, _, err := safetensors.Read(f)
+//	if err != nil {
+//		...
+//	}
+//	if _, err := safetensors.Inject(m.Session, m.Graph, tensors, safetensors.InjectOptions{}); err != nil {
+//		...
+//	}
+//
+// A safetensors file starts with the size of its header as a little-endian
+// 64-bit integer, followed by the header, a JSON object describing the type,
+// shape and location of each tensor and holding string metadata under the
+// "__metadata__" key, followed by the little-endian contents of the
+// tensors.
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package safetensors
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"unsafe"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+// maxHeaderSize is the maximum size of the header of a file, as in the
+// reference implementation, to reject corrupted files before allocating
+// their header.
+const maxHeaderSize = 100 << 20
+
+const metadataKey = "__metadata__"
+
+// dataTypes are the types of the format, by name, that TensorFlow supports.
+var dataTypes = map[string]tf.DataType{
+	"BOOL": tf.Bool,
+	"U8":   tf.Uint8,
+	"I8":   tf.Int8,
+	"I16":  tf.Int16,
+	"U16":  tf.Uint16,
+	"F16":  tf.Half,
+	"BF16": tf.Bfloat16,
+	"I32":  tf.Int32,
+	"I64":  tf.Int64,
+	"F32":  tf.Float,
+	"F64":  tf.Double,
+}
+
+// TensorInfo describes a tensor of a file.
+type TensorInfo struct {
+	DataType tf.DataType
+	Shape    []int64
+	// Begin and End are the offsets of the contents of the tensor,
+	// relative to the end of the header.
+	Begin, End int64
+}
+
+// Header is the header of a file.
+type Header struct {
+	Tensors  map[string]TensorInfo
+	Metadata map[string]string
+}
+
+type jsonTensor struct {
+	DType       string   `json:"dtype"`
+	Shape       []int64  `json:"shape"`
+	DataOffsets [2]int64 `json:"data_offsets"`
+}
+
+// ReadHeader reads the header of the file in r, leaving r at the start of
+// the contents of the tensors.
+func ReadHeader(r io.Reader) (*Header, error) {
+	var size uint64
+	if err := binary.Read(r, binary.LittleEndian, &size); err != nil {
+		return nil, fmt.Errorf("failed to read the header size: %v", err)
+	}
+	if size > maxHeaderSize {
+		return nil, fmt.Errorf("header of %d bytes exceeds the limit of %d bytes", size, maxHeaderSize)
+	}
+	b := make([]byte, size)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, fmt.Errorf("failed to read the header: %v", err)
+	}
+	var entries map[string]json.RawMessage
+	if err := json.Unmarshal(b, &entries); err != nil {
+		return nil, fmt.Errorf("invalid header: %v", err)
+	}
+	h := &Header{Tensors: make(map[string]TensorInfo, len(entries))}
+	for name, raw := range entries {
+		if name == metadataKey {
+			if err := json.Unmarshal(raw, &h.Metadata); err != nil {
+				return nil, fmt.Errorf("invalid metadata: %v", err)
+			}
+			continue
+		}
+		var t jsonTensor
+		if err := json.Unmarshal(raw, &t); err != nil {
+			return nil, fmt.Errorf("invalid tensor %q: %v", name, err)
+		}
+		dt, ok := dataTypes[t.DType]
+		if !ok {
+			return nil, fmt.Errorf("tensor %q has the unsupported type %q", name, t.DType)
+		}
+		info := TensorInfo{DataType: dt, Shape: t.Shape, Begin: t.DataOffsets[0], End: t.DataOffsets[1]}
+		if info.Shape == nil {
+			info.Shape = []int64{}
+		}
+		want, err := byteSize(dt, info.Shape)
+		if err != nil {
+			return nil, fmt.Errorf("tensor %q: %v", name, err)
+		}
+		if info.Begin < 0 || info.End-info.Begin != want {
+			return nil, fmt.Errorf("tensor %q of type %s and shape %v has invalid offsets [%d, %d]", name, t.DType, info.Shape, info.Begin, info.End)
+		}
+		h.Tensors[name] = info
+	}
+	return h, nil
+}
+
+// Read reads the file in r, returning its tensors, by name, and its
+// metadata.
+func Read(r io.Reader) (map[string]*tf.Tensor, map[string]string, error) {
+	h, err := ReadHeader(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	tensors := make(map[string]*tf.Tensor, len(h.Tensors))
+	for name, info := range h.Tensors {
+		if info.End > int64(len(data)) {
+			return nil, nil, fmt.Errorf("tensor %q ends at offset %d, past the end of the file (%d)", name, info.End, len(data))
+		}
+		contents := data[info.Begin:info.End]
+		if !littleEndian {
+			contents = swapBytes(contents, info.DataType)
+		}
+		if tensors[name], err = tf.ReadTensor(info.DataType, info.Shape, bytes.NewReader(contents)); err != nil {
+			return nil, nil, fmt.Errorf("tensor %q: %v", name, err)
+		}
+	}
+	return tensors, h.Metadata, nil
+}
+
+// Write writes tensors, by name, and metadata, which may be nil, to w in
+// the safetensors format.
+func Write(w io.Writer, tensors map[string]*tf.Tensor, metadata map[string]string) error {
+	names := make([]string, 0, len(tensors))
+	for name := range tensors {
+		if name == metadataKey {
+			return fmt.Errorf("invalid tensor name %q", name)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	entries := make(map[string]interface{}, len(tensors)+1)
+	if len(metadata) > 0 {
+		entries[metadataKey] = metadata
+	}
+	var offset int64
+	for _, name := range names {
+		t := tensors[name]
+		dtype, ok := typeName(t.DataType())
+		if !ok {
+			return fmt.Errorf("tensor %q has the unsupported type %v", name, t.DataType())
+		}
+		size, err := byteSize(t.DataType(), t.Shape())
+		if err != nil {
+			return fmt.Errorf("tensor %q: %v", name, err)
+		}
+		shape := t.Shape()
+		if shape == nil {
+			shape = []int64{} // Scalars have an empty shape, not null.
+		}
+		entries[name] = jsonTensor{DType: dtype, Shape: shape, DataOffsets: [2]int64{offset, offset + size}}
+		offset += size
+	}
+	header, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	// Pad the header with spaces so that the contents of the tensors are
+	// aligned on 8 bytes.
+	if n := len(header) % 8; n != 0 {
+		header = append(header, bytes.Repeat([]byte(" "), 8-n)...)
+	}
+	if err := binary.Write(w, binary.LittleEndian, uint64(len(header))); err != nil {
+		return err
+	}
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	for _, name := range names {
+		t := tensors[name]
+		if littleEndian {
+			if _, err := t.WriteContentsTo(w); err != nil {
+				return err
+			}
+			continue
+		}
+		var buf bytes.Buffer
+		if _, err := t.WriteContentsTo(&buf); err != nil {
+			return err
+		}
+		if _, err := w.Write(swapBytes(buf.Bytes(), t.DataType())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func typeName(dt tf.DataType) (string, bool) {
+	for name, t := range dataTypes {
+		if t == dt {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// byteSize returns the size of the contents of a tensor.
+func byteSize(dt tf.DataType, shape []int64) (int64, error) {
+	size := int64(dt.Size())
+	for _, d := range shape {
+		if d < 0 {
+			return 0, fmt.Errorf("invalid shape %v", shape)
+		}
+		size *= d
+	}
+	return size, nil
+}
+
+// littleEndian is true if the contents of tensors, which use the byte order
+// of the host, are little-endian, as in the files.
+var littleEndian = func() bool {
+	x := uint16(1)
+	return *(*byte)(unsafe.Pointer(&x)) == 1
+}()
+
+// swapBytes returns a copy of b, the contents of a tensor of type dt, with
+// the byte order of its elements reversed.
+func swapBytes(b []byte, dt tf.DataType) []byte {
+	size := dt.Size()
+	swapped := make([]byte, len(b))
+	for i := 0; i+size <= len(b); i += size {
+		for j := 0; j < size; j++ {
+			swapped[i+j] = b[i+size-1-j]
+		}
+	}
+	return swapped
+}
@@ -0,0 +1,182 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package safetensors
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"strings"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+func tensor(t *testing.T, v interface{}) *tf.Tensor {
+	tensor, err := tf.NewTensor(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tensor
+}
+
+func TestRoundTrip(t *testing.T) {
+	tensors := map[string]*tf.Tensor{
+		"dense.kernel": tensor(t, [][]float32{{1, 2}, {3, 4}, {5, 6}}),
+		"dense.bias":   tensor(t, []float64{-1, 1}),
+		"step":         tensor(t, int64(7)),
+		"mask":         tensor(t, []bool{true, false, true}),
+	}
+	metadata := map[string]string{"format": "tf"}
+	var buf bytes.Buffer
+	if err := Write(&buf, tensors, metadata); err != nil {
+		t.Fatal(err)
+	}
+	size := binary.LittleEndian.Uint64(buf.Bytes())
+	if size%8 != 0 {
+		t.Errorf("Got a header of %d bytes, want a multiple of 8", size)
+	}
+
+	h, err := ReadHeader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := h.Tensors["dense.kernel"], (TensorInfo{tf.Float, []int64{3, 2}, 16, 40}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Got %+v, want %+v", got, want)
+	}
+	if got, want := h.Tensors["step"].Shape, []int64{}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Got shape %v for a scalar, want %v", got, want)
+	}
+
+	got, gotMetadata, err := Read(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(gotMetadata, metadata) {
+		t.Errorf("Got metadata %v, want %v", gotMetadata, metadata)
+	}
+	if len(got) != len(tensors) {
+		t.Fatalf("Got %d tensors, want %d", len(got), len(tensors))
+	}
+	for name, want := range tensors {
+		if got[name].DataType() != want.DataType() || !reflect.DeepEqual(got[name].Value(), want.Value()) {
+			t.Errorf("Got %v %v for %s, want %v %v", got[name].DataType(), got[name].Value(), name, want.DataType(), want.Value())
+		}
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	file := func(header string, data int) []byte {
+		var buf bytes.Buffer
+		binary.Write(&buf, binary.LittleEndian, uint64(len(header)))
+		buf.WriteString(header)
+		buf.Write(make([]byte, data))
+		return buf.Bytes()
+	}
+	tests := map[string][]byte{
+		"header size":   {1, 2},
+		"limit":         {0, 0, 0, 0, 0, 0, 0, 1},
+		"header":        file(`{"x":`, 0),
+		"unsupported":   file(`{"x":{"dtype":"U32","shape":[1],"data_offsets":[0,4]}}`, 4),
+		"invalid":       file(`{"x":{"dtype":"F32","shape":[2],"data_offsets":[0,4]}}`, 8),
+		"past the end":  file(`{"x":{"dtype":"F32","shape":[2],"data_offsets":[0,8]}}`, 4),
+		"shape":         file(`{"x":{"dtype":"F32","shape":[-1],"data_offsets":[0,0]}}`, 0),
+		"metadata type": file(`{"__metadata__":{"a":1}}`, 0),
+	}
+	for name, b := range tests {
+		if _, _, err := Read(bytes.NewReader(b)); err == nil {
+			t.Errorf("%s: Read succeeded, want an error", name)
+		}
+	}
+}
+
+func TestInject(t *testing.T) {
+	g := tf.NewGraph()
+	variable := func(name string, shape tf.Shape) tf.Output {
+		op, err := g.AddOperation(tf.OpSpec{
+			Type:  "VariableV2",
+			Name:  name,
+			Attrs: map[string]interface{}{"dtype": tf.Float, "shape": shape},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return op.Output(0)
+	}
+	kernel := variable("dense/kernel", tf.MakeShape(2, 2))
+	bias := variable("dense/bias", tf.MakeShape(2))
+	s, err := tf.NewSession(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	rename := func(name string) string { return strings.Replace(name, ".", "/", -1) }
+	tensors := map[string]*tf.Tensor{
+		"dense.kernel": tensor(t, [][]float32{{1, 2}, {3, 4}}),
+		"dense.bias":   tensor(t, []float32{5, 6}),
+	}
+	names, err := Inject(s, g, tensors, InjectOptions{Rename: rename})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"dense/bias", "dense/kernel"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("Got %v, want %v", names, want)
+	}
+	values, err := s.Run(nil, []tf.Output{kernel, bias}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := values[0].Value(), [][]float32{{1, 2}, {3, 4}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Got kernel %v, want %v", got, want)
+	}
+	if got, want := values[1].Value(), []float32{5, 6}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Got bias %v, want %v", got, want)
+	}
+
+	// Injecting again reuses the operations assigning the variables.
+	tensors["dense.bias"] = tensor(t, []float32{7, 8})
+	if _, err := Inject(s, g, tensors, InjectOptions{Rename: rename}); err != nil {
+		t.Fatal(err)
+	}
+	if g.Operation("AssignVariables/value_2") != nil || g.Operation("AssignVariables_1/value_0") != nil {
+		t.Error("Operations added to inject again")
+	}
+	if values, err = s.Run(nil, []tf.Output{bias}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := values[0].Value(), []float32{7, 8}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Got bias %v, want %v", got, want)
+	}
+
+	tests := []struct {
+		tensors map[string]*tf.Tensor
+		opts    InjectOptions
+		want    string
+	}{
+		{map[string]*tf.Tensor{"dense/kernel": tensors["dense.kernel"]}, InjectOptions{}, "no tensor for variables dense/bias"},
+		{map[string]*tf.Tensor{"dense/other": tensors["dense.bias"]}, InjectOptions{AllowMissing: true}, "no variable for tensors dense/other"},
+		{map[string]*tf.Tensor{"dense/bias": tensor(t, []float64{1, 2})}, InjectOptions{AllowMissing: true}, "float64 tensor"},
+		{map[string]*tf.Tensor{"dense/bias": tensor(t, []float32{1, 2, 3})}, InjectOptions{AllowMissing: true}, "shape [3]"},
+	}
+	for _, test := range tests {
+		if _, err := Inject(s, g, test.tensors, test.opts); err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("Got error %v, want an error containing %q", err, test.want)
+		}
+	}
+	if names, err := Inject(s, g, map[string]*tf.Tensor{"other": tensors["dense.bias"]}, InjectOptions{AllowMissing: true, AllowUnused: true}); err != nil || len(names) != 0 {
+		t.Errorf("Got %v, %v, want no variables assigned", names, err)
+	}
+}
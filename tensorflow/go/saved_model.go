@@ -15,7 +15,7 @@
 package tensorflow
 
 import (
-	"runtime"
+	"fmt"
 	"unsafe"
 )
 
@@ -24,10 +24,19 @@ import (
 import "C"
 
 // SavedModel represents the contents of loaded SavedModel.
-// TODO(jhseu): Add and document metagraphdef when we pregenerate protobufs.
 type SavedModel struct {
 	Session *Session
 	Graph   *Graph
+
+	// MetaGraphDef is the serialized tensorflow.MetaGraphDef protocol
+	// buffer
+	// (https://www.tensorflow.org/code/tensorflow/core/protobuf/meta_graph.proto)
+	// of the loaded graph, which includes its SignatureDefs. The
+	// signature package can be used to interpret them.
+	MetaGraphDef []byte
+
+	// update holds the operations added to Graph by UpdateVariables.
+	update variableUpdate
 }
 
 // LoadSavedModel creates a new SavedModel from a model previously
@@ -44,29 +53,52 @@ type SavedModel struct {
 // See:
 // https://www.tensorflow.org/code/tensorflow/python/saved_model/
 func LoadSavedModel(exportDir string, tags []string, options *SessionOptions) (*SavedModel, error) {
+	if len(tags) == 0 {
+		return nil, fmt.Errorf("at least one tag must be provided to identify the graph to load")
+	}
+	if err := requireCapability(CapabilitySavedModel); err != nil {
+		return nil, err
+	}
 	status := newStatus()
 	cOpt, doneOpt, err := options.c()
 	defer doneOpt()
 	if err != nil {
 		return nil, err
 	}
-	cExportDir := C.CString(exportDir)
+	cExportDir := C.CString(nativePath(exportDir))
 	cTags := make([]*C.char, len(tags))
 	for i := range tags {
 		cTags[i] = C.CString(tags[i])
 	}
 	graph := NewGraph()
-	// TODO(jhseu): Add support for run_options and meta_graph_def.
-	cSess := C.TF_LoadSessionFromSavedModel(cOpt, nil, cExportDir, (**C.char)(unsafe.Pointer(&cTags[0])), C.int(len(cTags)), graph.c, nil, status.c)
+	graph.ref() // For the Session; graph cannot have been closed yet.
+	metaGraphDef := C.TF_NewBuffer()
+	defer C.TF_DeleteBuffer(metaGraphDef)
+	// TODO(jhseu): Add support for run_options.
+	cSess := C.TF_LoadSessionFromSavedModel(cOpt, nil, cExportDir, (**C.char)(unsafe.Pointer(&cTags[0])), C.int(len(cTags)), graph.c, metaGraphDef, status.c)
 	for i := range cTags {
 		C.free(unsafe.Pointer(cTags[i]))
 	}
 	C.free(unsafe.Pointer(cExportDir))
 
 	if err := status.Err(); err != nil {
+		graph.unref()
+		graph.Close()
 		return nil, err
 	}
-	s := &Session{c: cSess}
-	runtime.SetFinalizer(s, func(s *Session) { s.Close() })
-	return &SavedModel{Session: s, Graph: graph}, nil
+	return &SavedModel{
+		Session:      newSession(cSess, graph),
+		Graph:        graph,
+		MetaGraphDef: C.GoBytes(metaGraphDef.data, C.int(metaGraphDef.length)),
+	}, nil
+}
+
+// Close releases the resources associated with the Session and the Graph of
+// the SavedModel without waiting for them to be garbage collected.
+func (m *SavedModel) Close() error {
+	err := m.Session.Close()
+	if cerr := m.Graph.Close(); err == nil {
+		err = cerr
+	}
+	return err
 }
@@ -24,6 +24,9 @@ func TestSavedModel(t *testing.T) {
 	if op := bundle.Graph.Operation("y"); op == nil {
 		t.Fatalf("\"y\" not found in graph")
 	}
+	if len(bundle.MetaGraphDef) == 0 {
+		t.Error("MetaGraphDef is empty")
+	}
 	// TODO(jhseu): half_plus_two has a tf.Example proto dependency to run. Add a
 	// more thorough test when the generated protobufs are available.
 }
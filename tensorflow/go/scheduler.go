@@ -0,0 +1,161 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Priority identifies a class of runs of a Scheduler: it is an index in
+// SchedulerOptions.Classes.
+type Priority int
+
+// PriorityClass configures the runs of a Priority.
+type PriorityClass struct {
+	// Weight is the share of the runs started by the Scheduler that go to
+	// this class while runs of several classes are waiting. For example,
+	// with weights of 9 for interactive requests and 1 for batch scoring,
+	// batch scoring gets one run in ten under contention but can use all
+	// the runs otherwise. Defaults to 1.
+	Weight int
+	// RunOptions, if not nil, are used for the runs of this class, for
+	// example to run them in a dedicated inter-op thread pool.
+	RunOptions *RunOptions
+}
+
+// SchedulerOptions configures a Scheduler.
+type SchedulerOptions struct {
+	// MaxConcurrentRuns is the number of runs executed at the same time.
+	// Defaults to 1.
+	MaxConcurrentRuns int
+	// Classes configures the priorities of runs, which are indices in
+	// Classes. There must be at least one class.
+	Classes []PriorityClass
+}
+
+// Scheduler schedules the runs of a Session by priority, so that
+// workloads sharing a Session, such as interactive requests and batch
+// scoring, are given shares of it. Runs in excess of MaxConcurrentRuns wait
+// in a queue per class, from which the next run is picked by weighted
+// round robin over the classes with waiting runs; runs of the same class
+// are started in order.
+//
+// The methods of Scheduler are safe for concurrent use.
+type Scheduler struct {
+	session *Session
+	classes []PriorityClass
+	max     int
+
+	mu      sync.Mutex
+	running int
+	queues  [][]chan struct{} // Of waiting runs, per class.
+	credits []int             // For the smooth weighted round robin.
+}
+
+// NewScheduler returns a Scheduler for the runs of session.
+func NewScheduler(session *Session, opts SchedulerOptions) (*Scheduler, error) {
+	if len(opts.Classes) == 0 {
+		return nil, fmt.Errorf("at least one PriorityClass must be provided")
+	}
+	if opts.MaxConcurrentRuns < 0 {
+		return nil, fmt.Errorf("invalid MaxConcurrentRuns %d", opts.MaxConcurrentRuns)
+	}
+	s := &Scheduler{
+		session: session,
+		classes: append([]PriorityClass(nil), opts.Classes...),
+		max:     opts.MaxConcurrentRuns,
+		queues:  make([][]chan struct{}, len(opts.Classes)),
+		credits: make([]int, len(opts.Classes)),
+	}
+	if s.max == 0 {
+		s.max = 1
+	}
+	for i := range s.classes {
+		if s.classes[i].Weight < 0 {
+			return nil, fmt.Errorf("invalid weight %d for Priority %d", s.classes[i].Weight, i)
+		}
+		if s.classes[i].Weight == 0 {
+			s.classes[i].Weight = 1
+		}
+	}
+	return s, nil
+}
+
+// Run is like Session.RunWithOptions, with the RunOptions of the class of
+// priority, once the Scheduler starts the run.
+func (s *Scheduler) Run(priority Priority, feeds map[Output]*Tensor, fetches []Output, targets []*Operation) ([]*Tensor, error) {
+	if priority < 0 || int(priority) >= len(s.classes) {
+		return nil, fmt.Errorf("invalid Priority %d", priority)
+	}
+	s.acquire(priority)
+	defer s.release()
+	return s.session.RunWithOptions(s.classes[priority].RunOptions, feeds, fetches, targets)
+}
+
+// Waiting returns the number of runs waiting to start, per Priority.
+func (s *Scheduler) Waiting() []int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := make([]int, len(s.queues))
+	for i, q := range s.queues {
+		n[i] = len(q)
+	}
+	return n
+}
+
+// acquire waits until a run of the given priority can start.
+func (s *Scheduler) acquire(priority Priority) {
+	s.mu.Lock()
+	if s.running < s.max {
+		s.running++
+		s.mu.Unlock()
+		return
+	}
+	ready := make(chan struct{})
+	s.queues[priority] = append(s.queues[priority], ready)
+	s.mu.Unlock()
+	<-ready
+}
+
+// release ends a run, starting the next waiting run, if any.
+func (s *Scheduler) release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	next, total := -1, 0
+	for i, q := range s.queues {
+		if len(q) == 0 {
+			continue
+		}
+		s.credits[i] += s.classes[i].Weight
+		total += s.classes[i].Weight
+		if next < 0 || s.credits[i] > s.credits[next] {
+			next = i
+		}
+	}
+	if next < 0 {
+		s.running--
+		return
+	}
+	s.credits[next] -= total
+	ready := s.queues[next][0]
+	s.queues[next] = s.queues[next][1:]
+	if len(s.queues[next]) == 0 {
+		// Credits are only meaningful while the class has waiting runs.
+		s.credits[next] = 0
+	}
+	// The slot of the ended run is handed over to the next run.
+	close(ready)
+}
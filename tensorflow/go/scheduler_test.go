@@ -0,0 +1,101 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSchedulerOrder(t *testing.T) {
+	const batch, interactive = Priority(0), Priority(1)
+	s, err := NewScheduler(nil, SchedulerOptions{Classes: []PriorityClass{{Weight: 1}, {Weight: 3}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Occupy the only slot while the runs are queued.
+	s.acquire(batch)
+	var (
+		wg    sync.WaitGroup
+		order []byte
+	)
+	for i, p := range []Priority{batch, batch, batch, batch, interactive, interactive, interactive, interactive} {
+		wg.Add(1)
+		go func(p Priority) {
+			defer wg.Done()
+			s.acquire(p)
+			order = append(order, "BI"[p])
+			s.release()
+		}(p)
+		// Wait for the run to be queued, so that runs of the same class
+		// are queued in order.
+		for {
+			w := s.Waiting()
+			if w[0]+w[1] == i+1 {
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+	s.release()
+	wg.Wait()
+	if got, want := string(order), "IBIIIBBB"; got != want {
+		t.Errorf("Got order %s, want %s", got, want)
+	}
+	if s.running != 0 {
+		t.Errorf("Got %d running runs after all runs ended", s.running)
+	}
+}
+
+func TestScheduler(t *testing.T) {
+	m := addModel(t, 1)
+	defer m.Close()
+	s, err := NewScheduler(m.Session, SchedulerOptions{
+		MaxConcurrentRuns: 2,
+		Classes:           []PriorityClass{{}, {Weight: 9, RunOptions: &RunOptions{}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	x, err := NewTensor([]float32{1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	feeds := map[Output]*Tensor{m.Graph.Operation("x").Output(0): x}
+	fetches := []Output{m.Graph.Operation("y").Output(0)}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(p Priority) {
+			defer wg.Done()
+			out, err := s.Run(p, feeds, fetches, nil)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if got := out[0].Value().([]float32); got[0] != 2 || got[1] != 3 {
+				t.Errorf("Got %v, want [2 3]", got)
+			}
+		}(Priority(i % 2))
+	}
+	wg.Wait()
+	if _, err := s.Run(2, feeds, fetches, nil); err == nil {
+		t.Error("Run accepted an invalid Priority")
+	}
+	if _, err := NewScheduler(m.Session, SchedulerOptions{}); err == nil {
+		t.Error("NewScheduler accepted no classes")
+	}
+}
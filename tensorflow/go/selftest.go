@@ -0,0 +1,234 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// SelfTestReport is the result of SelfTest.
+type SelfTestReport struct {
+	// Version is the version of the runtime.
+	Version string
+	// Devices are the devices found, starting with the CPU.
+	Devices []DeviceReport
+	// Duration is the time taken by SelfTest.
+	Duration time.Duration
+}
+
+// DeviceReport is the result of the checks of a device by SelfTest.
+type DeviceReport struct {
+	// Device is the name of the device (e.g., "/device:GPU:0").
+	Device string
+	// Checks are the checks run on the device.
+	Checks []SelfTestCheck
+	// CuDNN is true if the device is a GPU on which the convolutions of
+	// cuDNN ran.
+	CuDNN bool
+}
+
+// SelfTestCheck is the result of the run of an operation on a device.
+type SelfTestCheck struct {
+	// Op is the type of the operation checked, such as "MatMul".
+	Op string
+	// Err is the error running the operation or the wrong result it
+	// computed, nil if the check passed.
+	Err error
+	// Duration is the time taken by the check, including the creation of
+	// its session.
+	Duration time.Duration
+}
+
+// Err returns an error listing the checks that failed, or nil if all the
+// checks passed.
+func (r *SelfTestReport) Err() error {
+	var failed []string
+	for _, d := range r.Devices {
+		for _, c := range d.Checks {
+			if c.Err != nil {
+				failed = append(failed, fmt.Sprintf("%s on %s: %v", c.Op, d.Device, c.Err))
+			}
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("self test of TensorFlow %s failed: %s", r.Version, strings.Join(failed, "; "))
+}
+
+// maxSelfTestGPUs bounds the number of GPUs probed by SelfTest.
+const maxSelfTestGPUs = 64
+
+// SelfTest checks the health of the runtime, for example as the startup or
+// readiness probe of a service: it runs small computations on each device
+// visible to the process, the CPU and the GPUs, and checks their results.
+//
+// The C API does not list the devices of the runtime, so the GPUs are
+// found by placing an operation on "/device:GPU:0", "/device:GPU:1", ...
+// until one is not available. On GPUs, the Conv2D check runs the
+// convolutions of cuDNN, which the runtime only loads when first used.
+func SelfTest() *SelfTestReport {
+	start := time.Now()
+	r := &SelfTestReport{Version: Version()}
+	r.Devices = append(r.Devices, selfTestDevice("/device:CPU:0"))
+	for i := 0; i < maxSelfTestGPUs; i++ {
+		device := fmt.Sprintf("/device:GPU:%d", i)
+		if selfTestRun(device, nil) != nil {
+			break
+		}
+		r.Devices = append(r.Devices, selfTestDevice(device))
+	}
+	r.Duration = time.Since(start)
+	return r
+}
+
+func selfTestDevice(device string) DeviceReport {
+	d := DeviceReport{Device: device}
+	for i := range selfTestChecks {
+		check := &selfTestChecks[i]
+		start := time.Now()
+		err := selfTestRun(device, check)
+		d.Checks = append(d.Checks, SelfTestCheck{Op: check.op, Err: err, Duration: time.Since(start)})
+		if check.op == "Conv2D" && err == nil && strings.Contains(device, "GPU") {
+			d.CuDNN = true
+		}
+	}
+	return d
+}
+
+// selfTestChecks are the computations run by SelfTest on each device.
+var selfTestChecks = []selfTestCheck{
+	{
+		op: "MatMul",
+		build: func(b *selfTestGraph) (Output, error) {
+			x, err := b.constant([][]float32{{1, 2}, {3, 4}})
+			if err != nil {
+				return Output{}, err
+			}
+			return b.add("MatMul", []Input{x, x}, nil)
+		},
+		want: [][]float32{{7, 10}, {15, 22}},
+	},
+	{
+		op: "Add",
+		build: func(b *selfTestGraph) (Output, error) {
+			x, err := b.constant([]float32{1, 2, 3})
+			if err != nil {
+				return Output{}, err
+			}
+			return b.add("Add", []Input{x, x}, nil)
+		},
+		want: []float32{2, 4, 6},
+	},
+	{
+		op: "Conv2D",
+		build: func(b *selfTestGraph) (Output, error) {
+			input, err := b.constant([][][][]float32{{{{1}, {1}}, {{1}, {1}}}})
+			if err != nil {
+				return Output{}, err
+			}
+			filter, err := b.constant([][][][]float32{{{{2}}}})
+			if err != nil {
+				return Output{}, err
+			}
+			return b.add("Conv2D", []Input{input, filter}, map[string]interface{}{
+				"strides": []int64{1, 1, 1, 1},
+				"padding": "VALID",
+			})
+		},
+		want: [][][][]float32{{{{2}, {2}}, {{2}, {2}}}},
+	},
+}
+
+type selfTestCheck struct {
+	op    string
+	build func(*selfTestGraph) (Output, error)
+	want  interface{}
+}
+
+// selfTestRun runs check on device in a new graph and session, or only
+// places a NoOp on device if check is nil.
+//
+// Each check has its own graph since an operation that cannot be placed
+// fails the runs of all the operations of its graph.
+func selfTestRun(device string, check *selfTestCheck) error {
+	b := &selfTestGraph{g: NewGraph(), device: device}
+	var (
+		fetches []Output
+		targets []*Operation
+	)
+	if check == nil {
+		probe, err := b.g.AddOperation(OpSpec{Type: "NoOp", Name: "probe", Device: device})
+		if err != nil {
+			return err
+		}
+		targets = []*Operation{probe}
+	} else {
+		out, err := check.build(b)
+		if err != nil {
+			return err
+		}
+		fetches = []Output{out}
+	}
+	s, err := NewSession(b.g, nil)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+	out, err := s.Run(nil, fetches, targets)
+	if err != nil {
+		return err
+	}
+	if check != nil {
+		if got := out[0].Value(); !reflect.DeepEqual(got, check.want) {
+			return fmt.Errorf("got %v, want %v", got, check.want)
+		}
+	}
+	return nil
+}
+
+// selfTestGraph adds the operations of a check to a graph, placing them on
+// a device.
+type selfTestGraph struct {
+	g      *Graph
+	device string
+	n      int
+}
+
+func (b *selfTestGraph) add(typ string, inputs []Input, attrs map[string]interface{}) (Output, error) {
+	b.n++
+	op, err := b.g.AddOperation(OpSpec{
+		Type:   typ,
+		Name:   fmt.Sprintf("%s_%d", typ, b.n),
+		Input:  inputs,
+		Attrs:  attrs,
+		Device: b.device,
+	})
+	if err != nil {
+		return Output{}, err
+	}
+	return op.Output(0), nil
+}
+
+func (b *selfTestGraph) constant(value interface{}) (Output, error) {
+	t, err := NewTensor(value)
+	if err != nil {
+		return Output{}, err
+	}
+	return b.add("Const", nil, map[string]interface{}{"dtype": t.DataType(), "value": t})
+}
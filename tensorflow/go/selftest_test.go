@@ -0,0 +1,48 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSelfTest(t *testing.T) {
+	r := SelfTest()
+	if err := r.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Devices) == 0 || r.Devices[0].Device != "/device:CPU:0" {
+		t.Fatalf("Got devices %+v, want the CPU first", r.Devices)
+	}
+	cpu := r.Devices[0]
+	if len(cpu.Checks) != len(selfTestChecks) || cpu.CuDNN {
+		t.Errorf("Got %+v", cpu)
+	}
+	if r.Version != Version() {
+		t.Errorf("Got version %q, want %q", r.Version, Version())
+	}
+}
+
+func TestSelfTestReportErr(t *testing.T) {
+	r := &SelfTestReport{Version: "1.0.0", Devices: []DeviceReport{{
+		Device: "/device:GPU:0",
+		Checks: []SelfTestCheck{{Op: "MatMul"}, {Op: "Conv2D", Err: errors.New("no cuDNN")}},
+	}}}
+	want := "self test of TensorFlow 1.0.0 failed: Conv2D on /device:GPU:0: no cuDNN"
+	if err := r.Err(); err == nil || err.Error() != want {
+		t.Errorf("Got %v, want %q", err, want)
+	}
+}
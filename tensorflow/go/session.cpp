@@ -12,8 +12,10 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+// +build !libtensorflow_1_1
+
 // TODO(ashankar): Remove this file when TensorFlow 1.1 is released.
-// See lib.go for details.
+// See prun_handle.go for details.
 
 extern "C" {
 extern void tfDeletePRunHandle(const char* h);
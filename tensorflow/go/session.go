@@ -35,7 +35,12 @@ import (
 // perform the computation and potentially fetch outputs as Tensors.
 // A Session allows concurrent calls to Run().
 type Session struct {
-	c *C.TF_Session
+	c     *C.TF_Session
+	graph *Graph
+
+	// dryRuns holds the graphs of DryRun, EstimateCost and the runs with
+	// a MemoryLimit.
+	dryRuns dryGraphCache
 
 	// For ensuring that:
 	// - Close() blocks on all Run() calls to complete.
@@ -53,14 +58,28 @@ func NewSession(graph *Graph, options *SessionOptions) (*Session, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := graph.ref(); err != nil {
+		return nil, err
+	}
 	cSess := C.TF_NewSession(graph.c, cOpt, status.c)
 	if err := status.Err(); err != nil {
+		graph.unref()
 		return nil, err
 	}
+	return newSession(cSess, graph), nil
+}
+
+// newSession returns the Session owning c, which was created with graph. The
+// caller must have called graph.ref.
+func newSession(c *C.TF_Session, graph *Graph) *Session {
+	s := &Session{c: c, graph: graph}
+	runtime.SetFinalizer(s, (*Session).finalizer)
+	return s
+}
 
-	s := &Session{c: cSess}
-	runtime.SetFinalizer(s, func(s *Session) { s.Close() })
-	return s, nil
+func (s *Session) finalizer() {
+	finalized("Session")
+	s.Close()
 }
 
 // Run the graph with the associated session starting with the supplied feeds
@@ -71,6 +90,12 @@ func NewSession(graph *Graph, options *SessionOptions) (*Session, error) {
 // the fetches argument. If fetches is set to nil, the returned Tensor fetches
 // is empty.
 func (s *Session) Run(feeds map[Output]*Tensor, fetches []Output, targets []*Operation) ([]*Tensor, error) {
+	return s.run(nil, nil, feeds, fetches, targets)
+}
+
+// run runs the session with the serialized RunOptions runOptions, storing
+// the serialized RunMetadata in runMetadata if not nil.
+func (s *Session) run(runOptions, runMetadata *C.TF_Buffer, feeds map[Output]*Tensor, fetches []Output, targets []*Operation) ([]*Tensor, error) {
 	s.mu.Lock()
 	if s.c == nil {
 		s.mu.Unlock()
@@ -80,13 +105,16 @@ func (s *Session) Run(feeds map[Output]*Tensor, fetches []Output, targets []*Ope
 	s.mu.Unlock()
 	defer s.wg.Done()
 
-	c := newCRunArgs(feeds, fetches, targets)
+	c, err := newCRunArgs(feeds, fetches, targets)
+	if err != nil {
+		return nil, err
+	}
 	status := newStatus()
-	C.TF_SessionRun(s.c, nil,
+	C.TF_SessionRun(s.c, runOptions,
 		ptrOutput(c.feeds), ptrTensor(c.feedTensors), C.int(len(feeds)),
 		ptrOutput(c.fetches), ptrTensor(c.fetchTensors), C.int(len(fetches)),
 		ptrOperation(c.targets), C.int(len(targets)),
-		nil, status.c)
+		runMetadata, status.c)
 	if err := status.Err(); err != nil {
 		return nil, err
 	}
@@ -111,16 +139,32 @@ func (s *Session) Run(feeds map[Output]*Tensor, fetches []Output, targets []*Ope
 type PartialRun struct {
 	session *Session
 	handle  *C.char
+
+	// For ensuring that Close() blocks on all Run() calls to complete.
+	wg sync.WaitGroup
+	mu sync.Mutex
 }
 
 // Run resumes execution of the graph to compute the requested fetches and
 // targets with the provided feeds.
 func (pr *PartialRun) Run(feeds map[Output]*Tensor, fetches []Output, targets []*Operation) ([]*Tensor, error) {
 	var (
-		c      = newCRunArgs(feeds, fetches, targets)
 		status = newStatus()
 		s      = pr.session
 	)
+	c, err := newCRunArgs(feeds, fetches, targets)
+	if err != nil {
+		return nil, err
+	}
+	pr.mu.Lock()
+	if pr.handle == nil {
+		pr.mu.Unlock()
+		return nil, errors.New("partial run is closed")
+	}
+	pr.wg.Add(1)
+	pr.mu.Unlock()
+	defer pr.wg.Done()
+
 	s.mu.Lock()
 	if s.c == nil {
 		s.mu.Unlock()
@@ -148,6 +192,9 @@ func (pr *PartialRun) Run(feeds map[Output]*Tensor, fetches []Output, targets []
 //
 // See documentation for the PartialRun type.
 func (s *Session) NewPartialRun(feeds, fetches []Output, targets []*Operation) (*PartialRun, error) {
+	if err := requireCapability(CapabilityPartialRun); err != nil {
+		return nil, err
+	}
 	var (
 		cfeeds   = make([]C.TF_Output, len(feeds))
 		cfetches = make([]C.TF_Output, len(fetches))
@@ -162,19 +209,28 @@ func (s *Session) NewPartialRun(feeds, fetches []Output, targets []*Operation) (
 	if len(feeds) > 0 {
 		pcfeeds = &cfeeds[0]
 		for i, o := range feeds {
+			if err := o.Op.resolve(); err != nil {
+				return nil, err
+			}
 			cfeeds[i] = o.c()
 		}
 	}
 	if len(fetches) > 0 {
 		pcfetches = &cfetches[0]
 		for i, o := range fetches {
+			if err := o.Op.resolve(); err != nil {
+				return nil, err
+			}
 			cfetches[i] = o.c()
 		}
 	}
 	if len(targets) > 0 {
 		pctargets = &ctargets[0]
 		for i, o := range targets {
-			ctargets[i] = o.c
+			if err := o.resolve(); err != nil {
+				return nil, err
+			}
+			ctargets[i] = o.cop()
 		}
 	}
 
@@ -196,14 +252,37 @@ func (s *Session) NewPartialRun(feeds, fetches []Output, targets []*Operation) (
 	if err := status.Err(); err != nil {
 		return nil, err
 	}
-	runtime.SetFinalizer(pr, func(pr *PartialRun) {
-		deletePRunHandle(pr.handle)
-	})
+	runtime.SetFinalizer(pr, (*PartialRun).finalizer)
 	return pr, nil
 }
 
+func (pr *PartialRun) finalizer() {
+	finalized("PartialRun")
+	deletePRunHandle(pr.handle)
+}
+
+// Close releases the resources associated with the PartialRun without waiting
+// for it to be garbage collected. Blocks until all previous calls to Run have
+// returned. Close may be called multiple times.
+func (pr *PartialRun) Close() error {
+	pr.mu.Lock()
+	defer pr.mu.Unlock()
+	pr.wg.Wait()
+	if pr.handle == nil {
+		return nil
+	}
+	runtime.SetFinalizer(pr, nil)
+	deletePRunHandle(pr.handle)
+	pr.handle = nil
+	return nil
+}
+
 // Close a session. This contacts any other processes associated with this
 // session, if applicable. Blocks until all previous calls to Run have returned.
+//
+// Close releases the resources associated with the session, and its reference
+// to the Graph it was created with, without waiting for the session to be
+// garbage collected. Close may be called multiple times.
 func (s *Session) Close() error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -218,6 +297,9 @@ func (s *Session) Close() error {
 	}
 	C.TF_DeleteSession(s.c, status.c)
 	s.c = nil
+	runtime.SetFinalizer(s, nil)
+	s.dryRuns.close()
+	s.graph.unref()
 	return status.Err()
 }
 
@@ -252,6 +334,13 @@ type SessionOptions struct {
 	// tensorflow.ConfigProto protocol message
 	// (https://www.tensorflow.org/code/tensorflow/core/protobuf/config.proto).
 	Config []byte
+
+	// InterOpThreadPools configures the inter-op thread pools of the
+	// session, which are selected for each run with
+	// RunOptions.InterOpThreadPool. If empty, the pools configured by
+	// Config (or the runtime defaults) are used. Otherwise, the pools are
+	// added to those configured by Config.
+	InterOpThreadPools []ThreadPoolOptions
 }
 
 // c converts the SessionOptions to the C API's TF_SessionOptions. Callers must
@@ -266,14 +355,14 @@ func (o *SessionOptions) c() (ret *C.TF_SessionOptions, done func(), err error)
 	C.free(unsafe.Pointer(t))
 
 	var cConfig unsafe.Pointer
-	if sz := len(o.Config); sz > 0 {
+	if config := o.config(); len(config) > 0 {
 		status := newStatus()
 		// Copying into C-memory is the simplest thing to do in terms
 		// of memory safety and cgo rules ("C code may not keep a copy
 		// of a Go pointer after the call returns" from
 		// https://golang.org/cmd/cgo/#hdr-Passing_pointers).
-		cConfig = C.CBytes(o.Config)
-		C.TF_SetConfig(opt, cConfig, C.size_t(sz), status.c)
+		cConfig = C.CBytes(config)
+		C.TF_SetConfig(opt, cConfig, C.size_t(len(config)), status.c)
 		if err := status.Err(); err != nil {
 			C.TF_DeleteSessionOptions(opt)
 			return nil, func() {}, fmt.Errorf("invalid SessionOptions.Config: %v", err)
@@ -295,23 +384,47 @@ type cRunArgs struct {
 	targets      []*C.TF_Operation
 }
 
-func newCRunArgs(feeds map[Output]*Tensor, fetches []Output, targets []*Operation) *cRunArgs {
+func newCRunArgs(feeds map[Output]*Tensor, fetches []Output, targets []*Operation) (*cRunArgs, error) {
 	c := &cRunArgs{
 		fetches:      make([]C.TF_Output, len(fetches)),
 		fetchTensors: make([]*C.TF_Tensor, len(fetches)),
 		targets:      make([]*C.TF_Operation, len(targets)),
 	}
 	for o, t := range feeds {
+		if o.Op == nil {
+			return nil, fmt.Errorf("feeds contains an Output with no Operation")
+		}
+		if t == nil {
+			return nil, fmt.Errorf("nil Tensor fed for %v:%d", o.Op.Name(), o.Index)
+		}
+		if t.c == nil {
+			return nil, fmt.Errorf("released Tensor fed for %v:%d", o.Op.Name(), o.Index)
+		}
+		if err := o.Op.resolve(); err != nil {
+			return nil, err
+		}
 		c.feeds = append(c.feeds, o.c())
 		c.feedTensors = append(c.feedTensors, t.c)
 	}
 	for i, o := range fetches {
+		if o.Op == nil {
+			return nil, fmt.Errorf("fetches[%d] is an Output with no Operation", i)
+		}
+		if err := o.Op.resolve(); err != nil {
+			return nil, err
+		}
 		c.fetches[i] = o.c()
 	}
 	for i, t := range targets {
-		c.targets[i] = t.c
+		if t == nil {
+			return nil, fmt.Errorf("targets[%d] is nil", i)
+		}
+		if err := t.resolve(); err != nil {
+			return nil, err
+		}
+		c.targets[i] = t.cop()
 	}
-	return c
+	return c, nil
 }
 
 func (c *cRunArgs) toGo() []*Tensor {
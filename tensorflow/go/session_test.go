@@ -182,6 +182,38 @@ func TestConcurrency(t *testing.T) {
 	}
 }
 
+func TestCloseGraphBeforeSession(t *testing.T) {
+	tensor, err := NewTensor(int64(1))
+	if err != nil {
+		t.Fatalf("NewTensor(): %v", err)
+	}
+	graph, inp, out := createTestGraph(t, tensor.DataType())
+	s, err := NewSession(graph, nil)
+	if err != nil {
+		t.Fatalf("NewSession(): %v", err)
+	}
+	defer s.Close()
+	if err := graph.Close(); err != nil {
+		t.Fatalf("Graph.Close(): %v", err)
+	}
+	if _, err := NewSession(graph, nil); err == nil {
+		t.Error("NewSession() with a closed graph should fail")
+	}
+	// The session keeps the graph alive.
+	output, err := s.Run(map[Output]*Tensor{inp: tensor}, []Output{out}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := output[0].Value().(int64), int64(-1); got != want {
+		t.Errorf("Got %v, want %v", got, want)
+	}
+	output[0].Release()
+	tensor.Release()
+	if _, err := s.Run(map[Output]*Tensor{inp: tensor}, []Output{out}, nil); err == nil {
+		t.Error("Run() with a released tensor should fail")
+	}
+}
+
 func ExamplePartialRun() {
 	var (
 		// Create a graph: a + 2 + 3 + b.
@@ -281,3 +313,72 @@ func TestSessionConfig(t *testing.T) {
 		t.Fatalf("Got %v, want -1", output[0].Value())
 	}
 }
+
+func TestResourceHandles(t *testing.T) {
+	g := NewGraph()
+	queue, err := g.AddOperation(OpSpec{
+		Type:  "FIFOQueueV2",
+		Name:  "queue",
+		Attrs: map[string]interface{}{"component_types": []DataType{Float}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	handle, err := Placeholder(g, "handle", Resource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := Const(g, "value", float32(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	enqueue, err := g.AddOperation(OpSpec{
+		Type:  "QueueEnqueueV2",
+		Name:  "enqueue",
+		Input: []Input{handle, OutputList{value}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dequeue, err := g.AddOperation(OpSpec{
+		Type:  "QueueDequeueV2",
+		Name:  "dequeue",
+		Input: []Input{handle},
+		Attrs: map[string]interface{}{"component_types": []DataType{Float}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewSession(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	// Fetch the handle of the queue, and use it in later runs.
+	out, err := s.Run(nil, []Output{queue.Output(0)}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := out[0]
+	if h.DataType() != Resource {
+		t.Fatalf("Got a %v Tensor, want %v", h.DataType(), Resource)
+	}
+	if _, err := h.DecodeValue(); err == nil {
+		t.Error("DecodeValue succeeded for a resource handle")
+	}
+	feeds := map[Output]*Tensor{handle: h}
+	if _, err := s.Run(feeds, nil, []*Operation{enqueue}); err != nil {
+		t.Fatal(err)
+	}
+	out, err = s.Run(feeds, []Output{dequeue.Output(0)}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := out[0].Value().(float32); got != 3 {
+		t.Errorf("Got %v, want 3", got)
+	}
+	if _, err := runCacheKey(feeds, nil); err == nil {
+		t.Error("runCacheKey accepted a resource handle")
+	}
+}
@@ -16,6 +16,7 @@ package tensorflow
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -57,7 +58,7 @@ func (s Shape) NumDimensions() int {
 //
 // REQUIRES: 0 <= dim < s.NumDimensions()
 func (s Shape) Size(dim int) int64 {
-	if dim < 0 || dim > s.NumDimensions() {
+	if dim < 0 || dim >= s.NumDimensions() {
 		return -1
 	}
 	return s.dims[dim]
@@ -70,7 +71,7 @@ func (s Shape) IsFullySpecified() bool {
 		return false
 	}
 	for _, size := range s.dims {
-		if size <= 1 {
+		if size < 0 {
 			return false
 		}
 	}
@@ -88,15 +89,162 @@ func (s Shape) ToSlice() ([]int64, error) {
 	return cpy, nil
 }
 
+// IsCompatibleWith returns true iff s and o could represent the same shape,
+// i.e., iff they can be merged.
+func (s Shape) IsCompatibleWith(o Shape) bool {
+	_, err := s.Merge(o)
+	return err == nil
+}
+
+// Merge returns a Shape combining the information in s and o, or an error if
+// they are not compatible. For example, merging [?, 2] with [3, ?] returns
+// [3, 2], while merging [2] with [3] or [2] with [2, 3] fails.
+func (s Shape) Merge(o Shape) (Shape, error) {
+	if s.dims == nil {
+		return o.clone(), nil
+	}
+	if o.dims == nil {
+		return s.clone(), nil
+	}
+	if len(s.dims) != len(o.dims) {
+		return Shape{}, fmt.Errorf("shapes %v and %v have different numbers of dimensions", s, o)
+	}
+	dims := make([]int64, len(s.dims))
+	for i := range dims {
+		d, ok := mergeDim(s.dims[i], o.dims[i])
+		if !ok {
+			return Shape{}, fmt.Errorf("shapes %v and %v are not compatible: dimension %d differs", s, o, i)
+		}
+		dims[i] = d
+	}
+	return Shape{dims: dims}, nil
+}
+
+// Concatenate returns the Shape whose dimensions are those of s followed by
+// those of o. The number of dimensions of the result is unknown if either
+// is.
+func (s Shape) Concatenate(o Shape) Shape {
+	if s.dims == nil || o.dims == nil {
+		return Shape{}
+	}
+	dims := make([]int64, 0, len(s.dims)+len(o.dims))
+	return Shape{dims: append(append(dims, s.dims...), o.dims...)}
+}
+
+// BroadcastShapes returns the shape resulting from broadcasting tensors of
+// shapes a and b against each other, following the NumPy broadcasting rules
+// used by operations like Add and Mul. An error is returned if the shapes
+// cannot be broadcast.
+func BroadcastShapes(a, b Shape) (Shape, error) {
+	if a.dims == nil || b.dims == nil {
+		return Shape{}, nil
+	}
+	long, short := a, b
+	if len(long.dims) < len(short.dims) {
+		long, short = b, a
+	}
+	// Dimensions are aligned starting with the last one.
+	dims := long.clone().dims
+	offset := len(long.dims) - len(short.dims)
+	for i, y := range short.dims {
+		x := dims[offset+i]
+		switch {
+		case x < 0 && y < 0:
+			dims[offset+i] = -1
+		case x < 0:
+			// If y is 1, the result depends on the unknown x.
+			if y > 1 {
+				dims[offset+i] = y
+			}
+		case y < 0:
+			if x <= 1 {
+				dims[offset+i] = -1
+			}
+		case x == 1:
+			dims[offset+i] = y
+		case y == 1 || x == y:
+		default:
+			return Shape{}, fmt.Errorf("shapes %v and %v cannot be broadcast: dimensions %d and %d are not compatible", a, b, x, y)
+		}
+	}
+	return Shape{dims: dims}, nil
+}
+
+// ConcatShapes returns the shape resulting from concatenating tensors of the
+// given shapes along the dimension axis, as done by the Concat operation. A
+// negative axis counts from the last dimension. The sizes of the other
+// dimensions must be compatible.
+func ConcatShapes(axis int, shapes ...Shape) (Shape, error) {
+	if len(shapes) == 0 {
+		return Shape{}, fmt.Errorf("no shapes to concatenate")
+	}
+	// Merging all shapes, with the concatenation dimension masked, checks
+	// their compatibility.
+	var ret Shape
+	for _, s := range shapes {
+		if s.dims == nil {
+			continue
+		}
+		if axis < -len(s.dims) || axis >= len(s.dims) {
+			return Shape{}, fmt.Errorf("concatenation axis %d out of range for shape %v", axis, s)
+		}
+		masked := s.clone()
+		masked.dims[(axis+len(s.dims))%len(s.dims)] = -1
+		var err error
+		if ret, err = ret.Merge(masked); err != nil {
+			return Shape{}, err
+		}
+	}
+	if ret.dims == nil {
+		return ret, nil
+	}
+	axis = (axis + len(ret.dims)) % len(ret.dims)
+	var size int64
+	for _, s := range shapes {
+		if s.dims == nil || s.dims[axis] < 0 {
+			size = -1
+			break
+		}
+		size += s.dims[axis]
+	}
+	ret.dims[axis] = size
+	return ret, nil
+}
+
+// clone returns a copy of s that does not share its dimensions.
+func (s Shape) clone() Shape {
+	if s.dims == nil {
+		return Shape{}
+	}
+	return MakeShape(s.dims...)
+}
+
+// mergeDim returns the merged size of two dimensions, which may be unknown
+// (-1), or false if they are not compatible.
+func mergeDim(a, b int64) (int64, bool) {
+	switch {
+	case a < 0:
+		return b, true
+	case b < 0, a == b:
+		return a, true
+	}
+	return 0, false
+}
+
+// String returns a representation of s in which unknown sizes are shown as
+// "?", for example "[?, 2, 3]". A shape with an unknown number of dimensions
+// is represented as "?".
 func (s Shape) String() string {
 	if s.dims == nil {
 		return "?"
 	}
-	ret := fmt.Sprint(s.dims)
-	for _, size := range s.dims {
+	sizes := make([]string, len(s.dims))
+	for i, size := range s.dims {
 		if size < 0 {
-			ret = strings.Replace(ret, fmt.Sprint(size), "?", 1)
+			sizes[i] = "?"
+		} else {
+			sizes[i] = strconv.FormatInt(size, 10)
 		}
 	}
-	return strings.Replace(ret, " ", ", ", -1)
+	return "[" + strings.Join(sizes, ", ") + "]"
 }
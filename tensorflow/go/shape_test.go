@@ -45,6 +45,18 @@ func TestShape(t *testing.T) {
 			full:  true,
 			str:   "[2, 3]",
 		},
+		{
+			shape: MakeShape(1, 0, -12),
+			slice: []int64{1, 0, -12},
+			full:  false,
+			str:   "[1, 0, ?]",
+		},
+		{
+			shape: MakeShape(1, 0),
+			slice: []int64{1, 0},
+			full:  true,
+			str:   "[1, 0]",
+		},
 	}
 	for _, test := range tests {
 		t.Run(fmt.Sprintf("%#v", test.shape), func(t *testing.T) {
@@ -60,6 +72,9 @@ func TestShape(t *testing.T) {
 			if got, want := test.shape.String(), test.str; got != want {
 				t.Errorf("Got %v, want %v", got, want)
 			}
+			if got := test.shape.Size(len(test.slice)); got != -1 {
+				t.Errorf("Size of a non-existent dimension: got %v, want -1", got)
+			}
 		})
 	}
 
@@ -81,3 +96,117 @@ func TestZeroShape(t *testing.T) {
 	}
 
 }
+
+func TestShapeMerge(t *testing.T) {
+	tests := []struct {
+		a, b Shape
+		want string // Empty if the shapes are not compatible.
+	}{
+		{Shape{}, Shape{}, "?"},
+		{Shape{}, MakeShape(2, -1), "[2, ?]"},
+		{MakeShape(-1, 2), Shape{}, "[?, 2]"},
+		{ScalarShape(), ScalarShape(), "[]"},
+		{MakeShape(-1, 2), MakeShape(3, -1), "[3, 2]"},
+		{MakeShape(2, 3), MakeShape(2, 3), "[2, 3]"},
+		{MakeShape(2), MakeShape(3), ""},
+		{MakeShape(2), MakeShape(2, 3), ""},
+		{ScalarShape(), MakeShape(1), ""},
+	}
+	for _, test := range tests {
+		got, err := test.a.Merge(test.b)
+		if compatible := test.a.IsCompatibleWith(test.b); compatible != (test.want != "") {
+			t.Errorf("%v.IsCompatibleWith(%v) = %v", test.a, test.b, compatible)
+		}
+		if test.want == "" {
+			if err == nil {
+				t.Errorf("%v.Merge(%v) = %v, want error", test.a, test.b, got)
+			}
+			continue
+		}
+		if err != nil || got.String() != test.want {
+			t.Errorf("%v.Merge(%v) = (%v, %v), want %v", test.a, test.b, got, err, test.want)
+		}
+	}
+}
+
+func TestShapeConcatenate(t *testing.T) {
+	tests := []struct {
+		a, b Shape
+		want string
+	}{
+		{MakeShape(2, -1), MakeShape(3), "[2, ?, 3]"},
+		{ScalarShape(), MakeShape(3), "[3]"},
+		{ScalarShape(), ScalarShape(), "[]"},
+		{Shape{}, MakeShape(3), "?"},
+		{MakeShape(3), Shape{}, "?"},
+	}
+	for _, test := range tests {
+		if got := test.a.Concatenate(test.b).String(); got != test.want {
+			t.Errorf("%v.Concatenate(%v) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestBroadcastShapes(t *testing.T) {
+	tests := []struct {
+		a, b Shape
+		want string // Empty if the shapes cannot be broadcast.
+	}{
+		{MakeShape(2, 3), MakeShape(2, 3), "[2, 3]"},
+		{MakeShape(2, 3), ScalarShape(), "[2, 3]"},
+		{MakeShape(3), MakeShape(4, 1), "[4, 3]"},
+		{MakeShape(1, 3), MakeShape(4, 1), "[4, 3]"},
+		{MakeShape(-1, 3), MakeShape(5, 1), "[5, 3]"},
+		{MakeShape(-1), MakeShape(1), "[?]"},
+		{MakeShape(-1), MakeShape(-1), "[?]"},
+		{MakeShape(2), MakeShape(-1), "[2]"},
+		{Shape{}, MakeShape(2), "?"},
+		{MakeShape(2), MakeShape(3), ""},
+		{MakeShape(2, 3), MakeShape(3, 3), ""},
+	}
+	for _, test := range tests {
+		got, err := BroadcastShapes(test.a, test.b)
+		if test.want == "" {
+			if err == nil {
+				t.Errorf("BroadcastShapes(%v, %v) = %v, want error", test.a, test.b, got)
+			}
+			continue
+		}
+		if err != nil || got.String() != test.want {
+			t.Errorf("BroadcastShapes(%v, %v) = (%v, %v), want %v", test.a, test.b, got, err, test.want)
+		}
+	}
+}
+
+func TestConcatShapes(t *testing.T) {
+	tests := []struct {
+		axis   int
+		shapes []Shape
+		want   string // Empty if the shapes cannot be concatenated.
+	}{
+		{0, []Shape{MakeShape(2, 3), MakeShape(4, 3)}, "[6, 3]"},
+		{1, []Shape{MakeShape(2, 3), MakeShape(2, 1), MakeShape(2, 2)}, "[2, 6]"},
+		{-1, []Shape{MakeShape(2, 3), MakeShape(2, 1)}, "[2, 4]"},
+		{0, []Shape{MakeShape(-1, 3), MakeShape(4, -1)}, "[?, 3]"},
+		{1, []Shape{MakeShape(-1, 3), MakeShape(4, 1)}, "[4, 4]"},
+		{0, []Shape{Shape{}, MakeShape(4, 3)}, "[?, 3]"},
+		{0, []Shape{Shape{}, Shape{}}, "?"},
+		{0, []Shape{MakeShape(2, 3), MakeShape(2, 4)}, ""},
+		{0, []Shape{MakeShape(2, 3), MakeShape(2)}, ""},
+		{2, []Shape{MakeShape(2, 3)}, ""},
+		{0, []Shape{ScalarShape()}, ""},
+		{0, nil, ""},
+	}
+	for _, test := range tests {
+		got, err := ConcatShapes(test.axis, test.shapes...)
+		if test.want == "" {
+			if err == nil {
+				t.Errorf("ConcatShapes(%d, %v) = %v, want error", test.axis, test.shapes, got)
+			}
+			continue
+		}
+		if err != nil || got.String() != test.want {
+			t.Errorf("ConcatShapes(%d, %v) = (%v, %v), want %v", test.axis, test.shapes, got, err, test.want)
+		}
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sidecar moves the large constants of frozen TensorFlow graphs to
+// weight files stored next to the graphs, so that deployment systems
+// control how multi-gigabyte weights are stored and loaded instead of
+// embedding them in the GraphDef.
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package sidecar
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/arena"
+	"github.com/tensorflow/tensorflow/tensorflow/go/codec"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+	"github.com/tensorflow/tensorflow/tensorflow/go/safetensors"
+)
+
+// Mode is how the constants moved out of a graph are loaded.
+type Mode int
+
+const (
+	// Feed replaces the constants by placeholders, whose values are read
+	// from the FeedsFile of the directory by Feeds and fed to every run.
+	Feed Mode = iota
+	// Mapped replaces the constants by ImmutableConst operations, which
+	// memory map their values from one file per constant when the graph
+	// is run, as an arena.Arena in Dir does. Only constants of numeric
+	// types placed on the CPU can be mapped.
+	Mapped
+)
+
+// FeedsFile is the name of the safetensors file to which Extract writes the
+// constants in the Feed mode.
+const FeedsFile = "weights.safetensors"
+
+// Options configures Extract.
+type Options struct {
+	Mode Mode
+	// Dir is the directory to which the weight files are written. It is
+	// created if needed.
+	Dir string
+	// MappedDir is the directory from which the ImmutableConst operations
+	// read their files in the Mapped mode, for graphs run on other hosts
+	// than the one extracting them. Defaults to the absolute path of Dir.
+	MappedDir string
+	// MinBytes is the minimum size of the constants moved out of the
+	// graph. Defaults to 1MB.
+	MinBytes int
+	// Select, if not nil, reports whether the constant name of at least
+	// MinBytes bytes is moved out of the graph.
+	Select func(name string) bool
+}
+
+// Stats describes the changes made by Extract.
+type Stats struct {
+	// Extracted lists the names of the constants moved out of the graph.
+	Extracted []string
+	// Files lists the paths of the files holding the extracted
+	// constants, relative to Options.Dir.
+	Files []string
+	// Bytes is the size of the extracted constants.
+	Bytes int64
+}
+
+// Extract moves the constants of a graph, given as a serialized
+// tensorflow.GraphDef protocol buffer
+// (https://www.tensorflow.org/code/tensorflow/core/framework/graph.proto),
+// to weight files in opts.Dir and returns the serialized rewritten graph.
+// Every operation keeps its name, so the feeds and fetches of the graph are
+// unchanged, but in the Feed mode the extracted constants must be fed too.
+//
+// String constants are left in the graph.
+func Extract(graphDef []byte, opts Options) ([]byte, *Stats, error) {
+	if opts.MinBytes == 0 {
+		opts.MinBytes = 1 << 20
+	}
+	if opts.Dir == "" {
+		return nil, nil, fmt.Errorf("no directory for the weight files")
+	}
+	switch opts.Mode {
+	case Feed:
+	case Mapped:
+		return extractMapped(graphDef, opts)
+	default:
+		return nil, nil, fmt.Errorf("invalid mode %d", opts.Mode)
+	}
+	if err := os.MkdirAll(opts.Dir, 0755); err != nil {
+		return nil, nil, err
+	}
+	var def pb.GraphDef
+	if err := proto.Unmarshal(graphDef, &def); err != nil {
+		return nil, nil, fmt.Errorf("invalid GraphDef: %v", err)
+	}
+	stats := new(Stats)
+	feeds := make(map[string]*tf.Tensor)
+	for _, n := range def.Node {
+		if !extractable(n, opts) {
+			continue
+		}
+		b, err := proto.Marshal(n.Attr["value"].GetTensor())
+		if err != nil {
+			return nil, nil, err
+		}
+		t, err := codec.Proto.Decode(b)
+		if err != nil {
+			return nil, nil, fmt.Errorf("constant %q: %v", n.Name, err)
+		}
+		var content bytes.Buffer
+		if _, err := t.WriteContentsTo(&content); err != nil {
+			return nil, nil, fmt.Errorf("constant %q: %v", n.Name, err)
+		}
+		if content.Len() < opts.MinBytes {
+			continue
+		}
+		feeds[n.Name] = t
+		n.Op = "Placeholder"
+		n.Attr = map[string]*pb.AttrValue{
+			"dtype": n.Attr["dtype"],
+			"shape": {Value: &pb.AttrValue_Shape{Shape: shapeProto(t.Shape())}},
+		}
+		stats.Extracted = append(stats.Extracted, n.Name)
+		stats.Bytes += int64(content.Len())
+	}
+	if len(feeds) > 0 {
+		var buf bytes.Buffer
+		if err := safetensors.Write(&buf, feeds, nil); err != nil {
+			return nil, nil, err
+		}
+		if err := writeFile(filepath.Join(opts.Dir, FeedsFile), buf.Bytes()); err != nil {
+			return nil, nil, err
+		}
+		stats.Files = append(stats.Files, FeedsFile)
+	}
+	sort.Strings(stats.Extracted)
+	out, err := proto.Marshal(&def)
+	if err != nil {
+		return nil, nil, err
+	}
+	return out, stats, nil
+}
+
+// extractMapped is Extract in the Mapped mode.
+func extractMapped(graphDef []byte, opts Options) ([]byte, *Stats, error) {
+	a, err := arena.New(opts.Dir, arena.Options{
+		MinBytes:  opts.MinBytes,
+		Select:    opts.Select,
+		MappedDir: opts.MappedDir,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	def, mapped, err := a.Rewrite(graphDef)
+	if err != nil {
+		return nil, nil, err
+	}
+	stats := &Stats{Extracted: mapped.Mapped, Files: mapped.Files, Bytes: mapped.MappedBytes}
+	sort.Strings(stats.Extracted)
+	return def, stats, nil
+}
+
+// Feeds returns the values of the constants extracted from a graph in the
+// Feed mode to dir, as the feeds of the placeholders replacing them in g,
+// to which the graph was imported with prefix.
+func Feeds(g *tf.Graph, dir, prefix string) (map[tf.Output]*tf.Tensor, error) {
+	f, err := os.Open(filepath.Join(dir, FeedsFile))
+	if os.IsNotExist(err) {
+		// No constant was extracted.
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	tensors, _, err := safetensors.Read(f)
+	if err != nil {
+		return nil, err
+	}
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	feeds := make(map[tf.Output]*tf.Tensor, len(tensors))
+	for name, t := range tensors {
+		op := g.Operation(prefix + name)
+		if op == nil {
+			return nil, fmt.Errorf("no operation %q for the extracted constant %q", prefix+name, name)
+		}
+		feeds[op.Output(0)] = t
+	}
+	return feeds, nil
+}
+
+// extractable reports whether n is a constant that can be extracted with
+// opts in the Feed mode, not accounting for its size.
+func extractable(n *pb.NodeDef, opts Options) bool {
+	if n.Op != "Const" {
+		return false
+	}
+	t := n.Attr["value"].GetTensor()
+	if t == nil || t.Dtype == pb.DataType_DT_STRING || t.TensorShape.GetUnknownRank() {
+		return false
+	}
+	return opts.Select == nil || opts.Select(n.Name)
+}
+
+func shapeProto(shape []int64) *pb.TensorShapeProto {
+	p := &pb.TensorShapeProto{Dim: make([]*pb.TensorShapeProto_Dim, len(shape))}
+	for i, d := range shape {
+		p.Dim[i] = &pb.TensorShapeProto_Dim{Size: d}
+	}
+	return p
+}
+
+// writeFile writes content to a temporary file renamed to path, so that
+// graphs never map a partially written file.
+func writeFile(path string, content []byte) error {
+	f, err := ioutil.TempFile(filepath.Dir(path), ".tmp")
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(content)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), path)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return err
+}
@@ -0,0 +1,129 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sidecar
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+// model returns a serialized graph computing "y/MatMul" = "x/Placeholder"
+// times "w/Const" plus "b/Const", a small constant.
+func model(t *testing.T, weights [][]float32) []byte {
+	s := op.NewScope()
+	op.Add(s.SubScope("y"),
+		op.MatMul(s.SubScope("y"),
+			op.Placeholder(s.SubScope("x"), tf.Float),
+			op.Const(s.SubScope("w"), weights)),
+		op.Const(s.SubScope("b"), float32(1)))
+	graph, err := s.Finalize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if _, err := graph.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func run(t *testing.T, graphDef []byte, feeds func(*tf.Graph) map[tf.Output]*tf.Tensor, x [][]float32) [][]float32 {
+	g := tf.NewGraph()
+	if err := g.Import(graphDef, ""); err != nil {
+		t.Fatal(err)
+	}
+	sess, err := tf.NewSession(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sess.Close()
+	in, err := tf.NewTensor(x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := feeds(g)
+	if f == nil {
+		f = make(map[tf.Output]*tf.Tensor)
+	}
+	f[g.Operation("x/Placeholder").Output(0)] = in
+	out, err := sess.Run(f, []tf.Output{g.Operation("y/Add").Output(0)}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return out[0].Value().([][]float32)
+}
+
+func TestExtract(t *testing.T) {
+	weights := [][]float32{{1, 2, 3}, {4, 5, 6}}
+	graphDef := model(t, weights)
+	x := [][]float32{{1, 1}}
+	want := [][]float32{{6, 8, 10}}
+	for _, mode := range []Mode{Feed, Mapped} {
+		dir, err := ioutil.TempDir("", "TestExtract")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		def, stats, err := Extract(graphDef, Options{Mode: mode, Dir: dir, MinBytes: 8})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := stats.Extracted, []string{"w/Const"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("mode %d: Got extracted constants %v, want %v", mode, got, want)
+		}
+		if stats.Bytes != 24 || len(stats.Files) != 1 {
+			t.Errorf("mode %d: Got %+v", mode, stats)
+		}
+		got := run(t, def, func(g *tf.Graph) map[tf.Output]*tf.Tensor {
+			feeds, err := Feeds(g, dir, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if mode == Feed && len(feeds) != 1 {
+				t.Errorf("Got %d feeds, want 1", len(feeds))
+			}
+			return feeds
+		}, x)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("mode %d: Got %v, want %v", mode, got, want)
+		}
+	}
+}
+
+func TestExtractSelect(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestExtractSelect")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	graphDef := model(t, [][]float32{{1, 2, 3}, {4, 5, 6}})
+	opts := Options{Dir: dir, MinBytes: 1, Select: func(name string) bool { return name == "b/Const" }}
+	_, stats, err := Extract(graphDef, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := stats.Extracted, []string{"b/Const"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Got extracted constants %v, want %v", got, want)
+	}
+	if _, _, err := Extract(graphDef, Options{}); err == nil {
+		t.Error("Extract succeeded without a directory")
+	}
+}
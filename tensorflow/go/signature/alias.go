@@ -0,0 +1,119 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+// AliasesFile is the name of the sidecar file of a SavedModel read by
+// LoadAliases, in its assets.extra directory.
+const AliasesFile = "aliases.json"
+
+// Aliases define stable names of the inputs and outputs of a signature,
+// which callers keep using when a re-exported model renames them. Each
+// alias maps to the key of an input or output of the signature, or to the
+// name of a tensor of the graph, such as "dense_3/BiasAdd:0".
+type Aliases struct {
+	Inputs  map[string]string `json:"inputs"`
+	Outputs map[string]string `json:"outputs"`
+}
+
+// LoadAliases reads the Aliases of the signature with the given key from
+// the assets.extra/aliases.json file of the SavedModel in exportDir, which
+// holds a JSON object with Aliases keyed by signature:
+//
+//	{"serving_default": {"inputs": {"image": "input_1"}, "outputs": {"scores": "dense_3/Softmax:0"}}}
+//
+// Empty Aliases are returned if the file or the signature is missing.
+func LoadAliases(exportDir, key string) (Aliases, error) {
+	path := filepath.Join(exportDir, "assets.extra", AliasesFile)
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return Aliases{}, nil
+		}
+		return Aliases{}, err
+	}
+	var all map[string]Aliases
+	if err := json.Unmarshal(b, &all); err != nil {
+		return Aliases{}, fmt.Errorf("invalid %s: %v", path, err)
+	}
+	return all[key], nil
+}
+
+// WithAliases returns a copy of sig whose inputs and outputs are also
+// available under their aliases, so that Feed, Bind and Prepend resolve
+// them. The types and shapes of the tensors of graph aliased by name are
+// those inferred for the graph. Aliases that are already keys of the
+// signature must refer to the same tensor.
+func (sig Signature) WithAliases(a Aliases, graph *tf.Graph) (Signature, error) {
+	inputs, err := withAliases(sig.Inputs, a.Inputs, graph)
+	if err != nil {
+		return Signature{}, fmt.Errorf("input %v", err)
+	}
+	outputs, err := withAliases(sig.Outputs, a.Outputs, graph)
+	if err != nil {
+		return Signature{}, fmt.Errorf("output %v", err)
+	}
+	return Signature{MethodName: sig.MethodName, Inputs: inputs, Outputs: outputs}, nil
+}
+
+func withAliases(infos map[string]TensorInfo, aliases map[string]string, graph *tf.Graph) (map[string]TensorInfo, error) {
+	ret := make(map[string]TensorInfo, len(infos)+len(aliases))
+	for key, info := range infos {
+		ret[key] = info
+	}
+	names := make([]string, 0, len(aliases))
+	for alias := range aliases {
+		names = append(names, alias)
+	}
+	sort.Strings(names)
+	for _, alias := range names {
+		target := aliases[alias]
+		info, ok := infos[target]
+		if !ok {
+			o, err := TensorInfo{Name: target}.Output(graph)
+			if err != nil {
+				return nil, fmt.Errorf("alias %q: %v", alias, err)
+			}
+			info = TensorInfo{Name: target, DataType: o.DataType(), Shape: o.Shape()}
+		}
+		if existing, ok := infos[alias]; ok {
+			if canonical(existing.Name) != canonical(info.Name) {
+				return nil, fmt.Errorf("alias %q is the key of tensor %q, not %q", alias, existing.Name, info.Name)
+			}
+			continue
+		}
+		ret[alias] = info
+	}
+	return ret, nil
+}
+
+// canonical returns the name of a tensor with an explicit output index.
+func canonical(name string) string {
+	op, index, err := tf.ParseTensorName(name)
+	if err != nil {
+		return name
+	}
+	return fmt.Sprintf("%s:%d", op, index)
+}
@@ -0,0 +1,127 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+func TestWithAliases(t *testing.T) {
+	s := op.NewScope()
+	op.Placeholder(s.SubScope("x"), tf.Float, op.PlaceholderShape(tf.MakeShape(-1)))
+	op.Placeholder(s.SubScope("renamed"), tf.Int64, op.PlaceholderShape(tf.MakeShape(2)))
+	graph, err := s.Finalize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig := Signature{
+		MethodName: "tensorflow/serving/predict",
+		Inputs:     map[string]TensorInfo{"x": {Name: "x/Placeholder:0", DataType: tf.Float, Shape: tf.MakeShape(-1)}},
+		Outputs:    map[string]TensorInfo{"y": {Name: "x/Placeholder:0", DataType: tf.Float}},
+	}
+	aliased, err := sig.WithAliases(Aliases{
+		Inputs:  map[string]string{"features": "x", "ids": "renamed/Placeholder", "x": "x/Placeholder"},
+		Outputs: map[string]string{"scores": "y"},
+	}, graph)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := aliased.Inputs["features"], sig.Inputs["x"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("Got %+v, want %+v", got, want)
+	}
+	if got := aliased.Inputs["ids"]; got.Name != "renamed/Placeholder" || got.DataType != tf.Int64 || got.Shape.String() != "[2]" {
+		t.Errorf("Got %+v, want the tensor of the graph", got)
+	}
+	if _, ok := aliased.Outputs["scores"]; !ok || len(sig.Outputs) != 1 {
+		t.Errorf("Got outputs %v, want y and its alias added to a copy", aliased.Outputs)
+	}
+
+	feeds, err := NewFeed().Set("features", []float32{1}).Set("ids", []int64{1, 2}).Build(aliased, graph)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(feeds) != 2 {
+		t.Errorf("Got %d feeds, want 2", len(feeds))
+	}
+
+	type request struct {
+		Features []float32 `tf:"features"`
+		IDs      []int64   `tf:"ids"`
+	}
+	if _, err := Bind(aliased, graph, request{}, nil); err != nil {
+		t.Errorf("Binding aliases: %v", err)
+	}
+	type both struct {
+		X        []float32 `tf:"x"`
+		Features []float32 `tf:"features"`
+		IDs      []int64   `tf:"ids"`
+	}
+	if _, err := Bind(aliased, graph, both{}, nil); err == nil {
+		t.Error("Binding an input and its alias succeeded, want error")
+	}
+	if _, err := NewFeed().Set("x", []float32{1}).Set("features", []float32{1}).Set("ids", []int64{1, 2}).Build(aliased, graph); err == nil {
+		t.Error("Feeding an input and its alias succeeded, want error")
+	}
+
+	for _, a := range []Aliases{
+		{Inputs: map[string]string{"x": "renamed/Placeholder:0"}},
+		{Inputs: map[string]string{"z": "missing:0"}},
+		{Outputs: map[string]string{"scores": "x/Placeholder:1"}},
+	} {
+		if _, err := sig.WithAliases(a, graph); err == nil {
+			t.Errorf("WithAliases(%+v) succeeded, want error", a)
+		}
+	}
+}
+
+func TestLoadAliases(t *testing.T) {
+	dir, err := ioutil.TempDir("", "aliases")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if a, err := LoadAliases(dir, DefaultKey); err != nil || a.Inputs != nil || a.Outputs != nil {
+		t.Errorf("Got (%+v, %v), want empty Aliases", a, err)
+	}
+	if err := os.Mkdir(filepath.Join(dir, "assets.extra"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "assets.extra", AliasesFile)
+	contents := `{"serving_default": {"inputs": {"image": "input_1"}, "outputs": {"scores": "dense/Softmax:0"}}}`
+	if err := ioutil.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatal(err)
+	}
+	a, err := LoadAliases(dir, DefaultKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Aliases{Inputs: map[string]string{"image": "input_1"}, Outputs: map[string]string{"scores": "dense/Softmax:0"}}
+	if !reflect.DeepEqual(a, want) {
+		t.Errorf("Got %+v, want %+v", a, want)
+	}
+	if err := ioutil.WriteFile(path, []byte("{"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadAliases(dir, DefaultKey); err == nil {
+		t.Error("LoadAliases succeeded with an invalid file")
+	}
+}
@@ -0,0 +1,269 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+var tensorType = reflect.TypeOf((*tf.Tensor)(nil))
+
+// Binding maps the fields of a request and a response struct type to the
+// inputs and outputs of a Signature.
+//
+// Fields are bound using struct tags containing the key of the input or
+// output in the Signature:
+//
+//	type Request struct {
+//		InputIDs [][]int64 `tf:"input_ids"`
+//		Mask     [][]int32 `tf:"input_mask"`
+//	}
+//
+//	type Response struct {
+//		Scores [][]float32 `tf:"scores"`
+//	}
+//
+// A field may be of any type accepted by tf.NewTensor, or a *tf.Tensor.
+// Fields without a tag are ignored.
+type Binding struct {
+	request, response reflect.Type
+	inputs, outputs   []field
+}
+
+type field struct {
+	index  int
+	key    string
+	info   TensorInfo
+	output tf.Output
+}
+
+// Bind validates the request and response struct types, given by values of
+// the types or pointers to them, against sig and the graph of the model,
+// returning an error describing any mismatch. Every input of sig must be
+// bound by request, while response may bind a subset of the outputs of sig
+// (or be nil). Keys of sig naming the same tensor, such as the aliases added
+// by WithAliases, are alternatives bound at most once. Binding at startup
+// catches differences between a model and the code using it before any
+// request is served.
+func Bind(sig Signature, graph *tf.Graph, request, response interface{}) (*Binding, error) {
+	b := &Binding{}
+	var err error
+	if b.request, b.inputs, err = bindStruct(request, sig.Inputs, graph); err != nil {
+		return nil, fmt.Errorf("request: %v", err)
+	}
+	// Keys naming the same tensor, such as aliases, are alternatives.
+	bound := make(map[string]string)
+	for _, f := range b.inputs {
+		if other, ok := bound[canonical(f.info.Name)]; ok {
+			return nil, fmt.Errorf("request: %q and %q are bound to the same tensor", other, f.key)
+		}
+		bound[canonical(f.info.Name)] = f.key
+	}
+	var missing []string
+	for key, info := range sig.Inputs {
+		if _, ok := bound[canonical(info.Name)]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("request: inputs %q of the signature are not bound by %v", missing, b.request)
+	}
+	if response != nil {
+		if b.response, b.outputs, err = bindStruct(response, sig.Outputs, graph); err != nil {
+			return nil, fmt.Errorf("response: %v", err)
+		}
+	}
+	return b, nil
+}
+
+func bindStruct(v interface{}, infos map[string]TensorInfo, graph *tf.Graph) (reflect.Type, []field, error) {
+	typ := reflect.TypeOf(v)
+	if typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return nil, nil, fmt.Errorf("%T is not a struct", v)
+	}
+	var fields []field
+	keys := make(map[string]string)
+	for i := 0; i < typ.NumField(); i++ {
+		sf := typ.Field(i)
+		key := sf.Tag.Get("tf")
+		if key == "" || key == "-" {
+			continue
+		}
+		if sf.PkgPath != "" {
+			return nil, nil, fmt.Errorf("field %s bound to %q is not exported", sf.Name, key)
+		}
+		if other, ok := keys[key]; ok {
+			return nil, nil, fmt.Errorf("fields %s and %s are both bound to %q", other, sf.Name, key)
+		}
+		keys[key] = sf.Name
+		info, ok := infos[key]
+		if !ok {
+			return nil, nil, fmt.Errorf("field %s is bound to %q, which is not in the signature", sf.Name, key)
+		}
+		if err := checkType(sf.Type, info); err != nil {
+			return nil, nil, fmt.Errorf("field %s bound to %q: %v", sf.Name, key, err)
+		}
+		output, err := info.Output(graph)
+		if err != nil {
+			return nil, nil, fmt.Errorf("field %s bound to %q: %v", sf.Name, key, err)
+		}
+		fields = append(fields, field{index: i, key: key, info: info, output: output})
+	}
+	return typ, fields, nil
+}
+
+// checkType returns an error if values of typ cannot represent tensors
+// described by info.
+func checkType(typ reflect.Type, info TensorInfo) error {
+	if typ == tensorType {
+		return nil
+	}
+	dt, err := tf.DataTypeOf(typ)
+	if err != nil {
+		return err
+	}
+	if dt != info.DataType {
+		return fmt.Errorf("type %v holds %v values, the signature requires %v", typ, dt, info.DataType)
+	}
+	var dims []int64
+	for ; typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array; typ = typ.Elem() {
+		if typ.Kind() == reflect.Array {
+			dims = append(dims, int64(typ.Len()))
+		} else {
+			dims = append(dims, -1)
+		}
+	}
+	if shape := tf.MakeShape(dims...); !shape.IsCompatibleWith(info.Shape) {
+		return fmt.Errorf("type %v has shape %v, the signature requires %v", typ, shape, info.Shape)
+	}
+	return nil
+}
+
+// Feeds converts the fields of request, a value of (or pointer to) the
+// request type of b, to the feeds of Session.Run.
+func (b *Binding) Feeds(request interface{}) (map[tf.Output]*tf.Tensor, error) {
+	v, err := structValue(request, b.request)
+	if err != nil {
+		return nil, err
+	}
+	feeds := make(map[tf.Output]*tf.Tensor, len(b.inputs))
+	for _, f := range b.inputs {
+		value := v.Field(f.index).Interface()
+		t, ok := value.(*tf.Tensor)
+		if !ok {
+			if t, err = tf.NewTensor(value); err != nil {
+				return nil, fmt.Errorf("input %q: %v", f.key, err)
+			}
+		}
+		if t == nil {
+			return nil, fmt.Errorf("input %q: nil Tensor", f.key)
+		}
+		if t.DataType() != f.info.DataType {
+			return nil, fmt.Errorf("input %q: got a %v Tensor, the signature requires %v", f.key, t.DataType(), f.info.DataType)
+		}
+		if shape := tf.MakeShape(t.Shape()...); !shape.IsCompatibleWith(f.info.Shape) {
+			return nil, fmt.Errorf("input %q: got shape %v, the signature requires %v", f.key, shape, f.info.Shape)
+		}
+		feeds[f.output] = t
+	}
+	return feeds, nil
+}
+
+// Fetches returns the outputs to fetch with Session.Run to populate the
+// response type of b, in the order expected by Decode.
+func (b *Binding) Fetches() []tf.Output {
+	fetches := make([]tf.Output, len(b.outputs))
+	for i, f := range b.outputs {
+		fetches[i] = f.output
+	}
+	return fetches
+}
+
+// Decode stores the tensors fetched for Fetches in the fields of response,
+// which must be a pointer to the response type of b.
+func (b *Binding) Decode(fetched []*tf.Tensor, response interface{}) error {
+	if len(fetched) != len(b.outputs) {
+		return fmt.Errorf("got %d tensors, want %d", len(fetched), len(b.outputs))
+	}
+	ptr := reflect.ValueOf(response)
+	if ptr.Kind() != reflect.Ptr || ptr.IsNil() {
+		return fmt.Errorf("response must be a non-nil pointer, got %T", response)
+	}
+	v, err := structValue(response, b.response)
+	if err != nil {
+		return err
+	}
+	for i, f := range b.outputs {
+		dst := v.Field(f.index)
+		if dst.Type() == tensorType {
+			dst.Set(reflect.ValueOf(fetched[i]))
+			continue
+		}
+		decoded, err := fetched[i].DecodeValue()
+		if err != nil {
+			return fmt.Errorf("output %q: %v", f.key, err)
+		}
+		value := reflect.ValueOf(decoded)
+		switch {
+		case value.Type().AssignableTo(dst.Type()):
+			dst.Set(value)
+		case value.Type().ConvertibleTo(dst.Type()):
+			dst.Set(value.Convert(dst.Type()))
+		default:
+			return fmt.Errorf("output %q: cannot store %v in a field of type %v", f.key, value.Type(), dst.Type())
+		}
+	}
+	return nil
+}
+
+// Run feeds request to sess, and stores the fetched outputs in response.
+func (b *Binding) Run(sess *tf.Session, request, response interface{}) error {
+	return b.RunWithOptions(sess, nil, request, response)
+}
+
+// RunWithOptions is like Run, using options for the call to
+// Session.RunWithOptions. For example, signatures serving latency sensitive
+// requests can be run in a different inter-op thread pool than signatures
+// processing large batches (see tf.RunOptions.InterOpThreadPool).
+func (b *Binding) RunWithOptions(sess *tf.Session, options *tf.RunOptions, request, response interface{}) error {
+	feeds, err := b.Feeds(request)
+	if err != nil {
+		return err
+	}
+	fetched, err := sess.RunWithOptions(options, feeds, b.Fetches(), nil)
+	if err != nil {
+		return err
+	}
+	return b.Decode(fetched, response)
+}
+
+func structValue(v interface{}, typ reflect.Type) (reflect.Value, error) {
+	value := reflect.ValueOf(v)
+	if value.Kind() == reflect.Ptr && !value.IsNil() {
+		value = value.Elem()
+	}
+	if !value.IsValid() || value.Type() != typ {
+		return reflect.Value{}, fmt.Errorf("got %T, want %v", v, typ)
+	}
+	return value, nil
+}
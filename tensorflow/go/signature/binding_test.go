@@ -0,0 +1,159 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+func negateModel(t *testing.T) (*tf.Graph, Signature) {
+	s := op.NewScope()
+	x := op.Placeholder(s.SubScope("x"), tf.Float, op.PlaceholderShape(tf.MakeShape(-1, 2)))
+	op.Neg(s.SubScope("y"), x)
+	graph, err := s.Finalize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return graph, Signature{
+		Inputs: map[string]TensorInfo{
+			"x": {Name: "x/Placeholder:0", DataType: tf.Float, Shape: tf.MakeShape(-1, 2)},
+		},
+		Outputs: map[string]TensorInfo{
+			"y": {Name: "y/Neg", DataType: tf.Float, Shape: tf.MakeShape(-1, 2)},
+		},
+	}
+}
+
+type vector [][2]float32
+
+func TestBindRun(t *testing.T) {
+	graph, sig := negateModel(t)
+	type request struct {
+		X       [][]float32 `tf:"x"`
+		Comment string
+	}
+	type response struct {
+		Y vector `tf:"y"`
+	}
+	b, err := Bind(sig, graph, request{}, &response{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sess, err := tf.NewSession(graph, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sess.Close()
+	var resp response
+	if err := b.Run(sess, &request{X: [][]float32{{1, 2}, {3, 4}}}, &resp); err != nil {
+		t.Fatal(err)
+	}
+	if want := (vector{{-1, -2}, {-3, -4}}); !reflect.DeepEqual(resp.Y, want) {
+		t.Errorf("Got %v, want %v", resp.Y, want)
+	}
+	if err := b.Run(sess, request{X: [][]float32{{1, 2, 3}}}, &resp); err == nil {
+		t.Error("Run accepted an input of shape [1, 3]")
+	}
+	if err := b.Run(sess, request{}, resp); err == nil {
+		t.Error("Run accepted a response that is not a pointer")
+	}
+}
+
+func TestBindTensor(t *testing.T) {
+	graph, sig := negateModel(t)
+	type request struct {
+		X *tf.Tensor `tf:"x"`
+	}
+	type response struct {
+		Y *tf.Tensor `tf:"y"`
+	}
+	b, err := Bind(sig, graph, request{}, response{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	x, err := tf.NewTensor([][2]float32{{1, 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.Feeds(request{X: x}); err != nil {
+		t.Error(err)
+	}
+	i, err := tf.NewTensor([][2]int32{{1, 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.Feeds(request{X: i}); err == nil {
+		t.Error("Feeds accepted an int32 Tensor")
+	}
+}
+
+func TestBindErrors(t *testing.T) {
+	graph, sig := negateModel(t)
+	type unbound struct {
+		X [][]float32
+	}
+	type unknownKey struct {
+		X [][]float32 `tf:"x"`
+		Z [][]float32 `tf:"z"`
+	}
+	type duplicate struct {
+		X  [][]float32 `tf:"x"`
+		X2 [][]float32 `tf:"x"`
+	}
+	type wrongType struct {
+		X [][]int64 `tf:"x"`
+	}
+	type wrongRank struct {
+		X []float32 `tf:"x"`
+	}
+	type wrongDim struct {
+		X [][3]float32 `tf:"x"`
+	}
+	type unexported struct {
+		x [][]float32 `tf:"x"`
+	}
+	type ok struct {
+		X [][]float32 `tf:"x"`
+	}
+	tests := []struct {
+		request, response interface{}
+		err               string
+	}{
+		{unbound{}, nil, "not bound"},
+		{unknownKey{}, nil, "not in the signature"},
+		{duplicate{}, nil, "both bound"},
+		{wrongType{}, nil, "requires float"},
+		{wrongRank{}, nil, "has shape"},
+		{wrongDim{}, nil, "has shape"},
+		{unexported{}, nil, "not exported"},
+		{ok{}, wrongType{}, "not in the signature"},
+		{1, nil, "not a struct"},
+	}
+	for _, test := range tests {
+		_, err := Bind(sig, graph, test.request, test.response)
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("Bind(%T, %T): got error %v, want one containing %q", test.request, test.response, err, test.err)
+		}
+	}
+	sig.Inputs["x"] = TensorInfo{Name: "missing:0", DataType: tf.Float}
+	if _, err := Bind(sig, graph, ok{}, nil); err == nil {
+		t.Error("Bind accepted a signature naming a missing operation")
+	}
+}
@@ -0,0 +1,297 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+// Feed builds the feeds of a Session.Run call from Go values keyed by the
+// names of the inputs of a Signature, for example:
+//
+//	feeds, err := signature.NewFeed().
+//		Set("image", img).
+//		Set("threshold", 0.5).
+//		Build(sig, model.Graph)
+//
+// Values are converted to tensors of the types and shapes of the inputs:
+// numeric values are converted to the type of their input according to the
+// Conversion of the Feed, ConvertSafe by default, and scalars are broadcast
+// to inputs of a fully known shape. Values can also be *tf.Tensors of the
+// type of their input.
+type Feed struct {
+	values     map[string]interface{}
+	conversion Conversion
+}
+
+// Conversion is a policy for converting numeric values to the types of the
+// inputs they are fed to.
+type Conversion int
+
+const (
+	// ConvertSafe converts values as long as integers are not truncated,
+	// do not overflow and do not change sign, e.g. int to int32 or float64
+	// 1.0 to int64. Floating-point values are rounded to the nearest value
+	// of the type of their input, e.g. float64 to float32, but finite
+	// values must not overflow to infinity.
+	ConvertSafe Conversion = iota
+	// ConvertStrict does not convert values: their elements must have the
+	// Go type of the type of their input, e.g. float32 for tf.Float.
+	ConvertStrict
+	// ConvertLossy converts numeric values like Go conversions do,
+	// truncating floating-point values converted to integers and wrapping
+	// integers around on overflow.
+	ConvertLossy
+)
+
+// NewFeed returns an empty Feed.
+func NewFeed() *Feed {
+	return &Feed{values: make(map[string]interface{})}
+}
+
+// Set sets the value of the input named name, replacing any previous value,
+// and returns f. Values are only checked by Build.
+func (f *Feed) Set(name string, value interface{}) *Feed {
+	f.values[name] = value
+	return f
+}
+
+// WithConversion sets the policy for converting the values of f to the
+// types of their inputs and returns f.
+func (f *Feed) WithConversion(c Conversion) *Feed {
+	f.conversion = c
+	return f
+}
+
+// Build returns the feeds for the inputs of sig in graph. It fails if the
+// value of an input is missing or cannot be converted, or if a value was set
+// for a name that is not an input of sig, describing the first such input in
+// sorted order. Keys of sig naming the same tensor, such as the aliases
+// added by WithAliases, are alternatives: a value must be set for one of
+// them only.
+func (f *Feed) Build(sig Signature, graph *tf.Graph) (map[tf.Output]*tf.Tensor, error) {
+	names := make([]string, 0, len(sig.Inputs)+len(f.values))
+	for name := range sig.Inputs {
+		names = append(names, name)
+	}
+	for name := range f.values {
+		if _, ok := sig.Inputs[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	feeds := make(map[tf.Output]*tf.Tensor, len(sig.Inputs))
+	fed := make(map[string]string) // The key fed for each tensor.
+	for _, name := range names {
+		info, ok := sig.Inputs[name]
+		if !ok {
+			return nil, fmt.Errorf("input %q: not an input of the signature", name)
+		}
+		value, ok := f.values[name]
+		if !ok {
+			continue
+		}
+		if other, ok := fed[canonical(info.Name)]; ok {
+			return nil, fmt.Errorf("input %q: value already set for %q, which names the same tensor", name, other)
+		}
+		fed[canonical(info.Name)] = name
+		t, err := toTensor(value, info, f.conversion)
+		if err != nil {
+			return nil, fmt.Errorf("input %q: %v", name, err)
+		}
+		o, err := info.Output(graph)
+		if err != nil {
+			return nil, fmt.Errorf("input %q: %v", name, err)
+		}
+		feeds[o] = t
+	}
+	for _, name := range names {
+		if _, ok := fed[canonical(sig.Inputs[name].Name)]; !ok {
+			return nil, fmt.Errorf("input %q: no value set", name)
+		}
+	}
+	return feeds, nil
+}
+
+// toTensor converts value to a Tensor matching info.
+func toTensor(value interface{}, info TensorInfo, c Conversion) (*tf.Tensor, error) {
+	t, ok := value.(*tf.Tensor)
+	if !ok {
+		elem, ok := goTypes[info.DataType]
+		if !ok {
+			return nil, fmt.Errorf("inputs of type %v are not supported", info.DataType)
+		}
+		v := reflect.ValueOf(value)
+		if !v.IsValid() {
+			return nil, fmt.Errorf("nil value")
+		}
+		if !isList(v.Type()) && info.Shape.NumDimensions() > 0 {
+			if !info.Shape.IsFullySpecified() {
+				return nil, fmt.Errorf("cannot broadcast a scalar to shape %v", info.Shape)
+			}
+			dims, _ := info.Shape.ToSlice()
+			v = broadcast(v, dims)
+		}
+		converted, err := convert(v, elem, c)
+		if err != nil {
+			return nil, err
+		}
+		if t, err = tf.NewTensor(converted.Interface()); err != nil {
+			return nil, err
+		}
+	}
+	if t.DataType() != info.DataType {
+		return nil, fmt.Errorf("got a %v tensor, want %v", t.DataType(), info.DataType)
+	}
+	if shape := tf.MakeShape(t.Shape()...); !shape.IsCompatibleWith(info.Shape) {
+		return nil, fmt.Errorf("got shape %v, want %v", shape, info.Shape)
+	}
+	return t, nil
+}
+
+// broadcast returns a value of shape dims filled with the scalar v.
+func broadcast(v reflect.Value, dims []int64) reflect.Value {
+	if len(dims) == 0 {
+		return v
+	}
+	elem := broadcast(v, dims[1:])
+	s := reflect.MakeSlice(reflect.SliceOf(elem.Type()), int(dims[0]), int(dims[0]))
+	for i := 0; i < s.Len(); i++ {
+		s.Index(i).Set(elem)
+	}
+	return s
+}
+
+// convert returns v, a scalar or (nested) list of scalars, with its
+// elements converted to elem according to c.
+func convert(v reflect.Value, elem reflect.Type, c Conversion) (reflect.Value, error) {
+	if v.Kind() == reflect.Interface {
+		if v = v.Elem(); !v.IsValid() {
+			return reflect.Value{}, fmt.Errorf("nil element")
+		}
+	}
+	if isList(v.Type()) {
+		var s reflect.Value
+		for i := 0; i < v.Len(); i++ {
+			e, err := convert(v.Index(i), elem, c)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			if !s.IsValid() {
+				s = reflect.MakeSlice(reflect.SliceOf(e.Type()), v.Len(), v.Len())
+			} else if e.Type() != s.Type().Elem() {
+				return reflect.Value{}, fmt.Errorf("elements of %v have different ranks", v.Type())
+			}
+			s.Index(i).Set(e)
+		}
+		if !s.IsValid() {
+			// An empty list: its rank is that of its type.
+			t := elem
+			for typ := v.Type(); isList(typ); typ = typ.Elem() {
+				t = reflect.SliceOf(t)
+			}
+			s = reflect.MakeSlice(t, 0, 0)
+		}
+		return s, nil
+	}
+	if v.Type() == elem {
+		return v, nil
+	}
+	if !numeric(v.Kind()) || !numeric(elem.Kind()) || !v.Type().ConvertibleTo(elem) {
+		return reflect.Value{}, fmt.Errorf("cannot convert %v to %v", v.Type(), elem)
+	}
+	if c == ConvertStrict {
+		return reflect.Value{}, fmt.Errorf("cannot convert %v to %v with ConvertStrict", v.Type(), elem)
+	}
+	converted := v.Convert(elem)
+	if c == ConvertSafe && !representable(v, converted) {
+		return reflect.Value{}, fmt.Errorf("cannot represent %v as %v", v.Interface(), elem)
+	}
+	return converted, nil
+}
+
+// representable reports whether converted, the conversion of the numeric
+// value v, has the value of v, up to the rounding of floating-point values.
+func representable(v, converted reflect.Value) bool {
+	if isUnsigned(converted.Kind()) && negative(v) {
+		return false
+	}
+	if isUnsigned(v.Kind()) && negative(converted) {
+		return false
+	}
+	if isInteger(converted.Kind()) {
+		return converted.Convert(v.Type()).Interface() == v.Interface()
+	}
+	return !isInf(converted) || isInf(v)
+}
+
+func negative(v reflect.Value) bool {
+	switch k := v.Kind(); {
+	case k >= reflect.Int && k <= reflect.Int64:
+		return v.Int() < 0
+	case k == reflect.Float32 || k == reflect.Float64:
+		return v.Float() < 0
+	}
+	return false
+}
+
+func isInf(v reflect.Value) bool {
+	switch k := v.Kind(); {
+	case k == reflect.Float32 || k == reflect.Float64:
+		return math.IsInf(v.Float(), 0)
+	case k == reflect.Complex64 || k == reflect.Complex128:
+		c := v.Complex()
+		return math.IsInf(real(c), 0) || math.IsInf(imag(c), 0)
+	}
+	return false
+}
+
+func isList(t reflect.Type) bool {
+	return t.Kind() == reflect.Slice || t.Kind() == reflect.Array
+}
+
+func numeric(k reflect.Kind) bool {
+	return isInteger(k) || (k >= reflect.Float32 && k <= reflect.Complex128)
+}
+
+func isInteger(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Uint64
+}
+
+func isUnsigned(k reflect.Kind) bool {
+	return k >= reflect.Uint && k <= reflect.Uint64
+}
+
+// goTypes maps the DataTypes of tensors to the Go types used for their
+// elements by tf.NewTensor.
+var goTypes = map[tf.DataType]reflect.Type{
+	tf.Float:      reflect.TypeOf(float32(0)),
+	tf.Double:     reflect.TypeOf(float64(0)),
+	tf.Int32:      reflect.TypeOf(int32(0)),
+	tf.Uint8:      reflect.TypeOf(uint8(0)),
+	tf.Int16:      reflect.TypeOf(int16(0)),
+	tf.Int8:       reflect.TypeOf(int8(0)),
+	tf.String:     reflect.TypeOf(""),
+	tf.Complex64:  reflect.TypeOf(complex64(0)),
+	tf.Int64:      reflect.TypeOf(int64(0)),
+	tf.Bool:       reflect.TypeOf(false),
+	tf.Uint16:     reflect.TypeOf(uint16(0)),
+	tf.Complex128: reflect.TypeOf(complex128(0)),
+}
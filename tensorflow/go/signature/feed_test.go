@@ -0,0 +1,164 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+func TestFeed(t *testing.T) {
+	s := op.NewScope()
+	op.Placeholder(s.SubScope("image"), tf.Float)
+	op.Placeholder(s.SubScope("threshold"), tf.Float)
+	op.Placeholder(s.SubScope("ids"), tf.Int64)
+	graph, err := s.Finalize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig := Signature{Inputs: map[string]TensorInfo{
+		"image":     {Name: "image/Placeholder:0", DataType: tf.Float, Shape: tf.MakeShape(2, 2)},
+		"threshold": {Name: "threshold/Placeholder:0", DataType: tf.Float, Shape: tf.ScalarShape()},
+		"ids":       {Name: "ids/Placeholder", DataType: tf.Int64, Shape: tf.MakeShape(-1)},
+	}}
+	feeds, err := NewFeed().
+		Set("image", 1).
+		Set("threshold", 0.5).
+		Set("ids", []int{3, 1, 2}).
+		Build(sig, graph)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{
+		"image/Placeholder":     [][]float32{{1, 1}, {1, 1}},
+		"threshold/Placeholder": float32(0.5),
+		"ids/Placeholder":       []int64{3, 1, 2},
+	}
+	if len(feeds) != len(want) {
+		t.Errorf("Got %d feeds, want %d", len(feeds), len(want))
+	}
+	for o, tensor := range feeds {
+		if got := tensor.Value(); !reflect.DeepEqual(got, want[o.Op.Name()]) {
+			t.Errorf("%s: got %v, want %v", o.Op.Name(), got, want[o.Op.Name()])
+		}
+	}
+
+	valid := func() *Feed {
+		return NewFeed().Set("image", 1).Set("threshold", 0.5).Set("ids", []int64{1})
+	}
+	ints, err := tf.NewTensor([]int32{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	errors := []struct {
+		feed *Feed
+		want string
+	}{
+		{NewFeed().Set("image", 1).Set("threshold", 0.5), `input "ids": no value set`},
+		{valid().Set("labels", 1), `input "labels": not an input`},
+		{valid().Set("image", [][]float32{{1, 2, 3}}), `input "image": got shape [1, 3], want [2, 2]`},
+		{valid().Set("ids", []float64{1.5}), `input "ids": cannot represent 1.5 as int64`},
+		{valid().Set("ids", 1), `input "ids": cannot broadcast`},
+		{valid().Set("ids", ints), `input "ids": got a int32 tensor, want int64`},
+		{valid().Set("threshold", "high"), `input "threshold": cannot convert string to float32`},
+	}
+	for _, test := range errors {
+		if _, err := test.feed.Build(sig, graph); err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("Got error %v, want %q", err, test.want)
+		}
+	}
+}
+
+func TestFeedConversion(t *testing.T) {
+	s := op.NewScope()
+	op.Placeholder(s.SubScope("x"), tf.Int32)
+	graph, err := s.Finalize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig := Signature{Inputs: map[string]TensorInfo{
+		"x": {Name: "x/Placeholder:0", DataType: tf.Int32, Shape: tf.MakeShape(-1)},
+	}}
+	tests := []struct {
+		c     Conversion
+		value interface{}
+		want  interface{} // The value of the feed, or the error if a string.
+	}{
+		{ConvertSafe, []int{1, 2}, []int32{1, 2}},
+		{ConvertSafe, []float64{1, 2}, []int32{1, 2}},
+		{ConvertSafe, []int64{1 << 40}, "cannot represent 1099511627776 as int32"},
+		{ConvertStrict, []int32{1, 2}, []int32{1, 2}},
+		{ConvertStrict, []int{1, 2}, "cannot convert int to int32 with ConvertStrict"},
+		{ConvertLossy, []float64{1.5, -2.5}, []int32{1, -2}},
+		{ConvertLossy, []int64{1<<32 + 3}, []int32{3}},
+		{ConvertLossy, []string{"1"}, "cannot convert string to int32"},
+	}
+	for _, test := range tests {
+		feeds, err := NewFeed().WithConversion(test.c).Set("x", test.value).Build(sig, graph)
+		if want, ok := test.want.(string); ok {
+			if err == nil || !strings.Contains(err.Error(), want) {
+				t.Errorf("%v with %v: got error %v, want %q", test.value, test.c, err, want)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v with %v: %v", test.value, test.c, err)
+			continue
+		}
+		for _, tensor := range feeds {
+			if got := tensor.Value(); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("%v with %v: got %v, want %v", test.value, test.c, got, test.want)
+			}
+		}
+	}
+}
+
+func TestConvertSafe(t *testing.T) {
+	tests := []struct {
+		value, to interface{} // to is a value of the type to convert to.
+		ok        bool
+	}{
+		{int8(1), uint8(0), true},
+		{int8(-1), uint8(0), false},
+		{float64(-1), uint8(0), false},
+		{uint64(1 << 62), int64(0), true},
+		{uint64(1 << 63), int64(0), false},
+		{uint32(1 << 31), int32(0), false},
+		{float64(0.1), float32(0), true},
+		{math.Inf(-1), float32(0), true},
+		{math.MaxFloat32 * 2, float32(0), false},
+		{complex(math.MaxFloat64, 0), complex64(0), false},
+	}
+	for _, test := range tests {
+		elem := reflect.TypeOf(test.to)
+		got, err := convert(reflect.ValueOf(test.value), elem, ConvertSafe)
+		if !test.ok {
+			if err == nil {
+				t.Errorf("%T(%v) converted to %v(%v)", test.value, test.value, elem, got.Interface())
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%T(%v): %v", test.value, test.value, err)
+		} else if want := reflect.ValueOf(test.value).Convert(elem).Interface(); got.Interface() != want {
+			t.Errorf("%T(%v): got %v, want %v", test.value, test.value, got.Interface(), want)
+		}
+	}
+}
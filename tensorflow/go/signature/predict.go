@@ -0,0 +1,108 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature
+
+import (
+	"fmt"
+	"sort"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+// Fetches select the outputs of a Signature fetched by Predict.
+type Fetches struct {
+	keys []string
+}
+
+// Fetch selects the outputs of a Signature with the given keys. Fetch()
+// selects all the outputs.
+func Fetch(keys ...string) Fetches {
+	return Fetches{keys: keys}
+}
+
+// resolve returns the sorted keys of the outputs of sig selected by f.
+func (f Fetches) resolve(sig Signature) ([]string, error) {
+	if len(f.keys) == 0 {
+		keys := make([]string, 0, len(sig.Outputs))
+		for key := range sig.Outputs {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		return keys, nil
+	}
+	keys := append([]string(nil), f.keys...)
+	sort.Strings(keys)
+	for i, key := range keys {
+		if _, ok := sig.Outputs[key]; !ok {
+			return nil, fmt.Errorf("output %q: not an output of the signature", key)
+		}
+		if i > 0 && keys[i-1] == key {
+			return nil, fmt.Errorf("output %q: fetched more than once", key)
+		}
+	}
+	return keys, nil
+}
+
+// Predict runs sig on m with the inputs set in feed, and returns the
+// outputs selected by fetch, by key, for example:
+//
+//	outputs, err := signature.Predict(model, sig, feed, signature.Fetch("probabilities", "top_k"))
+//
+// Only the selected outputs are fetched, so that the runtime neither
+// computes the operations needed by the other outputs only nor copies
+// them from the device, which matters for models with large auxiliary
+// outputs such as embeddings. Keys naming the same tensor, such as the
+// aliases added by WithAliases, share the fetched Tensor.
+func Predict(m *tf.SavedModel, sig Signature, feed *Feed, fetch Fetches) (map[string]*tf.Tensor, error) {
+	keys, err := fetch.resolve(sig)
+	if err != nil {
+		return nil, err
+	}
+	if feed == nil {
+		feed = NewFeed()
+	}
+	feeds, err := feed.Build(sig, m.Graph)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		fetches []tf.Output
+		fetched = make(map[string]int) // Index in fetches by tensor name.
+		indices = make([]int, len(keys))
+	)
+	for i, key := range keys {
+		info := sig.Outputs[key]
+		j, ok := fetched[canonical(info.Name)]
+		if !ok {
+			o, err := info.Output(m.Graph)
+			if err != nil {
+				return nil, fmt.Errorf("output %q: %v", key, err)
+			}
+			j = len(fetches)
+			fetched[canonical(info.Name)] = j
+			fetches = append(fetches, o)
+		}
+		indices[i] = j
+	}
+	results, err := m.Session.Run(feeds, fetches, nil)
+	if err != nil {
+		return nil, err
+	}
+	outputs := make(map[string]*tf.Tensor, len(keys))
+	for i, key := range keys {
+		outputs[key] = results[indices[i]]
+	}
+	return outputs, nil
+}
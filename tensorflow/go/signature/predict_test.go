@@ -0,0 +1,81 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature
+
+import (
+	"strings"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+func TestPredict(t *testing.T) {
+	s := op.NewScope()
+	x := op.Placeholder(s.SubScope("x"), tf.Float)
+	op.Neg(s.SubScope("neg"), x)
+	op.Square(s.SubScope("square"), x)
+	graph, err := s.Finalize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sess, err := tf.NewSession(graph, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sess.Close()
+	m := &tf.SavedModel{Session: sess, Graph: graph}
+	sig := Signature{
+		Inputs: map[string]TensorInfo{
+			"x": {Name: "x/Placeholder:0", DataType: tf.Float, Shape: tf.ScalarShape()},
+		},
+		Outputs: map[string]TensorInfo{
+			"neg":    {Name: "neg/Neg:0", DataType: tf.Float, Shape: tf.ScalarShape()},
+			"minus":  {Name: "neg/Neg", DataType: tf.Float, Shape: tf.ScalarShape()},
+			"square": {Name: "square/Square:0", DataType: tf.Float, Shape: tf.ScalarShape()},
+		},
+	}
+	outputs, err := Predict(m, sig, NewFeed().Set("x", 3), Fetch("square", "neg", "minus"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(outputs) != 3 || outputs["neg"] != outputs["minus"] {
+		t.Errorf("Got %v, want 3 outputs with neg and minus sharing a Tensor", outputs)
+	}
+	if got := outputs["square"].Value().(float32); got != 9 {
+		t.Errorf("Got square %v, want 9", got)
+	}
+	if outputs, err = Predict(m, sig, NewFeed().Set("x", 3), Fetch("neg")); err != nil {
+		t.Fatal(err)
+	} else if len(outputs) != 1 || outputs["neg"].Value().(float32) != -3 {
+		t.Errorf("Got %v, want only neg = -3", outputs)
+	}
+	if outputs, err = Predict(m, sig, NewFeed().Set("x", 3), Fetch()); err != nil {
+		t.Fatal(err)
+	} else if len(outputs) != 3 {
+		t.Errorf("Got %d outputs, want all 3", len(outputs))
+	}
+	for _, test := range []struct {
+		fetch Fetches
+		want  string
+	}{
+		{Fetch("probabilities"), `output "probabilities": not an output`},
+		{Fetch("neg", "neg"), `output "neg": fetched more than once`},
+	} {
+		if _, err := Predict(m, sig, NewFeed().Set("x", 3), test.fetch); err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("Got error %v, want %q", err, test.want)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature
+
+import (
+	"bytes"
+	"fmt"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+// Preprocessor adds operations to scope converting data in a wire format
+// (e.g., base64 encoded JPEG images) to values of the input of a signature
+// described by input. It returns the placeholders for the converted data,
+// keyed by the names used for them by clients, and the converted value.
+type Preprocessor func(scope *op.Scope, input TensorInfo) (inputs map[string]tf.Output, output tf.Output)
+
+// Pipeline is a model with preprocessing operations in front of one of the
+// inputs of one of its signatures.
+type Pipeline struct {
+	// Graph holds the preprocessing operations, followed by the
+	// operations of the model reading their output in place of the
+	// preprocessed input.
+	Graph *tf.Graph
+	// Signature describes the inputs of the pipeline in Graph, in which
+	// the preprocessed input of the original signature is replaced by the
+	// inputs of the preprocessing operations, and its outputs, which are
+	// those of the original signature. It can be exported as the
+	// SignatureDef of Graph with its SignatureDef method.
+	Signature Signature
+}
+
+// Prepend returns the Pipeline running the operations of p in front of the
+// input of sig named key. graph must be the graph of the model that sig
+// belongs to, and is left unchanged: the operations of p are added to a new
+// graph, in the "preprocess_<key>" namespace, and graph is imported into it
+// with the operation fed as the input named key replaced by the output of p.
+//
+// The variables of the model are not copied: sessions of the Graph of the
+// Pipeline must restore or initialize them, for example by running the
+// restore operation of the saver of the model.
+func Prepend(graph *tf.Graph, sig Signature, key string, p Preprocessor) (*Pipeline, error) {
+	info, ok := sig.Inputs[key]
+	if !ok {
+		return nil, fmt.Errorf("input %q not found in the signature", key)
+	}
+	// Checks that the input is in graph.
+	if _, err := info.Output(graph); err != nil {
+		return nil, err
+	}
+	name, index, err := tf.ParseTensorName(info.Name)
+	if err != nil {
+		return nil, err
+	}
+	pl := &Pipeline{
+		Graph: tf.NewGraph(),
+		Signature: Signature{
+			MethodName: sig.MethodName,
+			Inputs:     make(map[string]TensorInfo),
+			Outputs:    sig.Outputs,
+		},
+	}
+	scope := op.NewScopeWithGraph(pl.Graph).SubScope("preprocess_" + key)
+	inputs, output := p(scope, info)
+	if err := scope.Err(); err != nil {
+		return nil, err
+	}
+	if output.DataType() != info.DataType {
+		return nil, fmt.Errorf("preprocessing produces %v values, input %q requires %v", output.DataType(), key, info.DataType)
+	}
+	if shape := output.Shape(); !shape.IsCompatibleWith(info.Shape) {
+		return nil, fmt.Errorf("preprocessing produces values of shape %v, input %q requires %v", shape, key, info.Shape)
+	}
+	for k, v := range sig.Inputs {
+		if k != key {
+			pl.Signature.Inputs[k] = v
+		}
+	}
+	for k, o := range inputs {
+		if _, ok := pl.Signature.Inputs[k]; ok {
+			return nil, fmt.Errorf("preprocessing input %q collides with an input of the signature", k)
+		}
+		pl.Signature.Inputs[k] = TensorInfo{
+			Name:     fmt.Sprintf("%s:%d", o.Op.Name(), o.Index),
+			DataType: o.DataType(),
+			Shape:    o.Shape(),
+		}
+	}
+	var def bytes.Buffer
+	if _, err := graph.WriteTo(&def); err != nil {
+		return nil, err
+	}
+	var opts tf.GraphImportOptions
+	opts.AddInputMapping(name, index, output)
+	if err := pl.Graph.ImportWithOptions(def.Bytes(), opts); err != nil {
+		return nil, err
+	}
+	return pl, nil
+}
+
+// Run runs the pipeline in sess, a session of p.Graph, returning the values
+// of the outputs of the signature named in fetches. feeds and fetches are
+// keyed by the names in p.Signature. The preprocessing operations and the
+// model are run by a single Session.Run call.
+func (p *Pipeline) Run(sess *tf.Session, feeds map[string]*tf.Tensor, fetches []string) ([]*tf.Tensor, error) {
+	run := make(map[tf.Output]*tf.Tensor, len(feeds))
+	for k, t := range feeds {
+		info, ok := p.Signature.Inputs[k]
+		if !ok {
+			return nil, fmt.Errorf("input %q not found in the signature", k)
+		}
+		o, err := info.Output(p.Graph)
+		if err != nil {
+			return nil, err
+		}
+		run[o] = t
+	}
+	outputs := make([]tf.Output, len(fetches))
+	for i, k := range fetches {
+		info, ok := p.Signature.Outputs[k]
+		if !ok {
+			return nil, fmt.Errorf("output %q not found in the signature", k)
+		}
+		var err error
+		if outputs[i], err = info.Output(p.Graph); err != nil {
+			return nil, err
+		}
+	}
+	return sess.Run(run, outputs, nil)
+}
+
+// JPEGInput returns a Preprocessor for an image input of a signature of
+// shape [batch, height, width, channels] (where batch is 1 or unknown),
+// replacing it with an input named key holding a scalar string containing a
+// JPEG image, encoded with web-safe base64 (as in JSON requests) if base64
+// is true. The decoded image is converted to the type of the signature
+// input, without scaling.
+func JPEGInput(key string, base64 bool) Preprocessor {
+	return func(scope *op.Scope, input TensorInfo) (map[string]tf.Output, tf.Output) {
+		contents := op.Placeholder(scope.SubScope(key), tf.String, op.PlaceholderShape(tf.ScalarShape()))
+		inputs := map[string]tf.Output{key: contents}
+		if base64 {
+			contents = op.DecodeBase64(scope, contents)
+		}
+		var options []op.DecodeJpegAttr
+		if input.Shape.NumDimensions() == 4 {
+			if channels := input.Shape.Size(3); channels > 0 {
+				options = append(options, op.DecodeJpegChannels(channels))
+			}
+		}
+		image := op.DecodeJpeg(scope, contents, options...)
+		if input.DataType != tf.Uint8 {
+			image = op.Cast(scope, image, input.DataType)
+		}
+		return inputs, op.EnsureBatchDim(scope, image)
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+func TestPrepend(t *testing.T) {
+	graph, sig := negateModel(t)
+	// Parses "a,b" into [[a, b]].
+	parse := func(scope *op.Scope, input TensorInfo) (map[string]tf.Output, tf.Output) {
+		csv := op.Placeholder(scope, tf.String, op.PlaceholderShape(tf.ScalarShape()))
+		vector := op.Reshape(scope, csv, op.Const(scope.SubScope("shape"), []int32{1}))
+		_, values, _ := op.StringSplit(scope, vector, op.Const(scope.SubScope("delimiter"), ","))
+		return map[string]tf.Output{"csv": csv}, op.EnsureBatchDim(scope, op.StringToNumber(scope, values))
+	}
+	p, err := Prepend(graph, sig, "x", parse)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := p.Signature.Inputs["x"]; ok {
+		t.Error("Preprocessed input is still in the signature")
+	}
+	if info := p.Signature.Inputs["csv"]; info.DataType != tf.String || !strings.HasPrefix(info.Name, "preprocess_x/") {
+		t.Errorf("Unexpected input %+v", info)
+	}
+	if graph.Operation("preprocess_x/Placeholder") != nil {
+		t.Error("Prepend added operations to the graph of the model")
+	}
+	if neg := p.Graph.Operation("y/Neg"); neg == nil || !strings.HasPrefix(neg.Input(0).Op.Name(), "preprocess_x/") {
+		t.Error("The model does not read the output of the preprocessing operations")
+	}
+	sess, err := tf.NewSession(p.Graph, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sess.Close()
+	csv, err := tf.NewTensor("1.5,-2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := p.Run(sess, map[string]*tf.Tensor{"csv": csv}, []string{"y"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := out[0].Value(), [][]float32{{-1.5, 2}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Got %v, want %v", got, want)
+	}
+	if _, err := p.Run(sess, nil, []string{"y"}); err == nil {
+		t.Error("Run succeeded without feeding the preprocessing input")
+	}
+
+	if _, err := Prepend(graph, sig, "z", parse); err == nil {
+		t.Error("Prepend succeeded for a missing input")
+	}
+	wrongType := func(scope *op.Scope, input TensorInfo) (map[string]tf.Output, tf.Output) {
+		x := op.Placeholder(scope, tf.Int32)
+		return map[string]tf.Output{"i": x}, x
+	}
+	graph, sig = negateModel(t)
+	if _, err := Prepend(graph, sig, "x", wrongType); err == nil {
+		t.Error("Prepend succeeded with preprocessing producing the wrong type")
+	}
+}
+
+func TestJPEGInput(t *testing.T) {
+	s := op.NewScope()
+	op.Placeholder(s.SubScope("image"), tf.Float, op.PlaceholderShape(tf.MakeShape(-1, -1, -1, 3)))
+	graph, err := s.Finalize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig := Signature{Inputs: map[string]TensorInfo{
+		"image": {Name: "image/Placeholder:0", DataType: tf.Float, Shape: tf.MakeShape(-1, -1, -1, 3)},
+	}}
+	p, err := Prepend(graph, sig, "image", JPEGInput("image_bytes", true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info := p.Signature.Inputs["image_bytes"]; info.DataType != tf.String || info.Shape.NumDimensions() != 0 {
+		t.Errorf("Unexpected input %+v", info)
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	framework "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/protobuf"
+)
+
+// LoadSavedModelWithInputShapes is like tf.LoadSavedModel, but first gives
+// the inputs of the signature key the shapes in shapes, keyed like
+// Signature.Inputs. This allows a model exported with unknown dimensions,
+// such as the batch size or the size of images, to be specialized when it
+// is served with known ones: shape inference runs with the new shapes as
+// the graph is loaded, and optimizations (such as those of XLA) can rely
+// on them.
+//
+// Dimensions of shapes can be -1 to keep the dimension of the input, and
+// known dimensions of the input must not be changed. See SetInputShapes.
+//
+// The model is loaded from a temporary directory containing the rewritten
+// saved_model.pb and links to the variables and assets of exportDir, which
+// is removed once the model is loaded.
+func LoadSavedModelWithInputShapes(exportDir string, tags []string, options *tf.SessionOptions, key string, shapes map[string]tf.Shape) (*tf.SavedModel, error) {
+	b, err := ioutil.ReadFile(filepath.Join(exportDir, "saved_model.pb"))
+	if err != nil {
+		return nil, err
+	}
+	var sm pb.SavedModel
+	if err := proto.Unmarshal(b, &sm); err != nil {
+		return nil, fmt.Errorf("invalid SavedModel: %v", err)
+	}
+	mgd := findMetaGraphDef(sm.MetaGraphs, tags)
+	if mgd == nil {
+		return nil, fmt.Errorf("no MetaGraphDef with tags %q in %s", tags, exportDir)
+	}
+	if err := setInputShapes(mgd, key, shapes); err != nil {
+		return nil, err
+	}
+	if b, err = proto.Marshal(&sm); err != nil {
+		return nil, err
+	}
+
+	dir, err := ioutil.TempDir("", "saved_model")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "saved_model.pb"), b, 0644); err != nil {
+		return nil, err
+	}
+	abs, err := filepath.Abs(exportDir)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range []string{"variables", "assets", "assets.extra"} {
+		if _, err := os.Stat(filepath.Join(abs, name)); os.IsNotExist(err) {
+			continue
+		}
+		if err := os.Symlink(filepath.Join(abs, name), filepath.Join(dir, name)); err != nil {
+			return nil, err
+		}
+	}
+	return tf.LoadSavedModel(dir, tags, options)
+}
+
+// findMetaGraphDef returns the MetaGraphDef whose tags are tags, as
+// tf.LoadSavedModel does.
+func findMetaGraphDef(mgds []*pb.MetaGraphDef, tags []string) *pb.MetaGraphDef {
+	want := sortedSet(tags)
+	for _, mgd := range mgds {
+		if mgd.MetaInfoDef != nil && sortedSet(mgd.MetaInfoDef.Tags) == want {
+			return mgd
+		}
+	}
+	return nil
+}
+
+func sortedSet(tags []string) string {
+	set := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		set[t] = true
+	}
+	uniq := make([]string, 0, len(set))
+	for t := range set {
+		uniq = append(uniq, t)
+	}
+	sort.Strings(uniq)
+	return strings.Join(uniq, "\x00")
+}
+
+// SetInputShapes returns a copy of a serialized tensorflow.MetaGraphDef in
+// which the inputs of the signature key have the shapes in shapes, keyed
+// like Signature.Inputs. Both the placeholders of the graph feeding the
+// inputs and the signatures using them are updated.
+//
+// Dimensions of shapes can be -1 to keep the dimension of the input, and
+// known dimensions of the input must not be changed.
+func SetInputShapes(metaGraphDef []byte, key string, shapes map[string]tf.Shape) ([]byte, error) {
+	var mgd pb.MetaGraphDef
+	if err := proto.Unmarshal(metaGraphDef, &mgd); err != nil {
+		return nil, fmt.Errorf("invalid MetaGraphDef: %v", err)
+	}
+	if err := setInputShapes(&mgd, key, shapes); err != nil {
+		return nil, err
+	}
+	return proto.Marshal(&mgd)
+}
+
+func setInputShapes(mgd *pb.MetaGraphDef, key string, shapes map[string]tf.Shape) error {
+	def, ok := mgd.SignatureDef[key]
+	if !ok {
+		return fmt.Errorf("signature %q not found", key)
+	}
+	nodes := make(map[string]*framework.NodeDef)
+	if mgd.GraphDef != nil {
+		for _, n := range mgd.GraphDef.Node {
+			nodes[n.Name] = n
+		}
+	}
+	for input, shape := range shapes {
+		info, ok := def.Inputs[input]
+		if !ok {
+			return fmt.Errorf("signature %q has no input %q", key, input)
+		}
+		name, _, err := tf.ParseTensorName(info.Name)
+		if err != nil {
+			return fmt.Errorf("input %q: %v", input, err)
+		}
+		node, ok := nodes[name]
+		if !ok {
+			return fmt.Errorf("input %q: operation %q not found in the graph", input, name)
+		}
+		switch node.Op {
+		case "Placeholder", "PlaceholderV2", "PlaceholderWithDefault":
+		default:
+			return fmt.Errorf("input %q: %q is a %s, not a placeholder", input, name, node.Op)
+		}
+		old := node.Attr["shape"].GetShape()
+		if old != nil && !old.UnknownRank && len(old.Dim) == 0 && node.Op == "Placeholder" &&
+			(graphVersion(mgd.GraphDef) < scalarPlaceholderVersion || info.GetTensorShape().GetUnknownRank()) {
+			// An unknown shape, not a scalar.
+			old = nil
+		}
+		merged, err := mergeShape(old, shape)
+		if err != nil {
+			return fmt.Errorf("input %q: %v", input, err)
+		}
+		if node.Attr == nil {
+			node.Attr = make(map[string]*framework.AttrValue)
+		}
+		node.Attr["shape"] = &framework.AttrValue{Value: &framework.AttrValue_Shape{Shape: merged}}
+		// Recorded by older versions of TensorFlow, and no longer valid.
+		delete(node.Attr, "_output_shapes")
+		for _, sig := range mgd.SignatureDef {
+			for _, ti := range sig.Inputs {
+				if ti.Name == info.Name {
+					ti.TensorShape = merged
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// scalarPlaceholderVersion is the first version of GraphDefs in which an
+// empty shape of a Placeholder is a scalar: in older ones, as for the
+// runtime, it is an unknown shape, which the TensorInfo of signatures then
+// record as an unknown rank.
+const scalarPlaceholderVersion = 22
+
+// graphVersion returns the producer version of def.
+func graphVersion(def *framework.GraphDef) int32 {
+	if def.GetVersions() != nil {
+		return def.Versions.Producer
+	}
+	return def.GetVersion()
+}
+
+// mergeShape returns shape, with its unknown dimensions replaced by those
+// of the shape of the placeholder, old.
+func mergeShape(old *framework.TensorShapeProto, shape tf.Shape) (*framework.TensorShapeProto, error) {
+	if shape.NumDimensions() < 0 {
+		return nil, fmt.Errorf("the new shape must have a known rank")
+	}
+	known := old != nil && !old.UnknownRank
+	if known && len(old.Dim) != shape.NumDimensions() {
+		return nil, fmt.Errorf("cannot change the rank of %v to %v", protoShape(old), shape)
+	}
+	merged := &framework.TensorShapeProto{Dim: make([]*framework.TensorShapeProto_Dim, shape.NumDimensions())}
+	for i := range merged.Dim {
+		size := shape.Size(i)
+		if known && old.Dim[i].Size >= 0 {
+			if size >= 0 && size != old.Dim[i].Size {
+				return nil, fmt.Errorf("cannot change the known dimension %d of %v to %d", i, protoShape(old), size)
+			}
+			size = old.Dim[i].Size
+		}
+		merged.Dim[i] = &framework.TensorShapeProto_Dim{Size: size}
+	}
+	return merged, nil
+}
+
+func protoShape(s *framework.TensorShapeProto) tf.Shape {
+	if s == nil || s.UnknownRank {
+		return tf.Shape{}
+	}
+	dims := make([]int64, len(s.Dim))
+	for i, d := range s.Dim {
+		dims[i] = d.Size
+	}
+	return tf.MakeShape(dims...)
+}
@@ -0,0 +1,156 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	framework "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/protobuf"
+)
+
+func TestSetInputShapes(t *testing.T) {
+	shape := func(dims ...int64) *framework.TensorShapeProto {
+		s := &framework.TensorShapeProto{}
+		for _, d := range dims {
+			s.Dim = append(s.Dim, &framework.TensorShapeProto_Dim{Size: d})
+		}
+		return s
+	}
+	mgd := &pb.MetaGraphDef{
+		GraphDef: &framework.GraphDef{
+			Node: []*framework.NodeDef{
+				{
+					Name: "images",
+					Op:   "Placeholder",
+					Attr: map[string]*framework.AttrValue{
+						"dtype":          {Value: &framework.AttrValue_Type{Type: framework.DataType_DT_FLOAT}},
+						"shape":          {Value: &framework.AttrValue_Shape{Shape: shape(-1, -1, -1, 3)}},
+						"_output_shapes": {},
+					},
+				},
+				{Name: "scores", Op: "Identity", Input: []string{"images"}},
+			},
+		},
+		SignatureDef: map[string]*pb.SignatureDef{
+			DefaultKey: {
+				Inputs: map[string]*pb.TensorInfo{
+					"images": {Name: "images:0", Dtype: framework.DataType_DT_FLOAT, TensorShape: shape(-1, -1, -1, 3)},
+				},
+				Outputs: map[string]*pb.TensorInfo{
+					"scores": {Name: "scores:0", Dtype: framework.DataType_DT_FLOAT},
+				},
+			},
+		},
+	}
+	buf, err := proto.Marshal(mgd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf, err = SetInputShapes(buf, DefaultKey, map[string]tf.Shape{"images": tf.MakeShape(8, 224, 224, -1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got pb.MetaGraphDef
+	if err := proto.Unmarshal(buf, &got); err != nil {
+		t.Fatal(err)
+	}
+	attrs := got.GraphDef.Node[0].Attr
+	if s := protoShape(attrs["shape"].GetShape()); s.String() != "[8, 224, 224, 3]" {
+		t.Errorf("Got placeholder shape %v, want [8, 224, 224, 3]", s)
+	}
+	if _, ok := attrs["_output_shapes"]; ok {
+		t.Error("_output_shapes was not removed")
+	}
+	if s := protoShape(got.SignatureDef[DefaultKey].Inputs["images"].TensorShape); s.String() != "[8, 224, 224, 3]" {
+		t.Errorf("Got signature shape %v, want [8, 224, 224, 3]", s)
+	}
+
+	invalid := []struct {
+		input string
+		shape tf.Shape
+	}{
+		{"images", tf.MakeShape(8, 224, 224)},
+		{"images", tf.MakeShape(8, 224, 224, 1)},
+		{"images", tf.Shape{}},
+		{"labels", tf.MakeShape(8)},
+	}
+	for _, test := range invalid {
+		if _, err := SetInputShapes(buf, DefaultKey, map[string]tf.Shape{test.input: test.shape}); err == nil {
+			t.Errorf("SetInputShapes accepted shape %v for %q", test.shape, test.input)
+		}
+	}
+	if _, err := SetInputShapes(buf, "missing", nil); err == nil {
+		t.Error("SetInputShapes accepted a missing signature")
+	}
+}
+
+func TestLoadSavedModelWithInputShapes(t *testing.T) {
+	m, err := LoadSavedModelWithInputShapes("../../cc/saved_model/testdata/half_plus_two/00000123", []string{"serve"}, nil,
+		"regress_x_to_y", map[string]tf.Shape{"inputs": tf.MakeShape(2)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+	op := m.Graph.Operation("tf_example")
+	if op == nil {
+		t.Fatal("tf_example not found")
+	}
+	if s, err := op.Output(0).Shape().ToSlice(); err != nil || len(s) != 1 || s[0] != 2 {
+		t.Errorf("Got shape %v (%v), want [2]", s, err)
+	}
+}
+
+func TestSetInputShapesLegacyPlaceholder(t *testing.T) {
+	tests := []struct {
+		producer     int32
+		unknownRank  bool // Of the signature.
+		wantAccepted bool
+	}{
+		{producer: 21, wantAccepted: true},
+		{producer: 24, unknownRank: true, wantAccepted: true},
+		{producer: 24},
+	}
+	for _, test := range tests {
+		mgd := &pb.MetaGraphDef{
+			GraphDef: &framework.GraphDef{
+				Node: []*framework.NodeDef{{
+					Name: "x",
+					Op:   "Placeholder",
+					Attr: map[string]*framework.AttrValue{
+						"dtype": {Value: &framework.AttrValue_Type{Type: framework.DataType_DT_FLOAT}},
+						"shape": {Value: &framework.AttrValue_Shape{Shape: &framework.TensorShapeProto{}}},
+					},
+				}},
+				Versions: &framework.VersionDef{Producer: test.producer},
+			},
+			SignatureDef: map[string]*pb.SignatureDef{
+				DefaultKey: {Inputs: map[string]*pb.TensorInfo{
+					"x": {Name: "x:0", Dtype: framework.DataType_DT_FLOAT, TensorShape: &framework.TensorShapeProto{UnknownRank: test.unknownRank}},
+				}},
+			},
+		}
+		buf, err := proto.Marshal(mgd)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = SetInputShapes(buf, DefaultKey, map[string]tf.Shape{"x": tf.MakeShape(2)})
+		if accepted := err == nil; accepted != test.wantAccepted {
+			t.Errorf("producer %d, unknown rank %v: got error %v", test.producer, test.unknownRank, err)
+		}
+	}
+}
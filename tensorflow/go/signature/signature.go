@@ -0,0 +1,125 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package signature interprets the SignatureDefs of SavedModels, which
+// describe the inputs and outputs of the computations exported by a model,
+// and binds them to Go types.
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package signature
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	framework "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/protobuf"
+)
+
+// DefaultKey is the name of the signature used by TensorFlow Serving when a
+// request does not name one.
+const DefaultKey = "serving_default"
+
+// TensorInfo describes a tensor in a Signature.
+type TensorInfo struct {
+	// Name is the name of the tensor in the graph, in the form
+	// "operation:index" (e.g., "x:0").
+	Name     string
+	DataType tf.DataType
+	Shape    tf.Shape
+}
+
+// Signature describes the inputs and outputs of a computation exported by a
+// model, keyed by the names clients use for them.
+type Signature struct {
+	// MethodName identifies the kind of computation, for example
+	// "tensorflow/serving/predict".
+	MethodName string
+	Inputs     map[string]TensorInfo
+	Outputs    map[string]TensorInfo
+}
+
+// FromSavedModel returns the signatures of a SavedModel, keyed by name.
+func FromSavedModel(m *tf.SavedModel) (map[string]Signature, error) {
+	return FromMetaGraphDef(m.MetaGraphDef)
+}
+
+// FromMetaGraphDef returns the signatures in a serialized
+// tensorflow.MetaGraphDef protocol buffer, keyed by name.
+func FromMetaGraphDef(metaGraphDef []byte) (map[string]Signature, error) {
+	var mgd pb.MetaGraphDef
+	if err := proto.Unmarshal(metaGraphDef, &mgd); err != nil {
+		return nil, fmt.Errorf("invalid MetaGraphDef: %v", err)
+	}
+	sigs := make(map[string]Signature, len(mgd.SignatureDef))
+	for name, def := range mgd.SignatureDef {
+		sig := Signature{
+			MethodName: def.MethodName,
+			Inputs:     make(map[string]TensorInfo, len(def.Inputs)),
+			Outputs:    make(map[string]TensorInfo, len(def.Outputs)),
+		}
+		for key, info := range def.Inputs {
+			sig.Inputs[key] = tensorInfo(info)
+		}
+		for key, info := range def.Outputs {
+			sig.Outputs[key] = tensorInfo(info)
+		}
+		sigs[name] = sig
+	}
+	return sigs, nil
+}
+
+func tensorInfo(info *pb.TensorInfo) TensorInfo {
+	ret := TensorInfo{Name: info.Name, DataType: tf.DataType(info.Dtype)}
+	if s := info.TensorShape; s != nil && !s.UnknownRank {
+		dims := make([]int64, len(s.Dim))
+		for i, d := range s.Dim {
+			dims[i] = d.Size
+		}
+		ret.Shape = tf.MakeShape(dims...)
+	}
+	return ret
+}
+
+// SignatureDef returns s as a serialized tensorflow.SignatureDef protocol
+// buffer, as found in the MetaGraphDefs of SavedModels.
+func (s Signature) SignatureDef() ([]byte, error) {
+	def := &pb.SignatureDef{
+		MethodName: s.MethodName,
+		Inputs:     make(map[string]*pb.TensorInfo, len(s.Inputs)),
+		Outputs:    make(map[string]*pb.TensorInfo, len(s.Outputs)),
+	}
+	for key, info := range s.Inputs {
+		def.Inputs[key] = info.proto()
+	}
+	for key, info := range s.Outputs {
+		def.Outputs[key] = info.proto()
+	}
+	return proto.Marshal(def)
+}
+
+func (ti TensorInfo) proto() *pb.TensorInfo {
+	shape := &framework.TensorShapeProto{UnknownRank: ti.Shape.NumDimensions() < 0}
+	for i := 0; i < ti.Shape.NumDimensions(); i++ {
+		shape.Dim = append(shape.Dim, &framework.TensorShapeProto_Dim{Size: ti.Shape.Size(i)})
+	}
+	return &pb.TensorInfo{Name: ti.Name, Dtype: framework.DataType(ti.DataType), TensorShape: shape}
+}
+
+// Output returns the Output of graph identified by ti.Name.
+func (ti TensorInfo) Output(graph *tf.Graph) (tf.Output, error) {
+	return graph.OutputByName(ti.Name)
+}
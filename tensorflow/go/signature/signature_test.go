@@ -0,0 +1,135 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signature
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	framework "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/protobuf"
+)
+
+func TestFromMetaGraphDef(t *testing.T) {
+	mgd := &pb.MetaGraphDef{
+		SignatureDef: map[string]*pb.SignatureDef{
+			DefaultKey: {
+				MethodName: "tensorflow/serving/predict",
+				Inputs: map[string]*pb.TensorInfo{
+					"input_ids": {
+						Name:  "ids:0",
+						Dtype: framework.DataType_DT_INT64,
+						TensorShape: &framework.TensorShapeProto{
+							Dim: []*framework.TensorShapeProto_Dim{{Size: -1}, {Size: 128}},
+						},
+					},
+				},
+				Outputs: map[string]*pb.TensorInfo{
+					"scores": {
+						Name:        "scores:0",
+						Dtype:       framework.DataType_DT_FLOAT,
+						TensorShape: &framework.TensorShapeProto{UnknownRank: true},
+					},
+				},
+			},
+		},
+	}
+	buf, err := proto.Marshal(mgd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sigs, err := FromMetaGraphDef(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, ok := sigs[DefaultKey]
+	if !ok {
+		t.Fatalf("%q not found in %v", DefaultKey, sigs)
+	}
+	if got, want := sig.MethodName, "tensorflow/serving/predict"; got != want {
+		t.Errorf("Got method name %q, want %q", got, want)
+	}
+	in := sig.Inputs["input_ids"]
+	if in.Name != "ids:0" || in.DataType != tf.Int64 || in.Shape.String() != "[?, 128]" {
+		t.Errorf("Got input %+v", in)
+	}
+	out := sig.Outputs["scores"]
+	if out.Name != "scores:0" || out.DataType != tf.Float || out.Shape.NumDimensions() != -1 {
+		t.Errorf("Got output %+v", out)
+	}
+	if _, err := FromMetaGraphDef([]byte("not a proto")); err == nil {
+		t.Error("FromMetaGraphDef accepted an invalid MetaGraphDef")
+	}
+}
+
+func TestSignatureDef(t *testing.T) {
+	sig := Signature{
+		MethodName: "tensorflow/serving/predict",
+		Inputs: map[string]TensorInfo{
+			"x": {Name: "x:0", DataType: tf.Float, Shape: tf.MakeShape(-1, 2)},
+			"s": {Name: "s:0", DataType: tf.String, Shape: tf.ScalarShape()},
+		},
+		Outputs: map[string]TensorInfo{
+			"y": {Name: "y:1", DataType: tf.Int64},
+		},
+	}
+	b, err := sig.SignatureDef()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var def pb.SignatureDef
+	if err := proto.Unmarshal(b, &def); err != nil {
+		t.Fatal(err)
+	}
+	mgd, err := proto.Marshal(&pb.MetaGraphDef{SignatureDef: map[string]*pb.SignatureDef{DefaultKey: &def}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sigs, err := FromMetaGraphDef(mgd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := sigs[DefaultKey]; !reflect.DeepEqual(got, sig) {
+		t.Errorf("Got %+v, want %+v", got, sig)
+	}
+}
+
+func TestFromSavedModel(t *testing.T) {
+	m, err := tf.LoadSavedModel("../../cc/saved_model/testdata/half_plus_two/00000123", []string{"serve"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+	sigs, err := FromSavedModel(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, ok := sigs["regress_x_to_y"]
+	if !ok {
+		t.Fatalf("regress_x_to_y not found in %v", sigs)
+	}
+	for key, info := range sig.Inputs {
+		if _, err := info.Output(m.Graph); err != nil {
+			t.Errorf("Input %q: %v", key, err)
+		}
+	}
+	for key, info := range sig.Outputs {
+		if _, err := info.Output(m.Graph); err != nil {
+			t.Errorf("Output %q: %v", key, err)
+		}
+	}
+}
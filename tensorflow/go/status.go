@@ -17,9 +17,63 @@ package tensorflow
 // #include "tensorflow/c/c_api.h"
 import "C"
 
-import "runtime"
+import (
+	"fmt"
+	"regexp"
+	"runtime"
+)
 
-type code C.TF_Code
+// Code is the canonical error code of a TensorFlow status. The values match
+// those in https://www.tensorflow.org/code/tensorflow/core/lib/core/error_codes.proto
+type Code C.TF_Code
+
+// Canonical error codes.
+const (
+	OK                 Code = C.TF_OK
+	Cancelled          Code = C.TF_CANCELLED
+	Unknown            Code = C.TF_UNKNOWN
+	InvalidArgument    Code = C.TF_INVALID_ARGUMENT
+	DeadlineExceeded   Code = C.TF_DEADLINE_EXCEEDED
+	NotFound           Code = C.TF_NOT_FOUND
+	AlreadyExists      Code = C.TF_ALREADY_EXISTS
+	PermissionDenied   Code = C.TF_PERMISSION_DENIED
+	Unauthenticated    Code = C.TF_UNAUTHENTICATED
+	ResourceExhausted  Code = C.TF_RESOURCE_EXHAUSTED
+	FailedPrecondition Code = C.TF_FAILED_PRECONDITION
+	Aborted            Code = C.TF_ABORTED
+	OutOfRange         Code = C.TF_OUT_OF_RANGE
+	Unimplemented      Code = C.TF_UNIMPLEMENTED
+	Internal           Code = C.TF_INTERNAL
+	Unavailable        Code = C.TF_UNAVAILABLE
+	DataLoss           Code = C.TF_DATA_LOSS
+)
+
+var codeNames = map[Code]string{
+	OK:                 "OK",
+	Cancelled:          "Cancelled",
+	Unknown:            "Unknown",
+	InvalidArgument:    "InvalidArgument",
+	DeadlineExceeded:   "DeadlineExceeded",
+	NotFound:           "NotFound",
+	AlreadyExists:      "AlreadyExists",
+	PermissionDenied:   "PermissionDenied",
+	Unauthenticated:    "Unauthenticated",
+	ResourceExhausted:  "ResourceExhausted",
+	FailedPrecondition: "FailedPrecondition",
+	Aborted:            "Aborted",
+	OutOfRange:         "OutOfRange",
+	Unimplemented:      "Unimplemented",
+	Internal:           "Internal",
+	Unavailable:        "Unavailable",
+	DataLoss:           "DataLoss",
+}
+
+func (c Code) String() string {
+	if name, ok := codeNames[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("Code(%d)", int(c))
+}
 
 // status holds error information returned by TensorFlow. We convert all
 // TF statuses to Go errors.
@@ -37,8 +91,8 @@ func (s *status) finalizer() {
 	C.TF_DeleteStatus(s.c)
 }
 
-func (s *status) Code() code {
-	return code(C.TF_GetCode(s.c))
+func (s *status) Code() Code {
+	return Code(C.TF_GetCode(s.c))
 }
 
 func (s *status) String() string {
@@ -46,20 +100,62 @@ func (s *status) String() string {
 }
 
 // Err converts the status to a Go error and returns nil if the status is OK.
+// The returned error is always a *StatusError.
 func (s *status) Err() error {
-	if s == nil || s.Code() == C.TF_OK {
+	if s == nil || s.Code() == OK {
 		return nil
 	}
-	return (*statusError)(s)
+	msg := s.String()
+	return &StatusError{Code: s.Code(), Message: msg, Op: opFromMessage(msg)}
 }
 
-// statusError is distinct from status because it fulfills the error interface.
-// status itself may have a TF_OK code and is not always considered an error.
+// StatusError is the error returned by functions in this package when the
+// TensorFlow runtime reports a failure.
+//
+// Callers can inspect the canonical error code to decide how to handle the
+// error, for example:
+//
+//	var serr *tf.StatusError
+//	if errors.As(err, &serr) && serr.Code == tf.ResourceExhausted {
+//		// Retry with a smaller batch.
+//	}
 //
-// TODO(jhseu): Make public, rename to Error, and provide a way for users to
-// check status codes.
-type statusError status
+// or, equivalently, errors.Is(err, &tf.StatusError{Code: tf.ResourceExhausted}).
+type StatusError struct {
+	// Code is the canonical error code.
+	Code Code
+	// Message is the error message provided by the runtime.
+	Message string
+	// Op is the name of the operation that caused the error, or the
+	// empty string if it is not known.
+	Op string
+}
+
+func (e *StatusError) Error() string {
+	return e.Message
+}
+
+// Is reports whether target is a *StatusError with the same Code as e.
+// Fields of target other than Code are only compared if they are set, so
+// &StatusError{Code: NotFound} matches any NotFound error.
+func (e *StatusError) Is(target error) bool {
+	t, ok := target.(*StatusError)
+	if !ok {
+		return false
+	}
+	return t.Code == e.Code &&
+		(t.Message == "" || t.Message == e.Message) &&
+		(t.Op == "" || t.Op == e.Op)
+}
 
-func (s *statusError) Error() string {
-	return (*status)(s).String()
+// opNamePattern matches the node description that the runtime appends to the
+// messages of errors raised while executing an operation, for example:
+// "Incompatible shapes: [2] vs. [3]\n\t [[Node: add = Add[T=DT_INT32] ...]]"
+var opNamePattern = regexp.MustCompile(`\[\[Node: ([^ =]+) = `)
+
+func opFromMessage(msg string) string {
+	if m := opNamePattern.FindStringSubmatch(msg); m != nil {
+		return m[1]
+	}
+	return ""
 }
@@ -0,0 +1,86 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestStatusErrorIs(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", &StatusError{Code: NotFound, Message: "no such file", Op: "read"})
+	if !errors.Is(err, &StatusError{Code: NotFound}) {
+		t.Errorf("errors.Is(%v, NotFound) = false", err)
+	}
+	if !errors.Is(err, &StatusError{Code: NotFound, Op: "read"}) {
+		t.Errorf("errors.Is(%v, NotFound in read) = false", err)
+	}
+	if errors.Is(err, &StatusError{Code: NotFound, Op: "write"}) {
+		t.Errorf("errors.Is(%v, NotFound in write) = true", err)
+	}
+	if errors.Is(err, &StatusError{Code: Internal}) {
+		t.Errorf("errors.Is(%v, Internal) = true", err)
+	}
+	var serr *StatusError
+	if !errors.As(err, &serr) || serr.Code != NotFound {
+		t.Errorf("errors.As(%v) returned %v", err, serr)
+	}
+}
+
+func TestStatusErrorOp(t *testing.T) {
+	g := NewGraph()
+	x, err := Placeholder(g, "x", Int32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	y, err := Const(g, "y", []float32{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Invalid graph construction: adding an int32 to a float32.
+	_, err = Add(g, "add", x, y)
+	var serr *StatusError
+	if !errors.As(err, &serr) {
+		t.Fatalf("Got %T (%v), want a *StatusError", err, err)
+	}
+	if serr.Code != InvalidArgument || serr.Op != "add" {
+		t.Errorf("Got (%v, %q), want (%v, %q)", serr.Code, serr.Op, InvalidArgument, "add")
+	}
+}
+
+func TestOpFromMessage(t *testing.T) {
+	tests := []struct {
+		msg, op string
+	}{
+		{"Incompatible shapes: [2] vs. [3]\n\t [[Node: add = Add[T=DT_INT32, _device=\"/job:localhost/replica:0/task:0/cpu:0\"](x, y)]]", "add"},
+		{"You must feed a value for placeholder tensor 'scope/x'\n\t [[Node: scope/x = Placeholder[dtype=DT_FLOAT]()]]", "scope/x"},
+		{"Could not find SavedModel", ""},
+	}
+	for _, test := range tests {
+		if got := opFromMessage(test.msg); got != test.op {
+			t.Errorf("opFromMessage(%q) = %q, want %q", test.msg, got, test.op)
+		}
+	}
+}
+
+func TestCodeString(t *testing.T) {
+	if got, want := ResourceExhausted.String(), "ResourceExhausted"; got != want {
+		t.Errorf("Got %q, want %q", got, want)
+	}
+	if got, want := Code(100).String(), "Code(100)"; got != want {
+		t.Errorf("Got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// StreamState describes a state tensor of a stateful model, such as the
+// hidden state of a recurrent network used for streaming speech
+// recognition, which is returned by each run and fed to the next run of the
+// same stream.
+type StreamState struct {
+	// Input is fed the state of the stream, typically a placeholder.
+	Input Output
+	// Output is the state of the stream after the run.
+	Output Output
+	// Initial is fed to Input for the first run of a stream. If nil,
+	// Input is not fed for the first run, and must have a default
+	// value (e.g., be a PlaceholderWithDefault).
+	Initial *Tensor
+}
+
+// StreamRunnerOptions configures a StreamRunner.
+type StreamRunnerOptions struct {
+	// TTL is the duration after which the state of a stream that is not
+	// run is discarded, so that a later run starts a new stream. Zero
+	// means that states are kept until Delete is called.
+	TTL time.Duration
+}
+
+// StreamRunner runs a stateful model for many streams, identified by keys,
+// keeping the state of each stream between runs.
+//
+// The runs of a stream are serialized, as each depends on the state
+// returned by the previous one, while different streams run concurrently.
+// The methods of StreamRunner are safe for concurrent use.
+type StreamRunner struct {
+	session *Session
+	states  []StreamState
+	opts    StreamRunnerOptions
+	now     func() time.Time
+
+	mu        sync.Mutex
+	streams   map[string]*stream
+	lastSweep time.Time
+}
+
+type stream struct {
+	mu    sync.Mutex // Held during the runs of the stream.
+	state []*Tensor  // Nil before the first run.
+
+	// Guarded by StreamRunner.mu.
+	running  int
+	lastUsed time.Time
+}
+
+// NewStreamRunner returns a StreamRunner for the model in session, whose
+// state is described by states.
+func NewStreamRunner(session *Session, states []StreamState, opts StreamRunnerOptions) *StreamRunner {
+	return &StreamRunner{
+		session: session,
+		states:  states,
+		opts:    opts,
+		now:     time.Now,
+		streams: make(map[string]*stream),
+	}
+}
+
+// Run is like Session.Run for the stream identified by key, additionally
+// feeding the state of the stream and storing the state it returns. The
+// state inputs must not be in feeds.
+//
+// If the run fails, the state of the stream is unchanged.
+func (r *StreamRunner) Run(key string, feeds map[Output]*Tensor, fetches []Output, targets []*Operation) ([]*Tensor, error) {
+	for _, s := range r.states {
+		if _, ok := feeds[s.Input]; ok {
+			return nil, fmt.Errorf("state input %s:%d is fed by the caller", s.Input.Op.Name(), s.Input.Index)
+		}
+	}
+	st := r.acquire(key)
+	defer r.release(st)
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	f := make(map[Output]*Tensor, len(feeds)+len(r.states))
+	for o, t := range feeds {
+		f[o] = t
+	}
+	all := append(append([]Output(nil), fetches...), make([]Output, len(r.states))...)
+	for i, s := range r.states {
+		all[len(fetches)+i] = s.Output
+		if st.state != nil {
+			f[s.Input] = st.state[i]
+		} else if s.Initial != nil {
+			f[s.Input] = s.Initial
+		}
+	}
+	results, err := r.session.Run(f, all, targets)
+	if err != nil {
+		return nil, err
+	}
+	st.state = results[len(fetches):]
+	return results[:len(fetches)], nil
+}
+
+// acquire returns the stream for key, marked as running so that it is not
+// evicted, after discarding the expired streams.
+func (r *StreamRunner) acquire(key string) *stream {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.now()
+	if r.opts.TTL > 0 && now.Sub(r.lastSweep) >= r.opts.TTL {
+		for k, st := range r.streams {
+			if r.expiredLocked(st, now) {
+				delete(r.streams, k)
+			}
+		}
+		r.lastSweep = now
+	}
+	st, ok := r.streams[key]
+	if !ok || r.expiredLocked(st, now) {
+		st = new(stream)
+		r.streams[key] = st
+	}
+	st.running++
+	return st
+}
+
+func (r *StreamRunner) release(st *stream) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	st.running--
+	st.lastUsed = r.now()
+}
+
+func (r *StreamRunner) expiredLocked(st *stream, now time.Time) bool {
+	return r.opts.TTL > 0 && st.running == 0 && !now.Before(st.lastUsed.Add(r.opts.TTL))
+}
+
+// Delete discards the state of the stream identified by key, for example
+// when the stream ends, so that a later run starts a new stream.
+func (r *StreamRunner) Delete(key string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.streams, key)
+}
+
+// Len returns the number of streams whose state is kept, including expired
+// streams that have not been discarded yet.
+func (r *StreamRunner) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.streams)
+}
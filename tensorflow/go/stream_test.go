@@ -0,0 +1,141 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestStreamRunner(t *testing.T) {
+	// sum = state + x, where the state is the previous sum, or 0.
+	g := NewGraph()
+	zero, err := Const(g, "zero", int64(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	state, err := g.AddOperation(OpSpec{
+		Type:  "PlaceholderWithDefault",
+		Name:  "state",
+		Input: []Input{zero},
+		Attrs: map[string]interface{}{"shape": ScalarShape()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	x, err := Placeholder(g, "x", Int64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum, err := Add(g, "sum", state.Output(0), x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewSession(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	now := time.Unix(0, 0)
+	r := NewStreamRunner(s, []StreamState{{Input: state.Output(0), Output: sum}}, StreamRunnerOptions{TTL: time.Minute})
+	r.now = func() time.Time { return now }
+	run := func(key string, v, want int64) {
+		in, err := NewTensor(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, err := r.Run(key, map[Output]*Tensor{x: in}, []Output{sum}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := out[0].Value(); got != want {
+			t.Errorf("Run(%q, %d): got %v, want %d", key, v, got, want)
+		}
+	}
+
+	run("a", 1, 1)
+	run("a", 2, 3)
+	run("b", 10, 10)
+	run("a", 3, 6)
+	if got := r.Len(); got != 2 {
+		t.Errorf("Got %d streams, want 2", got)
+	}
+
+	r.Delete("a")
+	run("a", 1, 1)
+
+	now = now.Add(30 * time.Second)
+	run("a", 1, 2)
+	now = now.Add(45 * time.Second)
+	// "b" expired, "a" did not.
+	run("a", 1, 3)
+	run("b", 1, 1)
+
+	if _, err := r.Run("a", map[Output]*Tensor{state.Output(0): nil}, []Output{sum}, nil); err == nil {
+		t.Error("Run feeding a state input succeeded, want an error")
+	}
+
+	// Concurrent runs of a stream are serialized.
+	one, err := NewTensor(int64(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := r.Run("c", map[Output]*Tensor{x: one}, nil, nil); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	run("c", 0, 20)
+}
+
+func TestStreamRunnerInitialState(t *testing.T) {
+	g := NewGraph()
+	state, err := Placeholder(g, "state", Int64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	next, err := Neg(g, "next", state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewSession(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	initial, err := NewTensor([]int64{1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := NewStreamRunner(s, []StreamState{{Input: state, Output: next, Initial: initial}}, StreamRunnerOptions{})
+	for _, want := range [][]int64{{-1, -2}, {1, 2}, {-1, -2}} {
+		out, err := r.Run("key", nil, []Output{next}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := out[0].Value(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Got %v, want %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,281 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package streaming runs models on streams of audio or video, such as a
+// microphone or a camera, by framing the stream into overlapping windows
+// that are fed to the model one after the other.
+//
+// It provides the skeleton shared by streaming inference applications:
+//
+//	s, err := streaming.Start(session, r, streaming.Options{
+//		Format:  streaming.PCM16(1),
+//		Window:  16000, // One second at 16kHz.
+//		Hop:     8000,  // Half a second.
+//		Input:   graph.Operation("audio").Output(0),
+//		Fetches: []tf.Output{graph.Operation("labels").Output(0)},
+//	})
+//	if err != nil {
+//		...
+//	}
+//	for res := range s.Results() {
+//		if res.Err != nil {
+//			...
+//		}
+//		...
+//	}
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package streaming
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sync"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+)
+
+// Format describes the frames of a stream and how a window of frames is
+// converted to the Tensor fed to the model.
+type Format struct {
+	// FrameSize is the size of a frame in bytes.
+	FrameSize int
+	// Decode returns the Tensor for window, the contents of frames
+	// consecutive frames.
+	Decode func(window []byte, frames int) (*tf.Tensor, error)
+}
+
+// PCM16 returns the Format of audio made of 16-bit little-endian PCM
+// samples, with channels interleaved samples per frame. Windows are
+// decoded to Float Tensors of shape [frames, channels] with values in
+// [-1, 1), as the DecodeWav operation of TensorFlow does.
+func PCM16(channels int) Format {
+	return Format{
+		FrameSize: 2 * channels,
+		Decode: func(window []byte, frames int) (*tf.Tensor, error) {
+			samples := make([][]float32, frames)
+			for i := range samples {
+				samples[i] = make([]float32, channels)
+				for c := range samples[i] {
+					v := int16(binary.LittleEndian.Uint16(window[2*(i*channels+c):]))
+					samples[i][c] = float32(v) / (1 << 15)
+				}
+			}
+			return tf.NewTensor(samples)
+		},
+	}
+}
+
+// RawFrames returns the Format of uncompressed frames of bytes of the given
+// shape, such as video frames of shape [height, width, 3] in RGB. Windows
+// are decoded to Uint8 Tensors of shape [frames, shape...].
+func RawFrames(shape ...int64) Format {
+	size := int64(1)
+	for _, d := range shape {
+		size *= d
+	}
+	return Format{
+		FrameSize: int(size),
+		Decode: func(window []byte, frames int) (*tf.Tensor, error) {
+			s := append([]int64{int64(frames)}, shape...)
+			return tf.ReadTensor(tf.Uint8, s, bytes.NewReader(window))
+		},
+	}
+}
+
+// Options configures a Stream.
+type Options struct {
+	// Format is the format of the frames of the stream.
+	Format Format
+	// Window is the number of frames of each window.
+	Window int
+	// Hop is the number of frames between the starts of consecutive
+	// windows. Windows overlap if Hop is less than Window, and frames are
+	// skipped if it is greater. Zero means Window.
+	Hop int
+	// PadFinal, if true, runs the model on the last frames of the stream
+	// that do not fill a window, padded with zeros. Otherwise they are
+	// dropped.
+	PadFinal bool
+
+	// Input is fed the Tensor of each window.
+	Input tf.Output
+	// Feeds are additional inputs fed to every run, such as the sample
+	// rate.
+	Feeds map[tf.Output]*tf.Tensor
+	// Fetches are the outputs returned for each window.
+	Fetches []tf.Output
+	// Targets are the operations run for each window.
+	Targets []*tf.Operation
+	// States is the state of the model carried over from each window to
+	// the next, as with tf.StreamRunner.
+	States []tf.StreamState
+
+	// Buffer is the number of results that may be computed before they
+	// are received.
+	Buffer int
+}
+
+// Result is the result of running the model on a window.
+type Result struct {
+	// Window is the index of the window in the stream.
+	Window int
+	// Start is the index in the stream of the first frame of the window.
+	Start int64
+	// Frames is the number of frames of the window read from the stream.
+	// It is less than Options.Window only for a padded final window.
+	Frames int
+	// Outputs are the values of Options.Fetches.
+	Outputs []*tf.Tensor
+	// Err is the error that ended the stream, in which case the other
+	// fields are not set.
+	Err error
+}
+
+// Stream runs a model on the windows of a stream.
+type Stream struct {
+	results chan Result
+	done    chan struct{}
+	once    sync.Once
+}
+
+// Start starts running the model in session on the windows of the frames
+// read from r, until r returns io.EOF or an error, or Stop is called.
+func Start(session *tf.Session, r io.Reader, opts Options) (*Stream, error) {
+	if opts.Format.FrameSize <= 0 || opts.Format.Decode == nil {
+		return nil, errors.New("streaming: invalid Format")
+	}
+	if opts.Window <= 0 {
+		return nil, fmt.Errorf("streaming: invalid Window %d", opts.Window)
+	}
+	if opts.Hop < 0 {
+		return nil, fmt.Errorf("streaming: invalid Hop %d", opts.Hop)
+	}
+	if opts.Hop == 0 {
+		opts.Hop = opts.Window
+	}
+	if _, ok := opts.Feeds[opts.Input]; ok {
+		return nil, errors.New("streaming: Input is in Feeds")
+	}
+	s := &Stream{
+		results: make(chan Result, opts.Buffer),
+		done:    make(chan struct{}),
+	}
+	runner := tf.NewStreamRunner(session, opts.States, tf.StreamRunnerOptions{})
+	go s.run(runner, r, opts)
+	return s, nil
+}
+
+// Results returns the channel of the results of the windows, in order. It
+// is closed when the stream ends, after a Result with an error if the
+// stream did not end with io.EOF.
+func (s *Stream) Results() <-chan Result {
+	return s.results
+}
+
+// Stop stops running the model. The results of the windows already run
+// may still be received. Stop does not interrupt a Read of the stream that
+// is blocked: closing the stream does.
+func (s *Stream) Stop() {
+	s.once.Do(func() { close(s.done) })
+}
+
+func (s *Stream) run(runner *tf.StreamRunner, r io.Reader, opts Options) {
+	defer close(s.results)
+	var (
+		size   = opts.Format.FrameSize
+		window = make([]byte, opts.Window*size)
+		frames int   // The number of frames in window.
+		start  int64 // The index of the first frame of window.
+	)
+	for i := 0; ; i++ {
+		n, err := io.ReadFull(r, window[frames*size:])
+		frames += n / size
+		eof := err == io.EOF || err == io.ErrUnexpectedEOF
+		if err != nil && !eof {
+			s.send(Result{Err: err})
+			return
+		}
+		if eof && (frames == 0 || !opts.PadFinal || i > 0 && frames <= opts.Window-opts.Hop) {
+			// Nothing new to run: either no frames, or only those
+			// already part of the previous window.
+			return
+		}
+		if eof {
+			for j := frames * size; j < len(window); j++ {
+				window[j] = 0
+			}
+		}
+		res, err := s.runWindow(runner, opts, window)
+		if err != nil {
+			s.send(Result{Err: err})
+			return
+		}
+		res.Window, res.Start, res.Frames = i, start, frames
+		if !s.send(res) || eof {
+			return
+		}
+		start += int64(opts.Hop)
+		if opts.Hop < opts.Window {
+			copy(window, window[opts.Hop*size:])
+			frames -= opts.Hop
+			continue
+		}
+		frames = 0
+		skip := int64(opts.Hop-opts.Window) * int64(size)
+		if _, err := io.CopyN(ioutil.Discard, r, skip); err == io.EOF {
+			return
+		} else if err != nil {
+			s.send(Result{Err: err})
+			return
+		}
+	}
+}
+
+func (s *Stream) runWindow(runner *tf.StreamRunner, opts Options, window []byte) (Result, error) {
+	t, err := opts.Format.Decode(window, opts.Window)
+	if err != nil {
+		return Result{}, fmt.Errorf("streaming: failed to decode window: %v", err)
+	}
+	feeds := make(map[tf.Output]*tf.Tensor, len(opts.Feeds)+1)
+	for o, v := range opts.Feeds {
+		feeds[o] = v
+	}
+	feeds[opts.Input] = t
+	outputs, err := runner.Run("", feeds, opts.Fetches, opts.Targets)
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{Outputs: outputs}, nil
+}
+
+// send sends res unless the stream is stopped, reporting whether it did.
+func (s *Stream) send(res Result) bool {
+	select {
+	case <-s.done:
+		return false
+	default:
+	}
+	select {
+	case s.results <- res:
+		return true
+	case <-s.done:
+		return false
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package streaming
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"reflect"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+// sumModel returns a session computing the sum of the samples of a window
+// of audio, scaled back to integers, and the total of the sums of all the
+// windows of the stream, kept as its state.
+func sumModel(t *testing.T) (s *tf.Session, audio, sum tf.Output, state tf.StreamState) {
+	scope := op.NewScope()
+	audio = op.Placeholder(scope.SubScope("audio"), tf.Float, op.PlaceholderShape(tf.MakeShape(-1, 1)))
+	sum = op.Mul(scope, op.Sum(scope, audio, op.Const(scope, []int32{0, 1})), op.Const(scope, float32(1<<15)))
+	prev := op.PlaceholderWithDefault(scope.SubScope("total"), op.Const(scope, float32(0)), tf.ScalarShape())
+	total := op.Add(scope, prev, sum)
+	graph, err := scope.Finalize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err = tf.NewSession(graph, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, audio, sum, tf.StreamState{Input: prev, Output: total}
+}
+
+// pcm returns n mono frames whose samples are 1, 2, ..., n.
+func pcm(n int) []byte {
+	b := make([]byte, 2*n)
+	for i := 0; i < n; i++ {
+		binary.LittleEndian.PutUint16(b[2*i:], uint16(i+1))
+	}
+	return b
+}
+
+func TestStream(t *testing.T) {
+	session, audio, sum, state := sumModel(t)
+	defer session.Close()
+
+	type window struct {
+		start      int64
+		frames     int
+		sum, total float32
+	}
+	tests := []struct {
+		name    string
+		frames  int
+		opts    Options
+		windows []window
+	}{
+		{
+			name:   "overlapping",
+			frames: 10,
+			opts:   Options{Window: 4, Hop: 2},
+			windows: []window{
+				{0, 4, 10, 10},
+				{2, 4, 18, 28},
+				{4, 4, 26, 54},
+				{6, 4, 34, 88},
+			},
+		},
+		{
+			name:   "padded",
+			frames: 11,
+			opts:   Options{Window: 4, Hop: 2, PadFinal: true},
+			windows: []window{
+				{0, 4, 10, 10},
+				{2, 4, 18, 28},
+				{4, 4, 26, 54},
+				{6, 4, 34, 88},
+				{8, 3, 30, 118},
+			},
+		},
+		{
+			name:   "dropped",
+			frames: 11,
+			opts:   Options{Window: 4, Hop: 2},
+			windows: []window{
+				{0, 4, 10, 10},
+				{2, 4, 18, 28},
+				{4, 4, 26, 54},
+				{6, 4, 34, 88},
+			},
+		},
+		{
+			name:   "adjacent",
+			frames: 9,
+			opts:   Options{Window: 3, PadFinal: true},
+			windows: []window{
+				{0, 3, 6, 6},
+				{3, 3, 15, 21},
+				{6, 3, 24, 45},
+			},
+		},
+		{
+			name:   "skipping",
+			frames: 14,
+			opts:   Options{Window: 4, Hop: 6},
+			windows: []window{
+				{0, 4, 10, 10},
+				{6, 4, 34, 44},
+			},
+		},
+	}
+	for _, test := range tests {
+		opts := test.opts
+		opts.Format = PCM16(1)
+		opts.Input = audio
+		opts.Fetches = []tf.Output{sum, state.Output}
+		opts.States = []tf.StreamState{state}
+		s, err := Start(session, bytes.NewReader(pcm(test.frames)), opts)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		var got []window
+		for res := range s.Results() {
+			if res.Err != nil {
+				t.Fatalf("%s: %v", test.name, res.Err)
+			}
+			if res.Window != len(got) {
+				t.Errorf("%s: got window %d, want %d", test.name, res.Window, len(got))
+			}
+			got = append(got, window{res.Start, res.Frames, res.Outputs[0].Value().(float32), res.Outputs[1].Value().(float32)})
+		}
+		if !reflect.DeepEqual(got, test.windows) {
+			t.Errorf("%s: got windows %v, want %v", test.name, got, test.windows)
+		}
+	}
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) { return 0, errors.New("broken") }
+
+func TestStreamErrors(t *testing.T) {
+	session, audio, sum, _ := sumModel(t)
+	defer session.Close()
+	opts := Options{Format: PCM16(1), Window: 4, Input: audio, Fetches: []tf.Output{sum}}
+
+	s, err := Start(session, failingReader{}, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var results []Result
+	for res := range s.Results() {
+		results = append(results, res)
+	}
+	if len(results) != 1 || results[0].Err == nil || results[0].Err.Error() != "broken" {
+		t.Errorf("Got %v, want a single result with the error of the reader", results)
+	}
+
+	for _, bad := range []Options{
+		{Format: PCM16(1), Input: audio},
+		{Format: PCM16(1), Window: 4, Hop: -1, Input: audio},
+		{Window: 4, Input: audio},
+	} {
+		if _, err := Start(session, bytes.NewReader(nil), bad); err == nil {
+			t.Errorf("Start(%+v) succeeded, want an error", bad)
+		}
+	}
+}
+
+func TestStreamStop(t *testing.T) {
+	session, audio, sum, _ := sumModel(t)
+	defer session.Close()
+	s, err := Start(session, bytes.NewReader(pcm(1000)), Options{Format: PCM16(1), Window: 1, Input: audio, Fetches: []tf.Output{sum}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := <-s.Results()
+	if res.Err != nil {
+		t.Fatal(res.Err)
+	}
+	s.Stop()
+	n := 1
+	for range s.Results() {
+		n++
+	}
+	if n >= 1000 {
+		t.Errorf("Got %d results after Stop, want fewer than 1000", n)
+	}
+}
+
+func TestRawFrames(t *testing.T) {
+	f := RawFrames(2, 3)
+	if f.FrameSize != 6 {
+		t.Errorf("Got FrameSize %d, want 6", f.FrameSize)
+	}
+	window := []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+	tensor, err := f.Decode(window, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := tensor.Shape(), []int64{2, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Got shape %v, want %v", got, want)
+	}
+	want := [][][]uint8{{{1, 2, 3}, {4, 5, 6}}, {{7, 8, 9}, {10, 11, 12}}}
+	if got := tensor.Value(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package summary
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+)
+
+// defaultBucketLimits are the bucket boundaries used by TensorFlow's
+// histogram summaries: buckets whose range grows by 10% from 1e-12 to 1e20,
+// mirrored for negative values. See
+// https://www.tensorflow.org/code/tensorflow/core/lib/histogram/histogram.cc
+var defaultBucketLimits = func() []float64 {
+	var pos []float64
+	for v := 1.0e-12; v < 1.0e20; v *= 1.1 {
+		pos = append(pos, v)
+	}
+	pos = append(pos, math.MaxFloat64)
+	limits := make([]float64, 0, 2*len(pos)+1)
+	for i := len(pos) - 1; i >= 0; i-- {
+		limits = append(limits, -pos[i])
+	}
+	limits = append(limits, 0)
+	return append(limits, pos...)
+}()
+
+// Histogram accumulates values into buckets compatible with TensorFlow's
+// histogram summaries (and the "Distributions" and "Histograms" dashboards of
+// TensorBoard).
+//
+// The zero value is not usable, use NewHistogram instead.
+type Histogram struct {
+	limits          []float64
+	buckets         []float64
+	min, max, num   float64
+	sum, sumSquares float64
+}
+
+// NewHistogram returns an empty Histogram that uses the same exponential
+// buckets as TensorFlow.
+func NewHistogram() *Histogram {
+	return &Histogram{
+		limits:  defaultBucketLimits,
+		buckets: make([]float64, len(defaultBucketLimits)),
+		min:     math.MaxFloat64,
+		max:     -math.MaxFloat64,
+	}
+}
+
+// Add adds v to the histogram.
+func (h *Histogram) Add(v float64) {
+	// The bucket of v is the first one whose limit is greater than v.
+	b := sort.Search(len(h.limits), func(i int) bool { return h.limits[i] > v })
+	if b == len(h.limits) {
+		// Only possible for +Inf and NaN.
+		b--
+	}
+	h.buckets[b]++
+	h.min = math.Min(h.min, v)
+	h.max = math.Max(h.max, v)
+	h.num++
+	h.sum += v
+	h.sumSquares += v * v
+}
+
+// Proto returns the histogram in the form used by summaries. Runs of empty
+// buckets are collapsed, exactly as is done by TensorFlow.
+func (h *Histogram) Proto() *pb.HistogramProto {
+	p := &pb.HistogramProto{
+		Min:        h.min,
+		Max:        h.max,
+		Num:        h.num,
+		Sum:        h.sum,
+		SumSquares: h.sumSquares,
+	}
+	for i := 0; i < len(h.buckets); {
+		end, count := h.limits[i], h.buckets[i]
+		i++
+		if count <= 0 {
+			for i < len(h.buckets) && h.buckets[i] <= 0 {
+				end, count = h.limits[i], h.buckets[i]
+				i++
+			}
+		}
+		p.BucketLimit = append(p.BucketLimit, end)
+		p.Bucket = append(p.Bucket, count)
+	}
+	return p
+}
+
+// HistogramOf computes the histogram of all the elements of value, which can
+// be a *tf.Tensor, or a numeric scalar, slice or array (possibly nested) such
+// as []float32 or [][]int64.
+func HistogramOf(value interface{}) (*pb.HistogramProto, error) {
+	if t, ok := value.(*tf.Tensor); ok {
+		v, err := t.DecodeValue()
+		if err != nil {
+			return nil, err
+		}
+		value = v
+	}
+	h := NewHistogram()
+	if err := h.addAll(reflect.ValueOf(value)); err != nil {
+		return nil, err
+	}
+	return h.Proto(), nil
+}
+
+func (h *Histogram) addAll(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		h.Add(v.Float())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		h.Add(float64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		h.Add(float64(v.Uint()))
+	case reflect.Bool:
+		if v.Bool() {
+			h.Add(1)
+		} else {
+			h.Add(0)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := h.addAll(v.Index(i)); err != nil {
+				return err
+			}
+		}
+	default:
+		if !v.IsValid() {
+			return fmt.Errorf("cannot compute the histogram of a nil value")
+		}
+		return fmt.Errorf("cannot compute the histogram of values of type %v", v.Type())
+	}
+	return nil
+}
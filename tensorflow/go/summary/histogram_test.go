@@ -0,0 +1,89 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package summary
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestDefaultBucketLimits(t *testing.T) {
+	// 774 limits from 1e-12 to 1e20, DBL_MAX, their negations and 0.
+	if got, want := len(defaultBucketLimits), 1551; got != want {
+		t.Errorf("Got %d buckets, want %d", got, want)
+	}
+	if !sortedFloats(defaultBucketLimits) {
+		t.Error("Bucket limits are not sorted")
+	}
+}
+
+func sortedFloats(f []float64) bool {
+	for i := 1; i < len(f); i++ {
+		if f[i-1] >= f[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestHistogram(t *testing.T) {
+	h := NewHistogram()
+	h.Add(0)
+	p := h.Proto()
+	if want := []float64{0, 1e-12, math.MaxFloat64}; !reflect.DeepEqual(p.BucketLimit, want) {
+		t.Errorf("Got bucket limits %v, want %v", p.BucketLimit, want)
+	}
+	if want := []float64{0, 1, 0}; !reflect.DeepEqual(p.Bucket, want) {
+		t.Errorf("Got buckets %v, want %v", p.Bucket, want)
+	}
+}
+
+func TestHistogramOf(t *testing.T) {
+	p, err := HistogramOf([][]float32{{-1, 2}, {3, 4}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Min != -1 || p.Max != 4 || p.Num != 4 || p.Sum != 8 || p.SumSquares != 30 {
+		t.Errorf("Got %v", p)
+	}
+	var total float64
+	for i, c := range p.Bucket {
+		total += c
+		if i > 0 && p.BucketLimit[i-1] >= p.BucketLimit[i] {
+			t.Errorf("Bucket limits not sorted: %v", p.BucketLimit)
+		}
+	}
+	if total != 4 {
+		t.Errorf("Got %v values in buckets, want 4", total)
+	}
+
+	if _, err := HistogramOf([]string{"a"}); err == nil {
+		t.Error("HistogramOf([]string) should fail")
+	}
+	if _, err := HistogramOf(nil); err == nil {
+		t.Error("HistogramOf(nil) should fail")
+	}
+}
+
+func TestEmptyHistogram(t *testing.T) {
+	p := NewHistogram().Proto()
+	if want := []float64{math.MaxFloat64}; !reflect.DeepEqual(p.BucketLimit, want) {
+		t.Errorf("Got bucket limits %v, want %v", p.BucketLimit, want)
+	}
+	if want := []float64{0}; !reflect.DeepEqual(p.Bucket, want) {
+		t.Errorf("Got buckets %v, want %v", p.Bucket, want)
+	}
+}
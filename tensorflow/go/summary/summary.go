@@ -0,0 +1,207 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package summary writes event files that can be visualized with
+// TensorBoard.
+//
+// The event files contain serialized tensorflow.Event protocol buffers
+// (https://www.tensorflow.org/code/tensorflow/core/util/event.proto) framed
+// in the TFRecord format, and are equivalent to those written by
+// tf.summary.FileWriter in Python.
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package summary
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+	eventpb "github.com/tensorflow/tensorflow/tensorflow/go/core/util"
+	"github.com/tensorflow/tensorflow/tensorflow/go/tfrecord"
+)
+
+// now is replaced in tests.
+var now = time.Now
+
+// Writer writes summaries to an event file in a log directory.
+//
+// A Writer is safe for concurrent use by multiple goroutines.
+type Writer struct {
+	mu   sync.Mutex
+	f    *os.File
+	buf  *bufio.Writer
+	w    *tfrecord.Writer
+	path string
+}
+
+// NewWriter creates a new event file in logdir (creating the directory if
+// needed) and returns a Writer for it.
+func NewWriter(logdir string) (*Writer, error) {
+	if err := os.MkdirAll(logdir, 0755); err != nil {
+		return nil, err
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "localhost"
+	}
+	path := filepath.Join(logdir, fmt.Sprintf("events.out.tfevents.%d.%s", now().Unix(), hostname))
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return nil, err
+	}
+	buf := bufio.NewWriter(f)
+	w := &Writer{f: f, buf: buf, w: tfrecord.NewWriter(buf), path: path}
+	if err := w.WriteEvent(&eventpb.Event{What: &eventpb.Event_FileVersion{FileVersion: "brain.Event:2"}}); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+// Path returns the path of the event file written to by w.
+func (w *Writer) Path() string {
+	return w.path
+}
+
+// WriteEvent writes e to the event file. The wall time of e is set to the
+// current time if it is not already set.
+func (w *Writer) WriteEvent(e *eventpb.Event) error {
+	if e.WallTime == 0 {
+		e.WallTime = float64(now().UnixNano()) / 1e9
+	}
+	data, err := proto.Marshal(e)
+	if err != nil {
+		return err
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.f == nil {
+		return fmt.Errorf("summary.Writer for %q has been closed", w.path)
+	}
+	return w.w.Write(data)
+}
+
+// WriteSummary writes an event containing s for the given training step.
+func (w *Writer) WriteSummary(step int64, s *pb.Summary) error {
+	return w.WriteEvent(&eventpb.Event{Step: step, What: &eventpb.Event_Summary{Summary: s}})
+}
+
+func (w *Writer) writeValue(step int64, v *pb.Summary_Value) error {
+	return w.WriteSummary(step, &pb.Summary{Value: []*pb.Summary_Value{v}})
+}
+
+// Scalar writes a scalar summary, displayed as a chart in TensorBoard.
+func (w *Writer) Scalar(step int64, tag string, value float32) error {
+	return w.writeValue(step, &pb.Summary_Value{
+		Tag:   tag,
+		Value: &pb.Summary_Value_SimpleValue{SimpleValue: value},
+	})
+}
+
+// Histogram writes a histogram summary.
+func (w *Writer) Histogram(step int64, tag string, h *pb.HistogramProto) error {
+	return w.writeValue(step, &pb.Summary_Value{
+		Tag:   tag,
+		Value: &pb.Summary_Value_Histo{Histo: h},
+	})
+}
+
+// Image writes img as a PNG encoded image summary.
+func (w *Writer) Image(step int64, tag string, img image.Image) error {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return err
+	}
+	colorspace := 4 // RGBA
+	switch img.ColorModel() {
+	case color.GrayModel, color.Gray16Model:
+		colorspace = 1
+	}
+	bounds := img.Bounds()
+	return w.writeValue(step, &pb.Summary_Value{
+		Tag: tag,
+		Value: &pb.Summary_Value_Image{Image: &pb.Summary_Image{
+			Height:             int32(bounds.Dy()),
+			Width:              int32(bounds.Dx()),
+			Colorspace:         int32(colorspace),
+			EncodedImageString: buf.Bytes(),
+		}},
+	})
+}
+
+// Text writes a text summary, displayed in the "Text" dashboard of
+// TensorBoard. text may contain markdown.
+func (w *Writer) Text(step int64, tag string, text string) error {
+	return w.writeValue(step, &pb.Summary_Value{
+		// The TensorBoard text plugin identifies text summaries by
+		// this suffix, mirroring the name of the op created by
+		// tf.summary.text.
+		Tag: tag + "/text_summary",
+		Value: &pb.Summary_Value_Tensor{Tensor: &pb.TensorProto{
+			Dtype:       pb.DataType_DT_STRING,
+			TensorShape: &pb.TensorShapeProto{},
+			StringVal:   [][]byte{[]byte(text)},
+		}},
+	})
+}
+
+// Graph writes g, displayed in the "Graphs" dashboard of TensorBoard.
+func (w *Writer) Graph(g *tf.Graph) error {
+	var buf bytes.Buffer
+	if _, err := g.WriteTo(&buf); err != nil {
+		return err
+	}
+	return w.WriteEvent(&eventpb.Event{What: &eventpb.Event_GraphDef{GraphDef: buf.Bytes()}})
+}
+
+// Flush writes any buffered events to the event file.
+func (w *Writer) Flush() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.f == nil {
+		return nil
+	}
+	return w.buf.Flush()
+}
+
+// Close flushes any buffered events and closes the event file.
+func (w *Writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.f == nil {
+		return nil
+	}
+	err := w.buf.Flush()
+	if cerr := w.f.Close(); err == nil {
+		err = cerr
+	}
+	w.f = nil
+	return err
+}
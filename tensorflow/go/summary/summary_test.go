@@ -0,0 +1,122 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package summary
+
+import (
+	"image"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+	eventpb "github.com/tensorflow/tensorflow/tensorflow/go/core/util"
+	"github.com/tensorflow/tensorflow/tensorflow/go/tfrecord"
+)
+
+func readEvents(t *testing.T, path string) []*eventpb.Event {
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var events []*eventpb.Event
+	r := tfrecord.NewReader(f)
+	for {
+		data, err := r.Read()
+		if err == io.EOF {
+			return events
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		e := new(eventpb.Event)
+		if err := proto.Unmarshal(data, e); err != nil {
+			t.Fatal(err)
+		}
+		events = append(events, e)
+	}
+}
+
+func TestWriter(t *testing.T) {
+	defer func(orig func() time.Time) { now = orig }(now)
+	now = func() time.Time { return time.Unix(1490000000, 500000000) }
+
+	dir, err := ioutil.TempDir("", "summary_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	logdir := filepath.Join(dir, "train")
+
+	w, err := NewWriter(logdir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(filepath.Base(w.Path()), "events.out.tfevents.1490000000.") {
+		t.Errorf("Unexpected event file name %q", w.Path())
+	}
+	histo := &pb.HistogramProto{Min: 1, Max: 2, Num: 2, Sum: 3, SumSquares: 5, BucketLimit: []float64{1, 2}, Bucket: []float64{1, 1}}
+	if err := w.Scalar(1, "loss", 0.5); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Histogram(2, "weights", histo); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Image(3, "input", image.NewGray(image.Rect(0, 0, 4, 2))); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Text(4, "notes", "*hello*"); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Scalar(5, "loss", 0.5); err == nil {
+		t.Error("Scalar() after Close() should fail")
+	}
+
+	events := readEvents(t, w.Path())
+	if len(events) != 5 {
+		t.Fatalf("Got %d events, want 5: %v", len(events), events)
+	}
+	if got, want := events[0].GetFileVersion(), "brain.Event:2"; got != want {
+		t.Errorf("Got file version %q, want %q", got, want)
+	}
+	for i, e := range events {
+		if e.WallTime != 1490000000.5 {
+			t.Errorf("Event #%d: got wall time %v, want 1490000000.5", i, e.WallTime)
+		}
+		if i > 0 && e.Step != int64(i) {
+			t.Errorf("Event #%d: got step %d, want %d", i, e.Step, i)
+		}
+	}
+	if v := events[1].GetSummary().Value[0]; v.Tag != "loss" || v.GetSimpleValue() != 0.5 {
+		t.Errorf("Got %v, want scalar loss=0.5", v)
+	}
+	if v := events[2].GetSummary().Value[0]; v.Tag != "weights" || !proto.Equal(v.GetHisto(), histo) {
+		t.Errorf("Got %v, want histogram %v", v, histo)
+	}
+	if img := events[3].GetSummary().Value[0].GetImage(); img.Width != 4 || img.Height != 2 || img.Colorspace != 1 || len(img.EncodedImageString) == 0 {
+		t.Errorf("Got image %v", img)
+	}
+	if v := events[4].GetSummary().Value[0]; v.Tag != "notes/text_summary" || string(v.GetTensor().StringVal[0]) != "*hello*" {
+		t.Errorf("Got %v, want text summary", v)
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows && !tensorflow_dynamic
+// +build !windows,!tensorflow_dynamic
+
+package tensorflow
+
+// #cgo linux LDFLAGS: -ldl
+// #include <dlfcn.h>
+// #include <stdlib.h>
+//
+// static int tfHasSymbol(const char* name) {
+//   void* self = dlopen(NULL, RTLD_LAZY);
+//   int found;
+//   if (self == NULL) return 0;
+//   found = dlsym(self, name) != NULL;
+//   dlclose(self);
+//   return found;
+// }
+import "C"
+
+import "unsafe"
+
+// hasSymbol returns true if the C library function name can be resolved
+// in the running process.
+func hasSymbol(name string) bool {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	return C.tfHasSymbol(cname) != 0
+}
@@ -0,0 +1,28 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !tensorflow_dynamic
+// +build !tensorflow_dynamic
+
+package tensorflow
+
+import "syscall"
+
+var tensorflowDLL = syscall.NewLazyDLL("tensorflow.dll")
+
+// hasSymbol returns true if the C library function name is exported by
+// tensorflow.dll.
+func hasSymbol(name string) bool {
+	return tensorflowDLL.NewProc(name).Find() == nil
+}
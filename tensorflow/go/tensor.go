@@ -26,6 +26,7 @@ import (
 	"io"
 	"reflect"
 	"runtime"
+	"sync"
 	"unsafe"
 )
 
@@ -54,54 +55,95 @@ const (
 	Uint16     DataType = C.TF_UINT16
 	Complex128 DataType = C.TF_COMPLEX128
 	Half       DataType = C.TF_HALF
+	Resource   DataType = C.TF_RESOURCE
 )
 
 // Tensor holds a multi-dimensional array of elements of a single data type.
+//
+// The memory backing a Tensor is allocated by the TensorFlow runtime and is
+// freed when the Tensor is garbage collected, or earlier by calling Release.
+//
+// Tensors of DataType Resource, fetched from operations such as queues,
+// hold opaque handles to resources owned by a Session. They cannot be
+// created from or converted to Go values, nor serialized, but can be fed
+// back to the Session that produced them, to pass a resource between runs.
 type Tensor struct {
 	c     *C.TF_Tensor
 	shape []int64
+
+	mu sync.Mutex // Protects c in Release.
 }
 
 // NewTensor converts from a Go value to a Tensor. Valid values are scalars,
 // slices, and arrays. Every element of a slice must have the same length so
 // that the resulting Tensor has a valid shape.
 func NewTensor(value interface{}) (*Tensor, error) {
+	return newTensor(value, allocateTensor)
+}
+
+// allocateTensor allocates a TF_Tensor of the given type and shape, with
+// nbytes of uninitialized contents.
+func allocateTensor(dataType DataType, shape []int64, nbytes uintptr) (*C.TF_Tensor, error) {
+	var shapePtr *C.int64_t
+	if len(shape) > 0 {
+		shapePtr = (*C.int64_t)(unsafe.Pointer(&shape[0]))
+	}
+	return C.TF_AllocateTensor(C.TF_DataType(dataType), shapePtr, C.int(len(shape)), C.size_t(nbytes)), nil
+}
+
+// newTensor is like NewTensor, allocating the Tensor with alloc.
+func newTensor(value interface{}, alloc func(dataType DataType, shape []int64, nbytes uintptr) (*C.TF_Tensor, error)) (*Tensor, error) {
 	val := reflect.ValueOf(value)
+	if !val.IsValid() {
+		return nil, fmt.Errorf("cannot create a Tensor from a nil value")
+	}
 	shape, dataType, err := shapeAndDataTypeOf(val)
 	if err != nil {
 		return nil, err
 	}
+	if val.Kind() == reflect.Array {
+		// Arrays passed by value are not addressable, which the fast path
+		// of encodeTensor requires: copy it once.
+		addressable := reflect.New(val.Type()).Elem()
+		addressable.Set(val)
+		val = addressable
+	}
+	typ, err := typeOf(dataType, nil)
+	if err != nil {
+		return nil, err
+	}
 	nflattened := numElements(shape)
-	nbytes := typeOf(dataType, nil).Size() * uintptr(nflattened)
+	nbytes := typ.Size() * uintptr(nflattened)
 	if dataType == String {
 		// TF_STRING tensors are encoded as an array of 8-byte offsets
 		// followed by string data. See c_api.h.
 		nbytes = uintptr(nflattened*8) + byteSizeOfEncodedStrings(value)
 	}
-	var shapePtr *C.int64_t
-	if len(shape) > 0 {
-		shapePtr = (*C.int64_t)(unsafe.Pointer(&shape[0]))
-	}
-	t := &Tensor{
-		c:     C.TF_AllocateTensor(C.TF_DataType(dataType), shapePtr, C.int(len(shape)), C.size_t(nbytes)),
-		shape: shape,
+	c, err := alloc(dataType, shape, nbytes)
+	if err != nil {
+		return nil, err
 	}
+	t := &Tensor{c: c, shape: shape}
 	runtime.SetFinalizer(t, (*Tensor).finalize)
 	raw := tensorData(t.c)
 	buf := bytes.NewBuffer(raw[:0:len(raw)])
 	if dataType != String {
-		if err := encodeTensor(buf, val); err != nil {
+		if err := encodeTensor(buf, val, shape); err != nil {
+			t.Release()
 			return nil, err
 		}
 		if uintptr(buf.Len()) != nbytes {
+			t.Release()
 			return nil, bug("NewTensor incorrectly calculated the size of a tensor with type %v and shape %v as %v bytes instead of %v", dataType, shape, nbytes, buf.Len())
 		}
 	} else {
 		e := stringEncoder{offsets: buf, data: raw[nflattened*8 : len(raw)], status: newStatus()}
-		if e.encode(reflect.ValueOf(value)); err != nil {
+		if err := e.encode(reflect.ValueOf(value), shape); err != nil {
+			t.Release()
 			return nil, err
 		}
 		if int64(buf.Len()) != nflattened*8 {
+			t.Release()
 			return nil, bug("invalid offset encoding for TF_STRING tensor with shape %v (got %v, want %v)", shape, buf.Len(), nflattened*8)
 		}
 	}
@@ -116,7 +158,12 @@ func ReadTensor(dataType DataType, shape []int64, r io.Reader) (*Tensor, error)
 	if err := isTensorSerializable(dataType); err != nil {
 		return nil, err
 	}
-	nbytes := typeOf(dataType, nil).Size() * uintptr(numElements(shape))
+	for _, d := range shape {
+		if d < 0 {
+			return nil, fmt.Errorf("invalid shape %v: dimensions must be non-negative", shape)
+		}
+	}
+	nbytes := uintptr(dataType.Size()) * uintptr(numElements(shape))
 	var shapePtr *C.int64_t
 	if len(shape) > 0 {
 		shapePtr = (*C.int64_t)(unsafe.Pointer(&shape[0]))
@@ -127,12 +174,9 @@ func ReadTensor(dataType DataType, shape []int64, r io.Reader) (*Tensor, error)
 	}
 	runtime.SetFinalizer(t, (*Tensor).finalize)
 	raw := tensorData(t.c)
-	n, err := r.Read(raw)
-	if err != nil {
-		return nil, err
-	}
-	if uintptr(n) != nbytes {
-		return nil, fmt.Errorf("expected serialized tensor to be %v bytes, read %v", nbytes, n)
+	if n, err := io.ReadFull(r, raw); err != nil {
+		t.Release()
+		return nil, fmt.Errorf("expected serialized tensor to be %v bytes, read %v: %v", nbytes, n, err)
 	}
 	return t, nil
 }
@@ -151,7 +195,24 @@ func newTensorFromC(c *C.TF_Tensor) *Tensor {
 	return t
 }
 
-func (t *Tensor) finalize() { C.TF_DeleteTensor(t.c) }
+func (t *Tensor) finalize() {
+	finalized("Tensor")
+	C.TF_DeleteTensor(t.c)
+}
+
+// Release frees the memory backing the Tensor without waiting for it to be
+// garbage collected. The Tensor must not be used after it has been released.
+// Release may be called multiple times.
+func (t *Tensor) Release() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.c == nil {
+		return
+	}
+	runtime.SetFinalizer(t, nil)
+	C.TF_DeleteTensor(t.c)
+	t.c = nil
+}
 
 // DataType returns the scalar datatype of the Tensor.
 func (t *Tensor) DataType() DataType { return DataType(C.TF_TensorType(t.c)) }
@@ -160,29 +221,45 @@ func (t *Tensor) DataType() DataType { return DataType(C.TF_TensorType(t.c)) }
 func (t *Tensor) Shape() []int64 { return t.shape }
 
 // Value converts the Tensor to a Go value. For now, not all Tensor types are
-// supported, and this function may panic if it encounters an unsupported
-// DataType.
+// supported, and this function returns nil if it encounters an unsupported
+// DataType. Use DecodeValue to learn why a Tensor cannot be converted.
 //
 // The type of the output depends on the Tensor type and dimensions.
 // For example:
 // Tensor(int64, 0): int64
 // Tensor(float64, 3): [][][]float64
 func (t *Tensor) Value() interface{} {
-	typ := typeOf(t.DataType(), t.Shape())
+	val, err := t.DecodeValue()
+	if err != nil {
+		return nil
+	}
+	return val
+}
+
+// DecodeValue is like Value, but returns an error instead of nil if the
+// Tensor cannot be converted to a Go value.
+func (t *Tensor) DecodeValue() (interface{}, error) {
+	typ, err := typeOf(t.DataType(), t.Shape())
+	if err != nil {
+		return nil, err
+	}
 	val := reflect.New(typ)
 	raw := tensorData(t.c)
 	if t.DataType() != String {
 		if err := decodeTensor(bytes.NewReader(raw), t.Shape(), typ, val); err != nil {
-			panic(bug("unable to decode Tensor of type %v and shape %v - %v", t.DataType(), t.Shape(), err))
+			return nil, fmt.Errorf("unable to decode Tensor of type %v and shape %v - %v", t.DataType(), t.Shape(), err)
 		}
 	} else {
 		nflattened := numElements(t.Shape())
+		if int64(len(raw)) < 8*nflattened {
+			return nil, fmt.Errorf("invalid String Tensor with shape %v: %d bytes is too small", t.Shape(), len(raw))
+		}
 		d := stringDecoder{offsets: bytes.NewReader(raw[0 : 8*nflattened]), data: raw[8*nflattened:], status: newStatus()}
 		if err := d.decode(val, t.Shape()); err != nil {
-			panic(bug("unable to decode String tensor with shape %v - %v", t.Shape(), err))
+			return nil, fmt.Errorf("unable to decode String tensor with shape %v - %v", t.Shape(), err)
 		}
 	}
-	return reflect.Indirect(val).Interface()
+	return reflect.Indirect(val).Interface(), nil
 }
 
 // WriteContentsTo writes the serialized contents of t to w.
@@ -248,16 +325,12 @@ func shapeAndDataTypeOf(val reflect.Value) (shape []int64, dt DataType, err erro
 		}
 		typ = typ.Elem()
 	}
-	for _, t := range types {
-		if typ.Kind() == t.typ.Kind() {
-			return shape, DataType(t.dataType), nil
-		}
-	}
-	return shape, dt, fmt.Errorf("unsupported type %v", typ)
+	dt, err = DataTypeOf(typ)
+	return shape, dt, err
 }
 
 // typeOf converts from a DataType and Shape to the equivalent Go type.
-func typeOf(dt DataType, shape []int64) reflect.Type {
+func typeOf(dt DataType, shape []int64) (reflect.Type, error) {
 	var ret reflect.Type
 	for _, t := range types {
 		if dt == DataType(t.dataType) {
@@ -265,13 +338,16 @@ func typeOf(dt DataType, shape []int64) reflect.Type {
 			break
 		}
 	}
+	if dt == Resource {
+		return nil, fmt.Errorf("Tensors of DataType %v are opaque handles and have no Go equivalent", dt)
+	}
 	if ret == nil {
-		panic(bug("DataType %v is not supported", dt))
+		return nil, fmt.Errorf("DataType %v is not supported", dt)
 	}
 	for _ = range shape {
 		ret = reflect.SliceOf(ret)
 	}
-	return ret
+	return ret, nil
 }
 
 func numElements(shape []int64) int64 {
@@ -299,7 +375,7 @@ func byteSizeOfEncodedStrings(val interface{}) uintptr {
 
 // encodeTensor writes v to the specified buffer using the format specified in
 // c_api.h. Use stringEncoder for String tensors.
-func encodeTensor(w *bytes.Buffer, v reflect.Value) error {
+func encodeTensor(w *bytes.Buffer, v reflect.Value, shape []int64) error {
 	switch v.Kind() {
 	case reflect.Bool:
 		b := byte(0)
@@ -315,19 +391,20 @@ func encodeTensor(w *bytes.Buffer, v reflect.Value) error {
 		}
 
 	case reflect.Array, reflect.Slice:
-		// If slice elements are slices, verify that all of them have the same size.
-		// Go's type system makes that guarantee for arrays.
-		if v.Len() > 0 && v.Type().Elem().Kind() == reflect.Slice {
-			expected := v.Index(0).Len()
-			for i := 1; i < v.Len(); i++ {
-				if v.Index(i).Len() != expected {
-					return fmt.Errorf("mismatched slice lengths: %d and %d", v.Index(i).Len(), expected)
-				}
-			}
+		// Verify that all slices at the same depth have the same size, as
+		// the elements of the first slice at each depth do. Go's type
+		// system makes that guarantee for arrays.
+		if len(shape) == 0 || int64(v.Len()) != shape[0] {
+			return fmt.Errorf("mismatched slice lengths: %d and %v", v.Len(), shape)
+		}
+		if isFixedSize(v.Type().Elem().Kind()) && (v.Kind() == reflect.Slice || v.CanAddr()) {
+			// The memory of the elements has the layout of the tensor.
+			_, err := w.Write(rawBytes(v))
+			return err
 		}
 
 		for i := 0; i < v.Len(); i++ {
-			err := encodeTensor(w, v.Index(i))
+			err := encodeTensor(w, v.Index(i), shape[1:])
 			if err != nil {
 				return err
 			}
@@ -357,6 +434,10 @@ func decodeTensor(r *bytes.Reader, shape []int64, typ reflect.Type, ptr reflect.
 	case reflect.Slice:
 		val := reflect.Indirect(ptr)
 		val.Set(reflect.MakeSlice(typ, int(shape[0]), int(shape[0])))
+		if isFixedSize(typ.Elem().Kind()) {
+			_, err := io.ReadFull(r, rawBytes(val))
+			return err
+		}
 		for i := 0; i < val.Len(); i++ {
 			if err := decodeTensor(r, shape[1:], typ.Elem(), val.Index(i).Addr()); err != nil {
 				return err
@@ -369,6 +450,32 @@ func decodeTensor(r *bytes.Reader, shape []int64, typ reflect.Type, ptr reflect.
 	return nil
 }
 
+// isFixedSize reports whether values of kind k are stored in memory as in
+// tensors, in which case slices of them can be copied as a whole.
+func isFixedSize(k reflect.Kind) bool {
+	switch k {
+	case reflect.Bool, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Uint8, reflect.Uint16, reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return true
+	}
+	return false
+}
+
+// rawBytes returns the memory of the elements of v, a slice or an
+// addressable array of values of a fixed size kind.
+func rawBytes(v reflect.Value) []byte {
+	n := v.Len() * int(v.Type().Elem().Size())
+	if n == 0 {
+		return nil
+	}
+	var p unsafe.Pointer
+	if v.Kind() == reflect.Slice {
+		p = unsafe.Pointer(v.Pointer())
+	} else {
+		p = unsafe.Pointer(v.UnsafeAddr())
+	}
+	return (*[1 << 30]byte)(p)[:n:n]
+}
+
 type stringEncoder struct {
 	offsets io.Writer
 	data    []byte
@@ -376,7 +483,7 @@ type stringEncoder struct {
 	status  *status
 }
 
-func (e *stringEncoder) encode(v reflect.Value) error {
+func (e *stringEncoder) encode(v reflect.Value, shape []int64) error {
 	if v.Kind() == reflect.String {
 		if err := binary.Write(e.offsets, nativeEndian, e.offset); err != nil {
 			return err
@@ -392,8 +499,11 @@ func (e *stringEncoder) encode(v reflect.Value) error {
 		C.free(unsafe.Pointer(src))
 		return e.status.Err()
 	}
+	if len(shape) == 0 || int64(v.Len()) != shape[0] {
+		return fmt.Errorf("mismatched slice lengths: %d and %v", v.Len(), shape)
+	}
 	for i := 0; i < v.Len(); i++ {
-		if err := e.encode(v.Index(i)); err != nil {
+		if err := e.encode(v.Index(i), shape[1:]); err != nil {
 			return err
 		}
 	}
@@ -412,15 +522,15 @@ func (d *stringDecoder) decode(ptr reflect.Value, shape []int64) error {
 		if err := binary.Read(d.offsets, nativeEndian, &offset); err != nil {
 			return err
 		}
+		if offset >= uint64(len(d.data)) {
+			return fmt.Errorf("invalid offsets in String Tensor")
+		}
 		var (
 			src    = (*C.char)(unsafe.Pointer(&d.data[offset]))
 			srcLen = C.size_t(len(d.data)) - C.size_t(offset)
 			dst    *C.char
 			dstLen C.size_t
 		)
-		if offset > uint64(len(d.data)) {
-			return fmt.Errorf("invalid offsets in String Tensor")
-		}
 		C.TF_StringDecode(src, srcLen, &dst, &dstLen, d.status.c)
 		if err := d.status.Err(); err != nil {
 			return err
@@ -429,8 +539,12 @@ func (d *stringDecoder) decode(ptr reflect.Value, shape []int64) error {
 		*s = C.GoStringN(dst, C.int(dstLen))
 		return nil
 	}
+	typ, err := typeOf(String, shape)
+	if err != nil {
+		return err
+	}
 	val := reflect.Indirect(ptr)
-	val.Set(reflect.MakeSlice(typeOf(String, shape), int(shape[0]), int(shape[0])))
+	val.Set(reflect.MakeSlice(typ, int(shape[0]), int(shape[0])))
 	for i := 0; i < val.Len(); i++ {
 		if err := d.decode(val.Index(i).Addr(), shape[1:]); err != nil {
 			return err
@@ -456,6 +570,9 @@ func isTensorSerializable(dataType DataType) error {
 	case Float, Double, Int32, Uint8, Int16, Int8, Complex, Int64, Bool, Quint8, Qint32, Bfloat16, Qint16, Quint16, Uint16, Complex128, Half:
 		return nil
 	default:
+		if _, ok := registeredType(dataType); ok {
+			return nil
+		}
 		return fmt.Errorf("serialization of tensors with the DataType %d is not yet supported, see https://github.com/tensorflow/tensorflow/issues/6003", dataType)
 	}
 }
@@ -16,7 +16,9 @@ package tensorflow
 
 import (
 	"bytes"
+	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -37,6 +39,11 @@ func TestNewTensor(t *testing.T) {
 		{nil, complex(float32(5), float32(6))},
 		{nil, complex(float64(5), float64(6))},
 		{nil, "a string"},
+		// Strings are not NUL-terminated and need not be valid UTF-8.
+		{nil, ""},
+		{nil, "embedded\x00nul"},
+		{nil, "\xff\xfe invalid UTF-8"},
+		{[]int64{3}, []string{"日本語", "", "C:\\Program Files\\tensorflow.dll"}},
 		{[]int64{2}, []bool{true, false}},
 		{[]int64{1}, []float64{1}},
 		{[]int64{1}, [1]float64{1}},
@@ -72,6 +79,11 @@ func TestNewTensor(t *testing.T) {
 		[]uint64{5},
 		// Mismatched dimensions
 		[][]float32{{1, 2, 3}, {4}},
+		// Mismatched dimensions, not at the first level of nesting
+		[][][]float32{{{1}, {2}}, {{3, 4}, {5, 6}}},
+		[][][]string{{{"a"}, {"b"}}, {{"c", "d"}, {"e", "f"}}},
+		// nil
+		nil,
 	}
 
 	for _, test := range tests {
@@ -215,6 +227,44 @@ func TestTensorSerializationErrors(t *testing.T) {
 	}
 }
 
+func TestDecodeValueUnsupportedType(t *testing.T) {
+	// There is no Go representation of half-precision floats.
+	tensor, err := ReadTensor(Half, []int64{2}, bytes.NewReader([]byte{0, 0, 0, 0}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, err := tensor.DecodeValue(); err == nil {
+		t.Errorf("DecodeValue() = %v, want error", v)
+	}
+}
+
+func FuzzNewTensor(f *testing.F) {
+	f.Add([]byte{1, 2, 3}, "a,b,c")
+	f.Add([]byte{}, "")
+	f.Add([]byte{0, 0, 5}, ",,")
+	f.Fuzz(func(t *testing.T, data []byte, csv string) {
+		// Slices whose lengths are derived from the input, which are
+		// jagged more often than not.
+		ints := make([][]int32, len(data))
+		for i, b := range data {
+			ints[i] = make([]int32, b%4)
+		}
+		strs := make([][]string, len(data))
+		for i, b := range data {
+			strs[i] = strings.Split(csv, ",")[:int(b)%(strings.Count(csv, ",")+1)]
+		}
+		for _, v := range []interface{}{data, csv, ints, strs} {
+			tensor, err := NewTensor(v)
+			if err != nil {
+				continue
+			}
+			if _, err := tensor.DecodeValue(); err != nil {
+				t.Errorf("NewTensor(%#v).DecodeValue(): %v", v, err)
+			}
+		}
+	})
+}
+
 func benchmarkNewTensor(b *testing.B, v interface{}) {
 	for i := 0; i < b.N; i++ {
 		if t, err := NewTensor(v); err != nil || t == nil {
@@ -231,4 +281,71 @@ func BenchmarkNewTensor(b *testing.B) {
 		vector [224 * 224 * 3]int32
 	)
 	b.Run("[150528]", func(b *testing.B) { benchmarkNewTensor(b, vector) })
+	for _, v := range benchmarkValues {
+		v := v
+		b.Run(v.name, func(b *testing.B) { benchmarkNewTensor(b, v.value) })
+	}
+}
+
+func TestNewTensorContiguousValues(t *testing.T) {
+	// Slices and arrays of fixed size values are copied as a whole, which
+	// must preserve their values and shapes.
+	values := []interface{}{
+		[]bool{true, false, true},
+		[2][3]int16{{1, 2, 3}, {4, 5, -6}},
+		[][2]float64{{1.5, -2}, {3, 4}},
+		[][]complex64{{1 + 2i}, {3 - 4i}},
+		[][]float32{{}, {}},
+		[]uint8{},
+	}
+	for _, v := range values {
+		tensor, err := NewTensor(v)
+		if err != nil {
+			t.Errorf("NewTensor(%v): %v", v, err)
+			continue
+		}
+		// Arrays are decoded as slices.
+		if got, want := fmt.Sprint(tensor.Value()), fmt.Sprint(v); got != want {
+			t.Errorf("NewTensor(%v).Value(): got %v", v, got)
+		}
+	}
+}
+
+func benchmarkValue(b *testing.B, v interface{}) {
+	t, err := NewTensor(v)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := t.DecodeValue(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// Benchmarked values of the common types of feeds and fetches.
+var benchmarkValues = []struct {
+	name  string
+	value interface{}
+}{
+	{"[]float32", make([]float32, 224*224*3)},
+	{"[]int64", make([]int64, 224*224*3)},
+	{"[][]float32", makeFloat32Matrix(224*3, 224)},
+	{"[]byte", make([]byte, 224*224*3)},
+}
+
+func makeFloat32Matrix(rows, cols int) [][]float32 {
+	m := make([][]float32, rows)
+	for i := range m {
+		m[i] = make([]float32, cols)
+	}
+	return m
+}
+
+func BenchmarkTensorValue(b *testing.B) {
+	for _, v := range benchmarkValues {
+		v := v
+		b.Run(v.name, func(b *testing.B) { benchmarkValue(b, v.value) })
+	}
 }
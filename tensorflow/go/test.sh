@@ -21,7 +21,7 @@
 # This script acts as a brige between bazel and go so that:
 #   bazel test :test
 # succeeds iff
-#   go test github.com/tensorflow/tensorflow/tensorflow/go
+#   go test github.com/tensorflow/tensorflow/tensorflow/go/...
 # succeeds.
 
 set -ex
@@ -65,8 +65,13 @@ then
   fi
 fi
 
-# Document the Go version and run tests
+# Fetch the dependencies of the packages, such as the protocol buffer library.
+go get -d github.com/tensorflow/tensorflow/tensorflow/go/...
+
+# Document the Go version and run the tests of all the packages.
 echo "Go version: $(go version)"
-go test \
-  github.com/tensorflow/tensorflow/tensorflow/go  \
-  github.com/tensorflow/tensorflow/tensorflow/go/op
+go test github.com/tensorflow/tensorflow/tensorflow/go/...
+
+# Run the tests again with the TensorFlow C library loaded at run time
+# instead of linked at build time.
+go test -tags tensorflow_dynamic github.com/tensorflow/tensorflow/tensorflow/go/...
@@ -0,0 +1,307 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tflint checks TensorFlow graphs for common problems, such as
+// placeholders that are never used or operations that are not supported by
+// the runtime a model is deployed to.
+//
+// Findings are returned as values that can be encoded with encoding/json,
+// making it possible to run the checks as part of continuous integration:
+//
+//	findings, err := tflint.Lint(graph, tflint.Config{Inference: true})
+//	if err != nil {
+//		...
+//	}
+//	json.NewEncoder(os.Stdout).Encode(findings)
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package tflint
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+)
+
+// Names of the rules checked by Lint.
+const (
+	// UnusedPlaceholder reports placeholders whose output is not an
+	// input of any operation.
+	UnusedPlaceholder = "unused-placeholder"
+	// VariableInInferenceGraph reports variables in graphs intended for
+	// inference, which should have been frozen (converted to constants).
+	VariableInInferenceGraph = "variable-in-inference-graph"
+	// UnsupportedOp reports operations whose type is not in
+	// Config.SupportedOps.
+	UnsupportedOp = "unsupported-op"
+	// Float64OnGPU reports operations placed on a GPU that consume or
+	// produce float64 values, which most GPUs process much slower than
+	// float32 values.
+	Float64OnGPU = "float64-on-gpu"
+	// MissingDevice reports operations placed on a device that is not in
+	// Config.Devices.
+	MissingDevice = "missing-device"
+)
+
+// Severity indicates how serious a Finding is.
+type Severity int
+
+const (
+	// Warning indicates a likely inefficiency or mistake.
+	Warning Severity = iota
+	// Error indicates a problem that will prevent the graph from
+	// running as intended.
+	Error
+)
+
+func (s Severity) String() string {
+	switch s {
+	case Warning:
+		return "warning"
+	case Error:
+		return "error"
+	}
+	return fmt.Sprintf("Severity(%d)", int(s))
+}
+
+// MarshalText encodes s as its name, e.g. "warning".
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// Finding describes a problem found in a graph.
+type Finding struct {
+	// Rule is the name of the rule that found the problem, e.g.
+	// UnusedPlaceholder.
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	// Node is the name of the offending operation.
+	Node    string `json:"node,omitempty"`
+	Message string `json:"message"`
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s: %s [%s]", f.Node, f.Severity, f.Message, f.Rule)
+}
+
+// Config selects the rules checked by Lint. The zero value checks only the
+// rules that need no configuration.
+type Config struct {
+	// Inference indicates that the graph is intended for inference,
+	// enabling VariableInInferenceGraph.
+	Inference bool
+	// SupportedOps, if not nil, is the set of operation types supported
+	// by the target runtime, enabling UnsupportedOp. See TFLiteOps.
+	SupportedOps map[string]bool
+	// Devices, if not nil, lists the devices available to the graph
+	// (e.g., "/device:CPU:0"), enabling MissingDevice. An index of "*"
+	// matches any device of the type (e.g., "/device:GPU:*").
+	Devices []string
+	// Disabled is the set of names of rules that are not checked.
+	Disabled map[string]bool
+}
+
+// Lint checks graph according to c, returning the findings of each rule in
+// turn, in the order of the operations in the graph.
+func Lint(graph *tf.Graph, c Config) ([]Finding, error) {
+	var buf bytes.Buffer
+	if _, err := graph.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+	return LintGraphDef(buf.Bytes(), c)
+}
+
+// LintGraphDef is like Lint, for a serialized tensorflow.GraphDef protocol
+// buffer (https://www.tensorflow.org/code/tensorflow/core/framework/graph.proto),
+// such as a frozen graph exported by Python.
+func LintGraphDef(graphDef []byte, c Config) ([]Finding, error) {
+	var def pb.GraphDef
+	if err := proto.Unmarshal(graphDef, &def); err != nil {
+		return nil, fmt.Errorf("invalid GraphDef: %v", err)
+	}
+	l := &linter{config: c}
+	for _, r := range rules {
+		if !c.Disabled[r.name] {
+			r.check(l, def.Node)
+		}
+	}
+	return l.findings, nil
+}
+
+type linter struct {
+	config   Config
+	findings []Finding
+}
+
+func (l *linter) report(rule string, s Severity, node *pb.NodeDef, format string, args ...interface{}) {
+	l.findings = append(l.findings, Finding{
+		Rule:     rule,
+		Severity: s,
+		Node:     node.Name,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+var rules = []struct {
+	name  string
+	check func(*linter, []*pb.NodeDef)
+}{
+	{UnusedPlaceholder, checkUnusedPlaceholders},
+	{VariableInInferenceGraph, checkVariables},
+	{UnsupportedOp, checkSupportedOps},
+	{Float64OnGPU, checkFloat64OnGPU},
+	{MissingDevice, checkDevices},
+}
+
+func checkUnusedPlaceholders(l *linter, nodes []*pb.NodeDef) {
+	used := make(map[string]bool)
+	for _, n := range nodes {
+		for _, in := range n.Input {
+			op, index, err := tf.ParseTensorName(in)
+			if err != nil || index < 0 {
+				continue // Control inputs do not use the value.
+			}
+			used[op] = true
+		}
+	}
+	for _, n := range nodes {
+		if (n.Op == "Placeholder" || n.Op == "PlaceholderV2") && !used[n.Name] {
+			l.report(UnusedPlaceholder, Warning, n, "placeholder is not used by any operation")
+		}
+	}
+}
+
+var variableOps = map[string]bool{
+	"Variable":          true,
+	"VariableV2":        true,
+	"VarHandleOp":       true,
+	"TemporaryVariable": true,
+}
+
+func checkVariables(l *linter, nodes []*pb.NodeDef) {
+	if !l.config.Inference {
+		return
+	}
+	for _, n := range nodes {
+		if variableOps[n.Op] {
+			l.report(VariableInInferenceGraph, Error, n, "%s in an inference graph; freeze the graph to convert variables to constants", n.Op)
+		}
+	}
+}
+
+func checkSupportedOps(l *linter, nodes []*pb.NodeDef) {
+	if l.config.SupportedOps == nil {
+		return
+	}
+	for _, n := range nodes {
+		if !l.config.SupportedOps[n.Op] {
+			l.report(UnsupportedOp, Error, n, "operation type %s is not supported by the target runtime", n.Op)
+		}
+	}
+}
+
+func checkFloat64OnGPU(l *linter, nodes []*pb.NodeDef) {
+	for _, n := range nodes {
+		if d, ok := parseDevice(n.Device); !ok || d.typ != "GPU" {
+			continue
+		}
+		for _, name := range sortedAttrs(n) {
+			if usesDouble(n.Attr[name]) {
+				l.report(Float64OnGPU, Warning, n, "attribute %q is float64 on device %s", name, n.Device)
+				break
+			}
+		}
+	}
+}
+
+func usesDouble(v *pb.AttrValue) bool {
+	if v.GetType() == pb.DataType_DT_DOUBLE {
+		return true
+	}
+	for _, t := range v.GetList().GetType() {
+		if t == pb.DataType_DT_DOUBLE {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedAttrs(n *pb.NodeDef) []string {
+	names := make([]string, 0, len(n.Attr))
+	for name := range n.Attr {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func checkDevices(l *linter, nodes []*pb.NodeDef) {
+	if l.config.Devices == nil {
+		return
+	}
+	var available []device
+	for _, name := range l.config.Devices {
+		if d, ok := parseDevice(name); ok {
+			available = append(available, d)
+		}
+	}
+	for _, n := range nodes {
+		d, ok := parseDevice(n.Device)
+		if !ok {
+			continue
+		}
+		found := false
+		for _, a := range available {
+			if a.typ == d.typ && (a.index == "*" || d.index == "*" || a.index == d.index) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			l.report(MissingDevice, Error, n, "operation is placed on %s, which is not available", n.Device)
+		}
+	}
+}
+
+// device is the type and index of a device, e.g. {"GPU", "0"}.
+type device struct {
+	typ, index string
+}
+
+// parseDevice extracts the device type and index from a device
+// specification such as "/job:worker/device:GPU:0" or "/gpu:0". ok is
+// false if spec does not identify a device type.
+func parseDevice(spec string) (d device, ok bool) {
+	for _, part := range strings.Split(spec, "/") {
+		part = strings.TrimPrefix(part, "device:")
+		fields := strings.SplitN(part, ":", 2)
+		typ := strings.ToUpper(fields[0])
+		switch typ {
+		case "", "JOB", "REPLICA", "TASK":
+			continue
+		}
+		d = device{typ: typ, index: "*"}
+		if len(fields) == 2 {
+			d.index = fields[1]
+		}
+		ok = true
+	}
+	return d, ok
+}
@@ -0,0 +1,104 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tflint
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+func TestLint(t *testing.T) {
+	s := op.NewScope()
+	x := op.Placeholder(s.SubScope("x"), tf.Float)
+	op.Placeholder(s.SubScope("unused"), tf.Float)
+	op.Neg(s.SubScope("neg"), x)
+	op.VarHandleOp(s.SubScope("var"), tf.Float, tf.MakeShape(2))
+	op.Cast(s.WithDevice("/device:GPU:0").SubScope("gpu"), x, tf.Double)
+	op.Abs(s.WithDevice("/job:localhost/device:CPU:1").SubScope("cpu"), x)
+	graph, err := s.Finalize()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		config Config
+		want   []Finding
+	}{
+		{
+			Config{},
+			[]Finding{
+				{UnusedPlaceholder, Warning, "unused/Placeholder", "placeholder is not used by any operation"},
+				{Float64OnGPU, Warning, "gpu/Cast", `attribute "DstT" is float64 on device /device:GPU:0`},
+			},
+		},
+		{
+			Config{
+				Inference:    true,
+				SupportedOps: TFLiteOps,
+				Devices:      []string{"/cpu:0", "/device:GPU:*"},
+				Disabled:     map[string]bool{UnusedPlaceholder: true, Float64OnGPU: true},
+			},
+			[]Finding{
+				{VariableInInferenceGraph, Error, "var/VarHandleOp", "VarHandleOp in an inference graph; freeze the graph to convert variables to constants"},
+				{UnsupportedOp, Error, "neg/Neg", "operation type Neg is not supported by the target runtime"},
+				{UnsupportedOp, Error, "var/VarHandleOp", "operation type VarHandleOp is not supported by the target runtime"},
+				{UnsupportedOp, Error, "cpu/Abs", "operation type Abs is not supported by the target runtime"},
+				{MissingDevice, Error, "cpu/Abs", "operation is placed on /job:localhost/device:CPU:1, which is not available"},
+			},
+		},
+	}
+	for i, test := range tests {
+		got, err := Lint(graph, test.config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("#%d: got %v, want %v", i, got, test.want)
+		}
+	}
+}
+
+func TestFindingJSON(t *testing.T) {
+	b, err := json.Marshal(Finding{Rule: MissingDevice, Severity: Error, Node: "a", Message: "m"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(b), `{"rule":"missing-device","severity":"error","node":"a","message":"m"}`; got != want {
+		t.Errorf("Got %s, want %s", got, want)
+	}
+}
+
+func TestParseDevice(t *testing.T) {
+	tests := []struct {
+		spec string
+		want device
+		ok   bool
+	}{
+		{"", device{}, false},
+		{"/job:worker/replica:0/task:1", device{}, false},
+		{"/gpu:0", device{"GPU", "0"}, true},
+		{"/job:worker/device:CPU:1", device{"CPU", "1"}, true},
+		{"/device:GPU", device{"GPU", "*"}, true},
+	}
+	for _, test := range tests {
+		if got, ok := parseDevice(test.spec); got != test.want || ok != test.ok {
+			t.Errorf("parseDevice(%q) = %v, %v; want %v, %v", test.spec, got, ok, test.want, test.ok)
+		}
+	}
+}
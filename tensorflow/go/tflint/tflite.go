@@ -0,0 +1,70 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tflint
+
+// TFLiteOps is the set of operation types that the TensorFlow Lite converter
+// maps to builtin TensorFlow Lite operators, for use as
+// Config.SupportedOps. Placeholder, Const and Identity are included as the
+// converter removes or folds them.
+//
+// The set of supported operations grows with each release of TensorFlow
+// Lite; callers targeting a specific release may need to adjust it.
+var TFLiteOps = map[string]bool{
+	"Add":                     true,
+	"AvgPool":                 true,
+	"BatchToSpaceND":          true,
+	"Cast":                    true,
+	"ConcatV2":                true,
+	"Const":                   true,
+	"Conv2D":                  true,
+	"DepthToSpace":            true,
+	"DepthwiseConv2dNative":   true,
+	"Div":                     true,
+	"Exp":                     true,
+	"FakeQuantWithMinMaxArgs": true,
+	"FakeQuantWithMinMaxVars": true,
+	"FusedBatchNorm":          true,
+	"Gather":                  true,
+	"GatherV2":                true,
+	"Identity":                true,
+	"L2Loss":                  true,
+	"LRN":                     true,
+	"LogSoftmax":              true,
+	"MatMul":                  true,
+	"Max":                     true,
+	"MaxPool":                 true,
+	"Maximum":                 true,
+	"Mean":                    true,
+	"Minimum":                 true,
+	"Mul":                     true,
+	"Pad":                     true,
+	"Placeholder":             true,
+	"Relu":                    true,
+	"Relu6":                   true,
+	"Reshape":                 true,
+	"ResizeBilinear":          true,
+	"Sigmoid":                 true,
+	"Slice":                   true,
+	"Softmax":                 true,
+	"SpaceToBatchND":          true,
+	"SpaceToDepth":            true,
+	"Split":                   true,
+	"Squeeze":                 true,
+	"StridedSlice":            true,
+	"Sub":                     true,
+	"Tanh":                    true,
+	"TopKV2":                  true,
+	"Transpose":               true,
+}
@@ -0,0 +1,110 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tfrecord reads and writes files in the TFRecord format, which is
+// used by TensorFlow for, amongst others, event files read by TensorBoard and
+// datasets of tf.Example protocol buffers.
+//
+// Each record is framed as:
+//
+//	uint64 length
+//	uint32 masked crc32c of length
+//	byte   data[length]
+//	uint32 masked crc32c of data
+//
+// with all integers in little-endian byte order. See
+// https://www.tensorflow.org/code/tensorflow/core/lib/io/record_writer.cc
+package tfrecord
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+)
+
+var crc32c = crc32.MakeTable(crc32.Castagnoli)
+
+// maskedCRC returns the masked crc32c checksum of data, in the form used by
+// the TFRecord format.
+func maskedCRC(data []byte) uint32 {
+	crc := crc32.Checksum(data, crc32c)
+	return ((crc >> 15) | (crc << 17)) + 0xa282ead8
+}
+
+// Writer writes records to an underlying io.Writer.
+type Writer struct {
+	w      io.Writer
+	header [12]byte
+	footer [4]byte
+}
+
+// NewWriter returns a Writer that writes records to w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Write writes data as a single record.
+func (w *Writer) Write(data []byte) error {
+	binary.LittleEndian.PutUint64(w.header[0:8], uint64(len(data)))
+	binary.LittleEndian.PutUint32(w.header[8:12], maskedCRC(w.header[0:8]))
+	binary.LittleEndian.PutUint32(w.footer[:], maskedCRC(data))
+	if _, err := w.w.Write(w.header[:]); err != nil {
+		return err
+	}
+	if _, err := w.w.Write(data); err != nil {
+		return err
+	}
+	_, err := w.w.Write(w.footer[:])
+	return err
+}
+
+// Reader reads records from an underlying io.Reader.
+type Reader struct {
+	r      io.Reader
+	header [12]byte
+	footer [4]byte
+}
+
+// NewReader returns a Reader that reads records from r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: r}
+}
+
+// Read returns the next record, or io.EOF if there are no more records.
+//
+// An error is returned if the record is truncated or corrupted.
+func (r *Reader) Read() ([]byte, error) {
+	if _, err := io.ReadFull(r.r, r.header[:]); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return nil, fmt.Errorf("truncated record header")
+		}
+		return nil, err
+	}
+	if got, want := binary.LittleEndian.Uint32(r.header[8:12]), maskedCRC(r.header[0:8]); got != want {
+		return nil, fmt.Errorf("corrupted record header: checksum %#x, want %#x", got, want)
+	}
+	length := binary.LittleEndian.Uint64(r.header[0:8])
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r.r, data); err != nil {
+		return nil, fmt.Errorf("truncated record of %d bytes: %v", length, err)
+	}
+	if _, err := io.ReadFull(r.r, r.footer[:]); err != nil {
+		return nil, fmt.Errorf("truncated record footer: %v", err)
+	}
+	if got, want := binary.LittleEndian.Uint32(r.footer[:]), maskedCRC(data); got != want {
+		return nil, fmt.Errorf("corrupted record: checksum %#x, want %#x", got, want)
+	}
+	return data, nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tfrecord
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+func TestWriteAndRead(t *testing.T) {
+	records := [][]byte{
+		[]byte("first"),
+		{},
+		bytes.Repeat([]byte{0xff}, 1<<16),
+	}
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	for _, r := range records {
+		if err := w.Write(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	r := NewReader(&buf)
+	for i, want := range records {
+		got, err := r.Read()
+		if err != nil {
+			t.Fatalf("Record #%d: %v", i, err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("Record #%d: got %q, want %q", i, got, want)
+		}
+	}
+	if _, err := r.Read(); err != io.EOF {
+		t.Errorf("Got %v, want io.EOF", err)
+	}
+}
+
+func TestFraming(t *testing.T) {
+	// crc32c("abc") is 0x364b3fb7.
+	want := []byte{
+		0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // length
+		0xb0, 0x99, 0x49, 0x0e, // masked crc32c of length
+		'a', 'b', 'c', // data
+		0x6e, 0x57, 0xf1, 0x21, // masked crc32c of data
+	}
+	var buf bytes.Buffer
+	if err := NewWriter(&buf).Write([]byte("abc")); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("Got %#v, want %#v", buf.Bytes(), want)
+	}
+}
+
+func TestReadCorrupted(t *testing.T) {
+	var buf bytes.Buffer
+	if err := NewWriter(&buf).Write([]byte("abc")); err != nil {
+		t.Fatal(err)
+	}
+	valid := buf.Bytes()
+	for i := range valid {
+		corrupted := append([]byte(nil), valid...)
+		corrupted[i] ^= 0x01
+		if data, err := NewReader(bytes.NewReader(corrupted)).Read(); err == nil {
+			t.Errorf("Corrupting byte %d: got %q, want error", i, data)
+		}
+	}
+	for i := 1; i < len(valid); i++ {
+		if data, err := NewReader(bytes.NewReader(valid[:i])).Read(); err == nil || err == io.EOF {
+			t.Errorf("Truncating to %d bytes: got (%q, %v), want error", i, data, err)
+		}
+	}
+}
@@ -0,0 +1,408 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tftest provides helpers for testing TensorFlow models in Go, such
+// as regression tests comparing the outputs of a SavedModel with outputs
+// recorded when it was exported:
+//
+//	func TestModel(t *testing.T) {
+//		tftest.GoldenModelTest(t, "testdata/model", "testdata/golden", tftest.GoldenOptions{
+//			SignatureKey: signature.DefaultKey,
+//			Tolerance:    tf.Tolerance{Absolute: 1e-5},
+//			JUnitFile:    os.Getenv("JUNIT_REPORT"),
+//		})
+//	}
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package tftest
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/codec"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+	savedmodelpb "github.com/tensorflow/tensorflow/tensorflow/go/core/protobuf"
+	"github.com/tensorflow/tensorflow/tensorflow/go/signature"
+	"github.com/tensorflow/tensorflow/tensorflow/go/tfrecord"
+)
+
+// Prefixes of the names of the tensors of a golden case.
+const (
+	InputPrefix  = "inputs/"
+	OutputPrefix = "outputs/"
+)
+
+// GoldenOptions configures RunGolden and GoldenModelTest.
+//
+// A golden case is a file of the cases directory holding the inputs of a
+// run of the model and its expected outputs, named by InputPrefix and
+// OutputPrefix followed by the key of the tensor in the signature, or the
+// name of the tensor in the graph (e.g., "inputs/x:0") if SignatureKey is
+// empty. Two formats are supported, selected by the file extension:
+//
+//	.npz       NumPy archives, as written by numpy.savez.
+//	.tfrecord  TFRecord files of serialized tensorflow.NamedTensorProto
+//	           protocol buffers, one per tensor.
+//
+// Other files are ignored. Cases are named by their file names without the
+// extension.
+type GoldenOptions struct {
+	// Tags identifies the MetaGraphDef to load, {"serve"} if nil.
+	Tags           []string
+	SessionOptions *tf.SessionOptions
+	// SignatureKey is the name of the signature whose inputs and outputs
+	// are fed and fetched.
+	SignatureKey string
+	// Tolerance is the allowed difference between expected and actual
+	// outputs, unless overridden for the output in Tolerances.
+	Tolerance  tf.Tolerance
+	Tolerances map[string]tf.Tolerance
+	// JUnitFile and JSONFile, if not empty, are the paths to which
+	// GoldenModelTest writes the report of the test in the JUnit XML and
+	// JSON formats respectively.
+	JUnitFile string
+	JSONFile  string
+}
+
+// CaseResult is the outcome of a golden case.
+type CaseResult struct {
+	Name     string        `json:"name"`
+	Duration time.Duration `json:"duration"`
+	// Error is set if the case could not be run, for example because its
+	// file could not be read or the session failed.
+	Error string `json:"error,omitempty"`
+	// Failures describes the outputs that differ from the expected ones.
+	Failures []string `json:"failures,omitempty"`
+	// MaxDiff is the largest absolute difference between the elements of
+	// each output and the expected ones, keyed by output name. Outputs
+	// with a NaN where none was expected are omitted.
+	MaxDiff map[string]float64 `json:"max_diff,omitempty"`
+}
+
+// Passed reports whether the case ran and produced the expected outputs.
+func (c *CaseResult) Passed() bool {
+	return c.Error == "" && len(c.Failures) == 0
+}
+
+// Report is the outcome of the golden cases of a model.
+type Report struct {
+	Model string       `json:"model"`
+	Cases []CaseResult `json:"cases"`
+}
+
+// Failed returns the number of cases that did not pass.
+func (r *Report) Failed() int {
+	n := 0
+	for i := range r.Cases {
+		if !r.Cases[i].Passed() {
+			n++
+		}
+	}
+	return n
+}
+
+// WriteJSON writes the report to w as JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+type junitSuite struct {
+	XMLName  xml.Name    `xml:"testsuite"`
+	Name     string      `xml:"name,attr"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Errors   int         `xml:"errors,attr"`
+	Time     string      `xml:"time,attr"`
+	Cases    []junitCase `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit writes the report to w in the JUnit XML format understood by
+// most continuous integration systems, as a test suite named after the
+// model with a test case per golden case.
+func (r *Report) WriteJUnit(w io.Writer) error {
+	suite := junitSuite{Name: r.Model, Tests: len(r.Cases)}
+	var total time.Duration
+	for _, c := range r.Cases {
+		jc := junitCase{Name: c.Name, ClassName: r.Model, Time: seconds(c.Duration)}
+		switch {
+		case c.Error != "":
+			jc.Error = &junitMessage{Message: c.Error, Text: c.Error}
+			suite.Errors++
+		case len(c.Failures) > 0:
+			jc.Failure = &junitMessage{Message: c.Failures[0], Text: strings.Join(c.Failures, "\n")}
+			suite.Failures++
+		}
+		suite.Cases = append(suite.Cases, jc)
+		total += c.Duration
+	}
+	suite.Time = seconds(total)
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(suite); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func seconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
+
+// RunGolden loads the SavedModel in exportDir and runs the golden cases of
+// casesDir, in the order of their names. An error is returned only if the
+// model or the directory cannot be read: problems with individual cases are
+// recorded in the Report.
+func RunGolden(exportDir, casesDir string, opts GoldenOptions) (*Report, error) {
+	tags := opts.Tags
+	if tags == nil {
+		tags = []string{"serve"}
+	}
+	m, err := tf.LoadSavedModel(exportDir, tags, opts.SessionOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer m.Session.Close()
+	var sig *signature.Signature
+	if opts.SignatureKey != "" {
+		sigs, err := signature.FromSavedModel(m)
+		if err != nil {
+			return nil, err
+		}
+		s, ok := sigs[opts.SignatureKey]
+		if !ok {
+			return nil, fmt.Errorf("signature %q not found in %s", opts.SignatureKey, exportDir)
+		}
+		sig = &s
+	}
+	files, err := ioutil.ReadDir(casesDir)
+	if err != nil {
+		return nil, err
+	}
+	r := &Report{Model: filepath.Base(filepath.Clean(exportDir))}
+	for _, f := range files {
+		ext := filepath.Ext(f.Name())
+		if f.IsDir() || (ext != ".npz" && ext != ".tfrecord") {
+			continue
+		}
+		c := CaseResult{Name: strings.TrimSuffix(f.Name(), ext)}
+		start := time.Now()
+		if err := runCase(m, sig, filepath.Join(casesDir, f.Name()), opts, &c); err != nil {
+			c.Error = err.Error()
+		}
+		c.Duration = time.Since(start)
+		r.Cases = append(r.Cases, c)
+	}
+	return r, nil
+}
+
+// GoldenModelTest runs the golden cases of casesDir against the SavedModel
+// in exportDir as subtests of t, failing those whose outputs differ from the
+// expected ones, and writes the reports requested by opts.
+func GoldenModelTest(t *testing.T, exportDir, casesDir string, opts GoldenOptions) *Report {
+	t.Helper()
+	r, err := RunGolden(exportDir, casesDir, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Cases) == 0 {
+		t.Errorf("no golden cases found in %s", casesDir)
+	}
+	for _, c := range r.Cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			if c.Error != "" {
+				t.Fatal(c.Error)
+			}
+			for _, f := range c.Failures {
+				t.Error(f)
+			}
+		})
+	}
+	if opts.JUnitFile != "" {
+		if err := writeReport(opts.JUnitFile, r.WriteJUnit); err != nil {
+			t.Error(err)
+		}
+	}
+	if opts.JSONFile != "" {
+		if err := writeReport(opts.JSONFile, r.WriteJSON); err != nil {
+			t.Error(err)
+		}
+	}
+	return r
+}
+
+func writeReport(path string, write func(io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// runCase runs the golden case in path, recording its outcome in c.
+func runCase(m *tf.SavedModel, sig *signature.Signature, path string, opts GoldenOptions, c *CaseResult) error {
+	var tensors map[string]*tf.Tensor
+	var err error
+	if filepath.Ext(path) == ".npz" {
+		tensors, err = readNPZ(path)
+	} else {
+		tensors, err = readTFRecord(path)
+	}
+	if err != nil {
+		return err
+	}
+	feeds := make(map[tf.Output]*tf.Tensor)
+	var outputs []string
+	for name, t := range tensors {
+		switch {
+		case strings.HasPrefix(name, InputPrefix):
+			o, err := lookup(m.Graph, sig, name[len(InputPrefix):], true)
+			if err != nil {
+				return err
+			}
+			feeds[o] = t
+		case strings.HasPrefix(name, OutputPrefix):
+			outputs = append(outputs, name[len(OutputPrefix):])
+		default:
+			return fmt.Errorf("tensor %q is neither an input nor an output", name)
+		}
+	}
+	if len(outputs) == 0 {
+		return fmt.Errorf("no expected outputs")
+	}
+	sort.Strings(outputs)
+	fetches := make([]tf.Output, len(outputs))
+	for i, name := range outputs {
+		if fetches[i], err = lookup(m.Graph, sig, name, false); err != nil {
+			return err
+		}
+	}
+	got, err := m.Session.Run(feeds, fetches, nil)
+	if err != nil {
+		return err
+	}
+	c.MaxDiff = make(map[string]float64)
+	for i, name := range outputs {
+		tol, ok := opts.Tolerances[name]
+		if !ok {
+			tol = opts.Tolerance
+		}
+		d, err := tf.CompareTensors(tensors[OutputPrefix+name], got[i], tol)
+		if !math.IsInf(d, 0) {
+			// JSON has no infinity: the failure explains the
+			// difference.
+			c.MaxDiff[name] = d
+		}
+		if err != nil {
+			c.Failures = append(c.Failures, fmt.Sprintf("output %q: %v", name, err))
+		}
+	}
+	return nil
+}
+
+// lookup returns the Output identified by name: a key of the inputs or
+// outputs of sig, or a tensor name if sig is nil.
+func lookup(g *tf.Graph, sig *signature.Signature, name string, input bool) (tf.Output, error) {
+	if sig == nil {
+		return signature.TensorInfo{Name: name}.Output(g)
+	}
+	infos, kind := sig.Outputs, "output"
+	if input {
+		infos, kind = sig.Inputs, "input"
+	}
+	info, ok := infos[name]
+	if !ok {
+		return tf.Output{}, fmt.Errorf("signature has no %s %q", kind, name)
+	}
+	return info.Output(g)
+}
+
+// readTFRecord returns the tensors of the TFRecord file of serialized
+// NamedTensorProtos at path, keyed by name.
+func readTFRecord(path string) (map[string]*tf.Tensor, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	r := tfrecord.NewReader(f)
+	tensors := make(map[string]*tf.Tensor)
+	for {
+		rec, err := r.Read()
+		if err == io.EOF {
+			return tensors, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var named savedmodelpb.NamedTensorProto
+		if err := proto.Unmarshal(rec, &named); err != nil {
+			return nil, fmt.Errorf("invalid NamedTensorProto: %v", err)
+		}
+		if named.Tensor == nil {
+			return nil, fmt.Errorf("tensor %q has no value", named.Name)
+		}
+		t, err := decodeTensorProto(named.Tensor)
+		if err != nil {
+			return nil, fmt.Errorf("tensor %q: %v", named.Name, err)
+		}
+		tensors[named.Name] = t
+	}
+}
+
+// decodeTensorProto returns the Tensor holding the value of p.
+func decodeTensorProto(p *pb.TensorProto) (*tf.Tensor, error) {
+	b, err := proto.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return codec.Proto.Decode(b)
+}
@@ -0,0 +1,244 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tftest
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/codec"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+	savedmodelpb "github.com/tensorflow/tensorflow/tensorflow/go/core/protobuf"
+	"github.com/tensorflow/tensorflow/tensorflow/go/signature"
+	"github.com/tensorflow/tensorflow/tensorflow/go/tfrecord"
+)
+
+const halfPlusTwo = "../../cc/saved_model/testdata/half_plus_two/00000123"
+
+// npy returns the .npy encoding of a float32 array of the given shape.
+func npy(shape []int, values ...float32) []byte {
+	dims := make([]string, len(shape))
+	for i, d := range shape {
+		dims[i] = fmt.Sprint(d)
+	}
+	header := fmt.Sprintf("{'descr': '<f4', 'fortran_order': False, 'shape': (%s,), }", strings.Join(dims, ", "))
+	var buf bytes.Buffer
+	buf.WriteString("\x93NUMPY\x01\x00")
+	binary.Write(&buf, binary.LittleEndian, uint16(len(header)+1))
+	buf.WriteString(header + "\n")
+	binary.Write(&buf, binary.LittleEndian, values)
+	return buf.Bytes()
+}
+
+func writeNPZ(t *testing.T, path string, arrays map[string][]byte) {
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	z := zip.NewWriter(f)
+	for name, data := range arrays {
+		w, err := z.Create(name + ".npy")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write(data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := z.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func writeTFRecord(t *testing.T, path string, tensors map[string]interface{}) {
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	w := tfrecord.NewWriter(f)
+	for name, value := range tensors {
+		tensor, err := tf.NewTensor(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := codec.Proto.Encode(tensor)
+		if err != nil {
+			t.Fatal(err)
+		}
+		named := &savedmodelpb.NamedTensorProto{Name: name, Tensor: new(pb.TensorProto)}
+		if err := proto.Unmarshal(b, named.Tensor); err != nil {
+			t.Fatal(err)
+		}
+		rec, err := proto.Marshal(named)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Write(rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "tftest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestGoldenModelTest(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	writeNPZ(t, filepath.Join(dir, "batch.npz"), map[string][]byte{
+		"inputs/x":  npy([]int{3, 1}, 0, 1, 2),
+		"outputs/y": npy([]int{3, 1}, 2, 2.5, 3),
+	})
+	writeNPZ(t, filepath.Join(dir, "close.npz"), map[string][]byte{
+		"inputs/x":  npy([]int{1, 1}, 4),
+		"outputs/y": npy([]int{1, 1}, 4.0001),
+	})
+	if err := ioutil.WriteFile(filepath.Join(dir, "README"), []byte("ignored"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	junit := filepath.Join(dir, "report.xml")
+	r := GoldenModelTest(t, halfPlusTwo, dir, GoldenOptions{
+		SignatureKey: signature.DefaultKey,
+		Tolerance:    tf.Tolerance{Absolute: 1e-3},
+		JUnitFile:    junit,
+	})
+	if got, want := len(r.Cases), 2; got != want {
+		t.Fatalf("Got %d cases, want %d", got, want)
+	}
+	if got, want := r.Cases[0].Name, "batch"; got != want {
+		t.Errorf("Got case %q, want %q", got, want)
+	}
+	if d := r.Cases[1].MaxDiff["y"]; d == 0 || d > 1e-3 {
+		t.Errorf("Got a difference of %g for case %q, want about 1e-4", d, r.Cases[1].Name)
+	}
+	b, err := ioutil.ReadFile(junit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(b, []byte(`<testsuite name="00000123" tests="2" failures="0" errors="0"`)) {
+		t.Errorf("Unexpected JUnit report:\n%s", b)
+	}
+}
+
+func TestRunGoldenFailures(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	writeTFRecord(t, filepath.Join(dir, "pass.tfrecord"), map[string]interface{}{
+		"inputs/x:0":  [][]float32{{2}, {4}},
+		"outputs/y:0": [][]float32{{3}, {4}},
+	})
+	writeTFRecord(t, filepath.Join(dir, "wrong.tfrecord"), map[string]interface{}{
+		"inputs/x:0":  [][]float32{{2}, {4}},
+		"outputs/y:0": [][]float32{{3}, {5}},
+	})
+	writeTFRecord(t, filepath.Join(dir, "missing.tfrecord"), map[string]interface{}{
+		"inputs/nosuchop:0": [][]float32{{2}},
+		"outputs/y:0":       [][]float32{{3}},
+	})
+	r, err := RunGolden(halfPlusTwo, dir, GoldenOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := r.Failed(), 2; got != want {
+		t.Errorf("Got %d failed cases, want %d", got, want)
+	}
+	results := make(map[string]CaseResult)
+	for _, c := range r.Cases {
+		results[c.Name] = c
+	}
+	if c := results["pass"]; !c.Passed() {
+		t.Errorf("Case %q failed: %+v", c.Name, c)
+	}
+	if c := results["wrong"]; c.Error != "" || len(c.Failures) != 1 || !strings.Contains(c.Failures[0], "element [1 0]") {
+		t.Errorf("Got %+v for case \"wrong\", want a failure of element [1 0]", c)
+	}
+	if c := results["missing"]; !strings.Contains(c.Error, "nosuchop") {
+		t.Errorf("Got %+v for case \"missing\", want an error", c)
+	}
+
+	var buf bytes.Buffer
+	if err := r.WriteJUnit(&buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`failures="1" errors="1"`, `<failure message="output`, `<error message=`} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("JUnit report does not contain %q:\n%s", want, buf.String())
+		}
+	}
+	buf.Reset()
+	if err := r.WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var decoded Report
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&decoded, r) {
+		t.Errorf("Got %+v from the JSON report, want %+v", decoded, r)
+	}
+}
+
+func TestReadNPY(t *testing.T) {
+	tests := []struct {
+		descr string
+		shape string
+		data  []byte
+		want  interface{}
+	}{
+		{"<f4", "(2,)", []byte{0, 0, 0x80, 0x3f, 0, 0, 0, 0x40}, []float32{1, 2}},
+		{">i4", "(1, 2)", []byte{0, 0, 1, 0, 0xff, 0xff, 0xff, 0xff}, [][]int32{{256, -1}}},
+		{"|b1", "()", []byte{1}, true},
+		{"|S3", "(2,)", []byte("ab\x00xyz"), []string{"ab", "xyz"}},
+		{"<U2", "(1,)", []byte{0xe9, 0, 0, 0, 0, 0, 0, 0}, []string{"é"}},
+	}
+	for _, test := range tests {
+		header := fmt.Sprintf("{'descr': '%s', 'fortran_order': False, 'shape': %s, }\n", test.descr, test.shape)
+		var buf bytes.Buffer
+		buf.WriteString("\x93NUMPY\x01\x00")
+		binary.Write(&buf, binary.LittleEndian, uint16(len(header)))
+		buf.WriteString(header)
+		buf.Write(test.data)
+		got, err := readNPY(&buf)
+		if err != nil {
+			t.Errorf("%s: %v", test.descr, err)
+			continue
+		}
+		if !reflect.DeepEqual(got.Value(), test.want) {
+			t.Errorf("%s: got %v, want %v", test.descr, got.Value(), test.want)
+		}
+	}
+	header := "{'descr': '<f4', 'fortran_order': True, 'shape': (2, 2), }\n"
+	if _, err := readNPY(strings.NewReader("\x93NUMPY\x01\x00" + string([]byte{byte(len(header)), 0}) + header)); err == nil {
+		t.Error("readNPY accepted an array in Fortran order")
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tftest
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	pb "github.com/tensorflow/tensorflow/tensorflow/go/core/framework"
+)
+
+// npyDataTypes maps the kind and item size of NumPy array type descriptors
+// (e.g., "f4" in "<f4") to DataTypes. Strings ("S" and "U") are handled
+// separately since their size varies.
+var npyDataTypes = map[string]tf.DataType{
+	"f2":  tf.Half,
+	"f4":  tf.Float,
+	"f8":  tf.Double,
+	"i1":  tf.Int8,
+	"i2":  tf.Int16,
+	"i4":  tf.Int32,
+	"i8":  tf.Int64,
+	"u1":  tf.Uint8,
+	"u2":  tf.Uint16,
+	"b1":  tf.Bool,
+	"c8":  tf.Complex64,
+	"c16": tf.Complex128,
+}
+
+var (
+	npyDescr   = regexp.MustCompile(`'descr':\s*'([^']*)'`)
+	npyFortran = regexp.MustCompile(`'fortran_order':\s*(True|False)`)
+	npyShape   = regexp.MustCompile(`'shape':\s*\(([^)]*)\)`)
+)
+
+// readNPZ returns the arrays of the NumPy .npz archive at path, keyed by
+// their names without the ".npy" suffix.
+func readNPZ(path string) (map[string]*tf.Tensor, error) {
+	r, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	arrays := make(map[string]*tf.Tensor)
+	for _, f := range r.File {
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		t, err := readNPY(rc)
+		rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", f.Name, err)
+		}
+		arrays[strings.TrimSuffix(f.Name, ".npy")] = t
+	}
+	return arrays, nil
+}
+
+// readNPY reads an array in the NumPy .npy format
+// (https://numpy.org/doc/stable/reference/generated/numpy.lib.format.html).
+func readNPY(r io.Reader) (*tf.Tensor, error) {
+	var preamble [8]byte
+	if _, err := io.ReadFull(r, preamble[:]); err != nil {
+		return nil, fmt.Errorf("truncated .npy header: %v", err)
+	}
+	if string(preamble[:6]) != "\x93NUMPY" {
+		return nil, fmt.Errorf("not a .npy file")
+	}
+	var headerLen uint32
+	switch preamble[6] {
+	case 1:
+		var n uint16
+		if err := binary.Read(r, binary.LittleEndian, &n); err != nil {
+			return nil, fmt.Errorf("truncated .npy header: %v", err)
+		}
+		headerLen = uint32(n)
+	case 2, 3:
+		if err := binary.Read(r, binary.LittleEndian, &headerLen); err != nil {
+			return nil, fmt.Errorf("truncated .npy header: %v", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported .npy version %d.%d", preamble[6], preamble[7])
+	}
+	header := make([]byte, headerLen)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("truncated .npy header: %v", err)
+	}
+	descr := npyDescr.FindSubmatch(header)
+	fortran := npyFortran.FindSubmatch(header)
+	dims := npyShape.FindSubmatch(header)
+	if descr == nil || fortran == nil || dims == nil {
+		return nil, fmt.Errorf("invalid .npy header %q", header)
+	}
+	if string(fortran[1]) == "True" {
+		return nil, fmt.Errorf("arrays in Fortran order are not supported")
+	}
+	p := &pb.TensorProto{TensorShape: &pb.TensorShapeProto{}}
+	n := 1
+	for _, d := range strings.Split(string(dims[1]), ",") {
+		if d = strings.TrimSpace(d); d == "" {
+			continue
+		}
+		size, err := strconv.ParseInt(d, 10, 64)
+		if err != nil || size < 0 {
+			return nil, fmt.Errorf("invalid .npy shape (%s)", dims[1])
+		}
+		p.TensorShape.Dim = append(p.TensorShape.Dim, &pb.TensorShapeProto_Dim{Size: size})
+		n *= int(size)
+	}
+	if len(descr[1]) < 3 {
+		return nil, fmt.Errorf("unsupported .npy type %q", descr[1])
+	}
+	var order binary.ByteOrder = binary.LittleEndian
+	if descr[1][0] == '>' {
+		order = binary.BigEndian
+	}
+	kind := descr[1][1]
+	size, err := strconv.Atoi(string(descr[1][2:]))
+	if err != nil {
+		return nil, fmt.Errorf("unsupported .npy type %q", descr[1])
+	}
+	switch kind {
+	case 'S', 'U':
+		width := size
+		if kind == 'U' {
+			width *= 4
+		}
+		buf := make([]byte, width)
+		p.Dtype = pb.DataType(tf.String)
+		p.StringVal = make([][]byte, n)
+		for i := range p.StringVal {
+			if _, err := io.ReadFull(r, buf); err != nil {
+				return nil, fmt.Errorf("truncated .npy data: %v", err)
+			}
+			if kind == 'U' {
+				p.StringVal[i] = decodeUTF32(buf, order)
+			} else {
+				p.StringVal[i] = append([]byte(nil), bytes.TrimRight(buf, "\x00")...)
+			}
+		}
+	default:
+		dt, ok := npyDataTypes[string(descr[1][1:])]
+		if !ok {
+			return nil, fmt.Errorf("unsupported .npy type %q", descr[1])
+		}
+		p.Dtype = pb.DataType(dt)
+		p.TensorContent = make([]byte, n*size)
+		if _, err := io.ReadFull(r, p.TensorContent); err != nil {
+			return nil, fmt.Errorf("truncated .npy data: %v", err)
+		}
+		if order == binary.BigEndian {
+			// tensor_content is little-endian. Complex numbers are
+			// swapped one component at a time.
+			if kind == 'c' {
+				size /= 2
+			}
+			swapBytes(p.TensorContent, size)
+		}
+	}
+	return decodeTensorProto(p)
+}
+
+// decodeUTF32 returns the UTF-8 encoding of the NUL-padded UTF-32 string b.
+func decodeUTF32(b []byte, order binary.ByteOrder) []byte {
+	var s []byte
+	for i := 0; i+4 <= len(b); i += 4 {
+		r := rune(order.Uint32(b[i:]))
+		if r == 0 {
+			break
+		}
+		var enc [utf8.UTFMax]byte
+		s = append(s, enc[:utf8.EncodeRune(enc[:], r)]...)
+	}
+	return s
+}
+
+// swapBytes reverses the byte order of each size-byte element of b.
+func swapBytes(b []byte, size int) {
+	for i := 0; i+size <= len(b); i += size {
+		for j, k := i, i+size-1; j < k; j, k = j+1, k-1 {
+			b[j], b[k] = b[k], b[j]
+		}
+	}
+}
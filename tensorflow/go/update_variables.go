@@ -0,0 +1,134 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// variableUpdate holds the operations restoring the variables of a
+// SavedModel from a checkpoint, added to its graph by the first call to
+// UpdateVariables.
+type variableUpdate struct {
+	mu       sync.Mutex
+	vars     []Variable
+	prefix   Output   // The prefix of the checkpoint to restore.
+	restored []Output // The values restored for vars.
+}
+
+// UpdateVariables restores the variables of m from the checkpoint with
+// the given prefix, such as "export/variables/variables", into the
+// variables of its Session, so that models whose weights are refreshed
+// frequently, such as embeddings, do not need to be reloaded.
+//
+// Every variable of the graph must be saved in the checkpoint under the
+// name of its operation, with its type and a compatible shape. All the
+// values are read and validated before any variable is assigned, so that
+// a checkpoint that does not match the graph leaves the variables
+// unchanged. Runs concurrent with UpdateVariables may see the previous or
+// the new values of each variable; runs started after it returns see the
+// new values.
+//
+// The operations restoring the variables are added to Graph on the first
+// call, under a name scope starting with "UpdateVariables", and those
+// assigning them as by AssignVariables.
+func (m *SavedModel) UpdateVariables(prefix string) error {
+	u := &m.update
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.vars == nil {
+		if err := u.build(m.Graph); err != nil {
+			return fmt.Errorf("UpdateVariables: %v", err)
+		}
+	}
+	p, err := NewTensor(prefix)
+	if err != nil {
+		return err
+	}
+	restored, err := m.Session.Run(map[Output]*Tensor{u.prefix: p}, u.restored, nil)
+	if err != nil {
+		return err
+	}
+	values := make(map[string]*Tensor, len(restored))
+	for i, v := range u.vars {
+		if shape := MakeShape(restored[i].Shape()...); !shape.IsCompatibleWith(v.Shape) {
+			return fmt.Errorf("variable %s has shape %v, got %v from %s", v.Op.Name(), v.Shape, shape, prefix)
+		}
+		values[v.Op.Name()] = restored[i]
+	}
+	return AssignVariables(m.Session, m.Graph, values)
+}
+
+// build adds the operations restoring the variables of graph to it.
+func (u *variableUpdate) build(graph *Graph) error {
+	vars, err := graph.Variables()
+	if err != nil {
+		return err
+	}
+	if len(vars) == 0 {
+		return errors.New("the graph has no variables")
+	}
+	scope := "UpdateVariables"
+	for i := 1; graph.Operation(scope+"/prefix") != nil; i++ {
+		scope = fmt.Sprintf("UpdateVariables_%d", i)
+	}
+	names := make([]string, len(vars))
+	dtypes := make([]DataType, len(vars))
+	for i, v := range vars {
+		names[i] = v.Op.Name()
+		dtypes[i] = v.DataType
+	}
+	prefix, err := graph.AddOperation(OpSpec{Type: "Placeholder", Name: scope + "/prefix", Attrs: map[string]interface{}{"dtype": String}})
+	if err != nil {
+		return err
+	}
+	tensorNames, err := constOp(graph, scope+"/tensor_names", names)
+	if err != nil {
+		return err
+	}
+	slices, err := constOp(graph, scope+"/shape_and_slices", make([]string, len(vars)))
+	if err != nil {
+		return err
+	}
+	restore, err := graph.AddOperation(OpSpec{
+		Type:  "RestoreV2",
+		Name:  scope + "/restore",
+		Input: []Input{prefix.Output(0), tensorNames, slices},
+		Attrs: map[string]interface{}{"dtypes": dtypes},
+	})
+	if err != nil {
+		return err
+	}
+	restored := make([]Output, len(vars))
+	for i := range vars {
+		restored[i] = restore.Output(i)
+	}
+	u.vars, u.prefix, u.restored = vars, prefix.Output(0), restored
+	return nil
+}
+
+func constOp(graph *Graph, name string, value interface{}) (Output, error) {
+	t, err := NewTensor(value)
+	if err != nil {
+		return Output{}, err
+	}
+	op, err := graph.AddOperation(OpSpec{Type: "Const", Name: name, Attrs: map[string]interface{}{"dtype": t.DataType(), "value": t}})
+	if err != nil {
+		return Output{}, err
+	}
+	return op.Output(0), nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// saveCheckpoint saves value as the variable "v" in a checkpoint with the
+// given prefix.
+func saveCheckpoint(t *testing.T, prefix string, value interface{}) {
+	g := NewGraph()
+	p, err := Const(g, "prefix", prefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names, err := Const(g, "names", []string{"v"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	slices, err := Const(g, "slices", []string{""})
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := Const(g, "v", value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	save, err := g.AddOperation(OpSpec{Type: "SaveV2", Name: "save", Input: []Input{p, names, slices, OutputList{v}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewSession(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	if _, err := s.Run(nil, nil, []*Operation{save}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUpdateVariables(t *testing.T) {
+	dir, err := ioutil.TempDir("", "UpdateVariables")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	saveCheckpoint(t, filepath.Join(dir, "new"), []float32{5, 6})
+	saveCheckpoint(t, filepath.Join(dir, "wrong_shape"), []float32{5, 6, 7})
+
+	g := NewGraph()
+	v, err := g.AddOperation(OpSpec{Type: "VariableV2", Name: "v", Attrs: map[string]interface{}{"dtype": Float, "shape": MakeShape(2)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	initial, err := Const(g, "initial", []float32{1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assign, err := g.AddOperation(OpSpec{Type: "Assign", Name: "init", Input: []Input{v.Output(0), initial}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	read, err := g.AddOperation(OpSpec{Type: "Identity", Name: "read", Input: []Input{v.Output(0)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewSession(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	if _, err := s.Run(nil, nil, []*Operation{assign}); err != nil {
+		t.Fatal(err)
+	}
+	m := &SavedModel{Session: s, Graph: g}
+	check := func(want []float32) {
+		out, err := s.Run(nil, []Output{read.Output(0)}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := out[0].Value(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Got v = %v, want %v", got, want)
+		}
+	}
+	for _, prefix := range []string{"wrong_shape", "missing"} {
+		if err := m.UpdateVariables(filepath.Join(dir, prefix)); err == nil {
+			t.Errorf("%s: UpdateVariables succeeded", prefix)
+		}
+		check([]float32{1, 2})
+	}
+	if err := m.UpdateVariables(filepath.Join(dir, "new")); err != nil {
+		t.Fatal(err)
+	}
+	check([]float32{5, 6})
+}
@@ -0,0 +1,273 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// Variable describes a variable of a Graph.
+type Variable struct {
+	Op *Operation
+	// Resource reports whether Op is a VarHandleOp, rather than a
+	// reference variable (a VariableV2 or Variable operation).
+	Resource bool
+	DataType DataType
+	// Shape is the shape declared by the variable, which may be only
+	// partially known.
+	Shape Shape
+}
+
+// variableCache holds the variables of a Graph and the operations
+// assigning them added by AssignVariables.
+type variableCache struct {
+	mu      sync.Mutex
+	changes uint64 // The changes of the Graph that vars reflects.
+	valid   bool
+	vars    []Variable
+	scope   string // The name scope of the operations of assigns.
+	assigns map[string]variableAssign
+}
+
+// variableAssign are the operations assigning the value fed to a
+// Placeholder to a variable.
+type variableAssign struct {
+	value  Output
+	assign *Operation
+}
+
+// Variables returns the variables of g: its VariableV2, Variable and
+// VarHandleOp operations. The serialized graph they are found in is only
+// parsed again once operations have been added to g.
+func (g *Graph) Variables() ([]Variable, error) {
+	c := &g.variables
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	vars, err := c.get(g)
+	return append([]Variable(nil), vars...), err
+}
+
+// get returns the variables of g. c.mu must be held.
+func (c *variableCache) get(g *Graph) ([]Variable, error) {
+	changes := atomic.LoadUint64(&g.changes)
+	if c.valid && c.changes == changes {
+		return c.vars, nil
+	}
+	var buf bytes.Buffer
+	if _, err := g.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+	var vars []Variable
+	err := parseMessage(buf.Bytes(), func(field int, _ uint64, b []byte) error {
+		if field != 1 { // node
+			return nil
+		}
+		var (
+			name, typ string
+			v         Variable
+		)
+		err := parseMessage(b, func(field int, _ uint64, b []byte) error {
+			switch field {
+			case 1:
+				name = string(b)
+			case 2:
+				typ = string(b)
+			case 5: // attr
+				return parseTypeShapeAttr(b, &v.DataType, &v.Shape)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		switch typ {
+		case "VariableV2", "Variable":
+		case "VarHandleOp":
+			v.Resource = true
+		default:
+			return nil
+		}
+		if v.Op = g.Operation(name); v.Op == nil {
+			return fmt.Errorf("operation %s not found", name)
+		}
+		vars = append(vars, v)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid GraphDef: %v", err)
+	}
+	c.changes, c.valid, c.vars = changes, true, vars
+	return vars, nil
+}
+
+// AssignVariables assigns values to the variables of graph of the same
+// names in session. The type of each value must be that of its variable,
+// and its shape compatible with that of the variable: no variable is
+// assigned otherwise.
+//
+// The operations assigning a variable, a Placeholder and an Assign or
+// AssignVariableOp operation, are added to graph by the first call
+// assigning it, under a name scope starting with "AssignVariables", and
+// reused by later calls.
+func AssignVariables(session *Session, graph *Graph, values map[string]*Tensor) error {
+	c := &graph.variables
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	feeds := make(map[Output]*Tensor, len(names))
+	targets := make([]*Operation, len(names))
+	var vars map[string]Variable
+	for i, name := range names {
+		a, ok := c.assigns[name]
+		if !ok {
+			// Looked up once, since adding operations to graph
+			// invalidates the variables cached.
+			if vars == nil {
+				list, err := c.get(graph)
+				if err != nil {
+					return err
+				}
+				vars = make(map[string]Variable, len(list))
+				for _, v := range list {
+					vars[v.Op.Name()] = v
+				}
+			}
+			v, ok := vars[name]
+			if !ok {
+				return fmt.Errorf("no variable %s in the graph", name)
+			}
+			var err error
+			if a, err = c.addAssign(graph, v); err != nil {
+				return err
+			}
+		}
+		t := values[name]
+		if got, want := t.DataType(), a.value.DataType(); got != want {
+			return fmt.Errorf("%v value for %v variable %s", got, want, name)
+		}
+		if shape := MakeShape(t.Shape()...); !shape.IsCompatibleWith(a.value.Shape()) {
+			return fmt.Errorf("value of shape %v for variable %s of shape %v", shape, name, a.value.Shape())
+		}
+		feeds[a.value] = t
+		targets[i] = a.assign
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+	_, err := session.Run(feeds, nil, targets)
+	return err
+}
+
+// addAssign adds the operations assigning v to graph. c.mu must be held.
+func (c *variableCache) addAssign(graph *Graph, v Variable) (variableAssign, error) {
+	if c.scope == "" {
+		c.scope = "AssignVariables"
+		for i := 1; graph.Operation(c.scope+"/value_0") != nil; i++ {
+			c.scope = fmt.Sprintf("AssignVariables_%d", i)
+		}
+		c.assigns = make(map[string]variableAssign)
+	}
+	n := len(c.assigns)
+	value, err := graph.AddOperation(OpSpec{
+		Type:  "Placeholder",
+		Name:  fmt.Sprintf("%s/value_%d", c.scope, n),
+		Attrs: map[string]interface{}{"dtype": v.DataType, "shape": v.Shape},
+	})
+	if err != nil {
+		return variableAssign{}, err
+	}
+	spec := OpSpec{
+		Type:  "Assign",
+		Name:  fmt.Sprintf("%s/assign_%d", c.scope, n),
+		Input: []Input{v.Op.Output(0), value.Output(0)},
+	}
+	if v.Resource {
+		spec.Type = "AssignVariableOp"
+		spec.Attrs = map[string]interface{}{"dtype": v.DataType}
+	}
+	assign, err := graph.AddOperation(spec)
+	if err != nil {
+		return variableAssign{}, err
+	}
+	a := variableAssign{value: value.Output(0), assign: assign}
+	c.assigns[v.Op.Name()] = a
+	return a, nil
+}
+
+// parseTypeShapeAttr sets dt or shape from entry, an entry of the
+// attributes of a NodeDef, if it is its "dtype" or "shape" attribute.
+func parseTypeShapeAttr(entry []byte, dt *DataType, shape *Shape) error {
+	var key string
+	var value []byte
+	err := parseMessage(entry, func(field int, _ uint64, b []byte) error {
+		switch field {
+		case 1:
+			key = string(b)
+		case 2:
+			value = b
+		}
+		return nil
+	})
+	if err != nil || (key != "dtype" && key != "shape") {
+		return err
+	}
+	return parseMessage(value, func(field int, x uint64, b []byte) error {
+		switch {
+		case field == 6 && key == "dtype": // type
+			*dt = DataType(x)
+		case field == 7 && key == "shape": // shape
+			var err error
+			*shape, err = parseTensorShapeProto(b)
+			return err
+		}
+		return nil
+	})
+}
+
+// parseTensorShapeProto returns the Shape described by a serialized
+// TensorShapeProto.
+func parseTensorShapeProto(b []byte) (Shape, error) {
+	var (
+		dims    []int64
+		unknown bool
+	)
+	err := parseMessage(b, func(field int, x uint64, b []byte) error {
+		switch field {
+		case 2: // dim
+			dims = append(dims, 0)
+			return parseMessage(b, func(field int, x uint64, _ []byte) error {
+				if field == 1 { // size
+					dims[len(dims)-1] = int64(x)
+				}
+				return nil
+			})
+		case 3: // unknown_rank
+			unknown = x != 0
+		}
+		return nil
+	})
+	if err != nil || unknown {
+		return Shape{}, err
+	}
+	return MakeShape(dims...), nil
+}
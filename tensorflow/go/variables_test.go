@@ -0,0 +1,78 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAssignVariables(t *testing.T) {
+	g := NewGraph()
+	v, err := g.AddOperation(OpSpec{Type: "VariableV2", Name: "v", Attrs: map[string]interface{}{"dtype": Float, "shape": MakeShape(2)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	read, err := g.AddOperation(OpSpec{Type: "Identity", Name: "read", Input: []Input{v.Output(0)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	vars, err := g.Variables()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vars) != 1 || vars[0].Op.Name() != "v" || vars[0].Resource || vars[0].DataType != Float || vars[0].Shape.String() != "[2]" {
+		t.Fatalf("Got variables %+v", vars)
+	}
+	s, err := NewSession(g, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	for _, want := range [][]float32{{1, 2}, {3, 4}} {
+		value, err := NewTensor(want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := AssignVariables(s, g, map[string]*Tensor{"v": value}); err != nil {
+			t.Fatal(err)
+		}
+		out, err := s.Run(nil, []Output{read.Output(0)}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := out[0].Value(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Got v = %v, want %v", got, want)
+		}
+	}
+	if g.Operation("AssignVariables/value_1") != nil {
+		t.Error("Operations added to assign the variable again")
+	}
+	for _, values := range []map[string]interface{}{
+		{"v": []float32{1, 2, 3}},
+		{"v": []int32{1, 2}},
+		{"read": []float32{1, 2}},
+	} {
+		tensors := make(map[string]*Tensor)
+		for name, value := range values {
+			if tensors[name], err = NewTensor(value); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := AssignVariables(s, g, tensors); err == nil {
+			t.Errorf("AssignVariables(%v) succeeded", values)
+		}
+	}
+}
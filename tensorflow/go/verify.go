@@ -0,0 +1,131 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// SavedModelVerifier checks the files of a SavedModel before they are
+// loaded, for example against detached signatures or checksums published
+// with the model, so that tampered models are rejected before TensorFlow
+// parses any of their files.
+type SavedModelVerifier interface {
+	// Verify is called with the contents of each regular file of the
+	// SavedModel directory, including saved_model.pb, the variable shards
+	// and the assets, and its path relative to the directory, using
+	// slashes (e.g., "variables/variables.index"). Returning an error
+	// aborts the load.
+	Verify(name string, contents io.Reader) error
+}
+
+// VerifierFunc adapts a function to the SavedModelVerifier interface.
+type VerifierFunc func(name string, contents io.Reader) error
+
+// Verify implements SavedModelVerifier.
+func (f VerifierFunc) Verify(name string, contents io.Reader) error {
+	return f(name, contents)
+}
+
+// Checksums is a SavedModelVerifier checking that the SHA-256 hash of each
+// file matches the hexadecimal digest listed for its name. Files that are
+// not listed are rejected.
+type Checksums map[string]string
+
+// Verify implements SavedModelVerifier.
+func (c Checksums) Verify(name string, contents io.Reader) error {
+	want, ok := c[name]
+	if !ok {
+		return fmt.Errorf("no checksum for %s", name)
+	}
+	h := sha256.New()
+	if _, err := io.Copy(h, contents); err != nil {
+		return err
+	}
+	if got := hex.EncodeToString(h.Sum(nil)); got != want {
+		return fmt.Errorf("SHA-256 of %s is %s, want %s", name, got, want)
+	}
+	return nil
+}
+
+// LoadVerifiedSavedModel is like LoadSavedModel, but first passes every
+// file of the SavedModel to v, starting with saved_model.pb, and fails
+// without loading the model if any of them is rejected.
+//
+// The files are read again by TensorFlow once verified, so the directory
+// must not be writable by the parties the verification protects against.
+func LoadVerifiedSavedModel(exportDir string, tags []string, options *SessionOptions, v SavedModelVerifier) (*SavedModel, error) {
+	if err := verifySavedModel(exportDir, v); err != nil {
+		return nil, err
+	}
+	return LoadSavedModel(exportDir, tags, options)
+}
+
+func verifySavedModel(exportDir string, v SavedModelVerifier) error {
+	var names []string
+	err := filepath.Walk(exportDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(exportDir, path)
+		if err != nil {
+			return err
+		}
+		names = append(names, filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	sort.Sort(verificationOrder(names))
+	for _, name := range names {
+		f, err := os.Open(filepath.Join(exportDir, filepath.FromSlash(name)))
+		if err != nil {
+			return err
+		}
+		err = v.Verify(name, f)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("verification of %s failed: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// verificationOrder sorts the files of a SavedModel by name, with the
+// saved_model.pb (or .pbtxt) file first.
+type verificationOrder []string
+
+func (s verificationOrder) Len() int      { return len(s) }
+func (s verificationOrder) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s verificationOrder) Less(i, j int) bool {
+	if a, b := isSavedModelProto(s[i]), isSavedModelProto(s[j]); a != b {
+		return a
+	}
+	return s[i] < s[j]
+}
+
+func isSavedModelProto(name string) bool {
+	return name == "saved_model.pb" || name == "saved_model.pbtxt"
+}
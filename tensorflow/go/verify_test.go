@@ -0,0 +1,68 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoadVerifiedSavedModel(t *testing.T) {
+	const exportDir = "../cc/saved_model/testdata/half_plus_two/00000123"
+	checksums := make(Checksums)
+	var names []string
+	record := VerifierFunc(func(name string, contents io.Reader) error {
+		h := sha256.New()
+		if _, err := io.Copy(h, contents); err != nil {
+			return err
+		}
+		names = append(names, name)
+		checksums[name] = hex.EncodeToString(h.Sum(nil))
+		return nil
+	})
+	m, err := LoadVerifiedSavedModel(exportDir, []string{"serve"}, nil, record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.Close()
+	want := []string{
+		"saved_model.pb",
+		"assets/foo.txt",
+		"variables/variables.data-00000-of-00001",
+		"variables/variables.index",
+	}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("Verified %v, want %v", names, want)
+	}
+
+	m, err = LoadVerifiedSavedModel(exportDir, []string{"serve"}, nil, checksums)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.Close()
+
+	checksums["variables/variables.index"] = strings.Repeat("0", 64)
+	if _, err := LoadVerifiedSavedModel(exportDir, []string{"serve"}, nil, checksums); err == nil || !strings.Contains(err.Error(), "variables/variables.index") {
+		t.Errorf("Got %v, want an error for variables/variables.index", err)
+	}
+	delete(checksums, "assets/foo.txt")
+	if _, err := LoadVerifiedSavedModel(exportDir, []string{"serve"}, nil, checksums); err == nil || !strings.Contains(err.Error(), "no checksum for assets/foo.txt") {
+		t.Errorf("Got %v, want an error for assets/foo.txt", err)
+	}
+}
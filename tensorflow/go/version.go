@@ -18,6 +18,72 @@ package tensorflow
 // #include "tensorflow/c/c_api.h"
 import "C"
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
 // Version returns a string describing the version of the underlying TensorFlow
 // runtime.
 func Version() string { return C.GoString(C.TF_Version()) }
+
+// SemanticVersion is a version number of the form MAJOR.MINOR.PATCH, with an
+// optional pre-release suffix (e.g., "1.1.0-rc2").
+type SemanticVersion struct {
+	Major, Minor, Patch int
+	// Prerelease is the part of the version following the first "-", if
+	// any (e.g., "rc2").
+	Prerelease string
+}
+
+// AtLeast returns true if v is the given major.minor.patch version or newer,
+// ignoring any pre-release suffix.
+func (v SemanticVersion) AtLeast(major, minor, patch int) bool {
+	if v.Major != major {
+		return v.Major > major
+	}
+	if v.Minor != minor {
+		return v.Minor > minor
+	}
+	return v.Patch >= patch
+}
+
+func (v SemanticVersion) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Prerelease != "" {
+		s += "-" + v.Prerelease
+	}
+	return s
+}
+
+// RuntimeVersion returns the version of the underlying TensorFlow runtime,
+// which may differ from the version of the C library the program was built
+// against. An error is returned if Version is not a semantic version, as is
+// the case for some builds from source.
+//
+// See also HasCapability, which should be preferred for checking the
+// availability of specific features.
+func RuntimeVersion() (SemanticVersion, error) {
+	return parseVersion(Version())
+}
+
+func parseVersion(s string) (SemanticVersion, error) {
+	var v SemanticVersion
+	core := s
+	if i := strings.Index(s, "-"); i >= 0 {
+		core, v.Prerelease = s[:i], s[i+1:]
+	}
+	parts := strings.Split(core, ".")
+	if len(parts) != 3 {
+		return SemanticVersion{}, fmt.Errorf("invalid version %q: want MAJOR.MINOR.PATCH", s)
+	}
+	for i, p := range []*int{&v.Major, &v.Minor, &v.Patch} {
+		n, err := strconv.Atoi(parts[i])
+		if err != nil || n < 0 {
+			return SemanticVersion{}, fmt.Errorf("invalid version %q: bad component %q", s, parts[i])
+		}
+		*p = n
+	}
+	return v, nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorflow
+
+import "testing"
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		in   string
+		want SemanticVersion
+	}{
+		{"1.0.0", SemanticVersion{Major: 1}},
+		{"1.1.0-rc2", SemanticVersion{Major: 1, Minor: 1, Prerelease: "rc2"}},
+		{"0.12.1", SemanticVersion{Minor: 12, Patch: 1}},
+	}
+	for _, test := range tests {
+		got, err := parseVersion(test.in)
+		if err != nil {
+			t.Errorf("parseVersion(%q): %v", test.in, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("parseVersion(%q) = %+v, want %+v", test.in, got, test.want)
+		}
+		if got.String() != test.in {
+			t.Errorf("parseVersion(%q).String() = %q", test.in, got.String())
+		}
+	}
+	for _, in := range []string{"", "1.0", "1.x.0", "1.0.-1", "head"} {
+		if v, err := parseVersion(in); err == nil {
+			t.Errorf("parseVersion(%q) = %+v, want error", in, v)
+		}
+	}
+}
+
+func TestSemanticVersionAtLeast(t *testing.T) {
+	v := SemanticVersion{Major: 1, Minor: 1, Patch: 2}
+	for _, w := range [][3]int{{0, 12, 0}, {1, 0, 5}, {1, 1, 0}, {1, 1, 2}} {
+		if !v.AtLeast(w[0], w[1], w[2]) {
+			t.Errorf("%v.AtLeast(%v) = false", v, w)
+		}
+	}
+	for _, w := range [][3]int{{1, 1, 3}, {1, 2, 0}, {2, 0, 0}} {
+		if v.AtLeast(w[0], w[1], w[2]) {
+			t.Errorf("%v.AtLeast(%v) = true", v, w)
+		}
+	}
+}
+
+func TestHasCapability(t *testing.T) {
+	// The runtime the tests are built against provides everything used by
+	// this package.
+	for _, c := range []Capability{CapabilityPartialRun, CapabilitySavedModel, CapabilityLoadLibrary} {
+		if !HasCapability(c) {
+			t.Errorf("HasCapability(%v) = false", c)
+		}
+	}
+	if c := Capability(-1); HasCapability(c) {
+		t.Errorf("HasCapability(%v) = true", c)
+	}
+}
@@ -0,0 +1,290 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vocab reads and writes vocabulary files in the format of the
+// lookup table initializers of TensorFlow (InitializeTableFromTextFile, as
+// used by index_table_from_file in Python), and mirrors the lookups of the
+// tables in memory, so that tokenization done on the host can be checked
+// against the graph.
+//
+// As in TensorFlow, a vocabulary file has one entry per line, terminated by
+// "\n". Carriage returns are ignored and empty lines are invalid. The key
+// and the value of each entry are either a column of the line, split on a
+// delimiter, the whole line (WholeLine) or the line number (LineNumber).
+//
+// WARNING: The API in this package has not been finalized and can
+// change without notice.
+package vocab
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/tensorflow/tensorflow/tensorflow/go/hostop"
+)
+
+// Special column indices of Format, with the values used by the
+// key_index and value_index attributes of InitializeTableFromTextFile.
+const (
+	// WholeLine selects the whole line.
+	WholeLine = -2
+	// LineNumber selects the line number, starting at 0.
+	LineNumber = -1
+)
+
+// Format describes the entries of a vocabulary file.
+type Format struct {
+	// KeyIndex is the column of the tokens, or WholeLine.
+	KeyIndex int
+	// ValueIndex is the column of the ids, which must be integers, or
+	// LineNumber.
+	ValueIndex int
+	// Delimiter separates the columns of a line.
+	Delimiter byte
+	// VocabSize, if positive, is the number of lines to read. The file
+	// must have at least that many lines.
+	VocabSize int
+}
+
+// Common formats of vocabulary files.
+var (
+	// TokenPerLine files list one token per line, whose id is its line
+	// number. It is the default format of index_table_from_file.
+	TokenPerLine = Format{KeyIndex: WholeLine, ValueIndex: LineNumber, Delimiter: '\t'}
+	// TokenAndID files list a token and its id per line, separated by a
+	// tab.
+	TokenAndID = Format{KeyIndex: 0, ValueIndex: 1, Delimiter: '\t'}
+	// TokenAndCount files list a token and its frequency per line,
+	// separated by a tab, as written by WriteCounts. The id of a token is
+	// its line number.
+	TokenAndCount = Format{KeyIndex: 0, ValueIndex: LineNumber, Delimiter: '\t'}
+)
+
+// Vocabulary maps tokens to ids like a lookup table initialized from a
+// vocabulary file.
+type Vocabulary struct {
+	// OOVBuckets is the number of buckets of the tokens that are not in
+	// the vocabulary (num_oov_buckets of index_table_from_file). If
+	// positive, such a token has the id Len() + Fingerprint64(token) %
+	// OOVBuckets; otherwise its id is Default.
+	OOVBuckets int
+	// Default is the id of tokens that are not in the vocabulary when
+	// there are no OOV buckets. Read sets it to -1, as in TensorFlow.
+	Default int64
+
+	ids    map[string]int64
+	tokens map[int64]string
+}
+
+// Read reads a vocabulary file in the format f from r. It fails on the same
+// files as InitializeTableFromTextFile, with an error naming the offending
+// line, including when two lines have the same token and different ids.
+func Read(r io.Reader, f Format) (*Vocabulary, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	lines := splitLines(data)
+	if f.VocabSize > 0 {
+		if len(lines) < f.VocabSize {
+			return nil, fmt.Errorf("invalid vocab_size: expected %d lines, got %d", f.VocabSize, len(lines))
+		}
+		lines = lines[:f.VocabSize]
+	}
+	v := &Vocabulary{
+		Default: -1,
+		ids:     make(map[string]int64, len(lines)),
+		tokens:  make(map[int64]string, len(lines)),
+	}
+	for i, line := range lines {
+		if line == "" {
+			return nil, fmt.Errorf("line %d: empty line", i)
+		}
+		var columns []string
+		if f.KeyIndex >= 0 || f.ValueIndex >= 0 {
+			columns = strings.Split(line, string(f.Delimiter))
+			if max(f.KeyIndex, f.ValueIndex) >= len(columns) {
+				return nil, fmt.Errorf("line %d (%q): expected %d columns, got %d", i, line, max(f.KeyIndex, f.ValueIndex)+1, len(columns))
+			}
+		}
+		var token string
+		switch {
+		case f.KeyIndex == WholeLine:
+			token = line
+		case f.KeyIndex >= 0:
+			token = columns[f.KeyIndex]
+		default:
+			return nil, fmt.Errorf("invalid key index %d", f.KeyIndex)
+		}
+		var id int64
+		switch {
+		case f.ValueIndex == LineNumber:
+			id = int64(i)
+		case f.ValueIndex >= 0:
+			if id, err = strconv.ParseInt(strings.TrimSpace(columns[f.ValueIndex]), 10, 64); err != nil {
+				return nil, fmt.Errorf("line %d: field %q is not a valid int64", i, columns[f.ValueIndex])
+			}
+		default:
+			return nil, fmt.Errorf("invalid value index %d", f.ValueIndex)
+		}
+		if old, ok := v.ids[token]; ok && old != id {
+			return nil, fmt.Errorf("line %d: token %q has id %d and %d", i, token, old, id)
+		}
+		v.ids[token] = id
+		if _, ok := v.tokens[id]; !ok {
+			v.tokens[id] = token
+		}
+	}
+	return v, nil
+}
+
+// splitLines splits data as the line reader of TensorFlow does.
+func splitLines(data []byte) []string {
+	data = bytes.Replace(data, []byte("\r"), nil, -1)
+	if len(data) == 0 {
+		return nil
+	}
+	lines := strings.Split(string(data), "\n")
+	if lines[len(lines)-1] == "" {
+		// The last line is terminated.
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// Len returns the number of tokens of the vocabulary.
+func (v *Vocabulary) Len() int {
+	return len(v.ids)
+}
+
+// Lookup returns the id of token, as LookupTableFind does for a table
+// created by index_table_from_file with v.OOVBuckets and v.Default.
+func (v *Vocabulary) Lookup(token string) int64 {
+	if id, ok := v.ids[token]; ok {
+		return id
+	}
+	if v.OOVBuckets > 0 {
+		return int64(v.Len()) + int64(hostop.Fingerprint64([]byte(token))%uint64(v.OOVBuckets))
+	}
+	return v.Default
+}
+
+// LookupAll returns the ids of tokens.
+func (v *Vocabulary) LookupAll(tokens []string) []int64 {
+	ids := make([]int64, len(tokens))
+	for i, t := range tokens {
+		ids[i] = v.Lookup(t)
+	}
+	return ids
+}
+
+// Token returns the token of id, as index_to_string_table_from_file does.
+// If several tokens have the id, the first one in the file is returned. ok
+// is false if no token has the id.
+func (v *Vocabulary) Token(id int64) (token string, ok bool) {
+	token, ok = v.tokens[id]
+	return token, ok
+}
+
+// Write writes tokens in the TokenPerLine format. It fails if a token
+// cannot be read back unchanged, i.e. if it is empty, contains a line
+// terminator or is repeated.
+func Write(w io.Writer, tokens []string) error {
+	bw := bufio.NewWriter(w)
+	seen := make(map[string]bool, len(tokens))
+	for _, t := range tokens {
+		if err := checkToken(t, 0); err != nil {
+			return err
+		}
+		if seen[t] {
+			return fmt.Errorf("duplicate token %q", t)
+		}
+		seen[t] = true
+		bw.WriteString(t)
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
+
+// TokenCount is a token and its frequency in a corpus.
+type TokenCount struct {
+	Token string
+	Count int64
+}
+
+// WriteCounts writes counts in the TokenAndCount format, in order. It
+// fails under the same conditions as Write, or if a token contains a tab.
+func WriteCounts(w io.Writer, counts []TokenCount) error {
+	bw := bufio.NewWriter(w)
+	seen := make(map[string]bool, len(counts))
+	for _, c := range counts {
+		if err := checkToken(c.Token, '\t'); err != nil {
+			return err
+		}
+		if seen[c.Token] {
+			return fmt.Errorf("duplicate token %q", c.Token)
+		}
+		seen[c.Token] = true
+		fmt.Fprintf(bw, "%s\t%d\n", c.Token, c.Count)
+	}
+	return bw.Flush()
+}
+
+// ReadCounts reads a file written by WriteCounts.
+func ReadCounts(r io.Reader) ([]TokenCount, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	lines := splitLines(data)
+	counts := make([]TokenCount, len(lines))
+	for i, line := range lines {
+		fields := strings.Split(line, "\t")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("line %d (%q): expected 2 columns, got %d", i, line, len(fields))
+		}
+		n, err := strconv.ParseInt(strings.TrimSpace(fields[1]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: field %q is not a valid int64", i, fields[1])
+		}
+		counts[i] = TokenCount{Token: fields[0], Count: n}
+	}
+	return counts, nil
+}
+
+// checkToken returns an error if t cannot be written as a column of a
+// vocabulary file delimited by delimiter (0 for no columns).
+func checkToken(t string, delimiter byte) error {
+	switch {
+	case t == "":
+		return fmt.Errorf("empty token")
+	case strings.ContainsAny(t, "\r\n"):
+		return fmt.Errorf("token %q contains a line terminator", t)
+	case delimiter != 0 && strings.IndexByte(t, delimiter) >= 0:
+		return fmt.Errorf("token %q contains the delimiter %q", t, delimiter)
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+// Copyright 2017 The TensorFlow Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vocab
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	tf "github.com/tensorflow/tensorflow/tensorflow/go"
+	"github.com/tensorflow/tensorflow/tensorflow/go/hostop"
+	"github.com/tensorflow/tensorflow/tensorflow/go/op"
+)
+
+func TestRead(t *testing.T) {
+	tests := []struct {
+		contents string
+		format   Format
+		want     map[string]int64
+	}{
+		{"a\nb\r\nc", TokenPerLine, map[string]int64{"a": 0, "b": 1, "c": 2}},
+		{"a b\nc\n", TokenPerLine, map[string]int64{"a b": 0, "c": 1}},
+		{"a\t7\nb\t 3\n", TokenAndID, map[string]int64{"a": 7, "b": 3}},
+		{"a\t7\nb\t3\na\t7\n", TokenAndID, map[string]int64{"a": 7, "b": 3}},
+		{"x\t10\ny\t5\n", TokenAndCount, map[string]int64{"x": 0, "y": 1}},
+		{"a\nb\nc\n", Format{KeyIndex: WholeLine, ValueIndex: LineNumber, VocabSize: 2}, map[string]int64{"a": 0, "b": 1}},
+		{"1,a\n2,b\n", Format{KeyIndex: 1, ValueIndex: 0, Delimiter: ','}, map[string]int64{"a": 1, "b": 2}},
+	}
+	for _, test := range tests {
+		v, err := Read(strings.NewReader(test.contents), test.format)
+		if err != nil {
+			t.Errorf("Read(%q): %v", test.contents, err)
+			continue
+		}
+		if !reflect.DeepEqual(v.ids, test.want) {
+			t.Errorf("Read(%q): got %v, want %v", test.contents, v.ids, test.want)
+		}
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	tests := []struct {
+		contents string
+		format   Format
+	}{
+		{"a\n\nb\n", TokenPerLine},
+		{"a\nb\na\n", TokenPerLine},
+		{"a\t1\nb\n", TokenAndID},
+		{"a\tone\n", TokenAndID},
+		{"a\nb\n", Format{KeyIndex: WholeLine, ValueIndex: LineNumber, VocabSize: 3}},
+		{"a\n", Format{KeyIndex: LineNumber, ValueIndex: LineNumber}},
+	}
+	for _, test := range tests {
+		if v, err := Read(strings.NewReader(test.contents), test.format); err == nil {
+			t.Errorf("Read(%q, %+v): got %v, want an error", test.contents, test.format, v.ids)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	v, err := Read(strings.NewReader("a\nb\nc\n"), TokenPerLine)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := v.LookupAll([]string{"c", "a", "Hello"}), []int64{2, 0, -1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Got %v, want %v", got, want)
+	}
+	v.OOVBuckets = 5
+	if got, want := v.Lookup("Hello"), int64(3+hostop.Fingerprint64([]byte("Hello"))%5); got != want {
+		t.Errorf("Got %d, want %d", got, want)
+	}
+	if token, ok := v.Token(1); !ok || token != "b" {
+		t.Errorf("Got (%q, %v), want b", token, ok)
+	}
+	if token, ok := v.Token(3); ok {
+		t.Errorf("Got %q for an unknown id", token)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, []string{"a", "b c", "d\te"}); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), "a\nb c\nd\te\n"; got != want {
+		t.Errorf("Got %q, want %q", got, want)
+	}
+	for _, tokens := range [][]string{{""}, {"a\nb"}, {"a\r"}, {"a", "a"}} {
+		if err := Write(ioutil.Discard, tokens); err == nil {
+			t.Errorf("Write(%q) succeeded, want an error", tokens)
+		}
+	}
+
+	buf.Reset()
+	counts := []TokenCount{{"the", 10}, {"a", 7}}
+	if err := WriteCounts(&buf, counts); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), "the\t10\na\t7\n"; got != want {
+		t.Errorf("Got %q, want %q", got, want)
+	}
+	got, err := ReadCounts(bytes.NewReader(buf.Bytes()))
+	if err != nil || !reflect.DeepEqual(got, counts) {
+		t.Errorf("ReadCounts: got (%v, %v), want %v", got, err, counts)
+	}
+	if err := WriteCounts(ioutil.Discard, []TokenCount{{"a\tb", 1}}); err == nil {
+		t.Error("WriteCounts of a token with a tab succeeded, want an error")
+	}
+}
+
+// TestMatchesTensorFlow checks that a Vocabulary and a table initialized
+// from the same file by TensorFlow agree.
+func TestMatchesTensorFlow(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vocab")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "vocab.txt")
+	if err := ioutil.WriteFile(filename, []byte("w1\t5\r\nw 2\t3\nw3\t-1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	tokens := []string{"w1", "w 2", "w3", "w4", ""}
+	for _, f := range []Format{TokenPerLine, TokenAndID, TokenAndCount} {
+		s := op.NewScope()
+		table := op.Raw(s, "HashTable", nil, map[string]interface{}{"key_dtype": tf.String, "value_dtype": tf.Int64})
+		initialize := op.Raw(s, "InitializeTableFromTextFile", []tf.Input{table.Output(0), op.Const(s, filename)}, map[string]interface{}{
+			"key_index":   int64(f.KeyIndex),
+			"value_index": int64(f.ValueIndex),
+			"delimiter":   string(f.Delimiter),
+		})
+		find := op.Raw(s, "LookupTableFind", []tf.Input{table.Output(0), op.Const(s, tokens), op.Const(s, int64(-1))}, nil)
+		graph, err := s.Finalize()
+		if err != nil {
+			t.Fatal(err)
+		}
+		sess, err := tf.NewSession(graph, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer sess.Close()
+		if _, err := sess.Run(nil, nil, []*tf.Operation{initialize}); err != nil {
+			t.Fatal(err)
+		}
+		out, err := sess.Run(nil, []tf.Output{find.Output(0)}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		contents, err := os.Open(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		v, err := Read(contents, f)
+		contents.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := v.LookupAll(tokens), out[0].Value(); !reflect.DeepEqual(got, want) {
+			t.Errorf("%+v: got %v, want %v", f, got, want)
+		}
+	}
+}